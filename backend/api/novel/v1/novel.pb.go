@@ -75,7 +75,7 @@ func (x GenerateChapterStreamResponse_ResponseType) Number() protoreflect.EnumNu
 
 // Deprecated: Use GenerateChapterStreamResponse_ResponseType.Descriptor instead.
 func (GenerateChapterStreamResponse_ResponseType) EnumDescriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{23, 0}
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{24, 0}
 }
 
 // 项目相关消息
@@ -484,6 +484,8 @@ type UpdateProjectRequest struct {
 	Themes []string `protobuf:"bytes,7,rep,name=themes,proto3" json:"themes,omitempty"`
 	// 大纲
 	Outline *Outline `protobuf:"bytes,8,opt,name=outline,proto3" json:"outline,omitempty"`
+	// 期望的项目版本号，大于0时版本不一致将返回 VERSION_CONFLICT
+	ExpectedVersion int64 `protobuf:"varint,9,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
 func (x *UpdateProjectRequest) Reset() {
@@ -574,6 +576,13 @@ func (x *UpdateProjectRequest) GetOutline() *Outline {
 	return nil
 }
 
+func (x *UpdateProjectRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // 更新项目响应
 type UpdateProjectResponse struct {
 	state         protoimpl.MessageState
@@ -1063,6 +1072,8 @@ type UpdateChapterOutlineRequest struct {
 	TwistHint string `protobuf:"bytes,6,opt,name=twist_hint,json=twistHint,proto3" json:"twist_hint,omitempty"`
 	// 关键道具/线索
 	ImportantItems []string `protobuf:"bytes,7,rep,name=important_items,json=importantItems,proto3" json:"important_items,omitempty"`
+	// 期望的项目版本号，大于0时版本不一致将返回 VERSION_CONFLICT
+	ExpectedVersion int64 `protobuf:"varint,8,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
 func (x *UpdateChapterOutlineRequest) Reset() {
@@ -1146,6 +1157,13 @@ func (x *UpdateChapterOutlineRequest) GetImportantItems() []string {
 	return nil
 }
 
+func (x *UpdateChapterOutlineRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // 更新章节大纲响应
 type UpdateChapterOutlineResponse struct {
 	state         protoimpl.MessageState
@@ -1205,6 +1223,8 @@ type DeleteChapterOutlineRequest struct {
 	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	// 章节索引
 	ChapterIndex int32 `protobuf:"varint,2,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 期望的项目版本号，大于0时版本不一致将返回 VERSION_CONFLICT
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
 func (x *DeleteChapterOutlineRequest) Reset() {
@@ -1253,6 +1273,13 @@ func (x *DeleteChapterOutlineRequest) GetChapterIndex() int32 {
 	return 0
 }
 
+func (x *DeleteChapterOutlineRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // 删除章节大纲响应
 type DeleteChapterOutlineResponse struct {
 	state         protoimpl.MessageState
@@ -1312,6 +1339,8 @@ type ReorderChapterOutlineRequest struct {
 	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	// 章节索引映射：旧索引 -> 新索引
 	ChapterMappings []*ChapterIndexMapping `protobuf:"bytes,2,rep,name=chapter_mappings,json=chapterMappings,proto3" json:"chapter_mappings,omitempty"`
+	// 期望的项目版本号，大于0时版本不一致将返回 VERSION_CONFLICT
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
 func (x *ReorderChapterOutlineRequest) Reset() {
@@ -1360,6 +1389,13 @@ func (x *ReorderChapterOutlineRequest) GetChapterMappings() []*ChapterIndexMappi
 	return nil
 }
 
+func (x *ReorderChapterOutlineRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // 章节索引映射
 type ChapterIndexMapping struct {
 	state         protoimpl.MessageState
@@ -1560,6 +1596,8 @@ type GenerateChapterResponse struct {
 
 	// 章节内容
 	Chapter *Chapter `protobuf:"bytes,1,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	// 生成时引用的前文片段
+	Grounding []*RetrievedChunk `protobuf:"bytes,2,rep,name=grounding,proto3" json:"grounding,omitempty"`
 }
 
 func (x *GenerateChapterResponse) Reset() {
@@ -1601,6 +1639,134 @@ func (x *GenerateChapterResponse) GetChapter() *Chapter {
 	return nil
 }
 
+func (x *GenerateChapterResponse) GetGrounding() []*RetrievedChunk {
+	if x != nil {
+		return x.Grounding
+	}
+	return nil
+}
+
+// 章节生成时引用的知识库片段
+type RetrievedChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 向量库文档ID
+	DocumentId string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// 用途：character_scene/item_mention/world_rule
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 触发检索的人物、道具或规则
+	Subjects []string `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// 来源章节ID，世界规则为空
+	ChapterId string `protobuf:"bytes,4,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 来源章节索引
+	ChapterIndex int32 `protobuf:"varint,5,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 来源章节标题
+	ChapterTitle string `protobuf:"bytes,6,opt,name=chapter_title,json=chapterTitle,proto3" json:"chapter_title,omitempty"`
+	// 片段内容
+	Content string `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	// 估算的token数
+	Tokens int32 `protobuf:"varint,8,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// 检索分数
+	Score float32 `protobuf:"fixed32,9,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *RetrievedChunk) Reset() {
+	*x = RetrievedChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetrievedChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrievedChunk) ProtoMessage() {}
+
+func (x *RetrievedChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrievedChunk.ProtoReflect.Descriptor instead.
+func (*RetrievedChunk) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RetrievedChunk) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *RetrievedChunk) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RetrievedChunk) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *RetrievedChunk) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *RetrievedChunk) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *RetrievedChunk) GetChapterTitle() string {
+	if x != nil {
+		return x.ChapterTitle
+	}
+	return ""
+}
+
+func (x *RetrievedChunk) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RetrievedChunk) GetTokens() int32 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *RetrievedChunk) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
 // 流式章节生成响应
 type GenerateChapterStreamResponse struct {
 	state         protoimpl.MessageState
@@ -1623,12 +1789,14 @@ type GenerateChapterStreamResponse struct {
 	ErrorCode    string `protobuf:"bytes,10,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`         // 错误代码
 	// 完成信息
 	FinalChapter *Chapter `protobuf:"bytes,11,opt,name=final_chapter,json=finalChapter,proto3" json:"final_chapter,omitempty"` // 最终章节对象
+	// 生成时引用的前文片段，随 METADATA 消息发送
+	Grounding []*RetrievedChunk `protobuf:"bytes,12,rep,name=grounding,proto3" json:"grounding,omitempty"`
 }
 
 func (x *GenerateChapterStreamResponse) Reset() {
 	*x = GenerateChapterStreamResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[23]
+		mi := &file_novel_v1_novel_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1641,7 +1809,7 @@ func (x *GenerateChapterStreamResponse) String() string {
 func (*GenerateChapterStreamResponse) ProtoMessage() {}
 
 func (x *GenerateChapterStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[23]
+	mi := &file_novel_v1_novel_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1654,7 +1822,7 @@ func (x *GenerateChapterStreamResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateChapterStreamResponse.ProtoReflect.Descriptor instead.
 func (*GenerateChapterStreamResponse) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{23}
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GenerateChapterStreamResponse) GetType() GenerateChapterStreamResponse_ResponseType {
@@ -1734,6 +1902,13 @@ func (x *GenerateChapterStreamResponse) GetFinalChapter() *Chapter {
 	return nil
 }
 
+func (x *GenerateChapterStreamResponse) GetGrounding() []*RetrievedChunk {
+	if x != nil {
+		return x.Grounding
+	}
+	return nil
+}
+
 // 润色相关消息
 type PolishChapterRequest struct {
 	state         protoimpl.MessageState
@@ -1750,12 +1925,14 @@ type PolishChapterRequest struct {
 	Focus []string `protobuf:"bytes,4,rep,name=focus,proto3" json:"focus,omitempty"`
 	// LLM选项
 	LlmOptions *LLMOptions `protobuf:"bytes,5,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
+	// 期望的章节版本号，大于0时版本不一致将返回 VERSION_CONFLICT
+	ExpectedVersion int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
 func (x *PolishChapterRequest) Reset() {
 	*x = PolishChapterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[24]
+		mi := &file_novel_v1_novel_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1768,7 +1945,7 @@ func (x *PolishChapterRequest) String() string {
 func (*PolishChapterRequest) ProtoMessage() {}
 
 func (x *PolishChapterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[24]
+	mi := &file_novel_v1_novel_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1781,7 +1958,7 @@ func (x *PolishChapterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolishChapterRequest.ProtoReflect.Descriptor instead.
 func (*PolishChapterRequest) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{24}
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PolishChapterRequest) GetProjectId() string {
@@ -1819,6 +1996,13 @@ func (x *PolishChapterRequest) GetLlmOptions() *LLMOptions {
 	return nil
 }
 
+func (x *PolishChapterRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 // 润色章节响应
 type PolishChapterResponse struct {
 	state         protoimpl.MessageState
@@ -1832,7 +2016,7 @@ type PolishChapterResponse struct {
 func (x *PolishChapterResponse) Reset() {
 	*x = PolishChapterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[25]
+		mi := &file_novel_v1_novel_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1845,7 +2029,7 @@ func (x *PolishChapterResponse) String() string {
 func (*PolishChapterResponse) ProtoMessage() {}
 
 func (x *PolishChapterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[25]
+	mi := &file_novel_v1_novel_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,7 +2042,7 @@ func (x *PolishChapterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolishChapterResponse.ProtoReflect.Descriptor instead.
 func (*PolishChapterResponse) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{25}
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PolishChapterResponse) GetPolishedChapter() *Chapter {
@@ -1878,7 +2062,7 @@ type CheckQualityRequest struct {
 	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	// 章节ID
 	ChapterId string `protobuf:"bytes,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
-	// polish/proofread/critique/consistency/all
+	// polish/proofread/critique/consistency/metrics/all，metrics 只计算客观指标，不调用模型
 	CheckType string `protobuf:"bytes,3,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
 	// LLM选项
 	LlmOptions *LLMOptions `protobuf:"bytes,4,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
@@ -1887,7 +2071,7 @@ type CheckQualityRequest struct {
 func (x *CheckQualityRequest) Reset() {
 	*x = CheckQualityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[26]
+		mi := &file_novel_v1_novel_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1900,7 +2084,7 @@ func (x *CheckQualityRequest) String() string {
 func (*CheckQualityRequest) ProtoMessage() {}
 
 func (x *CheckQualityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[26]
+	mi := &file_novel_v1_novel_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1913,7 +2097,7 @@ func (x *CheckQualityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckQualityRequest.ProtoReflect.Descriptor instead.
 func (*CheckQualityRequest) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{26}
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CheckQualityRequest) GetProjectId() string {
@@ -1962,12 +2146,16 @@ type CheckQualityResponse struct {
 	OverallScore float64 `protobuf:"fixed64,5,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
 	// 质量检测建议
 	Recommendations []string `protobuf:"bytes,6,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	// 正文的客观指标
+	Metrics *TextMetrics `protobuf:"bytes,7,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// 与其他章节相似的段落，仅重复检测返回
+	Repetitions []*RepetitionPair `protobuf:"bytes,8,rep,name=repetitions,proto3" json:"repetitions,omitempty"`
 }
 
 func (x *CheckQualityResponse) Reset() {
 	*x = CheckQualityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[27]
+		mi := &file_novel_v1_novel_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1980,7 +2168,7 @@ func (x *CheckQualityResponse) String() string {
 func (*CheckQualityResponse) ProtoMessage() {}
 
 func (x *CheckQualityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[27]
+	mi := &file_novel_v1_novel_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1993,7 +2181,7 @@ func (x *CheckQualityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckQualityResponse.ProtoReflect.Descriptor instead.
 func (*CheckQualityResponse) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{27}
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CheckQualityResponse) GetPolishedChapter() *Chapter {
@@ -2038,39 +2226,51 @@ func (x *CheckQualityResponse) GetRecommendations() []string {
 	return nil
 }
 
-// 批量质量检测请求
-type BatchCheckQualityRequest struct {
+func (x *CheckQualityResponse) GetMetrics() *TextMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *CheckQualityResponse) GetRepetitions() []*RepetitionPair {
+	if x != nil {
+		return x.Repetitions
+	}
+	return nil
+}
+
+// 两个章节中相似的一对段落
+type RepetitionPair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 项目ID
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// 章节ID列表
-	ChapterIds []string `protobuf:"bytes,2,rep,name=chapter_ids,json=chapterIds,proto3" json:"chapter_ids,omitempty"`
-	// polish/proofread/critique/consistency/all
-	CheckType string `protobuf:"bytes,3,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
-	// LLM选项
-	LlmOptions *LLMOptions `protobuf:"bytes,4,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
+	// 较早章节中的段落
+	Source *TextSpan `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// 较晚章节中的段落
+	Target *TextSpan `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// 相似度 0-1
+	Similarity float64 `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
 }
 
-func (x *BatchCheckQualityRequest) Reset() {
-	*x = BatchCheckQualityRequest{}
+func (x *RepetitionPair) Reset() {
+	*x = RepetitionPair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[28]
+		mi := &file_novel_v1_novel_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCheckQualityRequest) String() string {
+func (x *RepetitionPair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCheckQualityRequest) ProtoMessage() {}
+func (*RepetitionPair) ProtoMessage() {}
 
-func (x *BatchCheckQualityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[28]
+func (x *RepetitionPair) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2081,70 +2281,61 @@ func (x *BatchCheckQualityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCheckQualityRequest.ProtoReflect.Descriptor instead.
-func (*BatchCheckQualityRequest) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *BatchCheckQualityRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use RepetitionPair.ProtoReflect.Descriptor instead.
+func (*RepetitionPair) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *BatchCheckQualityRequest) GetChapterIds() []string {
+func (x *RepetitionPair) GetSource() *TextSpan {
 	if x != nil {
-		return x.ChapterIds
+		return x.Source
 	}
 	return nil
 }
 
-func (x *BatchCheckQualityRequest) GetCheckType() string {
+func (x *RepetitionPair) GetTarget() *TextSpan {
 	if x != nil {
-		return x.CheckType
+		return x.Target
 	}
-	return ""
+	return nil
 }
 
-func (x *BatchCheckQualityRequest) GetLlmOptions() *LLMOptions {
+func (x *RepetitionPair) GetSimilarity() float64 {
 	if x != nil {
-		return x.LlmOptions
+		return x.Similarity
 	}
-	return nil
+	return 0
 }
 
-// 批量质量检测响应
-type BatchCheckQualityResponse struct {
+// 短语及其出现次数
+type PhraseCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 每个章节的质量检测结果
-	Results []*CheckQualityResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	// 质量检测摘要
-	Summary *QualitySummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
-	// 总质量分
-	OverallScore float64 `protobuf:"fixed64,3,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	// 短语
+	Phrase string `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
+	// 出现次数
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *BatchCheckQualityResponse) Reset() {
-	*x = BatchCheckQualityResponse{}
+func (x *PhraseCount) Reset() {
+	*x = PhraseCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[29]
+		mi := &file_novel_v1_novel_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchCheckQualityResponse) String() string {
+func (x *PhraseCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchCheckQualityResponse) ProtoMessage() {}
+func (*PhraseCount) ProtoMessage() {}
 
-func (x *BatchCheckQualityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[29]
+func (x *PhraseCount) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,63 +2346,84 @@ func (x *BatchCheckQualityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchCheckQualityResponse.ProtoReflect.Descriptor instead.
-func (*BatchCheckQualityResponse) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *BatchCheckQualityResponse) GetResults() []*CheckQualityResponse {
-	if x != nil {
-		return x.Results
-	}
-	return nil
+// Deprecated: Use PhraseCount.ProtoReflect.Descriptor instead.
+func (*PhraseCount) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *BatchCheckQualityResponse) GetSummary() *QualitySummary {
+func (x *PhraseCount) GetPhrase() string {
 	if x != nil {
-		return x.Summary
+		return x.Phrase
 	}
-	return nil
+	return ""
 }
 
-func (x *BatchCheckQualityResponse) GetOverallScore() float64 {
+func (x *PhraseCount) GetCount() int32 {
 	if x != nil {
-		return x.OverallScore
+		return x.Count
 	}
 	return 0
 }
 
-// 语法检查结果
-type ProofreadResult struct {
+// 正文的客观指标，字数按字符计，不含空白
+type TextMetrics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 修正后的章节内容
-	CorrectedContent string `protobuf:"bytes,1,opt,name=corrected_content,json=correctedContent,proto3" json:"corrected_content,omitempty"`
-	// 语法错误列表
-	Issues []*QualityIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
-	// 语法修正建议
-	Suggestions []string `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
-}
-
-func (x *ProofreadResult) Reset() {
-	*x = ProofreadResult{}
+	// 正文字数
+	CharCount int32 `protobuf:"varint,1,opt,name=char_count,json=charCount,proto3" json:"char_count,omitempty"`
+	// 句子数
+	SentenceCount int32 `protobuf:"varint,2,opt,name=sentence_count,json=sentenceCount,proto3" json:"sentence_count,omitempty"`
+	// 平均句长
+	AvgSentenceLength float64 `protobuf:"fixed64,3,opt,name=avg_sentence_length,json=avgSentenceLength,proto3" json:"avg_sentence_length,omitempty"`
+	// 句长标准差
+	SentenceLengthStdDev float64 `protobuf:"fixed64,4,opt,name=sentence_length_std_dev,json=sentenceLengthStdDev,proto3" json:"sentence_length_std_dev,omitempty"`
+	// 句长分布，键为句长区间：1-10/11-30/31-60/61+
+	SentenceLengths map[string]int32 `protobuf:"bytes,5,rep,name=sentence_lengths,json=sentenceLengths,proto3" json:"sentence_lengths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// 段落数
+	ParagraphCount int32 `protobuf:"varint,6,opt,name=paragraph_count,json=paragraphCount,proto3" json:"paragraph_count,omitempty"`
+	// 平均段落字数
+	AvgParagraphLength float64 `protobuf:"fixed64,7,opt,name=avg_paragraph_length,json=avgParagraphLength,proto3" json:"avg_paragraph_length,omitempty"`
+	// 最长段落字数
+	MaxParagraphLength int32 `protobuf:"varint,8,opt,name=max_paragraph_length,json=maxParagraphLength,proto3" json:"max_paragraph_length,omitempty"`
+	// 引号内对话字数占正文的比例，其余为叙述
+	DialogueRatio float64 `protobuf:"fixed64,9,opt,name=dialogue_ratio,json=dialogueRatio,proto3" json:"dialogue_ratio,omitempty"`
+	// 反复出现的片段
+	RepeatedNgrams []*PhraseCount `protobuf:"bytes,10,rep,name=repeated_ngrams,json=repeatedNgrams,proto3" json:"repeated_ngrams,omitempty"`
+	// 使用过多的套话
+	OverusedPhrases []*PhraseCount `protobuf:"bytes,11,rep,name=overused_phrases,json=overusedPhrases,proto3" json:"overused_phrases,omitempty"`
+	// 副词数
+	AdverbCount int32 `protobuf:"varint,12,opt,name=adverb_count,json=adverbCount,proto3" json:"adverb_count,omitempty"`
+	// 每千字副词数
+	AdverbDensity float64 `protobuf:"fixed64,13,opt,name=adverb_density,json=adverbDensity,proto3" json:"adverb_density,omitempty"`
+	// 标点数
+	PunctuationCount int32 `protobuf:"varint,14,opt,name=punctuation_count,json=punctuationCount,proto3" json:"punctuation_count,omitempty"`
+	// 中文语境中误用的半角标点数
+	HalfWidthPunctuation int32 `protobuf:"varint,15,opt,name=half_width_punctuation,json=halfWidthPunctuation,proto3" json:"half_width_punctuation,omitempty"`
+	// 误用的半角标点占标点的比例
+	PunctuationMisuseRatio float64 `protobuf:"fixed64,16,opt,name=punctuation_misuse_ratio,json=punctuationMisuseRatio,proto3" json:"punctuation_misuse_ratio,omitempty"`
+	// 综合指标得分 0-1
+	Score float64 `protobuf:"fixed64,17,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *TextMetrics) Reset() {
+	*x = TextMetrics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[30]
+		mi := &file_novel_v1_novel_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProofreadResult) String() string {
+func (x *TextMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProofreadResult) ProtoMessage() {}
+func (*TextMetrics) ProtoMessage() {}
 
-func (x *ProofreadResult) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[30]
+func (x *TextMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2222,148 +2434,149 @@ func (x *ProofreadResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProofreadResult.ProtoReflect.Descriptor instead.
-func (*ProofreadResult) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use TextMetrics.ProtoReflect.Descriptor instead.
+func (*TextMetrics) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ProofreadResult) GetCorrectedContent() string {
+func (x *TextMetrics) GetCharCount() int32 {
 	if x != nil {
-		return x.CorrectedContent
+		return x.CharCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ProofreadResult) GetIssues() []*QualityIssue {
+func (x *TextMetrics) GetSentenceCount() int32 {
 	if x != nil {
-		return x.Issues
+		return x.SentenceCount
 	}
-	return nil
+	return 0
 }
 
-func (x *ProofreadResult) GetSuggestions() []string {
+func (x *TextMetrics) GetAvgSentenceLength() float64 {
 	if x != nil {
-		return x.Suggestions
+		return x.AvgSentenceLength
 	}
-	return nil
+	return 0
 }
 
-// 逻辑检查结果
-type CritiqueResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// 逻辑错误列表
-	LogicalIssues []string `protobuf:"bytes,1,rep,name=logical_issues,json=logicalIssues,proto3" json:"logical_issues,omitempty"`
-	// 人物关系错误列表
-	CharacterIssues []string `protobuf:"bytes,2,rep,name=character_issues,json=characterIssues,proto3" json:"character_issues,omitempty"`
-	// pacing/flow 错误列表
-	PacingIssues []string `protobuf:"bytes,3,rep,name=pacing_issues,json=pacingIssues,proto3" json:"pacing_issues,omitempty"`
-	// 改进建议列表
-	Improvements []string `protobuf:"bytes,4,rep,name=improvements,proto3" json:"improvements,omitempty"`
-	// 修正后的示例段落
-	FixedExample string `protobuf:"bytes,5,opt,name=fixed_example,json=fixedExample,proto3" json:"fixed_example,omitempty"`
-	// 总质量分
-	OverallScore int32 `protobuf:"varint,6,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+func (x *TextMetrics) GetSentenceLengthStdDev() float64 {
+	if x != nil {
+		return x.SentenceLengthStdDev
+	}
+	return 0
 }
 
-func (x *CritiqueResult) Reset() {
-	*x = CritiqueResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TextMetrics) GetSentenceLengths() map[string]int32 {
+	if x != nil {
+		return x.SentenceLengths
 	}
+	return nil
 }
 
-func (x *CritiqueResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TextMetrics) GetParagraphCount() int32 {
+	if x != nil {
+		return x.ParagraphCount
+	}
+	return 0
 }
 
-func (*CritiqueResult) ProtoMessage() {}
+func (x *TextMetrics) GetAvgParagraphLength() float64 {
+	if x != nil {
+		return x.AvgParagraphLength
+	}
+	return 0
+}
 
-func (x *CritiqueResult) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TextMetrics) GetMaxParagraphLength() int32 {
+	if x != nil {
+		return x.MaxParagraphLength
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CritiqueResult.ProtoReflect.Descriptor instead.
-func (*CritiqueResult) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{31}
+func (x *TextMetrics) GetDialogueRatio() float64 {
+	if x != nil {
+		return x.DialogueRatio
+	}
+	return 0
 }
 
-func (x *CritiqueResult) GetLogicalIssues() []string {
+func (x *TextMetrics) GetRepeatedNgrams() []*PhraseCount {
 	if x != nil {
-		return x.LogicalIssues
+		return x.RepeatedNgrams
 	}
 	return nil
 }
 
-func (x *CritiqueResult) GetCharacterIssues() []string {
+func (x *TextMetrics) GetOverusedPhrases() []*PhraseCount {
 	if x != nil {
-		return x.CharacterIssues
+		return x.OverusedPhrases
 	}
 	return nil
 }
 
-func (x *CritiqueResult) GetPacingIssues() []string {
+func (x *TextMetrics) GetAdverbCount() int32 {
 	if x != nil {
-		return x.PacingIssues
+		return x.AdverbCount
 	}
-	return nil
+	return 0
 }
 
-func (x *CritiqueResult) GetImprovements() []string {
+func (x *TextMetrics) GetAdverbDensity() float64 {
 	if x != nil {
-		return x.Improvements
+		return x.AdverbDensity
 	}
-	return nil
+	return 0
 }
 
-func (x *CritiqueResult) GetFixedExample() string {
+func (x *TextMetrics) GetPunctuationCount() int32 {
 	if x != nil {
-		return x.FixedExample
+		return x.PunctuationCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CritiqueResult) GetOverallScore() int32 {
+func (x *TextMetrics) GetHalfWidthPunctuation() int32 {
 	if x != nil {
-		return x.OverallScore
+		return x.HalfWidthPunctuation
 	}
 	return 0
 }
 
-// 质量问题
-type QualityIssue struct {
+func (x *TextMetrics) GetPunctuationMisuseRatio() float64 {
+	if x != nil {
+		return x.PunctuationMisuseRatio
+	}
+	return 0
+}
+
+func (x *TextMetrics) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 批量质量检测请求
+type BatchCheckQualityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 问题类型：grammar/punctuation/spelling/style
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// 问题严重程度：high/medium/low
-	Severity string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
-	// 问题描述
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// 问题位置描述
-	Position string `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
-	// 原文
-	Original string `protobuf:"bytes,5,opt,name=original,proto3" json:"original,omitempty"`
-	// 修正后
-	Corrected string `protobuf:"bytes,6,opt,name=corrected,proto3" json:"corrected,omitempty"`
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节ID列表，重复检测时为空表示项目全部章节
+	ChapterIds []string `protobuf:"bytes,2,rep,name=chapter_ids,json=chapterIds,proto3" json:"chapter_ids,omitempty"`
+	// polish/proofread/critique/consistency/metrics/repetition/all，metrics 只计算客观指标，不调用模型；
+	// repetition 比较所有章节，找出跨章节近似重复的段落
+	CheckType string `protobuf:"bytes,3,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
+	// LLM选项
+	LlmOptions *LLMOptions `protobuf:"bytes,4,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
 }
 
-func (x *QualityIssue) Reset() {
-	*x = QualityIssue{}
+func (x *BatchCheckQualityRequest) Reset() {
+	*x = BatchCheckQualityRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2371,13 +2584,13 @@ func (x *QualityIssue) Reset() {
 	}
 }
 
-func (x *QualityIssue) String() string {
+func (x *BatchCheckQualityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QualityIssue) ProtoMessage() {}
+func (*BatchCheckQualityRequest) ProtoMessage() {}
 
-func (x *QualityIssue) ProtoReflect() protoreflect.Message {
+func (x *BatchCheckQualityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2389,73 +2602,57 @@ func (x *QualityIssue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QualityIssue.ProtoReflect.Descriptor instead.
-func (*QualityIssue) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCheckQualityRequest.ProtoReflect.Descriptor instead.
+func (*BatchCheckQualityRequest) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *QualityIssue) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *QualityIssue) GetSeverity() string {
-	if x != nil {
-		return x.Severity
-	}
-	return ""
-}
-
-func (x *QualityIssue) GetDescription() string {
+func (x *BatchCheckQualityRequest) GetProjectId() string {
 	if x != nil {
-		return x.Description
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *QualityIssue) GetPosition() string {
+func (x *BatchCheckQualityRequest) GetChapterIds() []string {
 	if x != nil {
-		return x.Position
+		return x.ChapterIds
 	}
-	return ""
+	return nil
 }
 
-func (x *QualityIssue) GetOriginal() string {
+func (x *BatchCheckQualityRequest) GetCheckType() string {
 	if x != nil {
-		return x.Original
+		return x.CheckType
 	}
 	return ""
 }
 
-func (x *QualityIssue) GetCorrected() string {
+func (x *BatchCheckQualityRequest) GetLlmOptions() *LLMOptions {
 	if x != nil {
-		return x.Corrected
+		return x.LlmOptions
 	}
-	return ""
+	return nil
 }
 
-// 质量检测摘要
-type QualitySummary struct {
+// 批量质量检测响应
+type BatchCheckQualityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 总问题数
-	TotalIssues int32 `protobuf:"varint,1,opt,name=total_issues,json=totalIssues,proto3" json:"total_issues,omitempty"`
-	// 按类型统计问题数
-	IssuesByType map[string]int32 `protobuf:"bytes,2,rep,name=issues_by_type,json=issuesByType,proto3" json:"issues_by_type,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	// 按严重程度统计问题数
-	IssuesBySeverity map[string]int32 `protobuf:"bytes,3,rep,name=issues_by_severity,json=issuesBySeverity,proto3" json:"issues_by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	// 质量检测建议
-	Recommendations []string `protobuf:"bytes,4,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
-	// 质量趋势（按章节）
-	QualityTrends []float64 `protobuf:"fixed64,5,rep,packed,name=quality_trends,json=qualityTrends,proto3" json:"quality_trends,omitempty"`
+	// 每个章节的质量检测结果
+	Results []*CheckQualityResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// 质量检测摘要
+	Summary *QualitySummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	// 总质量分
+	OverallScore float64 `protobuf:"fixed64,3,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	// 重复检测发现的全部相似段落，按相似度降序
+	Repetitions []*RepetitionPair `protobuf:"bytes,4,rep,name=repetitions,proto3" json:"repetitions,omitempty"`
 }
 
-func (x *QualitySummary) Reset() {
-	*x = QualitySummary{}
+func (x *BatchCheckQualityResponse) Reset() {
+	*x = BatchCheckQualityResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2463,13 +2660,13 @@ func (x *QualitySummary) Reset() {
 	}
 }
 
-func (x *QualitySummary) String() string {
+func (x *BatchCheckQualityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QualitySummary) ProtoMessage() {}
+func (*BatchCheckQualityResponse) ProtoMessage() {}
 
-func (x *QualitySummary) ProtoReflect() protoreflect.Message {
+func (x *BatchCheckQualityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2481,64 +2678,55 @@ func (x *QualitySummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QualitySummary.ProtoReflect.Descriptor instead.
-func (*QualitySummary) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchCheckQualityResponse.ProtoReflect.Descriptor instead.
+func (*BatchCheckQualityResponse) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *QualitySummary) GetTotalIssues() int32 {
-	if x != nil {
-		return x.TotalIssues
-	}
-	return 0
-}
-
-func (x *QualitySummary) GetIssuesByType() map[string]int32 {
+func (x *BatchCheckQualityResponse) GetResults() []*CheckQualityResponse {
 	if x != nil {
-		return x.IssuesByType
+		return x.Results
 	}
 	return nil
 }
 
-func (x *QualitySummary) GetIssuesBySeverity() map[string]int32 {
+func (x *BatchCheckQualityResponse) GetSummary() *QualitySummary {
 	if x != nil {
-		return x.IssuesBySeverity
+		return x.Summary
 	}
 	return nil
 }
 
-func (x *QualitySummary) GetRecommendations() []string {
+func (x *BatchCheckQualityResponse) GetOverallScore() float64 {
 	if x != nil {
-		return x.Recommendations
+		return x.OverallScore
 	}
-	return nil
+	return 0
 }
 
-func (x *QualitySummary) GetQualityTrends() []float64 {
+func (x *BatchCheckQualityResponse) GetRepetitions() []*RepetitionPair {
 	if x != nil {
-		return x.QualityTrends
+		return x.Repetitions
 	}
 	return nil
 }
 
-// 一致性检查相关消息
-type CheckConsistencyRequest struct {
+// 语法检查结果
+type ProofreadResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 项目ID
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// 检查类型：character/plot/world/timeline/all
-	CheckType string `protobuf:"bytes,2,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
-	// 章节ID列表
-	ChapterIds []string `protobuf:"bytes,3,rep,name=chapter_ids,json=chapterIds,proto3" json:"chapter_ids,omitempty"`
-	// LLM选项
-	LlmOptions *LLMOptions `protobuf:"bytes,4,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
+	// 修正后的章节内容
+	CorrectedContent string `protobuf:"bytes,1,opt,name=corrected_content,json=correctedContent,proto3" json:"corrected_content,omitempty"`
+	// 语法错误列表
+	Issues []*QualityIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	// 语法修正建议
+	Suggestions []string `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
 }
 
-func (x *CheckConsistencyRequest) Reset() {
-	*x = CheckConsistencyRequest{}
+func (x *ProofreadResult) Reset() {
+	*x = ProofreadResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2546,13 +2734,13 @@ func (x *CheckConsistencyRequest) Reset() {
 	}
 }
 
-func (x *CheckConsistencyRequest) String() string {
+func (x *ProofreadResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckConsistencyRequest) ProtoMessage() {}
+func (*ProofreadResult) ProtoMessage() {}
 
-func (x *CheckConsistencyRequest) ProtoReflect() protoreflect.Message {
+func (x *ProofreadResult) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2564,55 +2752,56 @@ func (x *CheckConsistencyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckConsistencyRequest.ProtoReflect.Descriptor instead.
-func (*CheckConsistencyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProofreadResult.ProtoReflect.Descriptor instead.
+func (*ProofreadResult) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CheckConsistencyRequest) GetProjectId() string {
+func (x *ProofreadResult) GetCorrectedContent() string {
 	if x != nil {
-		return x.ProjectId
+		return x.CorrectedContent
 	}
 	return ""
 }
 
-func (x *CheckConsistencyRequest) GetCheckType() string {
+func (x *ProofreadResult) GetIssues() []*QualityIssue {
 	if x != nil {
-		return x.CheckType
+		return x.Issues
 	}
-	return ""
+	return nil
 }
 
-func (x *CheckConsistencyRequest) GetChapterIds() []string {
-	if x != nil {
-		return x.ChapterIds
-	}
-	return nil
-}
-
-func (x *CheckConsistencyRequest) GetLlmOptions() *LLMOptions {
+func (x *ProofreadResult) GetSuggestions() []string {
 	if x != nil {
-		return x.LlmOptions
+		return x.Suggestions
 	}
 	return nil
 }
 
-// 一致性检查响应
-type CheckConsistencyResponse struct {
+// 逻辑检查结果
+type CritiqueResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 一致性问题列表
-	Issues []*ConsistencyIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
-	// 一致性修正建议列表
-	Suggestions []string `protobuf:"bytes,2,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// 逻辑错误列表
+	LogicalIssues []string `protobuf:"bytes,1,rep,name=logical_issues,json=logicalIssues,proto3" json:"logical_issues,omitempty"`
+	// 人物关系错误列表
+	CharacterIssues []string `protobuf:"bytes,2,rep,name=character_issues,json=characterIssues,proto3" json:"character_issues,omitempty"`
+	// pacing/flow 错误列表
+	PacingIssues []string `protobuf:"bytes,3,rep,name=pacing_issues,json=pacingIssues,proto3" json:"pacing_issues,omitempty"`
+	// 改进建议列表
+	Improvements []string `protobuf:"bytes,4,rep,name=improvements,proto3" json:"improvements,omitempty"`
+	// 修正后的示例段落
+	FixedExample string `protobuf:"bytes,5,opt,name=fixed_example,json=fixedExample,proto3" json:"fixed_example,omitempty"`
 	// 总质量分
-	OverallScore float64 `protobuf:"fixed64,3,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	OverallScore int32 `protobuf:"varint,6,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	// 问题的原文依据
+	Evidence []*CritiqueEvidence `protobuf:"bytes,7,rep,name=evidence,proto3" json:"evidence,omitempty"`
 }
 
-func (x *CheckConsistencyResponse) Reset() {
-	*x = CheckConsistencyResponse{}
+func (x *CritiqueResult) Reset() {
+	*x = CritiqueResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2620,13 +2809,13 @@ func (x *CheckConsistencyResponse) Reset() {
 	}
 }
 
-func (x *CheckConsistencyResponse) String() string {
+func (x *CritiqueResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckConsistencyResponse) ProtoMessage() {}
+func (*CritiqueResult) ProtoMessage() {}
 
-func (x *CheckConsistencyResponse) ProtoReflect() protoreflect.Message {
+func (x *CritiqueResult) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2638,46 +2827,76 @@ func (x *CheckConsistencyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckConsistencyResponse.ProtoReflect.Descriptor instead.
-func (*CheckConsistencyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CritiqueResult.ProtoReflect.Descriptor instead.
+func (*CritiqueResult) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CheckConsistencyResponse) GetIssues() []*ConsistencyIssue {
+func (x *CritiqueResult) GetLogicalIssues() []string {
 	if x != nil {
-		return x.Issues
+		return x.LogicalIssues
 	}
 	return nil
 }
 
-func (x *CheckConsistencyResponse) GetSuggestions() []string {
+func (x *CritiqueResult) GetCharacterIssues() []string {
 	if x != nil {
-		return x.Suggestions
+		return x.CharacterIssues
 	}
 	return nil
 }
 
-func (x *CheckConsistencyResponse) GetOverallScore() float64 {
+func (x *CritiqueResult) GetPacingIssues() []string {
+	if x != nil {
+		return x.PacingIssues
+	}
+	return nil
+}
+
+func (x *CritiqueResult) GetImprovements() []string {
+	if x != nil {
+		return x.Improvements
+	}
+	return nil
+}
+
+func (x *CritiqueResult) GetFixedExample() string {
+	if x != nil {
+		return x.FixedExample
+	}
+	return ""
+}
+
+func (x *CritiqueResult) GetOverallScore() int32 {
 	if x != nil {
 		return x.OverallScore
 	}
 	return 0
 }
 
-// 完整小说生成相关消息
-type GenerateNovelRequest struct {
+func (x *CritiqueResult) GetEvidence() []*CritiqueEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// 审查问题的原文依据
+type CritiqueEvidence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 项目ID
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// 生成选项
-	Options *GenerateOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// 问题类别：logical/character/pacing
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// 对应的问题
+	Issue string `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	// 原文位置
+	Span *TextSpan `protobuf:"bytes,3,opt,name=span,proto3" json:"span,omitempty"`
 }
 
-func (x *GenerateNovelRequest) Reset() {
-	*x = GenerateNovelRequest{}
+func (x *CritiqueEvidence) Reset() {
+	*x = CritiqueEvidence{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2685,13 +2904,13 @@ func (x *GenerateNovelRequest) Reset() {
 	}
 }
 
-func (x *GenerateNovelRequest) String() string {
+func (x *CritiqueEvidence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateNovelRequest) ProtoMessage() {}
+func (*CritiqueEvidence) ProtoMessage() {}
 
-func (x *GenerateNovelRequest) ProtoReflect() protoreflect.Message {
+func (x *CritiqueEvidence) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2703,47 +2922,52 @@ func (x *GenerateNovelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateNovelRequest.ProtoReflect.Descriptor instead.
-func (*GenerateNovelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CritiqueEvidence.ProtoReflect.Descriptor instead.
+func (*CritiqueEvidence) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GenerateNovelRequest) GetProjectId() string {
+func (x *CritiqueEvidence) GetCategory() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Category
 	}
 	return ""
 }
 
-func (x *GenerateNovelRequest) GetOptions() *GenerateOptions {
+func (x *CritiqueEvidence) GetIssue() string {
 	if x != nil {
-		return x.Options
+		return x.Issue
+	}
+	return ""
+}
+
+func (x *CritiqueEvidence) GetSpan() *TextSpan {
+	if x != nil {
+		return x.Span
 	}
 	return nil
 }
 
-// 完整小说生成响应
-type GenerateNovelResponse struct {
+// 问题在章节正文中对应的原文片段，偏移按字符（Unicode 码点）计
+type TextSpan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 生成状态：generating/completed/error
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// 生成进度：0.0-1.0
-	Progress float64 `protobuf:"fixed64,2,opt,name=progress,proto3" json:"progress,omitempty"`
-	// 当前生成阶段：worldbuilding/character/outline/chapter/polish/consistency
-	CurrentStage string `protobuf:"bytes,3,opt,name=current_stage,json=currentStage,proto3" json:"current_stage,omitempty"`
-	// 生成消息
-	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	// 生成的章节列表
-	Chapters []*Chapter `protobuf:"bytes,5,rep,name=chapters,proto3" json:"chapters,omitempty"`
-	// 生成问题列表
-	Issues []string `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues,omitempty"`
-}
-
-func (x *GenerateNovelResponse) Reset() {
-	*x = GenerateNovelResponse{}
+	// 章节ID
+	ChapterId string `protobuf:"bytes,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 起始偏移（含）
+	Start int32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// 结束偏移（不含）
+	End int32 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	// 引用的原文
+	Quote string `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	// 引文是否已在正文中找到，为 false 时偏移不可用
+	Verified bool `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (x *TextSpan) Reset() {
+	*x = TextSpan{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2751,13 +2975,13 @@ func (x *GenerateNovelResponse) Reset() {
 	}
 }
 
-func (x *GenerateNovelResponse) String() string {
+func (x *TextSpan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateNovelResponse) ProtoMessage() {}
+func (*TextSpan) ProtoMessage() {}
 
-func (x *GenerateNovelResponse) ProtoReflect() protoreflect.Message {
+func (x *TextSpan) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2769,69 +2993,70 @@ func (x *GenerateNovelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateNovelResponse.ProtoReflect.Descriptor instead.
-func (*GenerateNovelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TextSpan.ProtoReflect.Descriptor instead.
+func (*TextSpan) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GenerateNovelResponse) GetStatus() string {
+func (x *TextSpan) GetChapterId() string {
 	if x != nil {
-		return x.Status
+		return x.ChapterId
 	}
 	return ""
 }
 
-func (x *GenerateNovelResponse) GetProgress() float64 {
+func (x *TextSpan) GetStart() int32 {
 	if x != nil {
-		return x.Progress
+		return x.Start
 	}
 	return 0
 }
 
-func (x *GenerateNovelResponse) GetCurrentStage() string {
+func (x *TextSpan) GetEnd() int32 {
 	if x != nil {
-		return x.CurrentStage
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateNovelResponse) GetMessage() string {
+func (x *TextSpan) GetQuote() string {
 	if x != nil {
-		return x.Message
+		return x.Quote
 	}
 	return ""
 }
 
-func (x *GenerateNovelResponse) GetChapters() []*Chapter {
-	if x != nil {
-		return x.Chapters
-	}
-	return nil
-}
-
-func (x *GenerateNovelResponse) GetIssues() []string {
+func (x *TextSpan) GetVerified() bool {
 	if x != nil {
-		return x.Issues
+		return x.Verified
 	}
-	return nil
+	return false
 }
 
-// 导出相关消息
-type ExportNovelRequest struct {
+// 质量问题
+type QualityIssue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 项目ID
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// 导出格式：txt/epub/pdf
-	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
-	// 导出选项
-	Options *ExportOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	// 问题类型：grammar/punctuation/spelling/style
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 问题严重程度：high/medium/low
+	Severity string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	// 问题描述
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// 问题位置描述
+	Position string `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	// 原文
+	Original string `protobuf:"bytes,5,opt,name=original,proto3" json:"original,omitempty"`
+	// 修正后
+	Corrected string `protobuf:"bytes,6,opt,name=corrected,proto3" json:"corrected,omitempty"`
+	// 原文在正文中的位置
+	Span *TextSpan `protobuf:"bytes,7,opt,name=span,proto3" json:"span,omitempty"`
 }
 
-func (x *ExportNovelRequest) Reset() {
-	*x = ExportNovelRequest{}
+func (x *QualityIssue) Reset() {
+	*x = QualityIssue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2839,13 +3064,13 @@ func (x *ExportNovelRequest) Reset() {
 	}
 }
 
-func (x *ExportNovelRequest) String() string {
+func (x *QualityIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportNovelRequest) ProtoMessage() {}
+func (*QualityIssue) ProtoMessage() {}
 
-func (x *ExportNovelRequest) ProtoReflect() protoreflect.Message {
+func (x *QualityIssue) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2857,48 +3082,80 @@ func (x *ExportNovelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportNovelRequest.ProtoReflect.Descriptor instead.
-func (*ExportNovelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QualityIssue.ProtoReflect.Descriptor instead.
+func (*QualityIssue) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ExportNovelRequest) GetProjectId() string {
+func (x *QualityIssue) GetType() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ExportNovelRequest) GetFormat() string {
+func (x *QualityIssue) GetSeverity() string {
 	if x != nil {
-		return x.Format
+		return x.Severity
 	}
 	return ""
 }
 
-func (x *ExportNovelRequest) GetOptions() *ExportOptions {
+func (x *QualityIssue) GetDescription() string {
 	if x != nil {
-		return x.Options
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetCorrected() string {
+	if x != nil {
+		return x.Corrected
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetSpan() *TextSpan {
+	if x != nil {
+		return x.Span
 	}
 	return nil
 }
 
-// 导出响应
-type ExportNovelResponse struct {
+// 质量检测摘要
+type QualitySummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 下载URL
-	DownloadUrl string `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
-	// 文件名
-	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	// 文件大小（字节）
-	FileSize int64 `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// 总问题数
+	TotalIssues int32 `protobuf:"varint,1,opt,name=total_issues,json=totalIssues,proto3" json:"total_issues,omitempty"`
+	// 按类型统计问题数
+	IssuesByType map[string]int32 `protobuf:"bytes,2,rep,name=issues_by_type,json=issuesByType,proto3" json:"issues_by_type,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// 按严重程度统计问题数
+	IssuesBySeverity map[string]int32 `protobuf:"bytes,3,rep,name=issues_by_severity,json=issuesBySeverity,proto3" json:"issues_by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// 质量检测建议
+	Recommendations []string `protobuf:"bytes,4,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	// 质量趋势（按章节）
+	QualityTrends []float64 `protobuf:"fixed64,5,rep,packed,name=quality_trends,json=qualityTrends,proto3" json:"quality_trends,omitempty"`
 }
 
-func (x *ExportNovelResponse) Reset() {
-	*x = ExportNovelResponse{}
+func (x *QualitySummary) Reset() {
+	*x = QualitySummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2906,13 +3163,13 @@ func (x *ExportNovelResponse) Reset() {
 	}
 }
 
-func (x *ExportNovelResponse) String() string {
+func (x *QualitySummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportNovelResponse) ProtoMessage() {}
+func (*QualitySummary) ProtoMessage() {}
 
-func (x *ExportNovelResponse) ProtoReflect() protoreflect.Message {
+func (x *QualitySummary) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2924,48 +3181,64 @@ func (x *ExportNovelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportNovelResponse.ProtoReflect.Descriptor instead.
-func (*ExportNovelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use QualitySummary.ProtoReflect.Descriptor instead.
+func (*QualitySummary) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ExportNovelResponse) GetDownloadUrl() string {
+func (x *QualitySummary) GetTotalIssues() int32 {
 	if x != nil {
-		return x.DownloadUrl
+		return x.TotalIssues
 	}
-	return ""
+	return 0
 }
 
-func (x *ExportNovelResponse) GetFileName() string {
+func (x *QualitySummary) GetIssuesByType() map[string]int32 {
 	if x != nil {
-		return x.FileName
+		return x.IssuesByType
 	}
-	return ""
+	return nil
 }
 
-func (x *ExportNovelResponse) GetFileSize() int64 {
+func (x *QualitySummary) GetIssuesBySeverity() map[string]int32 {
 	if x != nil {
-		return x.FileSize
+		return x.IssuesBySeverity
 	}
-	return 0
+	return nil
 }
 
-// 短视频分镜脚本相关消息
-type GenerateVideoScriptRequest struct {
+func (x *QualitySummary) GetRecommendations() []string {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *QualitySummary) GetQualityTrends() []float64 {
+	if x != nil {
+		return x.QualityTrends
+	}
+	return nil
+}
+
+// 一致性检查相关消息
+type CheckConsistencyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// 项目ID
 	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 检查类型：character/plot/world/timeline/all，rules 表示只运行不调用模型的规则检查
+	CheckType string `protobuf:"bytes,2,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
 	// 章节ID列表
-	ChapterIds []string `protobuf:"bytes,2,rep,name=chapter_ids,json=chapterIds,proto3" json:"chapter_ids,omitempty"`
-	// 视频分镜脚本选项
-	Options *VideoScriptOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	ChapterIds []string `protobuf:"bytes,3,rep,name=chapter_ids,json=chapterIds,proto3" json:"chapter_ids,omitempty"`
+	// LLM选项
+	LlmOptions *LLMOptions `protobuf:"bytes,4,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
 }
 
-func (x *GenerateVideoScriptRequest) Reset() {
-	*x = GenerateVideoScriptRequest{}
+func (x *CheckConsistencyRequest) Reset() {
+	*x = CheckConsistencyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2973,13 +3246,13 @@ func (x *GenerateVideoScriptRequest) Reset() {
 	}
 }
 
-func (x *GenerateVideoScriptRequest) String() string {
+func (x *CheckConsistencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateVideoScriptRequest) ProtoMessage() {}
+func (*CheckConsistencyRequest) ProtoMessage() {}
 
-func (x *GenerateVideoScriptRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckConsistencyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2991,44 +3264,55 @@ func (x *GenerateVideoScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateVideoScriptRequest.ProtoReflect.Descriptor instead.
-func (*GenerateVideoScriptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyRequest) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GenerateVideoScriptRequest) GetProjectId() string {
+func (x *CheckConsistencyRequest) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *GenerateVideoScriptRequest) GetChapterIds() []string {
+func (x *CheckConsistencyRequest) GetCheckType() string {
+	if x != nil {
+		return x.CheckType
+	}
+	return ""
+}
+
+func (x *CheckConsistencyRequest) GetChapterIds() []string {
 	if x != nil {
 		return x.ChapterIds
 	}
 	return nil
 }
 
-func (x *GenerateVideoScriptRequest) GetOptions() *VideoScriptOptions {
+func (x *CheckConsistencyRequest) GetLlmOptions() *LLMOptions {
 	if x != nil {
-		return x.Options
+		return x.LlmOptions
 	}
 	return nil
 }
 
-// 视频分镜脚本响应
-type GenerateVideoScriptResponse struct {
+// 一致性检查响应
+type CheckConsistencyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 视频分镜场景列表
-	Scenes []*VideoScene `protobuf:"bytes,1,rep,name=scenes,proto3" json:"scenes,omitempty"`
+	// 一致性问题列表
+	Issues []*ConsistencyIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	// 一致性修正建议列表
+	Suggestions []string `protobuf:"bytes,2,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// 总质量分
+	OverallScore float64 `protobuf:"fixed64,3,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
 }
 
-func (x *GenerateVideoScriptResponse) Reset() {
-	*x = GenerateVideoScriptResponse{}
+func (x *CheckConsistencyResponse) Reset() {
+	*x = CheckConsistencyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3036,13 +3320,13 @@ func (x *GenerateVideoScriptResponse) Reset() {
 	}
 }
 
-func (x *GenerateVideoScriptResponse) String() string {
+func (x *CheckConsistencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateVideoScriptResponse) ProtoMessage() {}
+func (*CheckConsistencyResponse) ProtoMessage() {}
 
-func (x *GenerateVideoScriptResponse) ProtoReflect() protoreflect.Message {
+func (x *CheckConsistencyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3054,55 +3338,46 @@ func (x *GenerateVideoScriptResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateVideoScriptResponse.ProtoReflect.Descriptor instead.
-func (*GenerateVideoScriptResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyResponse) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GenerateVideoScriptResponse) GetScenes() []*VideoScene {
+func (x *CheckConsistencyResponse) GetIssues() []*ConsistencyIssue {
 	if x != nil {
-		return x.Scenes
+		return x.Issues
 	}
 	return nil
 }
 
-// 数据模型
-type Project struct {
+func (x *CheckConsistencyResponse) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *CheckConsistencyResponse) GetOverallScore() float64 {
+	if x != nil {
+		return x.OverallScore
+	}
+	return 0
+}
+
+// 完整小说生成相关消息
+type GenerateNovelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// 项目ID
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// 项目标题
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// 项目描述 （可选）
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// 项目类型：novel/short_story
-	Genre string `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
-	// 目标受众：general/adult
-	TargetAudience string `protobuf:"bytes,5,opt,name=target_audience,json=targetAudience,proto3" json:"target_audience,omitempty"`
-	// 写作风格：formal/informal
-	Tone   string   `protobuf:"bytes,6,opt,name=tone,proto3" json:"tone,omitempty"`
-	Themes []string `protobuf:"bytes,7,rep,name=themes,proto3" json:"themes,omitempty"`
-	// 项目状态：draft/generating/completed/error
-	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	// 世界视图
-	WorldView *WorldView `protobuf:"bytes,9,opt,name=world_view,json=worldView,proto3" json:"world_view,omitempty"`
-	// 人物角色列表
-	Characters []*Character `protobuf:"bytes,10,rep,name=characters,proto3" json:"characters,omitempty"`
-	// 章节大纲
-	Outline *Outline `protobuf:"bytes,11,opt,name=outline,proto3" json:"outline,omitempty"`
-	// 章节列表
-	Chapters []*Chapter `protobuf:"bytes,12,rep,name=chapters,proto3" json:"chapters,omitempty"`
-	// 创建时间
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// 更新时间
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 生成选项
+	Options *GenerateOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *Project) Reset() {
-	*x = Project{}
+func (x *GenerateNovelRequest) Reset() {
+	*x = GenerateNovelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_novel_v1_novel_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3110,13 +3385,13 @@ func (x *Project) Reset() {
 	}
 }
 
-func (x *Project) String() string {
+func (x *GenerateNovelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Project) ProtoMessage() {}
+func (*GenerateNovelRequest) ProtoMessage() {}
 
-func (x *Project) ProtoReflect() protoreflect.Message {
+func (x *GenerateNovelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_novel_v1_novel_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3128,146 +3403,177 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Project.ProtoReflect.Descriptor instead.
-func (*Project) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateNovelRequest.ProtoReflect.Descriptor instead.
+func (*GenerateNovelRequest) Descriptor() ([]byte, []int) {
 	return file_novel_v1_novel_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Project) GetId() string {
+func (x *GenerateNovelRequest) GetProjectId() string {
 	if x != nil {
-		return x.Id
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *Project) GetTitle() string {
+func (x *GenerateNovelRequest) GetOptions() *GenerateOptions {
 	if x != nil {
-		return x.Title
+		return x.Options
 	}
-	return ""
+	return nil
 }
 
-func (x *Project) GetDescription() string {
-	if x != nil {
-		return x.Description
+// 完整小说生成响应
+type GenerateNovelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 生成状态：generating/completed/error
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// 生成进度：0.0-1.0
+	Progress float64 `protobuf:"fixed64,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	// 当前生成阶段：worldbuilding/character/outline/chapter/polish/consistency
+	CurrentStage string `protobuf:"bytes,3,opt,name=current_stage,json=currentStage,proto3" json:"current_stage,omitempty"`
+	// 生成消息
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// 生成的章节列表
+	Chapters []*Chapter `protobuf:"bytes,5,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	// 生成问题列表
+	Issues []string `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues,omitempty"`
+	// 启用质量门禁时每章的评审记录
+	QualityGates []*QualityGateResult `protobuf:"bytes,7,rep,name=quality_gates,json=qualityGates,proto3" json:"quality_gates,omitempty"`
+	// 全部章节生成后的情节线索
+	PlotThreads []*PlotThread `protobuf:"bytes,8,rep,name=plot_threads,json=plotThreads,proto3" json:"plot_threads,omitempty"`
+	// 生成收尾章节前检查出的未回收伏笔与缺少铺垫的回收
+	PlotThreadIssues []*PlotThreadIssue `protobuf:"bytes,9,rep,name=plot_thread_issues,json=plotThreadIssues,proto3" json:"plot_thread_issues,omitempty"`
+}
+
+func (x *GenerateNovelResponse) Reset() {
+	*x = GenerateNovelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Project) GetGenre() string {
-	if x != nil {
-		return x.Genre
+func (x *GenerateNovelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateNovelResponse) ProtoMessage() {}
+
+func (x *GenerateNovelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Project) GetTargetAudience() string {
+// Deprecated: Use GenerateNovelResponse.ProtoReflect.Descriptor instead.
+func (*GenerateNovelResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GenerateNovelResponse) GetStatus() string {
 	if x != nil {
-		return x.TargetAudience
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Project) GetTone() string {
+func (x *GenerateNovelResponse) GetProgress() float64 {
 	if x != nil {
-		return x.Tone
+		return x.Progress
 	}
-	return ""
+	return 0
 }
 
-func (x *Project) GetThemes() []string {
+func (x *GenerateNovelResponse) GetCurrentStage() string {
 	if x != nil {
-		return x.Themes
+		return x.CurrentStage
 	}
-	return nil
+	return ""
 }
 
-func (x *Project) GetStatus() string {
+func (x *GenerateNovelResponse) GetMessage() string {
 	if x != nil {
-		return x.Status
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Project) GetWorldView() *WorldView {
+func (x *GenerateNovelResponse) GetChapters() []*Chapter {
 	if x != nil {
-		return x.WorldView
+		return x.Chapters
 	}
 	return nil
 }
 
-func (x *Project) GetCharacters() []*Character {
+func (x *GenerateNovelResponse) GetIssues() []string {
 	if x != nil {
-		return x.Characters
+		return x.Issues
 	}
 	return nil
 }
 
-func (x *Project) GetOutline() *Outline {
+func (x *GenerateNovelResponse) GetQualityGates() []*QualityGateResult {
 	if x != nil {
-		return x.Outline
-	}
-	return nil
-}
-
-func (x *Project) GetChapters() []*Chapter {
-	if x != nil {
-		return x.Chapters
+		return x.QualityGates
 	}
 	return nil
 }
 
-func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GenerateNovelResponse) GetPlotThreads() []*PlotThread {
 	if x != nil {
-		return x.CreatedAt
+		return x.PlotThreads
 	}
 	return nil
 }
 
-func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GenerateNovelResponse) GetPlotThreadIssues() []*PlotThreadIssue {
 	if x != nil {
-		return x.UpdatedAt
+		return x.PlotThreadIssues
 	}
 	return nil
 }
 
-// 世界视图
-type WorldView struct {
+// 导出相关消息
+type ExportNovelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 世界视图标题
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// 世界视图摘要
-	Synopsis string `protobuf:"bytes,2,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
-	// 世界视图设置描述
-	Setting string `protobuf:"bytes,3,opt,name=setting,proto3" json:"setting,omitempty"`
-	// 世界视图关键规则列表
-	KeyRules []string `protobuf:"bytes,4,rep,name=key_rules,json=keyRules,proto3" json:"key_rules,omitempty"`
-	// 世界视图写作风格示例列表
-	ToneExamples []string `protobuf:"bytes,5,rep,name=tone_examples,json=toneExamples,proto3" json:"tone_examples,omitempty"`
-	// 世界视图主题列表
-	Themes []string `protobuf:"bytes,6,rep,name=themes,proto3" json:"themes,omitempty"`
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 导出格式：txt/epub/pdf
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// 导出选项
+	Options *ExportOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *WorldView) Reset() {
-	*x = WorldView{}
+func (x *ExportNovelRequest) Reset() {
+	*x = ExportNovelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[43]
+		mi := &file_novel_v1_novel_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorldView) String() string {
+func (x *ExportNovelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorldView) ProtoMessage() {}
+func (*ExportNovelRequest) ProtoMessage() {}
 
-func (x *WorldView) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[43]
+func (x *ExportNovelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3278,102 +3584,63 @@ func (x *WorldView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorldView.ProtoReflect.Descriptor instead.
-func (*WorldView) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *WorldView) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use ExportNovelRequest.ProtoReflect.Descriptor instead.
+func (*ExportNovelRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *WorldView) GetSynopsis() string {
+func (x *ExportNovelRequest) GetProjectId() string {
 	if x != nil {
-		return x.Synopsis
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *WorldView) GetSetting() string {
+func (x *ExportNovelRequest) GetFormat() string {
 	if x != nil {
-		return x.Setting
+		return x.Format
 	}
 	return ""
 }
 
-func (x *WorldView) GetKeyRules() []string {
-	if x != nil {
-		return x.KeyRules
-	}
-	return nil
-}
-
-func (x *WorldView) GetToneExamples() []string {
-	if x != nil {
-		return x.ToneExamples
-	}
-	return nil
-}
-
-func (x *WorldView) GetThemes() []string {
+func (x *ExportNovelRequest) GetOptions() *ExportOptions {
 	if x != nil {
-		return x.Themes
+		return x.Options
 	}
 	return nil
 }
 
-// 人物角色
-type Character struct {
+// 导出响应
+type ExportNovelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 人物角色ID
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// 项目ID
-	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// 人物角色名称
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// 人物角色角色描述
-	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	// 人物角色年龄
-	Age int32 `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
-	// 人物角色外貌描述
-	Appearance string `protobuf:"bytes,6,opt,name=appearance,proto3" json:"appearance,omitempty"`
-	// 人物角色背景描述
-	Background string `protobuf:"bytes,7,opt,name=background,proto3" json:"background,omitempty"`
-	// 人物角色动机描述
-	Motivation string `protobuf:"bytes,8,opt,name=motivation,proto3" json:"motivation,omitempty"`
-	// 人物角色缺陷描述列表
-	Flaws []string `protobuf:"bytes,9,rep,name=flaws,proto3" json:"flaws,omitempty"`
-	// 人物角色语音语调描述
-	SpeechTone string `protobuf:"bytes,10,opt,name=speech_tone,json=speechTone,proto3" json:"speech_tone,omitempty"`
-	// 人物角色秘密描述列表
-	Secrets []string `protobuf:"bytes,11,rep,name=secrets,proto3" json:"secrets,omitempty"`
-	// 人物角色关系映射
-	RelationshipMap map[string]string `protobuf:"bytes,12,rep,name=relationship_map,json=relationshipMap,proto3" json:"relationship_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// 下载URL
+	DownloadUrl string `protobuf:"bytes,1,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	// 文件名
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// 文件大小（字节）
+	FileSize int64 `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
 }
 
-func (x *Character) Reset() {
-	*x = Character{}
+func (x *ExportNovelResponse) Reset() {
+	*x = ExportNovelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[44]
+		mi := &file_novel_v1_novel_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Character) String() string {
+func (x *ExportNovelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Character) ProtoMessage() {}
+func (*ExportNovelResponse) ProtoMessage() {}
 
-func (x *Character) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[44]
+func (x *ExportNovelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3384,126 +3651,126 @@ func (x *Character) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Character.ProtoReflect.Descriptor instead.
-func (*Character) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ExportNovelResponse.ProtoReflect.Descriptor instead.
+func (*ExportNovelResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Character) GetId() string {
+func (x *ExportNovelResponse) GetDownloadUrl() string {
 	if x != nil {
-		return x.Id
+		return x.DownloadUrl
 	}
 	return ""
 }
 
-func (x *Character) GetProjectId() string {
+func (x *ExportNovelResponse) GetFileName() string {
 	if x != nil {
-		return x.ProjectId
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *Character) GetName() string {
+func (x *ExportNovelResponse) GetFileSize() int64 {
 	if x != nil {
-		return x.Name
+		return x.FileSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Character) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
-}
+// 短视频分镜脚本相关消息
+type GenerateVideoScriptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Character) GetAge() int32 {
-	if x != nil {
-		return x.Age
-	}
-	return 0
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节ID列表
+	ChapterIds []string `protobuf:"bytes,2,rep,name=chapter_ids,json=chapterIds,proto3" json:"chapter_ids,omitempty"`
+	// 视频分镜脚本选项
+	Options *VideoScriptOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *Character) GetAppearance() string {
-	if x != nil {
-		return x.Appearance
+func (x *GenerateVideoScriptRequest) Reset() {
+	*x = GenerateVideoScriptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Character) GetBackground() string {
-	if x != nil {
-		return x.Background
-	}
-	return ""
+func (x *GenerateVideoScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Character) GetMotivation() string {
-	if x != nil {
-		return x.Motivation
+func (*GenerateVideoScriptRequest) ProtoMessage() {}
+
+func (x *GenerateVideoScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Character) GetFlaws() []string {
-	if x != nil {
-		return x.Flaws
-	}
-	return nil
+// Deprecated: Use GenerateVideoScriptRequest.ProtoReflect.Descriptor instead.
+func (*GenerateVideoScriptRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Character) GetSpeechTone() string {
+func (x *GenerateVideoScriptRequest) GetProjectId() string {
 	if x != nil {
-		return x.SpeechTone
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *Character) GetSecrets() []string {
+func (x *GenerateVideoScriptRequest) GetChapterIds() []string {
 	if x != nil {
-		return x.Secrets
+		return x.ChapterIds
 	}
 	return nil
 }
 
-func (x *Character) GetRelationshipMap() map[string]string {
+func (x *GenerateVideoScriptRequest) GetOptions() *VideoScriptOptions {
 	if x != nil {
-		return x.RelationshipMap
+		return x.Options
 	}
 	return nil
 }
 
-// 章节大纲
-type Outline struct {
+// 视频分镜脚本响应
+type GenerateVideoScriptResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 章节大纲ID
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// 项目ID
-	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// 章节大纲列表
-	Chapters []*ChapterOutline `protobuf:"bytes,3,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	// 视频分镜场景列表
+	Scenes []*VideoScene `protobuf:"bytes,1,rep,name=scenes,proto3" json:"scenes,omitempty"`
 }
 
-func (x *Outline) Reset() {
-	*x = Outline{}
+func (x *GenerateVideoScriptResponse) Reset() {
+	*x = GenerateVideoScriptResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[45]
+		mi := &file_novel_v1_novel_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Outline) String() string {
+func (x *GenerateVideoScriptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Outline) ProtoMessage() {}
+func (*GenerateVideoScriptResponse) ProtoMessage() {}
 
-func (x *Outline) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[45]
+func (x *GenerateVideoScriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3514,69 +3781,74 @@ func (x *Outline) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Outline.ProtoReflect.Descriptor instead.
-func (*Outline) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *Outline) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Outline) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use GenerateVideoScriptResponse.ProtoReflect.Descriptor instead.
+func (*GenerateVideoScriptResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Outline) GetChapters() []*ChapterOutline {
+func (x *GenerateVideoScriptResponse) GetScenes() []*VideoScene {
 	if x != nil {
-		return x.Chapters
+		return x.Scenes
 	}
 	return nil
 }
 
-// 章节大纲项
-type ChapterOutline struct {
+// 数据模型
+type Project struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 章节索引
-	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	// 章节标题
+	// 项目ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 项目标题
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// 章节摘要
-	Summary string `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
-	// 章节目标描述
-	Goal string `protobuf:"bytes,4,opt,name=goal,proto3" json:"goal,omitempty"`
-	// 章节 Twist 提示
-	TwistHint string `protobuf:"bytes,5,opt,name=twist_hint,json=twistHint,proto3" json:"twist_hint,omitempty"`
-	// 章节重要物品列表
-	ImportantItems []string `protobuf:"bytes,6,rep,name=important_items,json=importantItems,proto3" json:"important_items,omitempty"`
+	// 项目描述 （可选）
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// 项目类型：novel/short_story
+	Genre string `protobuf:"bytes,4,opt,name=genre,proto3" json:"genre,omitempty"`
+	// 目标受众：general/adult
+	TargetAudience string `protobuf:"bytes,5,opt,name=target_audience,json=targetAudience,proto3" json:"target_audience,omitempty"`
+	// 写作风格：formal/informal
+	Tone   string   `protobuf:"bytes,6,opt,name=tone,proto3" json:"tone,omitempty"`
+	Themes []string `protobuf:"bytes,7,rep,name=themes,proto3" json:"themes,omitempty"`
+	// 项目状态：draft/generating/completed/error
+	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	// 世界视图
+	WorldView *WorldView `protobuf:"bytes,9,opt,name=world_view,json=worldView,proto3" json:"world_view,omitempty"`
+	// 人物角色列表
+	Characters []*Character `protobuf:"bytes,10,rep,name=characters,proto3" json:"characters,omitempty"`
+	// 章节大纲
+	Outline *Outline `protobuf:"bytes,11,opt,name=outline,proto3" json:"outline,omitempty"`
+	// 章节列表
+	Chapters []*Chapter `protobuf:"bytes,12,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	// 创建时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 版本号，更新时作为 expected_version 回传用于并发控制
+	Version int64 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	// 所有者用户ID，为空表示未归属
+	OwnerId string `protobuf:"bytes,16,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 }
 
-func (x *ChapterOutline) Reset() {
-	*x = ChapterOutline{}
+func (x *Project) Reset() {
+	*x = Project{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[46]
+		mi := &file_novel_v1_novel_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChapterOutline) String() string {
+func (x *Project) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChapterOutline) ProtoMessage() {}
+func (*Project) ProtoMessage() {}
 
-func (x *ChapterOutline) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[46]
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3587,100 +3859,160 @@ func (x *ChapterOutline) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChapterOutline.ProtoReflect.Descriptor instead.
-func (*ChapterOutline) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ChapterOutline) GetIndex() int32 {
+func (x *Project) GetId() string {
 	if x != nil {
-		return x.Index
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ChapterOutline) GetTitle() string {
+func (x *Project) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *ChapterOutline) GetSummary() string {
+func (x *Project) GetDescription() string {
 	if x != nil {
-		return x.Summary
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ChapterOutline) GetGoal() string {
+func (x *Project) GetGenre() string {
 	if x != nil {
-		return x.Goal
+		return x.Genre
 	}
 	return ""
 }
 
-func (x *ChapterOutline) GetTwistHint() string {
+func (x *Project) GetTargetAudience() string {
 	if x != nil {
-		return x.TwistHint
+		return x.TargetAudience
 	}
 	return ""
 }
 
-func (x *ChapterOutline) GetImportantItems() []string {
+func (x *Project) GetTone() string {
 	if x != nil {
-		return x.ImportantItems
+		return x.Tone
+	}
+	return ""
+}
+
+func (x *Project) GetThemes() []string {
+	if x != nil {
+		return x.Themes
 	}
 	return nil
 }
 
-// 章节
-type Chapter struct {
+func (x *Project) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Project) GetWorldView() *WorldView {
+	if x != nil {
+		return x.WorldView
+	}
+	return nil
+}
+
+func (x *Project) GetCharacters() []*Character {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *Project) GetOutline() *Outline {
+	if x != nil {
+		return x.Outline
+	}
+	return nil
+}
+
+func (x *Project) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Project) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+// 世界视图
+type WorldView struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 章节ID
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// 项目ID
-	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// 章节索引
-	Index int32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	// 章节标题
-	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	// 章节摘要
-	Summary string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
-	// 原始内容
-	RawContent string `protobuf:"bytes,6,opt,name=raw_content,json=rawContent,proto3" json:"raw_content,omitempty"`
-	//	polished_content = 7;
-	//
-	// 章节字数
-	WordCount int32 `protobuf:"varint,8,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
-	// 章节状态：draft/generated/polished/reviewed
-	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	// 创建时间
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// 更新时间
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 世界视图标题
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// 世界视图摘要
+	Synopsis string `protobuf:"bytes,2,opt,name=synopsis,proto3" json:"synopsis,omitempty"`
+	// 世界视图设置描述
+	Setting string `protobuf:"bytes,3,opt,name=setting,proto3" json:"setting,omitempty"`
+	// 世界视图关键规则列表
+	KeyRules []string `protobuf:"bytes,4,rep,name=key_rules,json=keyRules,proto3" json:"key_rules,omitempty"`
+	// 世界视图写作风格示例列表
+	ToneExamples []string `protobuf:"bytes,5,rep,name=tone_examples,json=toneExamples,proto3" json:"tone_examples,omitempty"`
+	// 世界视图主题列表
+	Themes []string `protobuf:"bytes,6,rep,name=themes,proto3" json:"themes,omitempty"`
 }
 
-func (x *Chapter) Reset() {
-	*x = Chapter{}
+func (x *WorldView) Reset() {
+	*x = WorldView{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[47]
+		mi := &file_novel_v1_novel_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Chapter) String() string {
+func (x *WorldView) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Chapter) ProtoMessage() {}
+func (*WorldView) ProtoMessage() {}
 
-func (x *Chapter) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[47]
+func (x *WorldView) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,116 +4023,102 @@ func (x *Chapter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
-func (*Chapter) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use WorldView.ProtoReflect.Descriptor instead.
+func (*WorldView) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Chapter) GetId() string {
+func (x *WorldView) GetTitle() string {
 	if x != nil {
-		return x.Id
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Chapter) GetProjectId() string {
+func (x *WorldView) GetSynopsis() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Synopsis
 	}
 	return ""
 }
 
-func (x *Chapter) GetIndex() int32 {
+func (x *WorldView) GetSetting() string {
 	if x != nil {
-		return x.Index
+		return x.Setting
 	}
-	return 0
+	return ""
 }
 
-func (x *Chapter) GetTitle() string {
+func (x *WorldView) GetKeyRules() []string {
 	if x != nil {
-		return x.Title
+		return x.KeyRules
 	}
-	return ""
+	return nil
 }
 
-func (x *Chapter) GetSummary() string {
+func (x *WorldView) GetToneExamples() []string {
 	if x != nil {
-		return x.Summary
-	}
-	return ""
-}
-
-func (x *Chapter) GetRawContent() string {
-	if x != nil {
-		return x.RawContent
-	}
-	return ""
-}
-
-func (x *Chapter) GetWordCount() int32 {
-	if x != nil {
-		return x.WordCount
-	}
-	return 0
-}
-
-func (x *Chapter) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *Chapter) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+		return x.ToneExamples
 	}
 	return nil
 }
 
-func (x *Chapter) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *WorldView) GetThemes() []string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Themes
 	}
 	return nil
 }
 
-// 生成上下文
-type GenerationContext struct {
+// 人物角色
+type Character struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 上一章节摘要
-	PreviousSummary string `protobuf:"bytes,1,opt,name=previous_summary,json=previousSummary,proto3" json:"previous_summary,omitempty"`
-	// 人物角色列表
-	Characters []*Character `protobuf:"bytes,2,rep,name=characters,proto3" json:"characters,omitempty"`
-	// 时间线事件列表
-	Timeline []*TimelineEvent `protobuf:"bytes,3,rep,name=timeline,proto3" json:"timeline,omitempty"`
-	// 道具物品列表
-	Props []*PropItem `protobuf:"bytes,4,rep,name=props,proto3" json:"props,omitempty"`
-	// 写作风格示例列表
-	StyleExamples []string `protobuf:"bytes,5,rep,name=style_examples,json=styleExamples,proto3" json:"style_examples,omitempty"`
+	// 人物角色ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 项目ID
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 人物角色名称
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 人物角色角色描述
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// 人物角色年龄
+	Age int32 `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
+	// 人物角色外貌描述
+	Appearance string `protobuf:"bytes,6,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	// 人物角色背景描述
+	Background string `protobuf:"bytes,7,opt,name=background,proto3" json:"background,omitempty"`
+	// 人物角色动机描述
+	Motivation string `protobuf:"bytes,8,opt,name=motivation,proto3" json:"motivation,omitempty"`
+	// 人物角色缺陷描述列表
+	Flaws []string `protobuf:"bytes,9,rep,name=flaws,proto3" json:"flaws,omitempty"`
+	// 人物角色语音语调描述
+	SpeechTone string `protobuf:"bytes,10,opt,name=speech_tone,json=speechTone,proto3" json:"speech_tone,omitempty"`
+	// 人物角色秘密描述列表
+	Secrets []string `protobuf:"bytes,11,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// 人物角色关系映射
+	RelationshipMap map[string]string `protobuf:"bytes,12,rep,name=relationship_map,json=relationshipMap,proto3" json:"relationship_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *GenerationContext) Reset() {
-	*x = GenerationContext{}
+func (x *Character) Reset() {
+	*x = Character{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[48]
+		mi := &file_novel_v1_novel_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerationContext) String() string {
+func (x *Character) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerationContext) ProtoMessage() {}
+func (*Character) ProtoMessage() {}
 
-func (x *GenerationContext) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[48]
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3811,77 +4129,126 @@ func (x *GenerationContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerationContext.ProtoReflect.Descriptor instead.
-func (*GenerationContext) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GenerationContext) GetPreviousSummary() string {
+func (x *Character) GetId() string {
 	if x != nil {
-		return x.PreviousSummary
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GenerationContext) GetCharacters() []*Character {
+func (x *Character) GetProjectId() string {
 	if x != nil {
-		return x.Characters
+		return x.ProjectId
 	}
-	return nil
+	return ""
 }
 
-func (x *GenerationContext) GetTimeline() []*TimelineEvent {
+func (x *Character) GetName() string {
 	if x != nil {
-		return x.Timeline
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Character) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Character) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *Character) GetAppearance() string {
+	if x != nil {
+		return x.Appearance
+	}
+	return ""
+}
+
+func (x *Character) GetBackground() string {
+	if x != nil {
+		return x.Background
+	}
+	return ""
+}
+
+func (x *Character) GetMotivation() string {
+	if x != nil {
+		return x.Motivation
+	}
+	return ""
+}
+
+func (x *Character) GetFlaws() []string {
+	if x != nil {
+		return x.Flaws
 	}
 	return nil
 }
 
-func (x *GenerationContext) GetProps() []*PropItem {
+func (x *Character) GetSpeechTone() string {
 	if x != nil {
-		return x.Props
+		return x.SpeechTone
+	}
+	return ""
+}
+
+func (x *Character) GetSecrets() []string {
+	if x != nil {
+		return x.Secrets
 	}
 	return nil
 }
 
-func (x *GenerationContext) GetStyleExamples() []string {
+func (x *Character) GetRelationshipMap() map[string]string {
 	if x != nil {
-		return x.StyleExamples
+		return x.RelationshipMap
 	}
 	return nil
 }
 
-// 时间线事件
-type TimelineEvent struct {
+// 章节大纲
+type Outline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 事件时间戳
-	Timestamp string `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// 事件描述
-	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
-	// 事件详细描述
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// 章节大纲ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 项目ID
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节大纲列表
+	Chapters []*ChapterOutline `protobuf:"bytes,3,rep,name=chapters,proto3" json:"chapters,omitempty"`
 }
 
-func (x *TimelineEvent) Reset() {
-	*x = TimelineEvent{}
+func (x *Outline) Reset() {
+	*x = Outline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[49]
+		mi := &file_novel_v1_novel_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TimelineEvent) String() string {
+func (x *Outline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimelineEvent) ProtoMessage() {}
+func (*Outline) ProtoMessage() {}
 
-func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[49]
+func (x *Outline) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3892,63 +4259,69 @@ func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
-func (*TimelineEvent) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use Outline.ProtoReflect.Descriptor instead.
+func (*Outline) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *TimelineEvent) GetTimestamp() string {
+func (x *Outline) GetId() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Id
 	}
 	return ""
 }
 
-func (x *TimelineEvent) GetEvent() string {
+func (x *Outline) GetProjectId() string {
 	if x != nil {
-		return x.Event
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *TimelineEvent) GetDescription() string {
+func (x *Outline) GetChapters() []*ChapterOutline {
 	if x != nil {
-		return x.Description
+		return x.Chapters
 	}
-	return ""
+	return nil
 }
 
-// 道具物品
-type PropItem struct {
+// 章节大纲项
+type ChapterOutline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 道具物品名称
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// 道具物品描述
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// 道具物品位置描述
-	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// 章节索引
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// 章节标题
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// 章节摘要
+	Summary string `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	// 章节目标描述
+	Goal string `protobuf:"bytes,4,opt,name=goal,proto3" json:"goal,omitempty"`
+	// 章节 Twist 提示
+	TwistHint string `protobuf:"bytes,5,opt,name=twist_hint,json=twistHint,proto3" json:"twist_hint,omitempty"`
+	// 章节重要物品列表
+	ImportantItems []string `protobuf:"bytes,6,rep,name=important_items,json=importantItems,proto3" json:"important_items,omitempty"`
 }
 
-func (x *PropItem) Reset() {
-	*x = PropItem{}
+func (x *ChapterOutline) Reset() {
+	*x = ChapterOutline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[50]
+		mi := &file_novel_v1_novel_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PropItem) String() string {
+func (x *ChapterOutline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PropItem) ProtoMessage() {}
+func (*ChapterOutline) ProtoMessage() {}
 
-func (x *PropItem) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[50]
+func (x *ChapterOutline) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3959,67 +4332,102 @@ func (x *PropItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PropItem.ProtoReflect.Descriptor instead.
-func (*PropItem) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ChapterOutline.ProtoReflect.Descriptor instead.
+func (*ChapterOutline) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *PropItem) GetName() string {
+func (x *ChapterOutline) GetIndex() int32 {
 	if x != nil {
-		return x.Name
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ChapterOutline) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *PropItem) GetDescription() string {
+func (x *ChapterOutline) GetSummary() string {
 	if x != nil {
-		return x.Description
+		return x.Summary
 	}
 	return ""
 }
 
-func (x *PropItem) GetLocation() string {
+func (x *ChapterOutline) GetGoal() string {
 	if x != nil {
-		return x.Location
+		return x.Goal
 	}
 	return ""
 }
 
-// 一致性问题
-type ConsistencyIssue struct {
+func (x *ChapterOutline) GetTwistHint() string {
+	if x != nil {
+		return x.TwistHint
+	}
+	return ""
+}
+
+func (x *ChapterOutline) GetImportantItems() []string {
+	if x != nil {
+		return x.ImportantItems
+	}
+	return nil
+}
+
+// 章节
+type Chapter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 问题类型：character/plot/world/timeline
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// 问题严重程度：low/medium/high/critical
-	Severity string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
-	// 问题描述
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// 问题位置（章节索引/时间戳/道具名称等）
-	Location string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	// 建议修复方案
-	Suggestion string `protobuf:"bytes,5,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	// 章节ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 项目ID
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节索引
+	Index int32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// 章节标题
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// 章节摘要
+	Summary string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	// 原始内容
+	RawContent string `protobuf:"bytes,6,opt,name=raw_content,json=rawContent,proto3" json:"raw_content,omitempty"`
+	//	polished_content = 7;
+	//
+	// 章节字数
+	WordCount int32 `protobuf:"varint,8,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	// 章节状态：draft/generated/polished/reviewed
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// 创建时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 版本号，更新时作为 expected_version 回传用于并发控制
+	Version int64 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *ConsistencyIssue) Reset() {
-	*x = ConsistencyIssue{}
+func (x *Chapter) Reset() {
+	*x = Chapter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[51]
+		mi := &file_novel_v1_novel_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConsistencyIssue) String() string {
+func (x *Chapter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsistencyIssue) ProtoMessage() {}
+func (*Chapter) ProtoMessage() {}
 
-func (x *ConsistencyIssue) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[51]
+func (x *Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4030,83 +4438,125 @@ func (x *ConsistencyIssue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsistencyIssue.ProtoReflect.Descriptor instead.
-func (*ConsistencyIssue) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
+func (*Chapter) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ConsistencyIssue) GetType() string {
+func (x *Chapter) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ConsistencyIssue) GetSeverity() string {
+func (x *Chapter) GetProjectId() string {
 	if x != nil {
-		return x.Severity
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *ConsistencyIssue) GetDescription() string {
+func (x *Chapter) GetIndex() int32 {
 	if x != nil {
-		return x.Description
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Chapter) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *ConsistencyIssue) GetLocation() string {
+func (x *Chapter) GetSummary() string {
 	if x != nil {
-		return x.Location
+		return x.Summary
 	}
 	return ""
 }
 
-func (x *ConsistencyIssue) GetSuggestion() string {
+func (x *Chapter) GetRawContent() string {
 	if x != nil {
-		return x.Suggestion
+		return x.RawContent
 	}
 	return ""
 }
 
-// 视频场景
-type VideoScene struct {
+func (x *Chapter) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *Chapter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Chapter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Chapter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Chapter) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 生成上下文
+type GenerationContext struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 场景索引
-	ScreenIndex int32 `protobuf:"varint,1,opt,name=screen_index,json=screenIndex,proto3" json:"screen_index,omitempty"`
-	// 场景文本内容
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	// 建议背景音乐标签
-	SuggestedBgmTag string `protobuf:"bytes,3,opt,name=suggested_bgm_tag,json=suggestedBgmTag,proto3" json:"suggested_bgm_tag,omitempty"`
-	// 建议图片标签
-	SuggestedImageTag string `protobuf:"bytes,4,opt,name=suggested_image_tag,json=suggestedImageTag,proto3" json:"suggested_image_tag,omitempty"`
-	// 建议TTS语音类型：male/female
-	TtsVoice string `protobuf:"bytes,5,opt,name=tts_voice,json=ttsVoice,proto3" json:"tts_voice,omitempty"`
-	// 备注
-	Notes string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	// 上一章节摘要
+	PreviousSummary string `protobuf:"bytes,1,opt,name=previous_summary,json=previousSummary,proto3" json:"previous_summary,omitempty"`
+	// 人物角色列表
+	Characters []*Character `protobuf:"bytes,2,rep,name=characters,proto3" json:"characters,omitempty"`
+	// 时间线事件列表
+	Timeline []*TimelineEvent `protobuf:"bytes,3,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	// 道具物品列表
+	Props []*PropItem `protobuf:"bytes,4,rep,name=props,proto3" json:"props,omitempty"`
+	// 写作风格示例列表
+	StyleExamples []string `protobuf:"bytes,5,rep,name=style_examples,json=styleExamples,proto3" json:"style_examples,omitempty"`
+	// 人物当前所在与状态，为空时使用上一章结束时记录的状态
+	CharacterStates []*CharacterState `protobuf:"bytes,6,rep,name=character_states,json=characterStates,proto3" json:"character_states,omitempty"`
 }
 
-func (x *VideoScene) Reset() {
-	*x = VideoScene{}
+func (x *GenerationContext) Reset() {
+	*x = GenerationContext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[52]
+		mi := &file_novel_v1_novel_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoScene) String() string {
+func (x *GenerationContext) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoScene) ProtoMessage() {}
+func (*GenerationContext) ProtoMessage() {}
 
-func (x *VideoScene) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[52]
+func (x *GenerationContext) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4117,90 +4567,86 @@ func (x *VideoScene) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoScene.ProtoReflect.Descriptor instead.
-func (*VideoScene) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GenerationContext.ProtoReflect.Descriptor instead.
+func (*GenerationContext) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *VideoScene) GetScreenIndex() int32 {
+func (x *GenerationContext) GetPreviousSummary() string {
 	if x != nil {
-		return x.ScreenIndex
+		return x.PreviousSummary
 	}
-	return 0
+	return ""
 }
 
-func (x *VideoScene) GetText() string {
+func (x *GenerationContext) GetCharacters() []*Character {
 	if x != nil {
-		return x.Text
+		return x.Characters
 	}
-	return ""
+	return nil
 }
 
-func (x *VideoScene) GetSuggestedBgmTag() string {
+func (x *GenerationContext) GetTimeline() []*TimelineEvent {
 	if x != nil {
-		return x.SuggestedBgmTag
+		return x.Timeline
 	}
-	return ""
+	return nil
 }
 
-func (x *VideoScene) GetSuggestedImageTag() string {
+func (x *GenerationContext) GetProps() []*PropItem {
 	if x != nil {
-		return x.SuggestedImageTag
+		return x.Props
 	}
-	return ""
+	return nil
 }
 
-func (x *VideoScene) GetTtsVoice() string {
+func (x *GenerationContext) GetStyleExamples() []string {
 	if x != nil {
-		return x.TtsVoice
+		return x.StyleExamples
 	}
-	return ""
+	return nil
 }
 
-func (x *VideoScene) GetNotes() string {
+func (x *GenerationContext) GetCharacterStates() []*CharacterState {
 	if x != nil {
-		return x.Notes
+		return x.CharacterStates
 	}
-	return ""
+	return nil
 }
 
-// 选项和配置
-type LLMOptions struct {
+// 时间线事件
+type TimelineEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 温度参数，控制生成文本的随机性，默认0.7
-	Temperature float64 `protobuf:"fixed64,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
-	// Top-p（nucleus sampling）参数，控制生成文本的多样性，默认0.9
-	TopP float64 `protobuf:"fixed64,2,opt,name=top_p,json=topP,proto3" json:"top_p,omitempty"`
-	// 最大生成令牌数，默认2048
-	MaxTokens int32 `protobuf:"varint,3,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
-	// 频率惩罚参数，控制生成文本中重复令牌的惩罚，默认0.0
-	FrequencyPenalty float64 `protobuf:"fixed64,4,opt,name=frequency_penalty,json=frequencyPenalty,proto3" json:"frequency_penalty,omitempty"`
-	// 存在惩罚参数，控制生成文本中新令牌的惩罚，默认0.0
-	PresencePenalty float64 `protobuf:"fixed64,5,opt,name=presence_penalty,json=presencePenalty,proto3" json:"presence_penalty,omitempty"`
-	// 使用的模型名称，默认"default"
-	Model string `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
+	// 事件时间戳
+	Timestamp string `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 事件描述
+	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// 事件详细描述
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// 发生的章节，0表示未知
+	ChapterIndex int32 `protobuf:"varint,4,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
 }
 
-func (x *LLMOptions) Reset() {
-	*x = LLMOptions{}
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[53]
+		mi := &file_novel_v1_novel_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LLMOptions) String() string {
+func (x *TimelineEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LLMOptions) ProtoMessage() {}
+func (*TimelineEvent) ProtoMessage() {}
 
-func (x *LLMOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[53]
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4211,88 +4657,72 @@ func (x *LLMOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LLMOptions.ProtoReflect.Descriptor instead.
-func (*LLMOptions) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *LLMOptions) GetTemperature() float64 {
-	if x != nil {
-		return x.Temperature
-	}
-	return 0
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *LLMOptions) GetTopP() float64 {
+func (x *TimelineEvent) GetTimestamp() string {
 	if x != nil {
-		return x.TopP
+		return x.Timestamp
 	}
-	return 0
+	return ""
 }
 
-func (x *LLMOptions) GetMaxTokens() int32 {
+func (x *TimelineEvent) GetEvent() string {
 	if x != nil {
-		return x.MaxTokens
+		return x.Event
 	}
-	return 0
+	return ""
 }
 
-func (x *LLMOptions) GetFrequencyPenalty() float64 {
+func (x *TimelineEvent) GetDescription() string {
 	if x != nil {
-		return x.FrequencyPenalty
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *LLMOptions) GetPresencePenalty() float64 {
+func (x *TimelineEvent) GetChapterIndex() int32 {
 	if x != nil {
-		return x.PresencePenalty
+		return x.ChapterIndex
 	}
 	return 0
 }
 
-func (x *LLMOptions) GetModel() string {
-	if x != nil {
-		return x.Model
-	}
-	return ""
-}
-
-// 生成选项
-type GenerateOptions struct {
+// 道具物品
+type PropItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 最大生成章节数，默认10
-	MaxChapters int32 `protobuf:"varint,1,opt,name=max_chapters,json=maxChapters,proto3" json:"max_chapters,omitempty"`
-	// 每章最大单词数，默认2000
-	WordsPerChapter int32 `protobuf:"varint,2,opt,name=words_per_chapter,json=wordsPerChapter,proto3" json:"words_per_chapter,omitempty"`
-	// 是否启用内容 polished，默认false
-	PolishEnabled bool `protobuf:"varint,3,opt,name=polish_enabled,json=polishEnabled,proto3" json:"polish_enabled,omitempty"`
-	// 是否启用一致性检查，默认false
-	ConsistencyCheck bool `protobuf:"varint,4,opt,name=consistency_check,json=consistencyCheck,proto3" json:"consistency_check,omitempty"`
-	// LLM 选项
-	LlmOptions *LLMOptions `protobuf:"bytes,5,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
+	// 道具物品名称
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 道具物品描述
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// 道具物品位置描述
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// 当前持有人，无人持有时为空
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
 }
 
-func (x *GenerateOptions) Reset() {
-	*x = GenerateOptions{}
+func (x *PropItem) Reset() {
+	*x = PropItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[54]
+		mi := &file_novel_v1_novel_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateOptions) String() string {
+func (x *PropItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateOptions) ProtoMessage() {}
+func (*PropItem) ProtoMessage() {}
 
-func (x *GenerateOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[54]
+func (x *PropItem) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4303,73 +4733,72 @@ func (x *GenerateOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateOptions.ProtoReflect.Descriptor instead.
-func (*GenerateOptions) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *GenerateOptions) GetMaxChapters() int32 {
-	if x != nil {
-		return x.MaxChapters
-	}
-	return 0
+// Deprecated: Use PropItem.ProtoReflect.Descriptor instead.
+func (*PropItem) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GenerateOptions) GetWordsPerChapter() int32 {
+func (x *PropItem) GetName() string {
 	if x != nil {
-		return x.WordsPerChapter
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GenerateOptions) GetPolishEnabled() bool {
+func (x *PropItem) GetDescription() string {
 	if x != nil {
-		return x.PolishEnabled
+		return x.Description
 	}
-	return false
+	return ""
 }
 
-func (x *GenerateOptions) GetConsistencyCheck() bool {
+func (x *PropItem) GetLocation() string {
 	if x != nil {
-		return x.ConsistencyCheck
+		return x.Location
 	}
-	return false
+	return ""
 }
 
-func (x *GenerateOptions) GetLlmOptions() *LLMOptions {
+func (x *PropItem) GetHolder() string {
 	if x != nil {
-		return x.LlmOptions
+		return x.Holder
 	}
-	return nil
+	return ""
 }
 
-// 模型切换相关消息
-type SwitchModelRequest struct {
+// 人物在某一章结束时的状态
+type CharacterState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 模型名称，如 "default", "creative", "reasoning"
-	ModelName string `protobuf:"bytes,1,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	// 人物姓名
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 所在地点
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// 伤势与身体状况
+	Condition string `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	// 处境
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *SwitchModelRequest) Reset() {
-	*x = SwitchModelRequest{}
+func (x *CharacterState) Reset() {
+	*x = CharacterState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[55]
+		mi := &file_novel_v1_novel_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwitchModelRequest) String() string {
+func (x *CharacterState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwitchModelRequest) ProtoMessage() {}
+func (*CharacterState) ProtoMessage() {}
 
-func (x *SwitchModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[55]
+func (x *CharacterState) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4380,49 +4809,76 @@ func (x *SwitchModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwitchModelRequest.ProtoReflect.Descriptor instead.
-func (*SwitchModelRequest) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use CharacterState.ProtoReflect.Descriptor instead.
+func (*CharacterState) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SwitchModelRequest) GetModelName() string {
+func (x *CharacterState) GetName() string {
 	if x != nil {
-		return x.ModelName
+		return x.Name
 	}
 	return ""
 }
 
-// 模型切换响应
-type SwitchModelResponse struct {
+func (x *CharacterState) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CharacterState) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *CharacterState) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 一致性问题
+type ConsistencyIssue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 切换是否成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// 切换消息
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// 当前使用的模型名称
-	CurrentModel string `protobuf:"bytes,3,opt,name=current_model,json=currentModel,proto3" json:"current_model,omitempty"`
+	// 问题类型：character/plot/world/timeline
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 问题严重程度：low/medium/high/critical
+	Severity string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	// 问题描述
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// 问题位置（章节索引/时间戳/道具名称等）
+	Location string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// 建议修复方案
+	Suggestion string `protobuf:"bytes,5,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+	// 问题引用的原文在正文中的位置，仅涉及整章时只有章节ID
+	Span *TextSpan `protobuf:"bytes,6,opt,name=span,proto3" json:"span,omitempty"`
 }
 
-func (x *SwitchModelResponse) Reset() {
-	*x = SwitchModelResponse{}
+func (x *ConsistencyIssue) Reset() {
+	*x = ConsistencyIssue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[56]
+		mi := &file_novel_v1_novel_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwitchModelResponse) String() string {
+func (x *ConsistencyIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwitchModelResponse) ProtoMessage() {}
+func (*ConsistencyIssue) ProtoMessage() {}
 
-func (x *SwitchModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[56]
+func (x *ConsistencyIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4433,56 +4889,90 @@ func (x *SwitchModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwitchModelResponse.ProtoReflect.Descriptor instead.
-func (*SwitchModelResponse) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ConsistencyIssue.ProtoReflect.Descriptor instead.
+func (*ConsistencyIssue) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SwitchModelResponse) GetSuccess() bool {
+func (x *ConsistencyIssue) GetType() string {
 	if x != nil {
-		return x.Success
+		return x.Type
 	}
-	return false
+	return ""
 }
 
-func (x *SwitchModelResponse) GetMessage() string {
+func (x *ConsistencyIssue) GetSeverity() string {
 	if x != nil {
-		return x.Message
+		return x.Severity
 	}
 	return ""
 }
 
-func (x *SwitchModelResponse) GetCurrentModel() string {
+func (x *ConsistencyIssue) GetDescription() string {
 	if x != nil {
-		return x.CurrentModel
+		return x.Description
 	}
 	return ""
 }
 
-// 模型列表请求
-type ListModelsRequest struct {
+func (x *ConsistencyIssue) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetSuggestion() string {
+	if x != nil {
+		return x.Suggestion
+	}
+	return ""
+}
+
+func (x *ConsistencyIssue) GetSpan() *TextSpan {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+// 视频场景
+type VideoScene struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// 场景索引
+	ScreenIndex int32 `protobuf:"varint,1,opt,name=screen_index,json=screenIndex,proto3" json:"screen_index,omitempty"`
+	// 场景文本内容
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// 建议背景音乐标签
+	SuggestedBgmTag string `protobuf:"bytes,3,opt,name=suggested_bgm_tag,json=suggestedBgmTag,proto3" json:"suggested_bgm_tag,omitempty"`
+	// 建议图片标签
+	SuggestedImageTag string `protobuf:"bytes,4,opt,name=suggested_image_tag,json=suggestedImageTag,proto3" json:"suggested_image_tag,omitempty"`
+	// 建议TTS语音类型：male/female
+	TtsVoice string `protobuf:"bytes,5,opt,name=tts_voice,json=ttsVoice,proto3" json:"tts_voice,omitempty"`
+	// 备注
+	Notes string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
 }
 
-func (x *ListModelsRequest) Reset() {
-	*x = ListModelsRequest{}
+func (x *VideoScene) Reset() {
+	*x = VideoScene{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[57]
+		mi := &file_novel_v1_novel_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListModelsRequest) String() string {
+func (x *VideoScene) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListModelsRequest) ProtoMessage() {}
+func (*VideoScene) ProtoMessage() {}
 
-func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[57]
+func (x *VideoScene) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4493,104 +4983,90 @@ func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListModelsRequest.ProtoReflect.Descriptor instead.
-func (*ListModelsRequest) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{57}
-}
-
-// 模型列表响应
-type ListModelsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// 模型列表
-	Models []*ModelInfo `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
-	// 当前使用的模型名称
-	CurrentModel string `protobuf:"bytes,2,opt,name=current_model,json=currentModel,proto3" json:"current_model,omitempty"`
+// Deprecated: Use VideoScene.ProtoReflect.Descriptor instead.
+func (*VideoScene) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListModelsResponse) Reset() {
-	*x = ListModelsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[58]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *VideoScene) GetScreenIndex() int32 {
+	if x != nil {
+		return x.ScreenIndex
 	}
+	return 0
 }
 
-func (x *ListModelsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *VideoScene) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
 }
 
-func (*ListModelsResponse) ProtoMessage() {}
-
-func (x *ListModelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[58]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *VideoScene) GetSuggestedBgmTag() string {
+	if x != nil {
+		return x.SuggestedBgmTag
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListModelsResponse.ProtoReflect.Descriptor instead.
-func (*ListModelsResponse) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{58}
+func (x *VideoScene) GetSuggestedImageTag() string {
+	if x != nil {
+		return x.SuggestedImageTag
+	}
+	return ""
 }
 
-func (x *ListModelsResponse) GetModels() []*ModelInfo {
+func (x *VideoScene) GetTtsVoice() string {
 	if x != nil {
-		return x.Models
+		return x.TtsVoice
 	}
-	return nil
+	return ""
 }
 
-func (x *ListModelsResponse) GetCurrentModel() string {
+func (x *VideoScene) GetNotes() string {
 	if x != nil {
-		return x.CurrentModel
+		return x.Notes
 	}
 	return ""
 }
 
-// 模型信息
-type ModelInfo struct {
+// 选项和配置
+type LLMOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 模型名称
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// 模型供应商
-	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	// 模型ID
-	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
-	// 模型描述
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// 是否可用
-	Available bool `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	// 温度参数，控制生成文本的随机性，默认0.7
+	Temperature float64 `protobuf:"fixed64,1,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	// Top-p（nucleus sampling）参数，控制生成文本的多样性，默认0.9
+	TopP float64 `protobuf:"fixed64,2,opt,name=top_p,json=topP,proto3" json:"top_p,omitempty"`
+	// 最大生成令牌数，默认2048
+	MaxTokens int32 `protobuf:"varint,3,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	// 频率惩罚参数，控制生成文本中重复令牌的惩罚，默认0.0
+	FrequencyPenalty float64 `protobuf:"fixed64,4,opt,name=frequency_penalty,json=frequencyPenalty,proto3" json:"frequency_penalty,omitempty"`
+	// 存在惩罚参数，控制生成文本中新令牌的惩罚，默认0.0
+	PresencePenalty float64 `protobuf:"fixed64,5,opt,name=presence_penalty,json=presencePenalty,proto3" json:"presence_penalty,omitempty"`
+	// 使用的模型名称，默认"default"
+	Model string `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`
 }
 
-func (x *ModelInfo) Reset() {
-	*x = ModelInfo{}
+func (x *LLMOptions) Reset() {
+	*x = LLMOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[59]
+		mi := &file_novel_v1_novel_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModelInfo) String() string {
+func (x *LLMOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelInfo) ProtoMessage() {}
+func (*LLMOptions) ProtoMessage() {}
 
-func (x *ModelInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[59]
+func (x *LLMOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4601,79 +5077,94 @@ func (x *ModelInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelInfo.ProtoReflect.Descriptor instead.
-func (*ModelInfo) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use LLMOptions.ProtoReflect.Descriptor instead.
+func (*LLMOptions) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ModelInfo) GetName() string {
+func (x *LLMOptions) GetTemperature() float64 {
 	if x != nil {
-		return x.Name
+		return x.Temperature
 	}
-	return ""
+	return 0
 }
 
-func (x *ModelInfo) GetProvider() string {
+func (x *LLMOptions) GetTopP() float64 {
 	if x != nil {
-		return x.Provider
+		return x.TopP
 	}
-	return ""
+	return 0
 }
 
-func (x *ModelInfo) GetModel() string {
+func (x *LLMOptions) GetMaxTokens() int32 {
 	if x != nil {
-		return x.Model
+		return x.MaxTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *ModelInfo) GetDescription() string {
+func (x *LLMOptions) GetFrequencyPenalty() float64 {
 	if x != nil {
-		return x.Description
+		return x.FrequencyPenalty
 	}
-	return ""
+	return 0
 }
 
-func (x *ModelInfo) GetAvailable() bool {
+func (x *LLMOptions) GetPresencePenalty() float64 {
 	if x != nil {
-		return x.Available
+		return x.PresencePenalty
 	}
-	return false
+	return 0
 }
 
-// 导出选项
-type ExportOptions struct {
+func (x *LLMOptions) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+// 生成选项
+type GenerateOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 是否包含元数据，默认true
-	IncludeMetadata bool `protobuf:"varint,1,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
-	// 是否包含大纲，默认true
-	IncludeOutline bool `protobuf:"varint,2,opt,name=include_outline,json=includeOutline,proto3" json:"include_outline,omitempty"`
-	// 字体家族，默认"宋体"
-	FontFamily string `protobuf:"bytes,3,opt,name=font_family,json=fontFamily,proto3" json:"font_family,omitempty"`
-	// 字体大小，默认14
-	FontSize int32 `protobuf:"varint,4,opt,name=font_size,json=fontSize,proto3" json:"font_size,omitempty"`
+	// 最大生成章节数，默认10
+	MaxChapters int32 `protobuf:"varint,1,opt,name=max_chapters,json=maxChapters,proto3" json:"max_chapters,omitempty"`
+	// 每章最大单词数，默认2000
+	WordsPerChapter int32 `protobuf:"varint,2,opt,name=words_per_chapter,json=wordsPerChapter,proto3" json:"words_per_chapter,omitempty"`
+	// 是否启用内容 polished，默认false
+	PolishEnabled bool `protobuf:"varint,3,opt,name=polish_enabled,json=polishEnabled,proto3" json:"polish_enabled,omitempty"`
+	// 是否启用一致性检查，默认false
+	ConsistencyCheck bool `protobuf:"varint,4,opt,name=consistency_check,json=consistencyCheck,proto3" json:"consistency_check,omitempty"`
+	// LLM 选项
+	LlmOptions *LLMOptions `protobuf:"bytes,5,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
+	// 是否在每章生成后进行质量门禁评审，默认false
+	QualityGate bool `protobuf:"varint,6,opt,name=quality_gate,json=qualityGate,proto3" json:"quality_gate,omitempty"`
+	// 质量门禁通过阈值 0-1，默认0.7
+	QualityThreshold float64 `protobuf:"fixed64,7,opt,name=quality_threshold,json=qualityThreshold,proto3" json:"quality_threshold,omitempty"`
+	// 未通过时最多改写次数，默认2，用尽后章节标记为需人工审核
+	MaxRevisions int32 `protobuf:"varint,8,opt,name=max_revisions,json=maxRevisions,proto3" json:"max_revisions,omitempty"`
 }
 
-func (x *ExportOptions) Reset() {
-	*x = ExportOptions{}
+func (x *GenerateOptions) Reset() {
+	*x = GenerateOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[60]
+		mi := &file_novel_v1_novel_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExportOptions) String() string {
+func (x *GenerateOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportOptions) ProtoMessage() {}
+func (*GenerateOptions) ProtoMessage() {}
 
-func (x *ExportOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[60]
+func (x *GenerateOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4684,70 +5175,94 @@ func (x *ExportOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportOptions.ProtoReflect.Descriptor instead.
-func (*ExportOptions) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use GenerateOptions.ProtoReflect.Descriptor instead.
+func (*GenerateOptions) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ExportOptions) GetIncludeMetadata() bool {
+func (x *GenerateOptions) GetMaxChapters() int32 {
 	if x != nil {
-		return x.IncludeMetadata
+		return x.MaxChapters
+	}
+	return 0
+}
+
+func (x *GenerateOptions) GetWordsPerChapter() int32 {
+	if x != nil {
+		return x.WordsPerChapter
+	}
+	return 0
+}
+
+func (x *GenerateOptions) GetPolishEnabled() bool {
+	if x != nil {
+		return x.PolishEnabled
 	}
 	return false
 }
 
-func (x *ExportOptions) GetIncludeOutline() bool {
+func (x *GenerateOptions) GetConsistencyCheck() bool {
 	if x != nil {
-		return x.IncludeOutline
+		return x.ConsistencyCheck
 	}
 	return false
 }
 
-func (x *ExportOptions) GetFontFamily() string {
+func (x *GenerateOptions) GetLlmOptions() *LLMOptions {
 	if x != nil {
-		return x.FontFamily
+		return x.LlmOptions
 	}
-	return ""
+	return nil
 }
 
-func (x *ExportOptions) GetFontSize() int32 {
+func (x *GenerateOptions) GetQualityGate() bool {
 	if x != nil {
-		return x.FontSize
+		return x.QualityGate
+	}
+	return false
+}
+
+func (x *GenerateOptions) GetQualityThreshold() float64 {
+	if x != nil {
+		return x.QualityThreshold
 	}
 	return 0
 }
 
-// 视频脚本选项
-type VideoScriptOptions struct {
+func (x *GenerateOptions) GetMaxRevisions() int32 {
+	if x != nil {
+		return x.MaxRevisions
+	}
+	return 0
+}
+
+// 模型切换相关消息
+type SwitchModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 每章分几个场景，默认8-12
-	ScenesPerChapter int32 `protobuf:"varint,1,opt,name=scenes_per_chapter,json=scenesPerChapter,proto3" json:"scenes_per_chapter,omitempty"`
-	// 视频平台：tiktok/youtube/bilibili
-	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
-	// 语音类型：male/female/auto
-	VoiceType string `protobuf:"bytes,3,opt,name=voice_type,json=voiceType,proto3" json:"voice_type,omitempty"`
+	// 模型名称，如 "default", "creative", "reasoning"
+	ModelName string `protobuf:"bytes,1,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
 }
 
-func (x *VideoScriptOptions) Reset() {
-	*x = VideoScriptOptions{}
+func (x *SwitchModelRequest) Reset() {
+	*x = SwitchModelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[61]
+		mi := &file_novel_v1_novel_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VideoScriptOptions) String() string {
+func (x *SwitchModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoScriptOptions) ProtoMessage() {}
+func (*SwitchModelRequest) ProtoMessage() {}
 
-func (x *VideoScriptOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[61]
+func (x *SwitchModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4758,56 +5273,109 @@ func (x *VideoScriptOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoScriptOptions.ProtoReflect.Descriptor instead.
-func (*VideoScriptOptions) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use SwitchModelRequest.ProtoReflect.Descriptor instead.
+func (*SwitchModelRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *VideoScriptOptions) GetScenesPerChapter() int32 {
+func (x *SwitchModelRequest) GetModelName() string {
 	if x != nil {
-		return x.ScenesPerChapter
+		return x.ModelName
 	}
-	return 0
+	return ""
 }
 
-func (x *VideoScriptOptions) GetPlatform() string {
+// 模型切换响应
+type SwitchModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 切换是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 切换消息
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// 当前使用的模型名称
+	CurrentModel string `protobuf:"bytes,3,opt,name=current_model,json=currentModel,proto3" json:"current_model,omitempty"`
+}
+
+func (x *SwitchModelResponse) Reset() {
+	*x = SwitchModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchModelResponse) ProtoMessage() {}
+
+func (x *SwitchModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchModelResponse.ProtoReflect.Descriptor instead.
+func (*SwitchModelResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SwitchModelResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Platform
+		return x.Success
+	}
+	return false
+}
+
+func (x *SwitchModelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *VideoScriptOptions) GetVoiceType() string {
+func (x *SwitchModelResponse) GetCurrentModel() string {
 	if x != nil {
-		return x.VoiceType
+		return x.CurrentModel
 	}
 	return ""
 }
 
-// 统计信息请求
-type GetStatsRequest struct {
+// 模型列表请求
+type ListModelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetStatsRequest) Reset() {
-	*x = GetStatsRequest{}
+func (x *ListModelsRequest) Reset() {
+	*x = ListModelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[62]
+		mi := &file_novel_v1_novel_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatsRequest) String() string {
+func (x *ListModelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsRequest) ProtoMessage() {}
+func (*ListModelsRequest) ProtoMessage() {}
 
-func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[62]
+func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4818,38 +5386,40 @@ func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ListModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListModelsRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{64}
 }
 
-// 统计信息响应
-type GetStatsResponse struct {
+// 模型列表响应
+type ListModelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 统计信息
-	Stats *ProjectStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	// 模型列表
+	Models []*ModelInfo `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	// 当前使用的模型名称
+	CurrentModel string `protobuf:"bytes,2,opt,name=current_model,json=currentModel,proto3" json:"current_model,omitempty"`
 }
 
-func (x *GetStatsResponse) Reset() {
-	*x = GetStatsResponse{}
+func (x *ListModelsResponse) Reset() {
+	*x = ListModelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[63]
+		mi := &file_novel_v1_novel_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatsResponse) String() string {
+func (x *ListModelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsResponse) ProtoMessage() {}
+func (*ListModelsResponse) ProtoMessage() {}
 
-func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[63]
+func (x *ListModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4860,51 +5430,60 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use ListModelsResponse.ProtoReflect.Descriptor instead.
+func (*ListModelsResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetStatsResponse) GetStats() *ProjectStats {
+func (x *ListModelsResponse) GetModels() []*ModelInfo {
 	if x != nil {
-		return x.Stats
+		return x.Models
 	}
 	return nil
 }
 
-// 项目统计信息
-type ProjectStats struct {
+func (x *ListModelsResponse) GetCurrentModel() string {
+	if x != nil {
+		return x.CurrentModel
+	}
+	return ""
+}
+
+// 模型信息
+type ModelInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 总项目数
-	TotalProjects int32 `protobuf:"varint,1,opt,name=total_projects,json=totalProjects,proto3" json:"total_projects,omitempty"`
-	// 已完成项目数
-	CompletedProjects int32 `protobuf:"varint,2,opt,name=completed_projects,json=completedProjects,proto3" json:"completed_projects,omitempty"`
-	// 总字数
-	TotalWords int64 `protobuf:"varint,3,opt,name=total_words,json=totalWords,proto3" json:"total_words,omitempty"`
-	// 本月字数
-	MonthlyWords int64 `protobuf:"varint,4,opt,name=monthly_words,json=monthlyWords,proto3" json:"monthly_words,omitempty"`
+	// 模型名称
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 模型供应商
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 模型ID
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// 模型描述
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// 是否可用
+	Available bool `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
 }
 
-func (x *ProjectStats) Reset() {
-	*x = ProjectStats{}
+func (x *ModelInfo) Reset() {
+	*x = ModelInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_novel_v1_novel_proto_msgTypes[64]
+		mi := &file_novel_v1_novel_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProjectStats) String() string {
+func (x *ModelInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectStats) ProtoMessage() {}
+func (*ModelInfo) ProtoMessage() {}
 
-func (x *ProjectStats) ProtoReflect() protoreflect.Message {
-	mi := &file_novel_v1_novel_proto_msgTypes[64]
+func (x *ModelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4915,1146 +5494,7659 @@ func (x *ProjectStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectStats.ProtoReflect.Descriptor instead.
-func (*ProjectStats) Descriptor() ([]byte, []int) {
-	return file_novel_v1_novel_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ModelInfo.ProtoReflect.Descriptor instead.
+func (*ModelInfo) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ProjectStats) GetTotalProjects() int32 {
+func (x *ModelInfo) GetName() string {
 	if x != nil {
-		return x.TotalProjects
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ProjectStats) GetCompletedProjects() int32 {
+func (x *ModelInfo) GetProvider() string {
 	if x != nil {
-		return x.CompletedProjects
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *ProjectStats) GetTotalWords() int64 {
+func (x *ModelInfo) GetModel() string {
 	if x != nil {
-		return x.TotalWords
+		return x.Model
 	}
-	return 0
+	return ""
 }
 
-func (x *ProjectStats) GetMonthlyWords() int64 {
+func (x *ModelInfo) GetDescription() string {
 	if x != nil {
-		return x.MonthlyWords
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-var File_novel_v1_novel_proto protoreflect.FileDescriptor
+func (x *ModelInfo) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
 
-var file_novel_v1_novel_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xb9, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x32, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x64, 0x22, 0x41, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x22, 0x46, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x5b, 0x0a, 0x14,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x85, 0x02, 0x0a, 0x14, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e,
-	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68,
-	0x65, 0x6d, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
-	0x65, 0x22, 0x44, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x6f, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61,
-	0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x68, 0x65, 0x6d, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4f, 0x0a, 0x19,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72,
-	0x6c, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69,
-	0x65, 0x77, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x22, 0xce, 0x01,
-	0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68,
-	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x69, 0x65,
-	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x09, 0x77, 0x6f,
-	0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51,
-	0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a,
-	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72,
-	0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x73, 0x22, 0xfc, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75,
-	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64,
-	0x56, 0x69, 0x65, 0x77, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x46, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f,
-	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
-	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x67, 0x6f, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x77, 0x69, 0x73, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x77, 0x69, 0x73, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-	0x61, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x4b, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75,
-	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x61, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x4b, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75,
-	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
-	0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0f,
-	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22,
-	0x4f, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x22, 0x4c, 0x0a, 0x1d, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75,
-	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x94,
-	0x02, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x0e, 0x63, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x77, 0x6f, 0x72,
-	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35,
-	0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x46, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0x83, 0x04,
-	0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x48, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e,
-	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x66, 0x69, 0x6e,
-	0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x22, 0x50, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x0c,
-	0x0a, 0x08, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
-	0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
-	0x45, 0x10, 0x04, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x68,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
-	0x79, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x55, 0x0a,
-	0x15, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d,
-	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xf7, 0x02, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x70, 0x6f, 0x6c,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
-	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0f, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a,
-	0x0f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x49, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
-	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65,
-	0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xae, 0x01,
-	0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61,
-	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65,
-	0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x90,
-	0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x2e, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69,
-	0x74, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12,
-	0x20, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xf5, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f,
-	0x67, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63,
-	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70,
-	0x61, 0x63, 0x69, 0x6e, 0x67, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69,
-	0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x45, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6f, 0x76, 0x65,
-	0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x51, 0x75,
-	0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x22, 0xba, 0x03, 0x0a, 0x0e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69,
-	0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65,
-	0x73, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x69, 0x73,
-	0x73, 0x75, 0x65, 0x73, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5c, 0x0a, 0x12, 0x69, 0x73,
-	0x73, 0x75, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
-	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x42, 0x79,
-	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72,
-	0x65, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x49, 0x73, 0x73,
-	0x75, 0x65, 0x73, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x49, 0x73,
-	0x73, 0x75, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xaf, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c,
-	0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x95, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
-	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6f, 0x76, 0x65,
-	0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x6a, 0x0a, 0x14, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
-	0x12, 0x33, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x7e, 0x0a, 0x12, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a, 0x13, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x94, 0x01,
-	0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x06, 0x73, 0x63, 0x65, 0x6e, 0x65,
-	0x73, 0x22, 0x8f, 0x04, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x6d,
-	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6c,
-	0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65,
-	0x77, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x12, 0x33, 0x0a, 0x0a,
-	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72,
-	0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75,
-	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2d,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65,
-	0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70,
-	0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70,
-	0x73, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a,
-	0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
-	0x6e, 0x65, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x22, 0xbe, 0x03, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x72,
-	0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6d, 0x6f, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x77, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66,
-	0x6c, 0x61, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x5f, 0x74,
-	0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x63,
-	0x68, 0x54, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
-	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12,
-	0x53, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x5f,
-	0x6d, 0x61, 0x70, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x2e, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
-	0x70, 0x4d, 0x61, 0x70, 0x1a, 0x42, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x68, 0x69, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x6c,
-	0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08,
-	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x67, 0x6f, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x77, 0x69, 0x73, 0x74, 0x5f, 0x68,
-	0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x77, 0x69, 0x73, 0x74,
-	0x48, 0x69, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69,
-	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xcc, 0x02,
-	0x0a, 0x07, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f,
-	0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf9, 0x01, 0x0a,
-	0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x33, 0x0a,
-	0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
-	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70,
-	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x79, 0x6c, 0x65,
-	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x5c, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01,
-	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x73, 0x73,
-	0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xd2, 0x01, 0x0a, 0x0a, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x5f, 0x62, 0x67, 0x6d, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x67, 0x6d, 0x54,
-	0x61, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54,
-	0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x74, 0x73, 0x5f, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x74, 0x73, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0a, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x50, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
-	0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
-	0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x73, 0x65,
-	0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x6e, 0x61, 0x6c,
-	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0xeb, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x2a, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70,
-	0x6f, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
-	0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63,
-	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
-	0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x12, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a, 0x13, 0x53,
-	0x77, 0x69, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x66, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xa1, 0x01, 0x0a,
-	0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29,
-	0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69,
-	0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x6e, 0x74, 0x46, 0x61, 0x6d,
-	0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65,
-	0x22, 0x7d, 0x0a, 0x12, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x73,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x10, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d,
-	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x57, 0x6f, 0x72, 0x64,
-	0x73, 0x32, 0xe6, 0x18, 0x0a, 0x0c, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x73, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22,
-	0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x74, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a,
-	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x2e,
-	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x80, 0x01, 0x0a,
-	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x1a, 0x23, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0x96, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6c,
-	0x64, 0x56, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x77,
-	0x6f, 0x72, 0x6c, 0x64, 0x76, 0x69, 0x65, 0x77, 0x12, 0x9a, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x23, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x72, 0x61,
-	0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74,
-	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f,
-	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f,
-	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0xb6, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x25, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f,
-	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x3a, 0x01, 0x2a, 0x1a, 0x44, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f,
-	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f,
-	0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12,
-	0xb3, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x46, 0x2a,
-	0x44, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x63, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0xa8, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x26, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x3a, 0x01, 0x2a, 0x22, 0x33, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x3a,
-	0x01, 0x2a, 0x22, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73,
-	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x50, 0x6f,
-	0x6c, 0x69, 0x73, 0x68, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x45, 0x3a, 0x01, 0x2a, 0x22, 0x40, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
-	0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x9b, 0x01, 0x0a, 0x0c, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x6e, 0x6f, 0x76,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x46, 0x3a, 0x01, 0x2a, 0x22, 0x41, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x2e,
-	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01,
-	0x2a, 0x22, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
-	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2f, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f,
-	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
-	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x8b, 0x01, 0x0a,
-	0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x12, 0x1e,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x0b, 0x45,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x6e, 0x6f, 0x76,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a,
-	0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65,
-	0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5e,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x6e, 0x6f, 0x76,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x9f,
-	0x01, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x24, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e,
-	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x12, 0x71, 0x0a, 0x0b, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x2d, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x12, 0x65, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x12, 0x1b, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
-	0x76, 0x65, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x42, 0x42, 0x0a, 0x17, 0x64, 0x65,
-	0x76, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x6f, 0x76,
-	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x56, 0x31, 0x50, 0x01, 0x5a, 0x17, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// 导出选项
+type ExportOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 是否包含元数据，默认true
+	IncludeMetadata bool `protobuf:"varint,1,opt,name=include_metadata,json=includeMetadata,proto3" json:"include_metadata,omitempty"`
+	// 是否包含大纲，默认true
+	IncludeOutline bool `protobuf:"varint,2,opt,name=include_outline,json=includeOutline,proto3" json:"include_outline,omitempty"`
+	// 字体家族，默认"宋体"
+	FontFamily string `protobuf:"bytes,3,opt,name=font_family,json=fontFamily,proto3" json:"font_family,omitempty"`
+	// 字体大小，默认14
+	FontSize int32 `protobuf:"varint,4,opt,name=font_size,json=fontSize,proto3" json:"font_size,omitempty"`
 }
 
-var (
-	file_novel_v1_novel_proto_rawDescOnce sync.Once
-	file_novel_v1_novel_proto_rawDescData = file_novel_v1_novel_proto_rawDesc
-)
+func (x *ExportOptions) Reset() {
+	*x = ExportOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_novel_v1_novel_proto_rawDescGZIP() []byte {
-	file_novel_v1_novel_proto_rawDescOnce.Do(func() {
-		file_novel_v1_novel_proto_rawDescData = protoimpl.X.CompressGZIP(file_novel_v1_novel_proto_rawDescData)
-	})
-	return file_novel_v1_novel_proto_rawDescData
+func (x *ExportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_novel_v1_novel_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_novel_v1_novel_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
-var file_novel_v1_novel_proto_goTypes = []interface{}{
-	(GenerateChapterStreamResponse_ResponseType)(0), // 0: novel.v1.GenerateChapterStreamResponse.ResponseType
-	(*CreateProjectRequest)(nil),                    // 1: novel.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil),                   // 2: novel.v1.CreateProjectResponse
-	(*GetProjectRequest)(nil),                       // 3: novel.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),                      // 4: novel.v1.GetProjectResponse
-	(*ListProjectsRequest)(nil),                     // 5: novel.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),                    // 6: novel.v1.ListProjectsResponse
-	(*UpdateProjectRequest)(nil),                    // 7: novel.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),                   // 8: novel.v1.UpdateProjectResponse
-	(*GenerateWorldViewRequest)(nil),                // 9: novel.v1.GenerateWorldViewRequest
-	(*GenerateWorldViewResponse)(nil),               // 10: novel.v1.GenerateWorldViewResponse
-	(*GenerateCharactersRequest)(nil),               // 11: novel.v1.GenerateCharactersRequest
-	(*GenerateCharactersResponse)(nil),              // 12: novel.v1.GenerateCharactersResponse
-	(*GenerateOutlineRequest)(nil),                  // 13: novel.v1.GenerateOutlineRequest
-	(*GenerateOutlineResponse)(nil),                 // 14: novel.v1.GenerateOutlineResponse
-	(*UpdateChapterOutlineRequest)(nil),             // 15: novel.v1.UpdateChapterOutlineRequest
-	(*UpdateChapterOutlineResponse)(nil),            // 16: novel.v1.UpdateChapterOutlineResponse
-	(*DeleteChapterOutlineRequest)(nil),             // 17: novel.v1.DeleteChapterOutlineRequest
-	(*DeleteChapterOutlineResponse)(nil),            // 18: novel.v1.DeleteChapterOutlineResponse
-	(*ReorderChapterOutlineRequest)(nil),            // 19: novel.v1.ReorderChapterOutlineRequest
-	(*ChapterIndexMapping)(nil),                     // 20: novel.v1.ChapterIndexMapping
-	(*ReorderChapterOutlineResponse)(nil),           // 21: novel.v1.ReorderChapterOutlineResponse
-	(*GenerateChapterRequest)(nil),                  // 22: novel.v1.GenerateChapterRequest
-	(*GenerateChapterResponse)(nil),                 // 23: novel.v1.GenerateChapterResponse
-	(*GenerateChapterStreamResponse)(nil),           // 24: novel.v1.GenerateChapterStreamResponse
-	(*PolishChapterRequest)(nil),                    // 25: novel.v1.PolishChapterRequest
-	(*PolishChapterResponse)(nil),                   // 26: novel.v1.PolishChapterResponse
-	(*CheckQualityRequest)(nil),                     // 27: novel.v1.CheckQualityRequest
-	(*CheckQualityResponse)(nil),                    // 28: novel.v1.CheckQualityResponse
-	(*BatchCheckQualityRequest)(nil),                // 29: novel.v1.BatchCheckQualityRequest
-	(*BatchCheckQualityResponse)(nil),               // 30: novel.v1.BatchCheckQualityResponse
-	(*ProofreadResult)(nil),                         // 31: novel.v1.ProofreadResult
-	(*CritiqueResult)(nil),                          // 32: novel.v1.CritiqueResult
-	(*QualityIssue)(nil),                            // 33: novel.v1.QualityIssue
-	(*QualitySummary)(nil),                          // 34: novel.v1.QualitySummary
-	(*CheckConsistencyRequest)(nil),                 // 35: novel.v1.CheckConsistencyRequest
-	(*CheckConsistencyResponse)(nil),                // 36: novel.v1.CheckConsistencyResponse
-	(*GenerateNovelRequest)(nil),                    // 37: novel.v1.GenerateNovelRequest
-	(*GenerateNovelResponse)(nil),                   // 38: novel.v1.GenerateNovelResponse
-	(*ExportNovelRequest)(nil),                      // 39: novel.v1.ExportNovelRequest
-	(*ExportNovelResponse)(nil),                     // 40: novel.v1.ExportNovelResponse
-	(*GenerateVideoScriptRequest)(nil),              // 41: novel.v1.GenerateVideoScriptRequest
-	(*GenerateVideoScriptResponse)(nil),             // 42: novel.v1.GenerateVideoScriptResponse
-	(*Project)(nil),                                 // 43: novel.v1.Project
-	(*WorldView)(nil),                               // 44: novel.v1.WorldView
-	(*Character)(nil),                               // 45: novel.v1.Character
-	(*Outline)(nil),                                 // 46: novel.v1.Outline
-	(*ChapterOutline)(nil),                          // 47: novel.v1.ChapterOutline
-	(*Chapter)(nil),                                 // 48: novel.v1.Chapter
-	(*GenerationContext)(nil),                       // 49: novel.v1.GenerationContext
-	(*TimelineEvent)(nil),                           // 50: novel.v1.TimelineEvent
-	(*PropItem)(nil),                                // 51: novel.v1.PropItem
-	(*ConsistencyIssue)(nil),                        // 52: novel.v1.ConsistencyIssue
-	(*VideoScene)(nil),                              // 53: novel.v1.VideoScene
-	(*LLMOptions)(nil),                              // 54: novel.v1.LLMOptions
-	(*GenerateOptions)(nil),                         // 55: novel.v1.GenerateOptions
-	(*SwitchModelRequest)(nil),                      // 56: novel.v1.SwitchModelRequest
-	(*SwitchModelResponse)(nil),                     // 57: novel.v1.SwitchModelResponse
-	(*ListModelsRequest)(nil),                       // 58: novel.v1.ListModelsRequest
-	(*ListModelsResponse)(nil),                      // 59: novel.v1.ListModelsResponse
-	(*ModelInfo)(nil),                               // 60: novel.v1.ModelInfo
-	(*ExportOptions)(nil),                           // 61: novel.v1.ExportOptions
-	(*VideoScriptOptions)(nil),                      // 62: novel.v1.VideoScriptOptions
-	(*GetStatsRequest)(nil),                         // 63: novel.v1.GetStatsRequest
-	(*GetStatsResponse)(nil),                        // 64: novel.v1.GetStatsResponse
-	(*ProjectStats)(nil),                            // 65: novel.v1.ProjectStats
-	nil,                                             // 66: novel.v1.QualitySummary.IssuesByTypeEntry
-	nil,                                             // 67: novel.v1.QualitySummary.IssuesBySeverityEntry
-	nil,                                             // 68: novel.v1.Character.RelationshipMapEntry
-	(*timestamppb.Timestamp)(nil),                   // 69: google.protobuf.Timestamp
+func (*ExportOptions) ProtoMessage() {}
+
+func (x *ExportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_novel_v1_novel_proto_depIdxs = []int32{
-	69, // 0: novel.v1.CreateProjectResponse.created_at:type_name -> google.protobuf.Timestamp
-	43, // 1: novel.v1.GetProjectResponse.project:type_name -> novel.v1.Project
-	43, // 2: novel.v1.ListProjectsResponse.projects:type_name -> novel.v1.Project
-	46, // 3: novel.v1.UpdateProjectRequest.outline:type_name -> novel.v1.Outline
-	43, // 4: novel.v1.UpdateProjectResponse.project:type_name -> novel.v1.Project
-	54, // 5: novel.v1.GenerateWorldViewRequest.llm_options:type_name -> novel.v1.LLMOptions
-	44, // 6: novel.v1.GenerateWorldViewResponse.world_view:type_name -> novel.v1.WorldView
-	44, // 7: novel.v1.GenerateCharactersRequest.world_view:type_name -> novel.v1.WorldView
-	54, // 8: novel.v1.GenerateCharactersRequest.llm_options:type_name -> novel.v1.LLMOptions
-	45, // 9: novel.v1.GenerateCharactersResponse.characters:type_name -> novel.v1.Character
-	44, // 10: novel.v1.GenerateOutlineRequest.world_view:type_name -> novel.v1.WorldView
-	45, // 11: novel.v1.GenerateOutlineRequest.characters:type_name -> novel.v1.Character
-	54, // 12: novel.v1.GenerateOutlineRequest.llm_options:type_name -> novel.v1.LLMOptions
-	46, // 13: novel.v1.GenerateOutlineResponse.outline:type_name -> novel.v1.Outline
-	46, // 14: novel.v1.UpdateChapterOutlineResponse.outline:type_name -> novel.v1.Outline
-	46, // 15: novel.v1.DeleteChapterOutlineResponse.outline:type_name -> novel.v1.Outline
-	20, // 16: novel.v1.ReorderChapterOutlineRequest.chapter_mappings:type_name -> novel.v1.ChapterIndexMapping
-	46, // 17: novel.v1.ReorderChapterOutlineResponse.outline:type_name -> novel.v1.Outline
-	47, // 18: novel.v1.GenerateChapterRequest.chapter_outline:type_name -> novel.v1.ChapterOutline
-	49, // 19: novel.v1.GenerateChapterRequest.context:type_name -> novel.v1.GenerationContext
-	54, // 20: novel.v1.GenerateChapterRequest.llm_options:type_name -> novel.v1.LLMOptions
-	48, // 21: novel.v1.GenerateChapterResponse.chapter:type_name -> novel.v1.Chapter
-	0,  // 22: novel.v1.GenerateChapterStreamResponse.type:type_name -> novel.v1.GenerateChapterStreamResponse.ResponseType
-	48, // 23: novel.v1.GenerateChapterStreamResponse.final_chapter:type_name -> novel.v1.Chapter
-	54, // 24: novel.v1.PolishChapterRequest.llm_options:type_name -> novel.v1.LLMOptions
-	48, // 25: novel.v1.PolishChapterResponse.polished_chapter:type_name -> novel.v1.Chapter
-	54, // 26: novel.v1.CheckQualityRequest.llm_options:type_name -> novel.v1.LLMOptions
-	48, // 27: novel.v1.CheckQualityResponse.polished_chapter:type_name -> novel.v1.Chapter
-	31, // 28: novel.v1.CheckQualityResponse.proofread_result:type_name -> novel.v1.ProofreadResult
-	32, // 29: novel.v1.CheckQualityResponse.critique_result:type_name -> novel.v1.CritiqueResult
-	52, // 30: novel.v1.CheckQualityResponse.consistency_issues:type_name -> novel.v1.ConsistencyIssue
-	54, // 31: novel.v1.BatchCheckQualityRequest.llm_options:type_name -> novel.v1.LLMOptions
-	28, // 32: novel.v1.BatchCheckQualityResponse.results:type_name -> novel.v1.CheckQualityResponse
-	34, // 33: novel.v1.BatchCheckQualityResponse.summary:type_name -> novel.v1.QualitySummary
-	33, // 34: novel.v1.ProofreadResult.issues:type_name -> novel.v1.QualityIssue
-	66, // 35: novel.v1.QualitySummary.issues_by_type:type_name -> novel.v1.QualitySummary.IssuesByTypeEntry
-	67, // 36: novel.v1.QualitySummary.issues_by_severity:type_name -> novel.v1.QualitySummary.IssuesBySeverityEntry
-	54, // 37: novel.v1.CheckConsistencyRequest.llm_options:type_name -> novel.v1.LLMOptions
-	52, // 38: novel.v1.CheckConsistencyResponse.issues:type_name -> novel.v1.ConsistencyIssue
-	55, // 39: novel.v1.GenerateNovelRequest.options:type_name -> novel.v1.GenerateOptions
-	48, // 40: novel.v1.GenerateNovelResponse.chapters:type_name -> novel.v1.Chapter
-	61, // 41: novel.v1.ExportNovelRequest.options:type_name -> novel.v1.ExportOptions
-	62, // 42: novel.v1.GenerateVideoScriptRequest.options:type_name -> novel.v1.VideoScriptOptions
-	53, // 43: novel.v1.GenerateVideoScriptResponse.scenes:type_name -> novel.v1.VideoScene
-	44, // 44: novel.v1.Project.world_view:type_name -> novel.v1.WorldView
-	45, // 45: novel.v1.Project.characters:type_name -> novel.v1.Character
-	46, // 46: novel.v1.Project.outline:type_name -> novel.v1.Outline
-	48, // 47: novel.v1.Project.chapters:type_name -> novel.v1.Chapter
-	69, // 48: novel.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	69, // 49: novel.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	68, // 50: novel.v1.Character.relationship_map:type_name -> novel.v1.Character.RelationshipMapEntry
-	47, // 51: novel.v1.Outline.chapters:type_name -> novel.v1.ChapterOutline
-	69, // 52: novel.v1.Chapter.created_at:type_name -> google.protobuf.Timestamp
-	69, // 53: novel.v1.Chapter.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 54: novel.v1.GenerationContext.characters:type_name -> novel.v1.Character
-	50, // 55: novel.v1.GenerationContext.timeline:type_name -> novel.v1.TimelineEvent
-	51, // 56: novel.v1.GenerationContext.props:type_name -> novel.v1.PropItem
-	54, // 57: novel.v1.GenerateOptions.llm_options:type_name -> novel.v1.LLMOptions
-	60, // 58: novel.v1.ListModelsResponse.models:type_name -> novel.v1.ModelInfo
-	65, // 59: novel.v1.GetStatsResponse.stats:type_name -> novel.v1.ProjectStats
-	1,  // 60: novel.v1.NovelService.CreateProject:input_type -> novel.v1.CreateProjectRequest
-	3,  // 61: novel.v1.NovelService.GetProject:input_type -> novel.v1.GetProjectRequest
-	5,  // 62: novel.v1.NovelService.ListProjects:input_type -> novel.v1.ListProjectsRequest
-	7,  // 63: novel.v1.NovelService.UpdateProject:input_type -> novel.v1.UpdateProjectRequest
-	9,  // 64: novel.v1.NovelService.GenerateWorldView:input_type -> novel.v1.GenerateWorldViewRequest
-	11, // 65: novel.v1.NovelService.GenerateCharacters:input_type -> novel.v1.GenerateCharactersRequest
-	13, // 66: novel.v1.NovelService.GenerateOutline:input_type -> novel.v1.GenerateOutlineRequest
-	15, // 67: novel.v1.NovelService.UpdateChapterOutline:input_type -> novel.v1.UpdateChapterOutlineRequest
-	17, // 68: novel.v1.NovelService.DeleteChapterOutline:input_type -> novel.v1.DeleteChapterOutlineRequest
-	19, // 69: novel.v1.NovelService.ReorderChapterOutline:input_type -> novel.v1.ReorderChapterOutlineRequest
-	22, // 70: novel.v1.NovelService.GenerateChapter:input_type -> novel.v1.GenerateChapterRequest
-	22, // 71: novel.v1.NovelService.GenerateChapterStream:input_type -> novel.v1.GenerateChapterRequest
-	25, // 72: novel.v1.NovelService.PolishChapter:input_type -> novel.v1.PolishChapterRequest
-	27, // 73: novel.v1.NovelService.CheckQuality:input_type -> novel.v1.CheckQualityRequest
-	29, // 74: novel.v1.NovelService.BatchCheckQuality:input_type -> novel.v1.BatchCheckQualityRequest
-	35, // 75: novel.v1.NovelService.CheckConsistency:input_type -> novel.v1.CheckConsistencyRequest
-	37, // 76: novel.v1.NovelService.GenerateNovel:input_type -> novel.v1.GenerateNovelRequest
-	39, // 77: novel.v1.NovelService.ExportNovel:input_type -> novel.v1.ExportNovelRequest
-	63, // 78: novel.v1.NovelService.GetStats:input_type -> novel.v1.GetStatsRequest
-	41, // 79: novel.v1.NovelService.GenerateVideoScript:input_type -> novel.v1.GenerateVideoScriptRequest
-	56, // 80: novel.v1.NovelService.SwitchModel:input_type -> novel.v1.SwitchModelRequest
-	58, // 81: novel.v1.NovelService.ListModels:input_type -> novel.v1.ListModelsRequest
-	2,  // 82: novel.v1.NovelService.CreateProject:output_type -> novel.v1.CreateProjectResponse
-	4,  // 83: novel.v1.NovelService.GetProject:output_type -> novel.v1.GetProjectResponse
-	6,  // 84: novel.v1.NovelService.ListProjects:output_type -> novel.v1.ListProjectsResponse
-	8,  // 85: novel.v1.NovelService.UpdateProject:output_type -> novel.v1.UpdateProjectResponse
-	10, // 86: novel.v1.NovelService.GenerateWorldView:output_type -> novel.v1.GenerateWorldViewResponse
-	12, // 87: novel.v1.NovelService.GenerateCharacters:output_type -> novel.v1.GenerateCharactersResponse
-	14, // 88: novel.v1.NovelService.GenerateOutline:output_type -> novel.v1.GenerateOutlineResponse
-	16, // 89: novel.v1.NovelService.UpdateChapterOutline:output_type -> novel.v1.UpdateChapterOutlineResponse
-	18, // 90: novel.v1.NovelService.DeleteChapterOutline:output_type -> novel.v1.DeleteChapterOutlineResponse
-	21, // 91: novel.v1.NovelService.ReorderChapterOutline:output_type -> novel.v1.ReorderChapterOutlineResponse
-	23, // 92: novel.v1.NovelService.GenerateChapter:output_type -> novel.v1.GenerateChapterResponse
-	24, // 93: novel.v1.NovelService.GenerateChapterStream:output_type -> novel.v1.GenerateChapterStreamResponse
-	26, // 94: novel.v1.NovelService.PolishChapter:output_type -> novel.v1.PolishChapterResponse
-	28, // 95: novel.v1.NovelService.CheckQuality:output_type -> novel.v1.CheckQualityResponse
-	30, // 96: novel.v1.NovelService.BatchCheckQuality:output_type -> novel.v1.BatchCheckQualityResponse
-	36, // 97: novel.v1.NovelService.CheckConsistency:output_type -> novel.v1.CheckConsistencyResponse
-	38, // 98: novel.v1.NovelService.GenerateNovel:output_type -> novel.v1.GenerateNovelResponse
-	40, // 99: novel.v1.NovelService.ExportNovel:output_type -> novel.v1.ExportNovelResponse
-	64, // 100: novel.v1.NovelService.GetStats:output_type -> novel.v1.GetStatsResponse
-	42, // 101: novel.v1.NovelService.GenerateVideoScript:output_type -> novel.v1.GenerateVideoScriptResponse
-	57, // 102: novel.v1.NovelService.SwitchModel:output_type -> novel.v1.SwitchModelResponse
-	59, // 103: novel.v1.NovelService.ListModels:output_type -> novel.v1.ListModelsResponse
-	82, // [82:104] is the sub-list for method output_type
-	60, // [60:82] is the sub-list for method input_type
-	60, // [60:60] is the sub-list for extension type_name
-	60, // [60:60] is the sub-list for extension extendee
-	0,  // [0:60] is the sub-list for field type_name
+
+// Deprecated: Use ExportOptions.ProtoReflect.Descriptor instead.
+func (*ExportOptions) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{67}
 }
 
-func init() { file_novel_v1_novel_proto_init() }
-func file_novel_v1_novel_proto_init() {
-	if File_novel_v1_novel_proto != nil {
-		return
+func (x *ExportOptions) GetIncludeMetadata() bool {
+	if x != nil {
+		return x.IncludeMetadata
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_novel_v1_novel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProjectRequest); i {
+	return false
+}
+
+func (x *ExportOptions) GetIncludeOutline() bool {
+	if x != nil {
+		return x.IncludeOutline
+	}
+	return false
+}
+
+func (x *ExportOptions) GetFontFamily() string {
+	if x != nil {
+		return x.FontFamily
+	}
+	return ""
+}
+
+func (x *ExportOptions) GetFontSize() int32 {
+	if x != nil {
+		return x.FontSize
+	}
+	return 0
+}
+
+// 视频脚本选项
+type VideoScriptOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 每章分几个场景，默认8-12
+	ScenesPerChapter int32 `protobuf:"varint,1,opt,name=scenes_per_chapter,json=scenesPerChapter,proto3" json:"scenes_per_chapter,omitempty"`
+	// 视频平台：tiktok/youtube/bilibili
+	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	// 语音类型：male/female/auto
+	VoiceType string `protobuf:"bytes,3,opt,name=voice_type,json=voiceType,proto3" json:"voice_type,omitempty"`
+}
+
+func (x *VideoScriptOptions) Reset() {
+	*x = VideoScriptOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideoScriptOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoScriptOptions) ProtoMessage() {}
+
+func (x *VideoScriptOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoScriptOptions.ProtoReflect.Descriptor instead.
+func (*VideoScriptOptions) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *VideoScriptOptions) GetScenesPerChapter() int32 {
+	if x != nil {
+		return x.ScenesPerChapter
+	}
+	return 0
+}
+
+func (x *VideoScriptOptions) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *VideoScriptOptions) GetVoiceType() string {
+	if x != nil {
+		return x.VoiceType
+	}
+	return ""
+}
+
+// 统计信息请求
+type GetStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{69}
+}
+
+// 统计信息响应
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 统计信息
+	Stats *ProjectStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetStatsResponse) GetStats() *ProjectStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 项目统计信息
+type ProjectStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 总项目数
+	TotalProjects int32 `protobuf:"varint,1,opt,name=total_projects,json=totalProjects,proto3" json:"total_projects,omitempty"`
+	// 已完成项目数
+	CompletedProjects int32 `protobuf:"varint,2,opt,name=completed_projects,json=completedProjects,proto3" json:"completed_projects,omitempty"`
+	// 总字数
+	TotalWords int64 `protobuf:"varint,3,opt,name=total_words,json=totalWords,proto3" json:"total_words,omitempty"`
+	// 本月字数
+	MonthlyWords int64 `protobuf:"varint,4,opt,name=monthly_words,json=monthlyWords,proto3" json:"monthly_words,omitempty"`
+}
+
+func (x *ProjectStats) Reset() {
+	*x = ProjectStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectStats) ProtoMessage() {}
+
+func (x *ProjectStats) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectStats.ProtoReflect.Descriptor instead.
+func (*ProjectStats) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ProjectStats) GetTotalProjects() int32 {
+	if x != nil {
+		return x.TotalProjects
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetCompletedProjects() int32 {
+	if x != nil {
+		return x.CompletedProjects
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetTotalWords() int64 {
+	if x != nil {
+		return x.TotalWords
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetMonthlyWords() int64 {
+	if x != nil {
+		return x.MonthlyWords
+	}
+	return 0
+}
+
+// 导出项目数据包请求
+type ExportProjectBundleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *ExportProjectBundleRequest) Reset() {
+	*x = ExportProjectBundleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProjectBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProjectBundleRequest) ProtoMessage() {}
+
+func (x *ExportProjectBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProjectBundleRequest.ProtoReflect.Descriptor instead.
+func (*ExportProjectBundleRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ExportProjectBundleRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// 导出项目数据包响应
+type ExportProjectBundleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 数据包内容（JSON）
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// 建议文件名
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// 数据包结构版本
+	SchemaVersion int32 `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// 章节数
+	ChapterCount int32 `protobuf:"varint,4,opt,name=chapter_count,json=chapterCount,proto3" json:"chapter_count,omitempty"`
+	// 视频脚本数
+	VideoScriptCount int32 `protobuf:"varint,5,opt,name=video_script_count,json=videoScriptCount,proto3" json:"video_script_count,omitempty"`
+}
+
+func (x *ExportProjectBundleResponse) Reset() {
+	*x = ExportProjectBundleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportProjectBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProjectBundleResponse) ProtoMessage() {}
+
+func (x *ExportProjectBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProjectBundleResponse.ProtoReflect.Descriptor instead.
+func (*ExportProjectBundleResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExportProjectBundleResponse) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ExportProjectBundleResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportProjectBundleResponse) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *ExportProjectBundleResponse) GetChapterCount() int32 {
+	if x != nil {
+		return x.ChapterCount
+	}
+	return 0
+}
+
+func (x *ExportProjectBundleResponse) GetVideoScriptCount() int32 {
+	if x != nil {
+		return x.VideoScriptCount
+	}
+	return 0
+}
+
+// 导入项目数据包请求
+type ImportProjectBundleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 数据包内容（JSON）
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// 导入后的项目标题，为空时沿用数据包中的标题
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *ImportProjectBundleRequest) Reset() {
+	*x = ImportProjectBundleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProjectBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProjectBundleRequest) ProtoMessage() {}
+
+func (x *ImportProjectBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProjectBundleRequest.ProtoReflect.Descriptor instead.
+func (*ImportProjectBundleRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ImportProjectBundleRequest) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportProjectBundleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// 导入项目数据包响应
+type ImportProjectBundleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 新项目
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// 导入章节数
+	ChapterCount int32 `protobuf:"varint,2,opt,name=chapter_count,json=chapterCount,proto3" json:"chapter_count,omitempty"`
+	// 导入视频脚本数
+	VideoScriptCount int32 `protobuf:"varint,3,opt,name=video_script_count,json=videoScriptCount,proto3" json:"video_script_count,omitempty"`
+	// 原ID到新ID的映射
+	IdMapping map[string]string `protobuf:"bytes,4,rep,name=id_mapping,json=idMapping,proto3" json:"id_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ImportProjectBundleResponse) Reset() {
+	*x = ImportProjectBundleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProjectBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProjectBundleResponse) ProtoMessage() {}
+
+func (x *ImportProjectBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProjectBundleResponse.ProtoReflect.Descriptor instead.
+func (*ImportProjectBundleResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ImportProjectBundleResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *ImportProjectBundleResponse) GetChapterCount() int32 {
+	if x != nil {
+		return x.ChapterCount
+	}
+	return 0
+}
+
+func (x *ImportProjectBundleResponse) GetVideoScriptCount() int32 {
+	if x != nil {
+		return x.VideoScriptCount
+	}
+	return 0
+}
+
+func (x *ImportProjectBundleResponse) GetIdMapping() map[string]string {
+	if x != nil {
+		return x.IdMapping
+	}
+	return nil
+}
+
+// 全文检索请求
+type SearchContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 检索词
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 限定项目ID，为空表示全部项目
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 限定状态（章节状态或项目状态）
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// 章节范围起始（含），0表示不限
+	ChapterFrom int32 `protobuf:"varint,4,opt,name=chapter_from,json=chapterFrom,proto3" json:"chapter_from,omitempty"`
+	// 章节范围结束（含），0表示不限
+	ChapterTo int32 `protobuf:"varint,5,opt,name=chapter_to,json=chapterTo,proto3" json:"chapter_to,omitempty"`
+	// 限定文档类型：chapter/summary/character/outline
+	DocTypes []string `protobuf:"bytes,6,rep,name=doc_types,json=docTypes,proto3" json:"doc_types,omitempty"`
+	// 页码
+	Page int32 `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页数量
+	PageSize int32 `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *SearchContentRequest) Reset() {
+	*x = SearchContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContentRequest) ProtoMessage() {}
+
+func (x *SearchContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContentRequest.ProtoReflect.Descriptor instead.
+func (*SearchContentRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SearchContentRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchContentRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SearchContentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchContentRequest) GetChapterFrom() int32 {
+	if x != nil {
+		return x.ChapterFrom
+	}
+	return 0
+}
+
+func (x *SearchContentRequest) GetChapterTo() int32 {
+	if x != nil {
+		return x.ChapterTo
+	}
+	return 0
+}
+
+func (x *SearchContentRequest) GetDocTypes() []string {
+	if x != nil {
+		return x.DocTypes
+	}
+	return nil
+}
+
+func (x *SearchContentRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchContentRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 全文检索命中结果
+type SearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 文档类型：chapter/summary/character/outline
+	DocType string `protobuf:"bytes,1,opt,name=doc_type,json=docType,proto3" json:"doc_type,omitempty"`
+	// 项目ID
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 关联对象ID（章节ID/人物ID/大纲序号）
+	RefId string `protobuf:"bytes,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	// 章节序号，人物卡为0
+	ChapterIndex int32 `protobuf:"varint,4,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 标题
+	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	// 高亮片段，命中部分以<mark>标记
+	Snippet string `protobuf:"bytes,6,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// 相关度评分，越大越相关
+	Score float64 `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	// 状态
+	Status string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SearchHit) GetDocType() string {
+	if x != nil {
+		return x.DocType
+	}
+	return ""
+}
+
+func (x *SearchHit) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SearchHit) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+func (x *SearchHit) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *SearchHit) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 全文检索响应
+type SearchContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 命中结果
+	Hits []*SearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// 命中总数
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *SearchContentResponse) Reset() {
+	*x = SearchContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchContentResponse) ProtoMessage() {}
+
+func (x *SearchContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchContentResponse.ProtoReflect.Descriptor instead.
+func (*SearchContentResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SearchContentResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchContentResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 删除项目请求
+type DeleteProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// 删除项目响应
+type DeleteProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 删除是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteProjectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 删除章节请求
+type DeleteChapterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节ID
+	ChapterId string `protobuf:"bytes,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+}
+
+func (x *DeleteChapterRequest) Reset() {
+	*x = DeleteChapterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChapterRequest) ProtoMessage() {}
+
+func (x *DeleteChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChapterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChapterRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteChapterRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *DeleteChapterRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+// 删除章节响应
+type DeleteChapterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 删除是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteChapterResponse) Reset() {
+	*x = DeleteChapterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteChapterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChapterResponse) ProtoMessage() {}
+
+func (x *DeleteChapterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChapterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChapterResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteChapterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 回收站条目
+type TrashItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 条目类型：project/chapter
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 项目ID或章节ID
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// 所属项目ID
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 标题
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// 章节序号，项目为0
+	ChapterIndex int32 `protobuf:"varint,5,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 删除时间
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// 到期时间，到期后将被彻底删除
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TrashItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *TrashItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrashItem) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashItem) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// 列出回收站请求
+type ListTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 条目类型：project/chapter，为空表示全部
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 页码
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页数量
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListTrashRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListTrashRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 列出回收站响应
+type ListTrashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 回收站条目
+	Items []*TrashItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// 总数
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 恢复项目请求
+type RestoreProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *RestoreProjectRequest) Reset() {
+	*x = RestoreProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProjectRequest) ProtoMessage() {}
+
+func (x *RestoreProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProjectRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProjectRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RestoreProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// 恢复项目响应
+type RestoreProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 恢复后的项目（含章节）
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+}
+
+func (x *RestoreProjectResponse) Reset() {
+	*x = RestoreProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProjectResponse) ProtoMessage() {}
+
+func (x *RestoreProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProjectResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProjectResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RestoreProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// 恢复章节请求
+type RestoreChapterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 章节ID
+	ChapterId string `protobuf:"bytes,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+}
+
+func (x *RestoreChapterRequest) Reset() {
+	*x = RestoreChapterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreChapterRequest) ProtoMessage() {}
+
+func (x *RestoreChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreChapterRequest.ProtoReflect.Descriptor instead.
+func (*RestoreChapterRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RestoreChapterRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+// 恢复章节响应
+type RestoreChapterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 恢复后的章节
+	Chapter *Chapter `protobuf:"bytes,1,opt,name=chapter,proto3" json:"chapter,omitempty"`
+}
+
+func (x *RestoreChapterResponse) Reset() {
+	*x = RestoreChapterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreChapterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreChapterResponse) ProtoMessage() {}
+
+func (x *RestoreChapterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreChapterResponse.ProtoReflect.Descriptor instead.
+func (*RestoreChapterResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RestoreChapterResponse) GetChapter() *Chapter {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
+
+// 彻底删除项目请求
+type PurgeProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *PurgeProjectRequest) Reset() {
+	*x = PurgeProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeProjectRequest) ProtoMessage() {}
+
+func (x *PurgeProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeProjectRequest.ProtoReflect.Descriptor instead.
+func (*PurgeProjectRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PurgeProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// 彻底删除项目响应
+type PurgeProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 删除是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *PurgeProjectResponse) Reset() {
+	*x = PurgeProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeProjectResponse) ProtoMessage() {}
+
+func (x *PurgeProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeProjectResponse.ProtoReflect.Descriptor instead.
+func (*PurgeProjectResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PurgeProjectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 彻底删除章节请求
+type PurgeChapterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 章节ID
+	ChapterId string `protobuf:"bytes,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+}
+
+func (x *PurgeChapterRequest) Reset() {
+	*x = PurgeChapterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeChapterRequest) ProtoMessage() {}
+
+func (x *PurgeChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeChapterRequest.ProtoReflect.Descriptor instead.
+func (*PurgeChapterRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PurgeChapterRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+// 彻底删除章节响应
+type PurgeChapterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 删除是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *PurgeChapterResponse) Reset() {
+	*x = PurgeChapterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeChapterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeChapterResponse) ProtoMessage() {}
+
+func (x *PurgeChapterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeChapterResponse.ProtoReflect.Descriptor instead.
+func (*PurgeChapterResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PurgeChapterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 订阅生成进度请求
+type WatchGenerationProgressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *WatchGenerationProgressRequest) Reset() {
+	*x = WatchGenerationProgressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchGenerationProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGenerationProgressRequest) ProtoMessage() {}
+
+func (x *WatchGenerationProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGenerationProgressRequest.ProtoReflect.Descriptor instead.
+func (*WatchGenerationProgressRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *WatchGenerationProgressRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// 知识库索引条目状态
+type IndexItemStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 条目类型：worldview/character/chapter
+	ItemType string `protobuf:"bytes,1,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	// 条目ID
+	ItemId string `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// 人物姓名、章节标题等
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// 状态：indexed/stale/missing/failed
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// 最近索引时间
+	IndexedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+	// 失败原因
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *IndexItemStatus) Reset() {
+	*x = IndexItemStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexItemStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexItemStatus) ProtoMessage() {}
+
+func (x *IndexItemStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexItemStatus.ProtoReflect.Descriptor instead.
+func (*IndexItemStatus) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *IndexItemStatus) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *IndexItemStatus) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *IndexItemStatus) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IndexItemStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *IndexItemStatus) GetIndexedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IndexedAt
+	}
+	return nil
+}
+
+func (x *IndexItemStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 项目知识库索引新鲜度
+type IndexStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 全部条目已索引且没有待处理任务
+	Fresh bool `protobuf:"varint,2,opt,name=fresh,proto3" json:"fresh,omitempty"`
+	// 条目总数
+	Total int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// 已是最新的条目数
+	Indexed int32 `protobuf:"varint,4,opt,name=indexed,proto3" json:"indexed,omitempty"`
+	// 过期的条目数
+	Stale int32 `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	// 未索引的条目数
+	Missing int32 `protobuf:"varint,6,opt,name=missing,proto3" json:"missing,omitempty"`
+	// 索引失败的条目数
+	Failed int32 `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	// 排队中的索引任务数
+	Pending int32 `protobuf:"varint,8,opt,name=pending,proto3" json:"pending,omitempty"`
+	// 最近一次索引时间
+	LastIndexedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_indexed_at,json=lastIndexedAt,proto3" json:"last_indexed_at,omitempty"`
+	// 各条目状态
+	Items []*IndexItemStatus `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *IndexStatus) Reset() {
+	*x = IndexStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexStatus) ProtoMessage() {}
+
+func (x *IndexStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexStatus.ProtoReflect.Descriptor instead.
+func (*IndexStatus) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *IndexStatus) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *IndexStatus) GetFresh() bool {
+	if x != nil {
+		return x.Fresh
+	}
+	return false
+}
+
+func (x *IndexStatus) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *IndexStatus) GetIndexed() int32 {
+	if x != nil {
+		return x.Indexed
+	}
+	return 0
+}
+
+func (x *IndexStatus) GetStale() int32 {
+	if x != nil {
+		return x.Stale
+	}
+	return 0
+}
+
+func (x *IndexStatus) GetMissing() int32 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+func (x *IndexStatus) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *IndexStatus) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *IndexStatus) GetLastIndexedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastIndexedAt
+	}
+	return nil
+}
+
+func (x *IndexStatus) GetItems() []*IndexItemStatus {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 获取索引状态请求
+type GetIndexStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetIndexStatusRequest) Reset() {
+	*x = GetIndexStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIndexStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexStatusRequest) ProtoMessage() {}
+
+func (x *GetIndexStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetIndexStatusRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetIndexStatusRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// 获取索引状态响应
+type GetIndexStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *IndexStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetIndexStatusResponse) Reset() {
+	*x = GetIndexStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIndexStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexStatusResponse) ProtoMessage() {}
+
+func (x *GetIndexStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetIndexStatusResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetIndexStatusResponse) GetStatus() *IndexStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// 重新索引请求
+type ReindexProjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 忽略内容摘要，全部重新嵌入
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *ReindexProjectRequest) Reset() {
+	*x = ReindexProjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexProjectRequest) ProtoMessage() {}
+
+func (x *ReindexProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexProjectRequest.ProtoReflect.Descriptor instead.
+func (*ReindexProjectRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ReindexProjectRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ReindexProjectRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// 重新索引响应
+type ReindexProjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 提交重新索引时的状态
+	Status *IndexStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ReindexProjectResponse) Reset() {
+	*x = ReindexProjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexProjectResponse) ProtoMessage() {}
+
+func (x *ReindexProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexProjectResponse.ProtoReflect.Descriptor instead.
+func (*ReindexProjectResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ReindexProjectResponse) GetStatus() *IndexStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// 项目知识库检索请求
+type SearchProjectKnowledgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 自然语言问题
+	Question string `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	// 限定内容类型：worldview/character/chapter，为空表示全部
+	ContentTypes []string `protobuf:"bytes,3,rep,name=content_types,json=contentTypes,proto3" json:"content_types,omitempty"`
+	// 返回的片段数，默认10，最多50
+	TopK int32 `protobuf:"varint,4,opt,name=top_k,json=topK,proto3" json:"top_k,omitempty"`
+}
+
+func (x *SearchProjectKnowledgeRequest) Reset() {
+	*x = SearchProjectKnowledgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchProjectKnowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProjectKnowledgeRequest) ProtoMessage() {}
+
+func (x *SearchProjectKnowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProjectKnowledgeRequest.ProtoReflect.Descriptor instead.
+func (*SearchProjectKnowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SearchProjectKnowledgeRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SearchProjectKnowledgeRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *SearchProjectKnowledgeRequest) GetContentTypes() []string {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *SearchProjectKnowledgeRequest) GetTopK() int32 {
+	if x != nil {
+		return x.TopK
+	}
+	return 0
+}
+
+// 知识库检索命中的片段
+type KnowledgePassage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 向量库文档ID
+	DocumentId string `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// 内容类型：worldview/character/chapter
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// 章节标题、人物姓名或世界观标题
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// 片段内容
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// 相关度评分，越大越相关
+	Score float32 `protobuf:"fixed32,5,opt,name=score,proto3" json:"score,omitempty"`
+	// 来源章节ID，非章节片段为空
+	ChapterId string `protobuf:"bytes,6,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 来源章节索引
+	ChapterIndex int32 `protobuf:"varint,7,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 片段在章节正文中的起始位置（字符）
+	StartOffset int32 `protobuf:"varint,8,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	// 片段在章节正文中的结束位置（字符）
+	EndOffset int32 `protobuf:"varint,9,opt,name=end_offset,json=endOffset,proto3" json:"end_offset,omitempty"`
+}
+
+func (x *KnowledgePassage) Reset() {
+	*x = KnowledgePassage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnowledgePassage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgePassage) ProtoMessage() {}
+
+func (x *KnowledgePassage) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgePassage.ProtoReflect.Descriptor instead.
+func (*KnowledgePassage) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *KnowledgePassage) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *KnowledgePassage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *KnowledgePassage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *KnowledgePassage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *KnowledgePassage) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *KnowledgePassage) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *KnowledgePassage) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *KnowledgePassage) GetStartOffset() int32 {
+	if x != nil {
+		return x.StartOffset
+	}
+	return 0
+}
+
+func (x *KnowledgePassage) GetEndOffset() int32 {
+	if x != nil {
+		return x.EndOffset
+	}
+	return 0
+}
+
+// 项目知识库检索响应
+type SearchProjectKnowledgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 按相关度降序排列的片段
+	Passages []*KnowledgePassage `protobuf:"bytes,1,rep,name=passages,proto3" json:"passages,omitempty"`
+	// 各内容类型已索引的文档数
+	Stats map[string]int32 `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *SearchProjectKnowledgeResponse) Reset() {
+	*x = SearchProjectKnowledgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchProjectKnowledgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProjectKnowledgeResponse) ProtoMessage() {}
+
+func (x *SearchProjectKnowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProjectKnowledgeResponse.ProtoReflect.Descriptor instead.
+func (*SearchProjectKnowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SearchProjectKnowledgeResponse) GetPassages() []*KnowledgePassage {
+	if x != nil {
+		return x.Passages
+	}
+	return nil
+}
+
+func (x *SearchProjectKnowledgeResponse) GetStats() map[string]int32 {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 故事状态请求
+type GetStoryStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节索引，返回该章结束时的状态；0表示最新状态
+	ChapterIndex int32 `protobuf:"varint,2,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+}
+
+func (x *GetStoryStateRequest) Reset() {
+	*x = GetStoryStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryStateRequest) ProtoMessage() {}
+
+func (x *GetStoryStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryStateRequest.ProtoReflect.Descriptor instead.
+func (*GetStoryStateRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetStoryStateRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetStoryStateRequest) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+// 某一章结束时的故事状态，包含此前各章累积的结果
+type StoryState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节ID
+	ChapterId string `protobuf:"bytes,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 章节索引
+	ChapterIndex int32 `protobuf:"varint,3,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 人物所在与状态
+	Characters []*CharacterState `protobuf:"bytes,4,rep,name=characters,proto3" json:"characters,omitempty"`
+	// 已出现的地点
+	Locations []string `protobuf:"bytes,5,rep,name=locations,proto3" json:"locations,omitempty"`
+	// 道具的持有人与位置
+	Props []*PropItem `protobuf:"bytes,6,rep,name=props,proto3" json:"props,omitempty"`
+	// 按发生顺序排列的事件
+	Timeline []*TimelineEvent `protobuf:"bytes,7,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	// 更新时间
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *StoryState) Reset() {
+	*x = StoryState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoryState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoryState) ProtoMessage() {}
+
+func (x *StoryState) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoryState.ProtoReflect.Descriptor instead.
+func (*StoryState) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *StoryState) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *StoryState) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *StoryState) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *StoryState) GetCharacters() []*CharacterState {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *StoryState) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *StoryState) GetProps() []*PropItem {
+	if x != nil {
+		return x.Props
+	}
+	return nil
+}
+
+func (x *StoryState) GetTimeline() []*TimelineEvent {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *StoryState) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 故事状态响应
+type GetStoryStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 故事状态，尚未记录时为空
+	State *StoryState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *GetStoryStateResponse) Reset() {
+	*x = GetStoryStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStoryStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryStateResponse) ProtoMessage() {}
+
+func (x *GetStoryStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryStateResponse.ProtoReflect.Descriptor instead.
+func (*GetStoryStateResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetStoryStateResponse) GetState() *StoryState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// 应用修正请求
+type ApplyCorrectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节ID
+	ChapterId string `protobuf:"bytes,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 原文起始偏移（含），按字符计
+	Start int32 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	// 原文结束偏移（不含）
+	End int32 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	// 期望的原文，与偏移处的内容不一致时按原文重新定位，找不到时返回 CORRECTION_SPAN_MISMATCH
+	Original string `protobuf:"bytes,5,opt,name=original,proto3" json:"original,omitempty"`
+	// 替换后的文本
+	Replacement string `protobuf:"bytes,6,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	// 期望的章节版本号，大于0时版本不一致将返回 VERSION_CONFLICT
+	ExpectedVersion int64 `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *ApplyCorrectionRequest) Reset() {
+	*x = ApplyCorrectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyCorrectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCorrectionRequest) ProtoMessage() {}
+
+func (x *ApplyCorrectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCorrectionRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCorrectionRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ApplyCorrectionRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ApplyCorrectionRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *ApplyCorrectionRequest) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ApplyCorrectionRequest) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ApplyCorrectionRequest) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *ApplyCorrectionRequest) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+func (x *ApplyCorrectionRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// 应用修正响应
+type ApplyCorrectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 修正后的章节
+	Chapter *Chapter `protobuf:"bytes,1,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	// 替换后的文本在正文中的位置
+	Span *TextSpan `protobuf:"bytes,2,opt,name=span,proto3" json:"span,omitempty"`
+}
+
+func (x *ApplyCorrectionResponse) Reset() {
+	*x = ApplyCorrectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyCorrectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCorrectionResponse) ProtoMessage() {}
+
+func (x *ApplyCorrectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCorrectionResponse.ProtoReflect.Descriptor instead.
+func (*ApplyCorrectionResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ApplyCorrectionResponse) GetChapter() *Chapter {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
+
+func (x *ApplyCorrectionResponse) GetSpan() *TextSpan {
+	if x != nil {
+		return x.Span
+	}
+	return nil
+}
+
+// 章节某一版本的检查报告
+type QualityReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 报告ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 项目ID
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节ID
+	ChapterId string `protobuf:"bytes,3,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 章节索引
+	ChapterIndex int32 `protobuf:"varint,4,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 检查时章节的版本号
+	ChapterVersion int64 `protobuf:"varint,5,opt,name=chapter_version,json=chapterVersion,proto3" json:"chapter_version,omitempty"`
+	// 报告类型：quality/consistency/quality_gate
+	Kind string `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 检查类型
+	CheckType string `protobuf:"bytes,7,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
+	// 检查的是否为润色后的正文
+	Polished bool `protobuf:"varint,8,opt,name=polished,proto3" json:"polished,omitempty"`
+	// 总分 0-1
+	OverallScore float64 `protobuf:"fixed64,9,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	// 问题数
+	IssueCount int32 `protobuf:"varint,10,opt,name=issue_count,json=issueCount,proto3" json:"issue_count,omitempty"`
+	// 按严重程度统计问题数
+	IssuesBySeverity map[string]int32 `protobuf:"bytes,11,rep,name=issues_by_severity,json=issuesBySeverity,proto3" json:"issues_by_severity,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// 检查时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// 质量检测结果，kind 为 quality 时返回
+	QualityResult *CheckQualityResponse `protobuf:"bytes,13,opt,name=quality_result,json=qualityResult,proto3" json:"quality_result,omitempty"`
+	// 该章节的一致性问题，kind 为 consistency 时返回
+	ConsistencyIssues []*ConsistencyIssue `protobuf:"bytes,14,rep,name=consistency_issues,json=consistencyIssues,proto3" json:"consistency_issues,omitempty"`
+	// 质量门禁的评审记录，kind 为 quality_gate 时返回
+	GateAttempt *QualityGateAttempt `protobuf:"bytes,15,opt,name=gate_attempt,json=gateAttempt,proto3" json:"gate_attempt,omitempty"`
+}
+
+func (x *QualityReport) Reset() {
+	*x = QualityReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QualityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityReport) ProtoMessage() {}
+
+func (x *QualityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityReport.ProtoReflect.Descriptor instead.
+func (*QualityReport) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *QualityReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QualityReport) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *QualityReport) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *QualityReport) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *QualityReport) GetChapterVersion() int64 {
+	if x != nil {
+		return x.ChapterVersion
+	}
+	return 0
+}
+
+func (x *QualityReport) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *QualityReport) GetCheckType() string {
+	if x != nil {
+		return x.CheckType
+	}
+	return ""
+}
+
+func (x *QualityReport) GetPolished() bool {
+	if x != nil {
+		return x.Polished
+	}
+	return false
+}
+
+func (x *QualityReport) GetOverallScore() float64 {
+	if x != nil {
+		return x.OverallScore
+	}
+	return 0
+}
+
+func (x *QualityReport) GetIssueCount() int32 {
+	if x != nil {
+		return x.IssueCount
+	}
+	return 0
+}
+
+func (x *QualityReport) GetIssuesBySeverity() map[string]int32 {
+	if x != nil {
+		return x.IssuesBySeverity
+	}
+	return nil
+}
+
+func (x *QualityReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *QualityReport) GetQualityResult() *CheckQualityResponse {
+	if x != nil {
+		return x.QualityResult
+	}
+	return nil
+}
+
+func (x *QualityReport) GetConsistencyIssues() []*ConsistencyIssue {
+	if x != nil {
+		return x.ConsistencyIssues
+	}
+	return nil
+}
+
+func (x *QualityReport) GetGateAttempt() *QualityGateAttempt {
+	if x != nil {
+		return x.GateAttempt
+	}
+	return nil
+}
+
+// 质量门禁的一次评审
+type QualityGateAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 第几次评审，从1开始
+	Attempt int32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// 评审分数 0-1
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// 是否达到阈值
+	Passed bool `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	// 评审结果
+	Critique *CritiqueResult `protobuf:"bytes,4,opt,name=critique,proto3" json:"critique,omitempty"`
+	// 未通过时交给改写的意见
+	Feedback string `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	// 评审或改写失败的原因
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// 评审时间
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+}
+
+func (x *QualityGateAttempt) Reset() {
+	*x = QualityGateAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QualityGateAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityGateAttempt) ProtoMessage() {}
+
+func (x *QualityGateAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityGateAttempt.ProtoReflect.Descriptor instead.
+func (*QualityGateAttempt) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *QualityGateAttempt) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *QualityGateAttempt) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QualityGateAttempt) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *QualityGateAttempt) GetCritique() *CritiqueResult {
+	if x != nil {
+		return x.Critique
+	}
+	return nil
+}
+
+func (x *QualityGateAttempt) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *QualityGateAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QualityGateAttempt) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// 章节的质量门禁结果
+type QualityGateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 章节ID
+	ChapterId string `protobuf:"bytes,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 章节索引
+	ChapterIndex int32 `protobuf:"varint,2,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 通过阈值
+	Threshold float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// 最终是否通过
+	Passed bool `protobuf:"varint,4,opt,name=passed,proto3" json:"passed,omitempty"`
+	// 是否需要人工审核
+	NeedsReview bool `protobuf:"varint,5,opt,name=needs_review,json=needsReview,proto3" json:"needs_review,omitempty"`
+	// 每次评审的记录
+	Attempts []*QualityGateAttempt `protobuf:"bytes,6,rep,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *QualityGateResult) Reset() {
+	*x = QualityGateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QualityGateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityGateResult) ProtoMessage() {}
+
+func (x *QualityGateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityGateResult.ProtoReflect.Descriptor instead.
+func (*QualityGateResult) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *QualityGateResult) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *QualityGateResult) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *QualityGateResult) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *QualityGateResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *QualityGateResult) GetNeedsReview() bool {
+	if x != nil {
+		return x.NeedsReview
+	}
+	return false
+}
+
+func (x *QualityGateResult) GetAttempts() []*QualityGateAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+// 列出检查历史请求
+type ListQualityReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 章节ID
+	ChapterId string `protobuf:"bytes,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 报告类型：quality/consistency/quality_gate，为空表示全部
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 页码
+	Page int32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页数量
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListQualityReportsRequest) Reset() {
+	*x = ListQualityReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQualityReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQualityReportsRequest) ProtoMessage() {}
+
+func (x *ListQualityReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQualityReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListQualityReportsRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListQualityReportsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListQualityReportsRequest) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *ListQualityReportsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListQualityReportsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListQualityReportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 列出检查历史响应
+type ListQualityReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 按检查时间倒序排列的报告
+	Reports []*QualityReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	// 总数
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListQualityReportsResponse) Reset() {
+	*x = ListQualityReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListQualityReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQualityReportsResponse) ProtoMessage() {}
+
+func (x *ListQualityReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQualityReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListQualityReportsResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListQualityReportsResponse) GetReports() []*QualityReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ListQualityReportsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取质量趋势请求
+type GetQualityTrendsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 报告类型：quality/consistency/quality_gate，默认 quality
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 统计最近多少天，0表示全部
+	Days int32 `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *GetQualityTrendsRequest) Reset() {
+	*x = GetQualityTrendsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQualityTrendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityTrendsRequest) ProtoMessage() {}
+
+func (x *GetQualityTrendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityTrendsRequest.ProtoReflect.Descriptor instead.
+func (*GetQualityTrendsRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetQualityTrendsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetQualityTrendsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetQualityTrendsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// 某一天的项目质量
+type QualityTrendPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 日期（YYYY-MM-DD）
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// 当天报告的平均分
+	AverageScore float64 `protobuf:"fixed64,2,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	// 当天的报告数
+	ReportCount int32 `protobuf:"varint,3,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+}
+
+func (x *QualityTrendPoint) Reset() {
+	*x = QualityTrendPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QualityTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityTrendPoint) ProtoMessage() {}
+
+func (x *QualityTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityTrendPoint.ProtoReflect.Descriptor instead.
+func (*QualityTrendPoint) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *QualityTrendPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *QualityTrendPoint) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *QualityTrendPoint) GetReportCount() int32 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+// 单个章节的质量变化
+type ChapterQualityTrend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 章节ID
+	ChapterId string `protobuf:"bytes,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 章节索引
+	ChapterIndex int32 `protobuf:"varint,2,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 最近一次检查的分数
+	LatestScore float64 `protobuf:"fixed64,3,opt,name=latest_score,json=latestScore,proto3" json:"latest_score,omitempty"`
+	// 润色前最近一次检查的分数
+	BeforePolishScore float64 `protobuf:"fixed64,4,opt,name=before_polish_score,json=beforePolishScore,proto3" json:"before_polish_score,omitempty"`
+	// 润色后最近一次检查的分数
+	AfterPolishScore float64 `protobuf:"fixed64,5,opt,name=after_polish_score,json=afterPolishScore,proto3" json:"after_polish_score,omitempty"`
+	// 润色前后均有报告时为 true
+	HasPolishCompare bool `protobuf:"varint,6,opt,name=has_polish_compare,json=hasPolishCompare,proto3" json:"has_polish_compare,omitempty"`
+	// 报告数
+	ReportCount int32 `protobuf:"varint,7,opt,name=report_count,json=reportCount,proto3" json:"report_count,omitempty"`
+}
+
+func (x *ChapterQualityTrend) Reset() {
+	*x = ChapterQualityTrend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChapterQualityTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChapterQualityTrend) ProtoMessage() {}
+
+func (x *ChapterQualityTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChapterQualityTrend.ProtoReflect.Descriptor instead.
+func (*ChapterQualityTrend) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ChapterQualityTrend) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *ChapterQualityTrend) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *ChapterQualityTrend) GetLatestScore() float64 {
+	if x != nil {
+		return x.LatestScore
+	}
+	return 0
+}
+
+func (x *ChapterQualityTrend) GetBeforePolishScore() float64 {
+	if x != nil {
+		return x.BeforePolishScore
+	}
+	return 0
+}
+
+func (x *ChapterQualityTrend) GetAfterPolishScore() float64 {
+	if x != nil {
+		return x.AfterPolishScore
+	}
+	return 0
+}
+
+func (x *ChapterQualityTrend) GetHasPolishCompare() bool {
+	if x != nil {
+		return x.HasPolishCompare
+	}
+	return false
+}
+
+func (x *ChapterQualityTrend) GetReportCount() int32 {
+	if x != nil {
+		return x.ReportCount
+	}
+	return 0
+}
+
+// 获取质量趋势响应
+type GetQualityTrendsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 报告类型
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 按日期排列的平均分
+	Points []*QualityTrendPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	// 按章节索引排列的章节质量
+	Chapters []*ChapterQualityTrend `protobuf:"bytes,3,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	// 有润色前后对比的章节润色前的平均分
+	AverageBefore float64 `protobuf:"fixed64,4,opt,name=average_before,json=averageBefore,proto3" json:"average_before,omitempty"`
+	// 同一批章节润色后的平均分
+	AverageAfter float64 `protobuf:"fixed64,5,opt,name=average_after,json=averageAfter,proto3" json:"average_after,omitempty"`
+	// 有润色前后对比的章节数
+	PolishComparedCount int32 `protobuf:"varint,6,opt,name=polish_compared_count,json=polishComparedCount,proto3" json:"polish_compared_count,omitempty"`
+}
+
+func (x *GetQualityTrendsResponse) Reset() {
+	*x = GetQualityTrendsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQualityTrendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityTrendsResponse) ProtoMessage() {}
+
+func (x *GetQualityTrendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityTrendsResponse.ProtoReflect.Descriptor instead.
+func (*GetQualityTrendsResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetQualityTrendsResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetQualityTrendsResponse) GetPoints() []*QualityTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetQualityTrendsResponse) GetChapters() []*ChapterQualityTrend {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+func (x *GetQualityTrendsResponse) GetAverageBefore() float64 {
+	if x != nil {
+		return x.AverageBefore
+	}
+	return 0
+}
+
+func (x *GetQualityTrendsResponse) GetAverageAfter() float64 {
+	if x != nil {
+		return x.AverageAfter
+	}
+	return 0
+}
+
+func (x *GetQualityTrendsResponse) GetPolishComparedCount() int32 {
+	if x != nil {
+		return x.PolishComparedCount
+	}
+	return 0
+}
+
+// 情节线索
+type PlotThread struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 线索ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 类型：mystery/promise/clue/twist
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// 线索名称
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// 线索内容
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// 来源：outline/chapter
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// 埋下或提及这条线索的章节索引
+	PlantedChapters []int32 `protobuf:"varint,6,rep,packed,name=planted_chapters,json=plantedChapters,proto3" json:"planted_chapters,omitempty"`
+	// 大纲计划回收的章节索引，0 表示未计划
+	PayoffChapter int32 `protobuf:"varint,7,opt,name=payoff_chapter,json=payoffChapter,proto3" json:"payoff_chapter,omitempty"`
+	// 实际回收的章节索引，0 表示未回收
+	ResolvedChapter int32 `protobuf:"varint,8,opt,name=resolved_chapter,json=resolvedChapter,proto3" json:"resolved_chapter,omitempty"`
+	// 回收方式
+	Resolution string `protobuf:"bytes,9,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	// 状态：open/resolved
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// 更新时间
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *PlotThread) Reset() {
+	*x = PlotThread{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlotThread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotThread) ProtoMessage() {}
+
+func (x *PlotThread) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotThread.ProtoReflect.Descriptor instead.
+func (*PlotThread) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *PlotThread) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlotThread) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PlotThread) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlotThread) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlotThread) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PlotThread) GetPlantedChapters() []int32 {
+	if x != nil {
+		return x.PlantedChapters
+	}
+	return nil
+}
+
+func (x *PlotThread) GetPayoffChapter() int32 {
+	if x != nil {
+		return x.PayoffChapter
+	}
+	return 0
+}
+
+func (x *PlotThread) GetResolvedChapter() int32 {
+	if x != nil {
+		return x.ResolvedChapter
+	}
+	return 0
+}
+
+func (x *PlotThread) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *PlotThread) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PlotThread) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// 情节线索问题
+type PlotThreadIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 线索ID
+	ThreadId string `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	// 问题类型：dangling（未回收）/unprepared（缺少铺垫）
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// 线索名称
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// 相关章节索引
+	ChapterIndex int32 `protobuf:"varint,4,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 问题描述
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// 修改建议
+	Suggestion string `protobuf:"bytes,6,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+}
+
+func (x *PlotThreadIssue) Reset() {
+	*x = PlotThreadIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlotThreadIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlotThreadIssue) ProtoMessage() {}
+
+func (x *PlotThreadIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlotThreadIssue.ProtoReflect.Descriptor instead.
+func (*PlotThreadIssue) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *PlotThreadIssue) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *PlotThreadIssue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlotThreadIssue) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlotThreadIssue) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *PlotThreadIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlotThreadIssue) GetSuggestion() string {
+	if x != nil {
+		return x.Suggestion
+	}
+	return ""
+}
+
+// 列出情节线索请求
+type ListPlotThreadsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 状态过滤：open/resolved，为空时返回全部
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListPlotThreadsRequest) Reset() {
+	*x = ListPlotThreadsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlotThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlotThreadsRequest) ProtoMessage() {}
+
+func (x *ListPlotThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlotThreadsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlotThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListPlotThreadsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListPlotThreadsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 列出情节线索响应
+type ListPlotThreadsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 按埋下或计划回收的章节排列的线索
+	Threads []*PlotThread `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+}
+
+func (x *ListPlotThreadsResponse) Reset() {
+	*x = ListPlotThreadsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlotThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlotThreadsResponse) ProtoMessage() {}
+
+func (x *ListPlotThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlotThreadsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlotThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListPlotThreadsResponse) GetThreads() []*PlotThread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+// 检查情节线索请求
+type CheckPlotThreadsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 即将生成的章节索引，0 表示已有章节之后的一章
+	NextChapter int32 `protobuf:"varint,2,opt,name=next_chapter,json=nextChapter,proto3" json:"next_chapter,omitempty"`
+}
+
+func (x *CheckPlotThreadsRequest) Reset() {
+	*x = CheckPlotThreadsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPlotThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPlotThreadsRequest) ProtoMessage() {}
+
+func (x *CheckPlotThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPlotThreadsRequest.ProtoReflect.Descriptor instead.
+func (*CheckPlotThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CheckPlotThreadsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CheckPlotThreadsRequest) GetNextChapter() int32 {
+	if x != nil {
+		return x.NextChapter
+	}
+	return 0
+}
+
+// 检查情节线索响应
+type CheckPlotThreadsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 即将生成的章节索引
+	NextChapter int32 `protobuf:"varint,1,opt,name=next_chapter,json=nextChapter,proto3" json:"next_chapter,omitempty"`
+	// 大纲章节数
+	TotalChapters int32 `protobuf:"varint,2,opt,name=total_chapters,json=totalChapters,proto3" json:"total_chapters,omitempty"`
+	// 尚未回收的线索
+	OpenThreads []*PlotThread `protobuf:"bytes,3,rep,name=open_threads,json=openThreads,proto3" json:"open_threads,omitempty"`
+	// 未回收与缺少铺垫的问题，按章节排列
+	Issues []*PlotThreadIssue `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *CheckPlotThreadsResponse) Reset() {
+	*x = CheckPlotThreadsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPlotThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPlotThreadsResponse) ProtoMessage() {}
+
+func (x *CheckPlotThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPlotThreadsResponse.ProtoReflect.Descriptor instead.
+func (*CheckPlotThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CheckPlotThreadsResponse) GetNextChapter() int32 {
+	if x != nil {
+		return x.NextChapter
+	}
+	return 0
+}
+
+func (x *CheckPlotThreadsResponse) GetTotalChapters() int32 {
+	if x != nil {
+		return x.TotalChapters
+	}
+	return 0
+}
+
+func (x *CheckPlotThreadsResponse) GetOpenThreads() []*PlotThread {
+	if x != nil {
+		return x.OpenThreads
+	}
+	return nil
+}
+
+func (x *CheckPlotThreadsResponse) GetIssues() []*PlotThreadIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+// 优化章节大纲请求
+type RefineOutlineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 反馈意见
+	Feedback string `protobuf:"bytes,2,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	// 是否将尚未回收的情节线索交给模型，要求在大纲中安排回收
+	IncludeOpenThreads bool `protobuf:"varint,3,opt,name=include_open_threads,json=includeOpenThreads,proto3" json:"include_open_threads,omitempty"`
+	// LLM选项
+	LlmOptions *LLMOptions `protobuf:"bytes,4,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
+}
+
+func (x *RefineOutlineRequest) Reset() {
+	*x = RefineOutlineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefineOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefineOutlineRequest) ProtoMessage() {}
+
+func (x *RefineOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefineOutlineRequest.ProtoReflect.Descriptor instead.
+func (*RefineOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *RefineOutlineRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RefineOutlineRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *RefineOutlineRequest) GetIncludeOpenThreads() bool {
+	if x != nil {
+		return x.IncludeOpenThreads
+	}
+	return false
+}
+
+func (x *RefineOutlineRequest) GetLlmOptions() *LLMOptions {
+	if x != nil {
+		return x.LlmOptions
+	}
+	return nil
+}
+
+// 优化章节大纲响应
+type RefineOutlineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 优化后的章节大纲
+	Outline *Outline `protobuf:"bytes,1,opt,name=outline,proto3" json:"outline,omitempty"`
+	// 优化时交给模型的未回收线索
+	OpenThreads []*PlotThread `protobuf:"bytes,2,rep,name=open_threads,json=openThreads,proto3" json:"open_threads,omitempty"`
+}
+
+func (x *RefineOutlineResponse) Reset() {
+	*x = RefineOutlineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefineOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefineOutlineResponse) ProtoMessage() {}
+
+func (x *RefineOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefineOutlineResponse.ProtoReflect.Descriptor instead.
+func (*RefineOutlineResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *RefineOutlineResponse) GetOutline() *Outline {
+	if x != nil {
+		return x.Outline
+	}
+	return nil
+}
+
+func (x *RefineOutlineResponse) GetOpenThreads() []*PlotThread {
+	if x != nil {
+		return x.OpenThreads
+	}
+	return nil
+}
+
+// 内容安全命中
+type SafetyHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 风险类别：violence/sexual/politics/minors
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// 风险等级：low/medium/high
+	Severity string `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	// 命中的关键词或正则，模型判定时为空
+	Term string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	// 命中的原文
+	Quote string `protobuf:"bytes,4,opt,name=quote,proto3" json:"quote,omitempty"`
+	// 原文起始字符偏移（含）
+	Start int32 `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
+	// 原文结束字符偏移（不含）
+	End int32 `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"`
+	// 来源：dictionary/llm
+	Source string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *SafetyHit) Reset() {
+	*x = SafetyHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SafetyHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafetyHit) ProtoMessage() {}
+
+func (x *SafetyHit) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafetyHit.ProtoReflect.Descriptor instead.
+func (*SafetyHit) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SafetyHit) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SafetyHit) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *SafetyHit) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *SafetyHit) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *SafetyHit) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SafetyHit) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *SafetyHit) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// 内容安全类别结论
+type SafetyLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 风险类别：violence/sexual/politics/minors
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// 风险等级：none/low/medium/high
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// 词库命中次数
+	HitCount int32 `protobuf:"varint,3,opt,name=hit_count,json=hitCount,proto3" json:"hit_count,omitempty"`
+	// 模型给出的判定理由
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SafetyLabel) Reset() {
+	*x = SafetyLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SafetyLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafetyLabel) ProtoMessage() {}
+
+func (x *SafetyLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafetyLabel.ProtoReflect.Descriptor instead.
+func (*SafetyLabel) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SafetyLabel) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SafetyLabel) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SafetyLabel) GetHitCount() int32 {
+	if x != nil {
+		return x.HitCount
+	}
+	return 0
+}
+
+func (x *SafetyLabel) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 单章内容安全审核结果
+type SafetyReview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 章节ID
+	ChapterId string `protobuf:"bytes,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	// 章节索引
+	ChapterIndex int32 `protobuf:"varint,2,opt,name=chapter_index,json=chapterIndex,proto3" json:"chapter_index,omitempty"`
+	// 内容分级：general/teen/mature/restricted
+	Rating string `protobuf:"bytes,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// 各风险类别的结论
+	Labels []*SafetyLabel `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	// 命中的风险内容，按位置排列
+	Hits []*SafetyHit `protobuf:"bytes,5,rep,name=hits,proto3" json:"hits,omitempty"`
+	// 分级是否达到阻止导出的阈值
+	Blocked bool `protobuf:"varint,6,opt,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (x *SafetyReview) Reset() {
+	*x = SafetyReview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SafetyReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafetyReview) ProtoMessage() {}
+
+func (x *SafetyReview) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafetyReview.ProtoReflect.Descriptor instead.
+func (*SafetyReview) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SafetyReview) GetChapterId() string {
+	if x != nil {
+		return x.ChapterId
+	}
+	return ""
+}
+
+func (x *SafetyReview) GetChapterIndex() int32 {
+	if x != nil {
+		return x.ChapterIndex
+	}
+	return 0
+}
+
+func (x *SafetyReview) GetRating() string {
+	if x != nil {
+		return x.Rating
+	}
+	return ""
+}
+
+func (x *SafetyReview) GetLabels() []*SafetyLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SafetyReview) GetHits() []*SafetyHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SafetyReview) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+// 内容安全审核请求
+type ReviewContentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 项目ID
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// 要审核的章节索引，为空时审核全部章节
+	ChapterIndexes []int32 `protobuf:"varint,2,rep,packed,name=chapter_indexes,json=chapterIndexes,proto3" json:"chapter_indexes,omitempty"`
+	// 是否调用模型分类，否则只使用词库
+	UseLlm bool `protobuf:"varint,3,opt,name=use_llm,json=useLlm,proto3" json:"use_llm,omitempty"`
+	// LLM选项
+	LlmOptions *LLMOptions `protobuf:"bytes,4,opt,name=llm_options,json=llmOptions,proto3" json:"llm_options,omitempty"`
+}
+
+func (x *ReviewContentRequest) Reset() {
+	*x = ReviewContentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewContentRequest) ProtoMessage() {}
+
+func (x *ReviewContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewContentRequest.ProtoReflect.Descriptor instead.
+func (*ReviewContentRequest) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ReviewContentRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ReviewContentRequest) GetChapterIndexes() []int32 {
+	if x != nil {
+		return x.ChapterIndexes
+	}
+	return nil
+}
+
+func (x *ReviewContentRequest) GetUseLlm() bool {
+	if x != nil {
+		return x.UseLlm
+	}
+	return false
+}
+
+func (x *ReviewContentRequest) GetLlmOptions() *LLMOptions {
+	if x != nil {
+		return x.LlmOptions
+	}
+	return nil
+}
+
+// 内容安全审核响应
+type ReviewContentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 所有章节中最高的内容分级，只审核部分章节时为空
+	Rating string `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	// 阻止导出的分级阈值，为空表示不阻止
+	BlockRating string `protobuf:"bytes,2,opt,name=block_rating,json=blockRating,proto3" json:"block_rating,omitempty"`
+	// 是否有章节达到阻止阈值，只审核部分章节时为 false，以各章节的结果为准
+	Blocked bool `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// 是否调用了模型分类
+	UsedLlm bool `protobuf:"varint,4,opt,name=used_llm,json=usedLlm,proto3" json:"used_llm,omitempty"`
+	// 各章节的审核结果
+	Reviews []*SafetyReview `protobuf:"bytes,5,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	// 审核时间
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+}
+
+func (x *ReviewContentResponse) Reset() {
+	*x = ReviewContentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_novel_v1_novel_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewContentResponse) ProtoMessage() {}
+
+func (x *ReviewContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_novel_v1_novel_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewContentResponse.ProtoReflect.Descriptor instead.
+func (*ReviewContentResponse) Descriptor() ([]byte, []int) {
+	return file_novel_v1_novel_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ReviewContentResponse) GetRating() string {
+	if x != nil {
+		return x.Rating
+	}
+	return ""
+}
+
+func (x *ReviewContentResponse) GetBlockRating() string {
+	if x != nil {
+		return x.BlockRating
+	}
+	return ""
+}
+
+func (x *ReviewContentResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *ReviewContentResponse) GetUsedLlm() bool {
+	if x != nil {
+		return x.UsedLlm
+	}
+	return false
+}
+
+func (x *ReviewContentResponse) GetReviews() []*SafetyReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ReviewContentResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+var File_novel_v1_novel_proto protoreflect.FileDescriptor
+
+var file_novel_v1_novel_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xb9, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x32, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x22, 0x41, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x22, 0x46, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x5b, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xb0, 0x02, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68,
+	0x65, 0x6d, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x65, 0x6e, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x6d,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73,
+	0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x22, 0xce, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x32,
+	0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69,
+	0x65, 0x77, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c,
+	0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x1a, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x22, 0xfc, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x77,
+	0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64,
+	0x56, 0x69, 0x65, 0x77, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x12,
+	0x33, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x46, 0x0a, 0x17, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x77, 0x69, 0x73, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x77, 0x69, 0x73, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4b,
+	0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x1b,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x1c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75,
+	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x0f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x13,
+	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x4c, 0x0a,
+	0x1d, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f,
+	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x16,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x6c,
+	0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x52, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x22, 0x92, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x64,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xbb, 0x04, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x22, 0x50, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x45, 0x10, 0x04, 0x22, 0xe2, 0x01, 0x0a, 0x14, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68,
+	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x15, 0x50, 0x6f,
+	0x6c, 0x69, 0x73, 0x68, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe4, 0x03,
+	0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x72,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x63, 0x72,
+	0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x63,
+	0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x49, 0x0a,
+	0x12, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22,
+	0x3b, 0x0a, 0x0b, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x88, 0x07, 0x0a,
+	0x0b, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x11, 0x61, 0x76, 0x67, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x14, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x53, 0x74, 0x64, 0x44, 0x65, 0x76, 0x12, 0x55, 0x0a, 0x10, 0x73, 0x65, 0x6e,
+	0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x65,
+	0x6e, 0x63, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0f, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x67, 0x72, 0x61, 0x70, 0x68, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x61, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x76, 0x67,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x67, 0x72, 0x61, 0x70, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x61, 0x76, 0x67, 0x50, 0x61, 0x72, 0x61,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x67, 0x72, 0x61, 0x70, 0x68, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x61,
+	0x72, 0x61, 0x67, 0x72, 0x61, 0x70, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x61, 0x6c, 0x6f, 0x67, 0x75, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x12, 0x3e, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x6e, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x67,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x40, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x75, 0x73, 0x65, 0x64, 0x50,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x64, 0x76, 0x65, 0x72, 0x62,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x76,
+	0x65, 0x72, 0x62, 0x5f, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72, 0x62, 0x44, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79,
+	0x12, 0x2b, 0x0a, 0x11, 0x70, 0x75, 0x6e, 0x63, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x75, 0x6e,
+	0x63, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a,
+	0x16, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x70, 0x75, 0x6e, 0x63,
+	0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x68,
+	0x61, 0x6c, 0x66, 0x57, 0x69, 0x64, 0x74, 0x68, 0x50, 0x75, 0x6e, 0x63, 0x74, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x75, 0x6e, 0x63, 0x74, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x75, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x70, 0x75, 0x6e, 0x63, 0x74, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x75, 0x73, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x1a, 0x42, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a,
+	0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xea, 0x01, 0x0a, 0x19, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x72,
+	0x65, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xad, 0x02, 0x0a, 0x0e, 0x43,
+	0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x43, 0x72,
+	0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x12, 0x26, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x70,
+	0x61, 0x6e, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x08, 0x54, 0x65, 0x78,
+	0x74, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xde,
+	0x01, 0x0a, 0x0c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x22,
+	0xba, 0x03, 0x0a, 0x0e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x5f,
+	0x62, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x42, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5c, 0x0a, 0x12, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x10, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73,
+	0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x42, 0x79, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x01, 0x0a,
+	0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95,
+	0x01, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x6a, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x95, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x2d, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x47, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x47, 0x61, 0x74, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6c, 0x6f, 0x74,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x52, 0x0b, 0x70, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x12, 0x47, 0x0a, 0x12, 0x70, 0x6c, 0x6f, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x10, 0x70, 0x6c, 0x6f, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x7e, 0x0a, 0x12, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a, 0x13, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x94,
+	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x52, 0x06, 0x73, 0x63, 0x65, 0x6e,
+	0x65, 0x73, 0x22, 0xc4, 0x04, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x65,
+	0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72,
+	0x6c, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x12, 0x33, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x2d, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb1, 0x01, 0x0a, 0x09, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x79, 0x6e, 0x6f, 0x70, 0x73, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x6e, 0x65, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6e, 0x65, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x73, 0x22, 0xbe, 0x03,
+	0x0a, 0x09, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65, 0x61, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x77, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70,
+	0x65, 0x65, 0x63, 0x68, 0x5f, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x70, 0x65, 0x65, 0x63, 0x68, 0x54, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x4d, 0x61, 0x70, 0x1a, 0x42, 0x0a, 0x14, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e,
+	0x0a, 0x07, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x22, 0xb2,
+	0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x77, 0x69, 0x73, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x77, 0x69, 0x73, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6e, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbe, 0x02, 0x0a,
+	0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x33, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0x8a, 0x01,
+	0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x74, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x22, 0x76, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x73,
+	0x70, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x04, 0x73,
+	0x70, 0x61, 0x6e, 0x22, 0xd2, 0x01, 0x0a, 0x0a, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x65,
+	0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x67, 0x6d, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42,
+	0x67, 0x6d, 0x54, 0x61, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x54, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x74, 0x73, 0x5f, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x74, 0x73, 0x56, 0x6f, 0x69,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0a, 0x4c, 0x4c, 0x4d,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x5f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x50, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0xe0, 0x02, 0x0a, 0x0f,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x77,
+	0x6f, 0x72, 0x64, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c,
+	0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x47, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x33,
+	0x0a, 0x12, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x6e, 0x0a, 0x13, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x22, 0x91, 0x01, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6f, 0x75, 0x74,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f,
+	0x6e, 0x74, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x6f, 0x6e, 0x74, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x6f, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x66, 0x6f, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7d, 0x0a, 0x12, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x63, 0x65, 0x6e,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xaa, 0x01, 0x0a,
+	0x0c, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x5f,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x6c, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x3b, 0x0a, 0x1a, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0xcc, 0x01, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x10, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x22, 0xb0, 0x02, 0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x53, 0x0a, 0x0a, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x64, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69, 0x64, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x3c, 0x0a, 0x0e, 0x49, 0x64, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xf3, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x6f, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x6f, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x09, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6f, 0x63, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x56, 0x0a, 0x15,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x54,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x73,
+	0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x57, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x54, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x36, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x22, 0x45, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x36, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x45, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x34,
+	0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x1e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0xc4, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc9,
+	0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x36, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x22, 0x47, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4c, 0x0a, 0x15, 0x52,
+	0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x47, 0x0a, 0x16, 0x52, 0x65, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4b, 0x22, 0xa2, 0x02, 0x0a, 0x10, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xdd,
+	0x01, 0x0a, 0x1e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe1, 0x02, 0x0a, 0x0a, 0x53,
+	0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x38, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x33,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x43,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0xe7, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a,
+	0x17, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x78, 0x74, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x22, 0xf0, 0x05,
+	0x0a, 0x0d, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x5b, 0x0a, 0x12, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x45, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0d, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x49, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x67, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x47, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x0b,
+	0x67, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x1a, 0x43, 0x0a, 0x15, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xff, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x47, 0x61, 0x74, 0x65,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x34, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x71, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x69,
+	0x74, 0x69, 0x71, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x08, 0x63, 0x72, 0x69,
+	0x74, 0x69, 0x71, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x47, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x65, 0x65, 0x64, 0x73, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x47, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22,
+	0x9e, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x65, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x6f, 0x0a, 0x11, 0x51, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xab, 0x02, 0x0a, 0x13, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65,
+	0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x73, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x61, 0x66, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69,
+	0x73, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x61, 0x73, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x61, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x39,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf0, 0x02, 0x0a, 0x0a, 0x50, 0x6c,
+	0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6f, 0x66,
+	0x66, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x70, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbf, 0x01, 0x0a,
+	0x0f, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x17, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x37,
+	0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x14, 0x52,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x30,
+	0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x6c, 0x6c, 0x6d,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7d, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x75, 0x74,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x37, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x09, 0x53, 0x61, 0x66, 0x65, 0x74,
+	0x79, 0x48, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x74, 0x0a, 0x0b, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x68, 0x69, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xdc, 0x01, 0x0a,
+	0x0c, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x48, 0x69, 0x74, 0x52, 0x04, 0x68, 0x69, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x5f, 0x6c, 0x6c, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x4c, 0x6c, 0x6d, 0x12, 0x35, 0x0a, 0x0b, 0x6c, 0x6c, 0x6d, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x4c, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0a, 0x6c, 0x6c, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf4, 0x01, 0x0a,
+	0x15, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x6c, 0x6c, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x64, 0x4c, 0x6c, 0x6d, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x32, 0xee, 0x31, 0x0a, 0x0c, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x73, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01,
+	0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x74, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x6d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x1d, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x80,
+	0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x1a, 0x23, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x96, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x12, 0x22, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6c, 0x64,
+	0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6c, 0x64, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x76, 0x69, 0x65, 0x77, 0x12, 0x9a, 0x01, 0x0a, 0x12, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x23, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
+	0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4f,
+	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0xb6, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x25, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x3a, 0x01, 0x2a, 0x1a, 0x44, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x7d, 0x12, 0xb3, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x25, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x46, 0x2a, 0x44, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x63,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x7d, 0x12, 0xa8, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x26, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x3a, 0x01, 0x2a, 0x22, 0x33, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x72, 0x65, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x38, 0x3a, 0x01, 0x2a, 0x22, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x9d, 0x01, 0x0a, 0x0d,
+	0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x3a, 0x01, 0x2a, 0x22, 0x40, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x9b, 0x01, 0x0a, 0x0c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x46, 0x3a, 0x01, 0x2a, 0x22, 0x41, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x22, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36,
+	0x3a, 0x01, 0x2a, 0x22, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x8b,
+	0x01, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c,
+	0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x81, 0x01, 0x0a,
+	0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f,
+	0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x6f, 0x76, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x5e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x9f, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x24, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a,
+	0x22, 0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x2d, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x71, 0x0a, 0x0b, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x2d,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x65, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x12, 0x1b, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x96, 0x01, 0x0a,
+	0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x24, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x6e, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x7d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x2a, 0x23,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x93, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x2a, 0x39,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x12, 0x91, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x22, 0x31, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x74, 0x72, 0x61,
+	0x73, 0x68, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x91, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01,
+	0x2a, 0x22, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x74, 0x72, 0x61, 0x73,
+	0x68, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a,
+	0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x74,
+	0x72, 0x61, 0x73, 0x68, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x17, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x30, 0x01, 0x12, 0x86, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b,
+	0x12, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x91, 0x01, 0x0a, 0x0e,
+	0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x22, 0x31, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x2f, 0x72, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0xa9, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x89, 0x01, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0xa8, 0x01, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6e, 0x6f,
+	0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x43, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x50, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x3a, 0x01, 0x2a, 0x22, 0x45, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6e, 0x6f, 0x76, 0x65,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12, 0x49, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2d,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51,
+	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x6e,
+	0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2d, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x12,
+	0x90, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x12, 0x20, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32,
+	0x12, 0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x6c, 0x6f, 0x74, 0x2d, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6c, 0x6f, 0x74,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6c, 0x6f, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6c, 0x6f, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x22, 0x36, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70,
+	0x6c, 0x6f, 0x74, 0x2d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x12, 0x8f, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x22,
+	0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x2f, 0x72, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01,
+	0x2a, 0x22, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x76, 0x65, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x2f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x42, 0x42, 0x0a, 0x17, 0x64, 0x65, 0x76, 0x2e, 0x6b, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6e, 0x6f, 0x76, 0x65, 0x6c, 0x2e, 0x76, 0x31, 0x42,
+	0x0c, 0x4e, 0x6f, 0x76, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x31, 0x50, 0x01, 0x5a,
+	0x17, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x76,
+	0x65, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_novel_v1_novel_proto_rawDescOnce sync.Once
+	file_novel_v1_novel_proto_rawDescData = file_novel_v1_novel_proto_rawDesc
+)
+
+func file_novel_v1_novel_proto_rawDescGZIP() []byte {
+	file_novel_v1_novel_proto_rawDescOnce.Do(func() {
+		file_novel_v1_novel_proto_rawDescData = protoimpl.X.CompressGZIP(file_novel_v1_novel_proto_rawDescData)
+	})
+	return file_novel_v1_novel_proto_rawDescData
+}
+
+var file_novel_v1_novel_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_novel_v1_novel_proto_msgTypes = make([]protoimpl.MessageInfo, 138)
+var file_novel_v1_novel_proto_goTypes = []interface{}{
+	(GenerateChapterStreamResponse_ResponseType)(0), // 0: novel.v1.GenerateChapterStreamResponse.ResponseType
+	(*CreateProjectRequest)(nil),                    // 1: novel.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),                   // 2: novel.v1.CreateProjectResponse
+	(*GetProjectRequest)(nil),                       // 3: novel.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),                      // 4: novel.v1.GetProjectResponse
+	(*ListProjectsRequest)(nil),                     // 5: novel.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),                    // 6: novel.v1.ListProjectsResponse
+	(*UpdateProjectRequest)(nil),                    // 7: novel.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),                   // 8: novel.v1.UpdateProjectResponse
+	(*GenerateWorldViewRequest)(nil),                // 9: novel.v1.GenerateWorldViewRequest
+	(*GenerateWorldViewResponse)(nil),               // 10: novel.v1.GenerateWorldViewResponse
+	(*GenerateCharactersRequest)(nil),               // 11: novel.v1.GenerateCharactersRequest
+	(*GenerateCharactersResponse)(nil),              // 12: novel.v1.GenerateCharactersResponse
+	(*GenerateOutlineRequest)(nil),                  // 13: novel.v1.GenerateOutlineRequest
+	(*GenerateOutlineResponse)(nil),                 // 14: novel.v1.GenerateOutlineResponse
+	(*UpdateChapterOutlineRequest)(nil),             // 15: novel.v1.UpdateChapterOutlineRequest
+	(*UpdateChapterOutlineResponse)(nil),            // 16: novel.v1.UpdateChapterOutlineResponse
+	(*DeleteChapterOutlineRequest)(nil),             // 17: novel.v1.DeleteChapterOutlineRequest
+	(*DeleteChapterOutlineResponse)(nil),            // 18: novel.v1.DeleteChapterOutlineResponse
+	(*ReorderChapterOutlineRequest)(nil),            // 19: novel.v1.ReorderChapterOutlineRequest
+	(*ChapterIndexMapping)(nil),                     // 20: novel.v1.ChapterIndexMapping
+	(*ReorderChapterOutlineResponse)(nil),           // 21: novel.v1.ReorderChapterOutlineResponse
+	(*GenerateChapterRequest)(nil),                  // 22: novel.v1.GenerateChapterRequest
+	(*GenerateChapterResponse)(nil),                 // 23: novel.v1.GenerateChapterResponse
+	(*RetrievedChunk)(nil),                          // 24: novel.v1.RetrievedChunk
+	(*GenerateChapterStreamResponse)(nil),           // 25: novel.v1.GenerateChapterStreamResponse
+	(*PolishChapterRequest)(nil),                    // 26: novel.v1.PolishChapterRequest
+	(*PolishChapterResponse)(nil),                   // 27: novel.v1.PolishChapterResponse
+	(*CheckQualityRequest)(nil),                     // 28: novel.v1.CheckQualityRequest
+	(*CheckQualityResponse)(nil),                    // 29: novel.v1.CheckQualityResponse
+	(*RepetitionPair)(nil),                          // 30: novel.v1.RepetitionPair
+	(*PhraseCount)(nil),                             // 31: novel.v1.PhraseCount
+	(*TextMetrics)(nil),                             // 32: novel.v1.TextMetrics
+	(*BatchCheckQualityRequest)(nil),                // 33: novel.v1.BatchCheckQualityRequest
+	(*BatchCheckQualityResponse)(nil),               // 34: novel.v1.BatchCheckQualityResponse
+	(*ProofreadResult)(nil),                         // 35: novel.v1.ProofreadResult
+	(*CritiqueResult)(nil),                          // 36: novel.v1.CritiqueResult
+	(*CritiqueEvidence)(nil),                        // 37: novel.v1.CritiqueEvidence
+	(*TextSpan)(nil),                                // 38: novel.v1.TextSpan
+	(*QualityIssue)(nil),                            // 39: novel.v1.QualityIssue
+	(*QualitySummary)(nil),                          // 40: novel.v1.QualitySummary
+	(*CheckConsistencyRequest)(nil),                 // 41: novel.v1.CheckConsistencyRequest
+	(*CheckConsistencyResponse)(nil),                // 42: novel.v1.CheckConsistencyResponse
+	(*GenerateNovelRequest)(nil),                    // 43: novel.v1.GenerateNovelRequest
+	(*GenerateNovelResponse)(nil),                   // 44: novel.v1.GenerateNovelResponse
+	(*ExportNovelRequest)(nil),                      // 45: novel.v1.ExportNovelRequest
+	(*ExportNovelResponse)(nil),                     // 46: novel.v1.ExportNovelResponse
+	(*GenerateVideoScriptRequest)(nil),              // 47: novel.v1.GenerateVideoScriptRequest
+	(*GenerateVideoScriptResponse)(nil),             // 48: novel.v1.GenerateVideoScriptResponse
+	(*Project)(nil),                                 // 49: novel.v1.Project
+	(*WorldView)(nil),                               // 50: novel.v1.WorldView
+	(*Character)(nil),                               // 51: novel.v1.Character
+	(*Outline)(nil),                                 // 52: novel.v1.Outline
+	(*ChapterOutline)(nil),                          // 53: novel.v1.ChapterOutline
+	(*Chapter)(nil),                                 // 54: novel.v1.Chapter
+	(*GenerationContext)(nil),                       // 55: novel.v1.GenerationContext
+	(*TimelineEvent)(nil),                           // 56: novel.v1.TimelineEvent
+	(*PropItem)(nil),                                // 57: novel.v1.PropItem
+	(*CharacterState)(nil),                          // 58: novel.v1.CharacterState
+	(*ConsistencyIssue)(nil),                        // 59: novel.v1.ConsistencyIssue
+	(*VideoScene)(nil),                              // 60: novel.v1.VideoScene
+	(*LLMOptions)(nil),                              // 61: novel.v1.LLMOptions
+	(*GenerateOptions)(nil),                         // 62: novel.v1.GenerateOptions
+	(*SwitchModelRequest)(nil),                      // 63: novel.v1.SwitchModelRequest
+	(*SwitchModelResponse)(nil),                     // 64: novel.v1.SwitchModelResponse
+	(*ListModelsRequest)(nil),                       // 65: novel.v1.ListModelsRequest
+	(*ListModelsResponse)(nil),                      // 66: novel.v1.ListModelsResponse
+	(*ModelInfo)(nil),                               // 67: novel.v1.ModelInfo
+	(*ExportOptions)(nil),                           // 68: novel.v1.ExportOptions
+	(*VideoScriptOptions)(nil),                      // 69: novel.v1.VideoScriptOptions
+	(*GetStatsRequest)(nil),                         // 70: novel.v1.GetStatsRequest
+	(*GetStatsResponse)(nil),                        // 71: novel.v1.GetStatsResponse
+	(*ProjectStats)(nil),                            // 72: novel.v1.ProjectStats
+	(*ExportProjectBundleRequest)(nil),              // 73: novel.v1.ExportProjectBundleRequest
+	(*ExportProjectBundleResponse)(nil),             // 74: novel.v1.ExportProjectBundleResponse
+	(*ImportProjectBundleRequest)(nil),              // 75: novel.v1.ImportProjectBundleRequest
+	(*ImportProjectBundleResponse)(nil),             // 76: novel.v1.ImportProjectBundleResponse
+	(*SearchContentRequest)(nil),                    // 77: novel.v1.SearchContentRequest
+	(*SearchHit)(nil),                               // 78: novel.v1.SearchHit
+	(*SearchContentResponse)(nil),                   // 79: novel.v1.SearchContentResponse
+	(*DeleteProjectRequest)(nil),                    // 80: novel.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),                   // 81: novel.v1.DeleteProjectResponse
+	(*DeleteChapterRequest)(nil),                    // 82: novel.v1.DeleteChapterRequest
+	(*DeleteChapterResponse)(nil),                   // 83: novel.v1.DeleteChapterResponse
+	(*TrashItem)(nil),                               // 84: novel.v1.TrashItem
+	(*ListTrashRequest)(nil),                        // 85: novel.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),                       // 86: novel.v1.ListTrashResponse
+	(*RestoreProjectRequest)(nil),                   // 87: novel.v1.RestoreProjectRequest
+	(*RestoreProjectResponse)(nil),                  // 88: novel.v1.RestoreProjectResponse
+	(*RestoreChapterRequest)(nil),                   // 89: novel.v1.RestoreChapterRequest
+	(*RestoreChapterResponse)(nil),                  // 90: novel.v1.RestoreChapterResponse
+	(*PurgeProjectRequest)(nil),                     // 91: novel.v1.PurgeProjectRequest
+	(*PurgeProjectResponse)(nil),                    // 92: novel.v1.PurgeProjectResponse
+	(*PurgeChapterRequest)(nil),                     // 93: novel.v1.PurgeChapterRequest
+	(*PurgeChapterResponse)(nil),                    // 94: novel.v1.PurgeChapterResponse
+	(*WatchGenerationProgressRequest)(nil),          // 95: novel.v1.WatchGenerationProgressRequest
+	(*IndexItemStatus)(nil),                         // 96: novel.v1.IndexItemStatus
+	(*IndexStatus)(nil),                             // 97: novel.v1.IndexStatus
+	(*GetIndexStatusRequest)(nil),                   // 98: novel.v1.GetIndexStatusRequest
+	(*GetIndexStatusResponse)(nil),                  // 99: novel.v1.GetIndexStatusResponse
+	(*ReindexProjectRequest)(nil),                   // 100: novel.v1.ReindexProjectRequest
+	(*ReindexProjectResponse)(nil),                  // 101: novel.v1.ReindexProjectResponse
+	(*SearchProjectKnowledgeRequest)(nil),           // 102: novel.v1.SearchProjectKnowledgeRequest
+	(*KnowledgePassage)(nil),                        // 103: novel.v1.KnowledgePassage
+	(*SearchProjectKnowledgeResponse)(nil),          // 104: novel.v1.SearchProjectKnowledgeResponse
+	(*GetStoryStateRequest)(nil),                    // 105: novel.v1.GetStoryStateRequest
+	(*StoryState)(nil),                              // 106: novel.v1.StoryState
+	(*GetStoryStateResponse)(nil),                   // 107: novel.v1.GetStoryStateResponse
+	(*ApplyCorrectionRequest)(nil),                  // 108: novel.v1.ApplyCorrectionRequest
+	(*ApplyCorrectionResponse)(nil),                 // 109: novel.v1.ApplyCorrectionResponse
+	(*QualityReport)(nil),                           // 110: novel.v1.QualityReport
+	(*QualityGateAttempt)(nil),                      // 111: novel.v1.QualityGateAttempt
+	(*QualityGateResult)(nil),                       // 112: novel.v1.QualityGateResult
+	(*ListQualityReportsRequest)(nil),               // 113: novel.v1.ListQualityReportsRequest
+	(*ListQualityReportsResponse)(nil),              // 114: novel.v1.ListQualityReportsResponse
+	(*GetQualityTrendsRequest)(nil),                 // 115: novel.v1.GetQualityTrendsRequest
+	(*QualityTrendPoint)(nil),                       // 116: novel.v1.QualityTrendPoint
+	(*ChapterQualityTrend)(nil),                     // 117: novel.v1.ChapterQualityTrend
+	(*GetQualityTrendsResponse)(nil),                // 118: novel.v1.GetQualityTrendsResponse
+	(*PlotThread)(nil),                              // 119: novel.v1.PlotThread
+	(*PlotThreadIssue)(nil),                         // 120: novel.v1.PlotThreadIssue
+	(*ListPlotThreadsRequest)(nil),                  // 121: novel.v1.ListPlotThreadsRequest
+	(*ListPlotThreadsResponse)(nil),                 // 122: novel.v1.ListPlotThreadsResponse
+	(*CheckPlotThreadsRequest)(nil),                 // 123: novel.v1.CheckPlotThreadsRequest
+	(*CheckPlotThreadsResponse)(nil),                // 124: novel.v1.CheckPlotThreadsResponse
+	(*RefineOutlineRequest)(nil),                    // 125: novel.v1.RefineOutlineRequest
+	(*RefineOutlineResponse)(nil),                   // 126: novel.v1.RefineOutlineResponse
+	(*SafetyHit)(nil),                               // 127: novel.v1.SafetyHit
+	(*SafetyLabel)(nil),                             // 128: novel.v1.SafetyLabel
+	(*SafetyReview)(nil),                            // 129: novel.v1.SafetyReview
+	(*ReviewContentRequest)(nil),                    // 130: novel.v1.ReviewContentRequest
+	(*ReviewContentResponse)(nil),                   // 131: novel.v1.ReviewContentResponse
+	nil,                                             // 132: novel.v1.TextMetrics.SentenceLengthsEntry
+	nil,                                             // 133: novel.v1.QualitySummary.IssuesByTypeEntry
+	nil,                                             // 134: novel.v1.QualitySummary.IssuesBySeverityEntry
+	nil,                                             // 135: novel.v1.Character.RelationshipMapEntry
+	nil,                                             // 136: novel.v1.ImportProjectBundleResponse.IdMappingEntry
+	nil,                                             // 137: novel.v1.SearchProjectKnowledgeResponse.StatsEntry
+	nil,                                             // 138: novel.v1.QualityReport.IssuesBySeverityEntry
+	(*timestamppb.Timestamp)(nil),                   // 139: google.protobuf.Timestamp
+}
+var file_novel_v1_novel_proto_depIdxs = []int32{
+	139, // 0: novel.v1.CreateProjectResponse.created_at:type_name -> google.protobuf.Timestamp
+	49,  // 1: novel.v1.GetProjectResponse.project:type_name -> novel.v1.Project
+	49,  // 2: novel.v1.ListProjectsResponse.projects:type_name -> novel.v1.Project
+	52,  // 3: novel.v1.UpdateProjectRequest.outline:type_name -> novel.v1.Outline
+	49,  // 4: novel.v1.UpdateProjectResponse.project:type_name -> novel.v1.Project
+	61,  // 5: novel.v1.GenerateWorldViewRequest.llm_options:type_name -> novel.v1.LLMOptions
+	50,  // 6: novel.v1.GenerateWorldViewResponse.world_view:type_name -> novel.v1.WorldView
+	50,  // 7: novel.v1.GenerateCharactersRequest.world_view:type_name -> novel.v1.WorldView
+	61,  // 8: novel.v1.GenerateCharactersRequest.llm_options:type_name -> novel.v1.LLMOptions
+	51,  // 9: novel.v1.GenerateCharactersResponse.characters:type_name -> novel.v1.Character
+	50,  // 10: novel.v1.GenerateOutlineRequest.world_view:type_name -> novel.v1.WorldView
+	51,  // 11: novel.v1.GenerateOutlineRequest.characters:type_name -> novel.v1.Character
+	61,  // 12: novel.v1.GenerateOutlineRequest.llm_options:type_name -> novel.v1.LLMOptions
+	52,  // 13: novel.v1.GenerateOutlineResponse.outline:type_name -> novel.v1.Outline
+	52,  // 14: novel.v1.UpdateChapterOutlineResponse.outline:type_name -> novel.v1.Outline
+	52,  // 15: novel.v1.DeleteChapterOutlineResponse.outline:type_name -> novel.v1.Outline
+	20,  // 16: novel.v1.ReorderChapterOutlineRequest.chapter_mappings:type_name -> novel.v1.ChapterIndexMapping
+	52,  // 17: novel.v1.ReorderChapterOutlineResponse.outline:type_name -> novel.v1.Outline
+	53,  // 18: novel.v1.GenerateChapterRequest.chapter_outline:type_name -> novel.v1.ChapterOutline
+	55,  // 19: novel.v1.GenerateChapterRequest.context:type_name -> novel.v1.GenerationContext
+	61,  // 20: novel.v1.GenerateChapterRequest.llm_options:type_name -> novel.v1.LLMOptions
+	54,  // 21: novel.v1.GenerateChapterResponse.chapter:type_name -> novel.v1.Chapter
+	24,  // 22: novel.v1.GenerateChapterResponse.grounding:type_name -> novel.v1.RetrievedChunk
+	0,   // 23: novel.v1.GenerateChapterStreamResponse.type:type_name -> novel.v1.GenerateChapterStreamResponse.ResponseType
+	54,  // 24: novel.v1.GenerateChapterStreamResponse.final_chapter:type_name -> novel.v1.Chapter
+	24,  // 25: novel.v1.GenerateChapterStreamResponse.grounding:type_name -> novel.v1.RetrievedChunk
+	61,  // 26: novel.v1.PolishChapterRequest.llm_options:type_name -> novel.v1.LLMOptions
+	54,  // 27: novel.v1.PolishChapterResponse.polished_chapter:type_name -> novel.v1.Chapter
+	61,  // 28: novel.v1.CheckQualityRequest.llm_options:type_name -> novel.v1.LLMOptions
+	54,  // 29: novel.v1.CheckQualityResponse.polished_chapter:type_name -> novel.v1.Chapter
+	35,  // 30: novel.v1.CheckQualityResponse.proofread_result:type_name -> novel.v1.ProofreadResult
+	36,  // 31: novel.v1.CheckQualityResponse.critique_result:type_name -> novel.v1.CritiqueResult
+	59,  // 32: novel.v1.CheckQualityResponse.consistency_issues:type_name -> novel.v1.ConsistencyIssue
+	32,  // 33: novel.v1.CheckQualityResponse.metrics:type_name -> novel.v1.TextMetrics
+	30,  // 34: novel.v1.CheckQualityResponse.repetitions:type_name -> novel.v1.RepetitionPair
+	38,  // 35: novel.v1.RepetitionPair.source:type_name -> novel.v1.TextSpan
+	38,  // 36: novel.v1.RepetitionPair.target:type_name -> novel.v1.TextSpan
+	132, // 37: novel.v1.TextMetrics.sentence_lengths:type_name -> novel.v1.TextMetrics.SentenceLengthsEntry
+	31,  // 38: novel.v1.TextMetrics.repeated_ngrams:type_name -> novel.v1.PhraseCount
+	31,  // 39: novel.v1.TextMetrics.overused_phrases:type_name -> novel.v1.PhraseCount
+	61,  // 40: novel.v1.BatchCheckQualityRequest.llm_options:type_name -> novel.v1.LLMOptions
+	29,  // 41: novel.v1.BatchCheckQualityResponse.results:type_name -> novel.v1.CheckQualityResponse
+	40,  // 42: novel.v1.BatchCheckQualityResponse.summary:type_name -> novel.v1.QualitySummary
+	30,  // 43: novel.v1.BatchCheckQualityResponse.repetitions:type_name -> novel.v1.RepetitionPair
+	39,  // 44: novel.v1.ProofreadResult.issues:type_name -> novel.v1.QualityIssue
+	37,  // 45: novel.v1.CritiqueResult.evidence:type_name -> novel.v1.CritiqueEvidence
+	38,  // 46: novel.v1.CritiqueEvidence.span:type_name -> novel.v1.TextSpan
+	38,  // 47: novel.v1.QualityIssue.span:type_name -> novel.v1.TextSpan
+	133, // 48: novel.v1.QualitySummary.issues_by_type:type_name -> novel.v1.QualitySummary.IssuesByTypeEntry
+	134, // 49: novel.v1.QualitySummary.issues_by_severity:type_name -> novel.v1.QualitySummary.IssuesBySeverityEntry
+	61,  // 50: novel.v1.CheckConsistencyRequest.llm_options:type_name -> novel.v1.LLMOptions
+	59,  // 51: novel.v1.CheckConsistencyResponse.issues:type_name -> novel.v1.ConsistencyIssue
+	62,  // 52: novel.v1.GenerateNovelRequest.options:type_name -> novel.v1.GenerateOptions
+	54,  // 53: novel.v1.GenerateNovelResponse.chapters:type_name -> novel.v1.Chapter
+	112, // 54: novel.v1.GenerateNovelResponse.quality_gates:type_name -> novel.v1.QualityGateResult
+	119, // 55: novel.v1.GenerateNovelResponse.plot_threads:type_name -> novel.v1.PlotThread
+	120, // 56: novel.v1.GenerateNovelResponse.plot_thread_issues:type_name -> novel.v1.PlotThreadIssue
+	68,  // 57: novel.v1.ExportNovelRequest.options:type_name -> novel.v1.ExportOptions
+	69,  // 58: novel.v1.GenerateVideoScriptRequest.options:type_name -> novel.v1.VideoScriptOptions
+	60,  // 59: novel.v1.GenerateVideoScriptResponse.scenes:type_name -> novel.v1.VideoScene
+	50,  // 60: novel.v1.Project.world_view:type_name -> novel.v1.WorldView
+	51,  // 61: novel.v1.Project.characters:type_name -> novel.v1.Character
+	52,  // 62: novel.v1.Project.outline:type_name -> novel.v1.Outline
+	54,  // 63: novel.v1.Project.chapters:type_name -> novel.v1.Chapter
+	139, // 64: novel.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	139, // 65: novel.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	135, // 66: novel.v1.Character.relationship_map:type_name -> novel.v1.Character.RelationshipMapEntry
+	53,  // 67: novel.v1.Outline.chapters:type_name -> novel.v1.ChapterOutline
+	139, // 68: novel.v1.Chapter.created_at:type_name -> google.protobuf.Timestamp
+	139, // 69: novel.v1.Chapter.updated_at:type_name -> google.protobuf.Timestamp
+	51,  // 70: novel.v1.GenerationContext.characters:type_name -> novel.v1.Character
+	56,  // 71: novel.v1.GenerationContext.timeline:type_name -> novel.v1.TimelineEvent
+	57,  // 72: novel.v1.GenerationContext.props:type_name -> novel.v1.PropItem
+	58,  // 73: novel.v1.GenerationContext.character_states:type_name -> novel.v1.CharacterState
+	38,  // 74: novel.v1.ConsistencyIssue.span:type_name -> novel.v1.TextSpan
+	61,  // 75: novel.v1.GenerateOptions.llm_options:type_name -> novel.v1.LLMOptions
+	67,  // 76: novel.v1.ListModelsResponse.models:type_name -> novel.v1.ModelInfo
+	72,  // 77: novel.v1.GetStatsResponse.stats:type_name -> novel.v1.ProjectStats
+	49,  // 78: novel.v1.ImportProjectBundleResponse.project:type_name -> novel.v1.Project
+	136, // 79: novel.v1.ImportProjectBundleResponse.id_mapping:type_name -> novel.v1.ImportProjectBundleResponse.IdMappingEntry
+	78,  // 80: novel.v1.SearchContentResponse.hits:type_name -> novel.v1.SearchHit
+	139, // 81: novel.v1.TrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	139, // 82: novel.v1.TrashItem.expires_at:type_name -> google.protobuf.Timestamp
+	84,  // 83: novel.v1.ListTrashResponse.items:type_name -> novel.v1.TrashItem
+	49,  // 84: novel.v1.RestoreProjectResponse.project:type_name -> novel.v1.Project
+	54,  // 85: novel.v1.RestoreChapterResponse.chapter:type_name -> novel.v1.Chapter
+	139, // 86: novel.v1.IndexItemStatus.indexed_at:type_name -> google.protobuf.Timestamp
+	139, // 87: novel.v1.IndexStatus.last_indexed_at:type_name -> google.protobuf.Timestamp
+	96,  // 88: novel.v1.IndexStatus.items:type_name -> novel.v1.IndexItemStatus
+	97,  // 89: novel.v1.GetIndexStatusResponse.status:type_name -> novel.v1.IndexStatus
+	97,  // 90: novel.v1.ReindexProjectResponse.status:type_name -> novel.v1.IndexStatus
+	103, // 91: novel.v1.SearchProjectKnowledgeResponse.passages:type_name -> novel.v1.KnowledgePassage
+	137, // 92: novel.v1.SearchProjectKnowledgeResponse.stats:type_name -> novel.v1.SearchProjectKnowledgeResponse.StatsEntry
+	58,  // 93: novel.v1.StoryState.characters:type_name -> novel.v1.CharacterState
+	57,  // 94: novel.v1.StoryState.props:type_name -> novel.v1.PropItem
+	56,  // 95: novel.v1.StoryState.timeline:type_name -> novel.v1.TimelineEvent
+	139, // 96: novel.v1.StoryState.updated_at:type_name -> google.protobuf.Timestamp
+	106, // 97: novel.v1.GetStoryStateResponse.state:type_name -> novel.v1.StoryState
+	54,  // 98: novel.v1.ApplyCorrectionResponse.chapter:type_name -> novel.v1.Chapter
+	38,  // 99: novel.v1.ApplyCorrectionResponse.span:type_name -> novel.v1.TextSpan
+	138, // 100: novel.v1.QualityReport.issues_by_severity:type_name -> novel.v1.QualityReport.IssuesBySeverityEntry
+	139, // 101: novel.v1.QualityReport.created_at:type_name -> google.protobuf.Timestamp
+	29,  // 102: novel.v1.QualityReport.quality_result:type_name -> novel.v1.CheckQualityResponse
+	59,  // 103: novel.v1.QualityReport.consistency_issues:type_name -> novel.v1.ConsistencyIssue
+	111, // 104: novel.v1.QualityReport.gate_attempt:type_name -> novel.v1.QualityGateAttempt
+	36,  // 105: novel.v1.QualityGateAttempt.critique:type_name -> novel.v1.CritiqueResult
+	139, // 106: novel.v1.QualityGateAttempt.checked_at:type_name -> google.protobuf.Timestamp
+	111, // 107: novel.v1.QualityGateResult.attempts:type_name -> novel.v1.QualityGateAttempt
+	110, // 108: novel.v1.ListQualityReportsResponse.reports:type_name -> novel.v1.QualityReport
+	116, // 109: novel.v1.GetQualityTrendsResponse.points:type_name -> novel.v1.QualityTrendPoint
+	117, // 110: novel.v1.GetQualityTrendsResponse.chapters:type_name -> novel.v1.ChapterQualityTrend
+	139, // 111: novel.v1.PlotThread.updated_at:type_name -> google.protobuf.Timestamp
+	119, // 112: novel.v1.ListPlotThreadsResponse.threads:type_name -> novel.v1.PlotThread
+	119, // 113: novel.v1.CheckPlotThreadsResponse.open_threads:type_name -> novel.v1.PlotThread
+	120, // 114: novel.v1.CheckPlotThreadsResponse.issues:type_name -> novel.v1.PlotThreadIssue
+	61,  // 115: novel.v1.RefineOutlineRequest.llm_options:type_name -> novel.v1.LLMOptions
+	52,  // 116: novel.v1.RefineOutlineResponse.outline:type_name -> novel.v1.Outline
+	119, // 117: novel.v1.RefineOutlineResponse.open_threads:type_name -> novel.v1.PlotThread
+	128, // 118: novel.v1.SafetyReview.labels:type_name -> novel.v1.SafetyLabel
+	127, // 119: novel.v1.SafetyReview.hits:type_name -> novel.v1.SafetyHit
+	61,  // 120: novel.v1.ReviewContentRequest.llm_options:type_name -> novel.v1.LLMOptions
+	129, // 121: novel.v1.ReviewContentResponse.reviews:type_name -> novel.v1.SafetyReview
+	139, // 122: novel.v1.ReviewContentResponse.checked_at:type_name -> google.protobuf.Timestamp
+	1,   // 123: novel.v1.NovelService.CreateProject:input_type -> novel.v1.CreateProjectRequest
+	3,   // 124: novel.v1.NovelService.GetProject:input_type -> novel.v1.GetProjectRequest
+	5,   // 125: novel.v1.NovelService.ListProjects:input_type -> novel.v1.ListProjectsRequest
+	7,   // 126: novel.v1.NovelService.UpdateProject:input_type -> novel.v1.UpdateProjectRequest
+	9,   // 127: novel.v1.NovelService.GenerateWorldView:input_type -> novel.v1.GenerateWorldViewRequest
+	11,  // 128: novel.v1.NovelService.GenerateCharacters:input_type -> novel.v1.GenerateCharactersRequest
+	13,  // 129: novel.v1.NovelService.GenerateOutline:input_type -> novel.v1.GenerateOutlineRequest
+	15,  // 130: novel.v1.NovelService.UpdateChapterOutline:input_type -> novel.v1.UpdateChapterOutlineRequest
+	17,  // 131: novel.v1.NovelService.DeleteChapterOutline:input_type -> novel.v1.DeleteChapterOutlineRequest
+	19,  // 132: novel.v1.NovelService.ReorderChapterOutline:input_type -> novel.v1.ReorderChapterOutlineRequest
+	22,  // 133: novel.v1.NovelService.GenerateChapter:input_type -> novel.v1.GenerateChapterRequest
+	22,  // 134: novel.v1.NovelService.GenerateChapterStream:input_type -> novel.v1.GenerateChapterRequest
+	26,  // 135: novel.v1.NovelService.PolishChapter:input_type -> novel.v1.PolishChapterRequest
+	28,  // 136: novel.v1.NovelService.CheckQuality:input_type -> novel.v1.CheckQualityRequest
+	33,  // 137: novel.v1.NovelService.BatchCheckQuality:input_type -> novel.v1.BatchCheckQualityRequest
+	41,  // 138: novel.v1.NovelService.CheckConsistency:input_type -> novel.v1.CheckConsistencyRequest
+	43,  // 139: novel.v1.NovelService.GenerateNovel:input_type -> novel.v1.GenerateNovelRequest
+	45,  // 140: novel.v1.NovelService.ExportNovel:input_type -> novel.v1.ExportNovelRequest
+	70,  // 141: novel.v1.NovelService.GetStats:input_type -> novel.v1.GetStatsRequest
+	47,  // 142: novel.v1.NovelService.GenerateVideoScript:input_type -> novel.v1.GenerateVideoScriptRequest
+	63,  // 143: novel.v1.NovelService.SwitchModel:input_type -> novel.v1.SwitchModelRequest
+	65,  // 144: novel.v1.NovelService.ListModels:input_type -> novel.v1.ListModelsRequest
+	73,  // 145: novel.v1.NovelService.ExportProjectBundle:input_type -> novel.v1.ExportProjectBundleRequest
+	75,  // 146: novel.v1.NovelService.ImportProjectBundle:input_type -> novel.v1.ImportProjectBundleRequest
+	77,  // 147: novel.v1.NovelService.SearchContent:input_type -> novel.v1.SearchContentRequest
+	80,  // 148: novel.v1.NovelService.DeleteProject:input_type -> novel.v1.DeleteProjectRequest
+	82,  // 149: novel.v1.NovelService.DeleteChapter:input_type -> novel.v1.DeleteChapterRequest
+	85,  // 150: novel.v1.NovelService.ListTrash:input_type -> novel.v1.ListTrashRequest
+	87,  // 151: novel.v1.NovelService.RestoreProject:input_type -> novel.v1.RestoreProjectRequest
+	89,  // 152: novel.v1.NovelService.RestoreChapter:input_type -> novel.v1.RestoreChapterRequest
+	91,  // 153: novel.v1.NovelService.PurgeProject:input_type -> novel.v1.PurgeProjectRequest
+	93,  // 154: novel.v1.NovelService.PurgeChapter:input_type -> novel.v1.PurgeChapterRequest
+	95,  // 155: novel.v1.NovelService.WatchGenerationProgress:input_type -> novel.v1.WatchGenerationProgressRequest
+	98,  // 156: novel.v1.NovelService.GetIndexStatus:input_type -> novel.v1.GetIndexStatusRequest
+	100, // 157: novel.v1.NovelService.ReindexProject:input_type -> novel.v1.ReindexProjectRequest
+	102, // 158: novel.v1.NovelService.SearchProjectKnowledge:input_type -> novel.v1.SearchProjectKnowledgeRequest
+	105, // 159: novel.v1.NovelService.GetStoryState:input_type -> novel.v1.GetStoryStateRequest
+	108, // 160: novel.v1.NovelService.ApplyCorrection:input_type -> novel.v1.ApplyCorrectionRequest
+	113, // 161: novel.v1.NovelService.ListQualityReports:input_type -> novel.v1.ListQualityReportsRequest
+	115, // 162: novel.v1.NovelService.GetQualityTrends:input_type -> novel.v1.GetQualityTrendsRequest
+	121, // 163: novel.v1.NovelService.ListPlotThreads:input_type -> novel.v1.ListPlotThreadsRequest
+	123, // 164: novel.v1.NovelService.CheckPlotThreads:input_type -> novel.v1.CheckPlotThreadsRequest
+	125, // 165: novel.v1.NovelService.RefineOutline:input_type -> novel.v1.RefineOutlineRequest
+	130, // 166: novel.v1.NovelService.ReviewContent:input_type -> novel.v1.ReviewContentRequest
+	2,   // 167: novel.v1.NovelService.CreateProject:output_type -> novel.v1.CreateProjectResponse
+	4,   // 168: novel.v1.NovelService.GetProject:output_type -> novel.v1.GetProjectResponse
+	6,   // 169: novel.v1.NovelService.ListProjects:output_type -> novel.v1.ListProjectsResponse
+	8,   // 170: novel.v1.NovelService.UpdateProject:output_type -> novel.v1.UpdateProjectResponse
+	10,  // 171: novel.v1.NovelService.GenerateWorldView:output_type -> novel.v1.GenerateWorldViewResponse
+	12,  // 172: novel.v1.NovelService.GenerateCharacters:output_type -> novel.v1.GenerateCharactersResponse
+	14,  // 173: novel.v1.NovelService.GenerateOutline:output_type -> novel.v1.GenerateOutlineResponse
+	16,  // 174: novel.v1.NovelService.UpdateChapterOutline:output_type -> novel.v1.UpdateChapterOutlineResponse
+	18,  // 175: novel.v1.NovelService.DeleteChapterOutline:output_type -> novel.v1.DeleteChapterOutlineResponse
+	21,  // 176: novel.v1.NovelService.ReorderChapterOutline:output_type -> novel.v1.ReorderChapterOutlineResponse
+	23,  // 177: novel.v1.NovelService.GenerateChapter:output_type -> novel.v1.GenerateChapterResponse
+	25,  // 178: novel.v1.NovelService.GenerateChapterStream:output_type -> novel.v1.GenerateChapterStreamResponse
+	27,  // 179: novel.v1.NovelService.PolishChapter:output_type -> novel.v1.PolishChapterResponse
+	29,  // 180: novel.v1.NovelService.CheckQuality:output_type -> novel.v1.CheckQualityResponse
+	34,  // 181: novel.v1.NovelService.BatchCheckQuality:output_type -> novel.v1.BatchCheckQualityResponse
+	42,  // 182: novel.v1.NovelService.CheckConsistency:output_type -> novel.v1.CheckConsistencyResponse
+	44,  // 183: novel.v1.NovelService.GenerateNovel:output_type -> novel.v1.GenerateNovelResponse
+	46,  // 184: novel.v1.NovelService.ExportNovel:output_type -> novel.v1.ExportNovelResponse
+	71,  // 185: novel.v1.NovelService.GetStats:output_type -> novel.v1.GetStatsResponse
+	48,  // 186: novel.v1.NovelService.GenerateVideoScript:output_type -> novel.v1.GenerateVideoScriptResponse
+	64,  // 187: novel.v1.NovelService.SwitchModel:output_type -> novel.v1.SwitchModelResponse
+	66,  // 188: novel.v1.NovelService.ListModels:output_type -> novel.v1.ListModelsResponse
+	74,  // 189: novel.v1.NovelService.ExportProjectBundle:output_type -> novel.v1.ExportProjectBundleResponse
+	76,  // 190: novel.v1.NovelService.ImportProjectBundle:output_type -> novel.v1.ImportProjectBundleResponse
+	79,  // 191: novel.v1.NovelService.SearchContent:output_type -> novel.v1.SearchContentResponse
+	81,  // 192: novel.v1.NovelService.DeleteProject:output_type -> novel.v1.DeleteProjectResponse
+	83,  // 193: novel.v1.NovelService.DeleteChapter:output_type -> novel.v1.DeleteChapterResponse
+	86,  // 194: novel.v1.NovelService.ListTrash:output_type -> novel.v1.ListTrashResponse
+	88,  // 195: novel.v1.NovelService.RestoreProject:output_type -> novel.v1.RestoreProjectResponse
+	90,  // 196: novel.v1.NovelService.RestoreChapter:output_type -> novel.v1.RestoreChapterResponse
+	92,  // 197: novel.v1.NovelService.PurgeProject:output_type -> novel.v1.PurgeProjectResponse
+	94,  // 198: novel.v1.NovelService.PurgeChapter:output_type -> novel.v1.PurgeChapterResponse
+	44,  // 199: novel.v1.NovelService.WatchGenerationProgress:output_type -> novel.v1.GenerateNovelResponse
+	99,  // 200: novel.v1.NovelService.GetIndexStatus:output_type -> novel.v1.GetIndexStatusResponse
+	101, // 201: novel.v1.NovelService.ReindexProject:output_type -> novel.v1.ReindexProjectResponse
+	104, // 202: novel.v1.NovelService.SearchProjectKnowledge:output_type -> novel.v1.SearchProjectKnowledgeResponse
+	107, // 203: novel.v1.NovelService.GetStoryState:output_type -> novel.v1.GetStoryStateResponse
+	109, // 204: novel.v1.NovelService.ApplyCorrection:output_type -> novel.v1.ApplyCorrectionResponse
+	114, // 205: novel.v1.NovelService.ListQualityReports:output_type -> novel.v1.ListQualityReportsResponse
+	118, // 206: novel.v1.NovelService.GetQualityTrends:output_type -> novel.v1.GetQualityTrendsResponse
+	122, // 207: novel.v1.NovelService.ListPlotThreads:output_type -> novel.v1.ListPlotThreadsResponse
+	124, // 208: novel.v1.NovelService.CheckPlotThreads:output_type -> novel.v1.CheckPlotThreadsResponse
+	126, // 209: novel.v1.NovelService.RefineOutline:output_type -> novel.v1.RefineOutlineResponse
+	131, // 210: novel.v1.NovelService.ReviewContent:output_type -> novel.v1.ReviewContentResponse
+	167, // [167:211] is the sub-list for method output_type
+	123, // [123:167] is the sub-list for method input_type
+	123, // [123:123] is the sub-list for extension type_name
+	123, // [123:123] is the sub-list for extension extendee
+	0,   // [0:123] is the sub-list for field type_name
+}
+
+func init() { file_novel_v1_novel_proto_init() }
+func file_novel_v1_novel_proto_init() {
+	if File_novel_v1_novel_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_novel_v1_novel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProjectsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProjectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateWorldViewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateWorldViewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateCharactersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateCharactersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateOutlineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateOutlineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChapterOutlineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChapterOutlineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteChapterOutlineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteChapterOutlineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderChapterOutlineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChapterIndexMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReorderChapterOutlineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateChapterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateChapterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetrievedChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateChapterStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolishChapterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolishChapterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckQualityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckQualityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepetitionPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhraseCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCheckQualityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCheckQualityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofreadResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CritiqueResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CritiqueEvidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextSpan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QualityIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QualitySummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConsistencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConsistencyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateNovelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateNovelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportNovelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportNovelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateVideoScriptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateVideoScriptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorldView); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Character); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Outline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChapterOutline); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chapter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerationContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelineEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6065,8 +13157,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProjectResponse); i {
+		file_novel_v1_novel_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CharacterState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6077,8 +13169,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectRequest); i {
+		file_novel_v1_novel_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsistencyIssue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6089,8 +13181,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectResponse); i {
+		file_novel_v1_novel_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoScene); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6101,8 +13193,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectsRequest); i {
+		file_novel_v1_novel_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LLMOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6113,8 +13205,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProjectsResponse); i {
+		file_novel_v1_novel_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6125,8 +13217,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectRequest); i {
+		file_novel_v1_novel_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchModelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6137,8 +13229,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateProjectResponse); i {
+		file_novel_v1_novel_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchModelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6149,8 +13241,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateWorldViewRequest); i {
+		file_novel_v1_novel_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6161,8 +13253,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateWorldViewResponse); i {
+		file_novel_v1_novel_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6173,8 +13265,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateCharactersRequest); i {
+		file_novel_v1_novel_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6185,8 +13277,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateCharactersResponse); i {
+		file_novel_v1_novel_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6197,8 +13289,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateOutlineRequest); i {
+		file_novel_v1_novel_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideoScriptOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6209,8 +13301,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateOutlineResponse); i {
+		file_novel_v1_novel_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6221,8 +13313,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateChapterOutlineRequest); i {
+		file_novel_v1_novel_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6233,8 +13325,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateChapterOutlineResponse); i {
+		file_novel_v1_novel_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6245,8 +13337,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteChapterOutlineRequest); i {
+		file_novel_v1_novel_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProjectBundleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6257,8 +13349,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteChapterOutlineResponse); i {
+		file_novel_v1_novel_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportProjectBundleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6269,8 +13361,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReorderChapterOutlineRequest); i {
+		file_novel_v1_novel_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProjectBundleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6281,8 +13373,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChapterIndexMapping); i {
+		file_novel_v1_novel_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProjectBundleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6293,8 +13385,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReorderChapterOutlineResponse); i {
+		file_novel_v1_novel_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchContentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6305,8 +13397,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateChapterRequest); i {
+		file_novel_v1_novel_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchHit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6317,8 +13409,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateChapterResponse); i {
+		file_novel_v1_novel_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchContentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6329,8 +13421,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateChapterStreamResponse); i {
+		file_novel_v1_novel_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6341,8 +13433,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolishChapterRequest); i {
+		file_novel_v1_novel_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6353,8 +13445,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolishChapterResponse); i {
+		file_novel_v1_novel_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteChapterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6365,8 +13457,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckQualityRequest); i {
+		file_novel_v1_novel_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteChapterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6377,8 +13469,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckQualityResponse); i {
+		file_novel_v1_novel_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrashItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6389,8 +13481,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCheckQualityRequest); i {
+		file_novel_v1_novel_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrashRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6401,8 +13493,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchCheckQualityResponse); i {
+		file_novel_v1_novel_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTrashResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6413,8 +13505,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProofreadResult); i {
+		file_novel_v1_novel_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6425,8 +13517,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CritiqueResult); i {
+		file_novel_v1_novel_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6437,8 +13529,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QualityIssue); i {
+		file_novel_v1_novel_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreChapterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6449,8 +13541,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QualitySummary); i {
+		file_novel_v1_novel_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreChapterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6461,8 +13553,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckConsistencyRequest); i {
+		file_novel_v1_novel_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6473,8 +13565,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckConsistencyResponse); i {
+		file_novel_v1_novel_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6485,8 +13577,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateNovelRequest); i {
+		file_novel_v1_novel_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeChapterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6497,8 +13589,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateNovelResponse); i {
+		file_novel_v1_novel_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeChapterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6509,8 +13601,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportNovelRequest); i {
+		file_novel_v1_novel_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchGenerationProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6521,8 +13613,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportNovelResponse); i {
+		file_novel_v1_novel_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexItemStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6533,8 +13625,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateVideoScriptRequest); i {
+		file_novel_v1_novel_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6545,8 +13637,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateVideoScriptResponse); i {
+		file_novel_v1_novel_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIndexStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6557,8 +13649,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
+		file_novel_v1_novel_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIndexStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6569,8 +13661,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorldView); i {
+		file_novel_v1_novel_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReindexProjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6581,8 +13673,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Character); i {
+		file_novel_v1_novel_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReindexProjectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6593,8 +13685,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Outline); i {
+		file_novel_v1_novel_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchProjectKnowledgeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6605,8 +13697,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChapterOutline); i {
+		file_novel_v1_novel_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KnowledgePassage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6617,8 +13709,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chapter); i {
+		file_novel_v1_novel_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchProjectKnowledgeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6629,8 +13721,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerationContext); i {
+		file_novel_v1_novel_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6641,8 +13733,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimelineEvent); i {
+		file_novel_v1_novel_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoryState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6653,8 +13745,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PropItem); i {
+		file_novel_v1_novel_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoryStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6665,8 +13757,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConsistencyIssue); i {
+		file_novel_v1_novel_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyCorrectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6677,8 +13769,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VideoScene); i {
+		file_novel_v1_novel_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyCorrectionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6689,8 +13781,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LLMOptions); i {
+		file_novel_v1_novel_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QualityReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6701,8 +13793,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateOptions); i {
+		file_novel_v1_novel_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QualityGateAttempt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6713,8 +13805,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwitchModelRequest); i {
+		file_novel_v1_novel_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QualityGateResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6725,8 +13817,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwitchModelResponse); i {
+		file_novel_v1_novel_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQualityReportsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6737,8 +13829,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListModelsRequest); i {
+		file_novel_v1_novel_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListQualityReportsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6749,8 +13841,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListModelsResponse); i {
+		file_novel_v1_novel_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQualityTrendsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6761,8 +13853,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelInfo); i {
+		file_novel_v1_novel_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QualityTrendPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6773,8 +13865,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExportOptions); i {
+		file_novel_v1_novel_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChapterQualityTrend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6785,8 +13877,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VideoScriptOptions); i {
+		file_novel_v1_novel_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQualityTrendsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6797,8 +13889,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatsRequest); i {
+		file_novel_v1_novel_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlotThread); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6809,8 +13901,8 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatsResponse); i {
+		file_novel_v1_novel_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlotThreadIssue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6821,8 +13913,128 @@ func file_novel_v1_novel_proto_init() {
 				return nil
 			}
 		}
-		file_novel_v1_novel_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectStats); i {
+		file_novel_v1_novel_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlotThreadsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlotThreadsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPlotThreadsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPlotThreadsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefineOutlineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefineOutlineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SafetyHit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SafetyLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SafetyReview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewContentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_novel_v1_novel_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewContentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6840,7 +14052,7 @@ func file_novel_v1_novel_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_novel_v1_novel_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   68,
+			NumMessages:   138,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
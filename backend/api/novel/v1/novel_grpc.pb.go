@@ -19,28 +19,50 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	NovelService_CreateProject_FullMethodName         = "/novel.v1.NovelService/CreateProject"
-	NovelService_GetProject_FullMethodName            = "/novel.v1.NovelService/GetProject"
-	NovelService_ListProjects_FullMethodName          = "/novel.v1.NovelService/ListProjects"
-	NovelService_UpdateProject_FullMethodName         = "/novel.v1.NovelService/UpdateProject"
-	NovelService_GenerateWorldView_FullMethodName     = "/novel.v1.NovelService/GenerateWorldView"
-	NovelService_GenerateCharacters_FullMethodName    = "/novel.v1.NovelService/GenerateCharacters"
-	NovelService_GenerateOutline_FullMethodName       = "/novel.v1.NovelService/GenerateOutline"
-	NovelService_UpdateChapterOutline_FullMethodName  = "/novel.v1.NovelService/UpdateChapterOutline"
-	NovelService_DeleteChapterOutline_FullMethodName  = "/novel.v1.NovelService/DeleteChapterOutline"
-	NovelService_ReorderChapterOutline_FullMethodName = "/novel.v1.NovelService/ReorderChapterOutline"
-	NovelService_GenerateChapter_FullMethodName       = "/novel.v1.NovelService/GenerateChapter"
-	NovelService_GenerateChapterStream_FullMethodName = "/novel.v1.NovelService/GenerateChapterStream"
-	NovelService_PolishChapter_FullMethodName         = "/novel.v1.NovelService/PolishChapter"
-	NovelService_CheckQuality_FullMethodName          = "/novel.v1.NovelService/CheckQuality"
-	NovelService_BatchCheckQuality_FullMethodName     = "/novel.v1.NovelService/BatchCheckQuality"
-	NovelService_CheckConsistency_FullMethodName      = "/novel.v1.NovelService/CheckConsistency"
-	NovelService_GenerateNovel_FullMethodName         = "/novel.v1.NovelService/GenerateNovel"
-	NovelService_ExportNovel_FullMethodName           = "/novel.v1.NovelService/ExportNovel"
-	NovelService_GetStats_FullMethodName              = "/novel.v1.NovelService/GetStats"
-	NovelService_GenerateVideoScript_FullMethodName   = "/novel.v1.NovelService/GenerateVideoScript"
-	NovelService_SwitchModel_FullMethodName           = "/novel.v1.NovelService/SwitchModel"
-	NovelService_ListModels_FullMethodName            = "/novel.v1.NovelService/ListModels"
+	NovelService_CreateProject_FullMethodName           = "/novel.v1.NovelService/CreateProject"
+	NovelService_GetProject_FullMethodName              = "/novel.v1.NovelService/GetProject"
+	NovelService_ListProjects_FullMethodName            = "/novel.v1.NovelService/ListProjects"
+	NovelService_UpdateProject_FullMethodName           = "/novel.v1.NovelService/UpdateProject"
+	NovelService_GenerateWorldView_FullMethodName       = "/novel.v1.NovelService/GenerateWorldView"
+	NovelService_GenerateCharacters_FullMethodName      = "/novel.v1.NovelService/GenerateCharacters"
+	NovelService_GenerateOutline_FullMethodName         = "/novel.v1.NovelService/GenerateOutline"
+	NovelService_UpdateChapterOutline_FullMethodName    = "/novel.v1.NovelService/UpdateChapterOutline"
+	NovelService_DeleteChapterOutline_FullMethodName    = "/novel.v1.NovelService/DeleteChapterOutline"
+	NovelService_ReorderChapterOutline_FullMethodName   = "/novel.v1.NovelService/ReorderChapterOutline"
+	NovelService_GenerateChapter_FullMethodName         = "/novel.v1.NovelService/GenerateChapter"
+	NovelService_GenerateChapterStream_FullMethodName   = "/novel.v1.NovelService/GenerateChapterStream"
+	NovelService_PolishChapter_FullMethodName           = "/novel.v1.NovelService/PolishChapter"
+	NovelService_CheckQuality_FullMethodName            = "/novel.v1.NovelService/CheckQuality"
+	NovelService_BatchCheckQuality_FullMethodName       = "/novel.v1.NovelService/BatchCheckQuality"
+	NovelService_CheckConsistency_FullMethodName        = "/novel.v1.NovelService/CheckConsistency"
+	NovelService_GenerateNovel_FullMethodName           = "/novel.v1.NovelService/GenerateNovel"
+	NovelService_ExportNovel_FullMethodName             = "/novel.v1.NovelService/ExportNovel"
+	NovelService_GetStats_FullMethodName                = "/novel.v1.NovelService/GetStats"
+	NovelService_GenerateVideoScript_FullMethodName     = "/novel.v1.NovelService/GenerateVideoScript"
+	NovelService_SwitchModel_FullMethodName             = "/novel.v1.NovelService/SwitchModel"
+	NovelService_ListModels_FullMethodName              = "/novel.v1.NovelService/ListModels"
+	NovelService_ExportProjectBundle_FullMethodName     = "/novel.v1.NovelService/ExportProjectBundle"
+	NovelService_ImportProjectBundle_FullMethodName     = "/novel.v1.NovelService/ImportProjectBundle"
+	NovelService_SearchContent_FullMethodName           = "/novel.v1.NovelService/SearchContent"
+	NovelService_DeleteProject_FullMethodName           = "/novel.v1.NovelService/DeleteProject"
+	NovelService_DeleteChapter_FullMethodName           = "/novel.v1.NovelService/DeleteChapter"
+	NovelService_ListTrash_FullMethodName               = "/novel.v1.NovelService/ListTrash"
+	NovelService_RestoreProject_FullMethodName          = "/novel.v1.NovelService/RestoreProject"
+	NovelService_RestoreChapter_FullMethodName          = "/novel.v1.NovelService/RestoreChapter"
+	NovelService_PurgeProject_FullMethodName            = "/novel.v1.NovelService/PurgeProject"
+	NovelService_PurgeChapter_FullMethodName            = "/novel.v1.NovelService/PurgeChapter"
+	NovelService_WatchGenerationProgress_FullMethodName = "/novel.v1.NovelService/WatchGenerationProgress"
+	NovelService_GetIndexStatus_FullMethodName          = "/novel.v1.NovelService/GetIndexStatus"
+	NovelService_ReindexProject_FullMethodName          = "/novel.v1.NovelService/ReindexProject"
+	NovelService_SearchProjectKnowledge_FullMethodName  = "/novel.v1.NovelService/SearchProjectKnowledge"
+	NovelService_GetStoryState_FullMethodName           = "/novel.v1.NovelService/GetStoryState"
+	NovelService_ApplyCorrection_FullMethodName         = "/novel.v1.NovelService/ApplyCorrection"
+	NovelService_ListQualityReports_FullMethodName      = "/novel.v1.NovelService/ListQualityReports"
+	NovelService_GetQualityTrends_FullMethodName        = "/novel.v1.NovelService/GetQualityTrends"
+	NovelService_ListPlotThreads_FullMethodName         = "/novel.v1.NovelService/ListPlotThreads"
+	NovelService_CheckPlotThreads_FullMethodName        = "/novel.v1.NovelService/CheckPlotThreads"
+	NovelService_RefineOutline_FullMethodName           = "/novel.v1.NovelService/RefineOutline"
+	NovelService_ReviewContent_FullMethodName           = "/novel.v1.NovelService/ReviewContent"
 )
 
 // NovelServiceClient is the client API for NovelService service.
@@ -91,6 +113,50 @@ type NovelServiceClient interface {
 	SwitchModel(ctx context.Context, in *SwitchModelRequest, opts ...grpc.CallOption) (*SwitchModelResponse, error)
 	// 获取可用模型列表
 	ListModels(ctx context.Context, in *ListModelsRequest, opts ...grpc.CallOption) (*ListModelsResponse, error)
+	// 导出项目数据包（含世界观、人物、大纲、章节与视频脚本）
+	ExportProjectBundle(ctx context.Context, in *ExportProjectBundleRequest, opts ...grpc.CallOption) (*ExportProjectBundleResponse, error)
+	// 导入项目数据包
+	ImportProjectBundle(ctx context.Context, in *ImportProjectBundleRequest, opts ...grpc.CallOption) (*ImportProjectBundleResponse, error)
+	// 全文检索章节正文、摘要、人物卡与大纲
+	SearchContent(ctx context.Context, in *SearchContentRequest, opts ...grpc.CallOption) (*SearchContentResponse, error)
+	// 删除项目（移入回收站，章节与视频脚本随之删除）
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	// 删除章节（移入回收站）
+	DeleteChapter(ctx context.Context, in *DeleteChapterRequest, opts ...grpc.CallOption) (*DeleteChapterResponse, error)
+	// 列出回收站中的项目与章节
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// 从回收站恢复项目（连同章节与视频脚本）
+	RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*RestoreProjectResponse, error)
+	// 从回收站恢复章节
+	RestoreChapter(ctx context.Context, in *RestoreChapterRequest, opts ...grpc.CallOption) (*RestoreChapterResponse, error)
+	// 彻底删除回收站中的项目
+	PurgeProject(ctx context.Context, in *PurgeProjectRequest, opts ...grpc.CallOption) (*PurgeProjectResponse, error)
+	// 彻底删除回收站中的章节
+	PurgeChapter(ctx context.Context, in *PurgeChapterRequest, opts ...grpc.CallOption) (*PurgeChapterResponse, error)
+	// 订阅项目生成进度（可在任意实例上订阅）
+	WatchGenerationProgress(ctx context.Context, in *WatchGenerationProgressRequest, opts ...grpc.CallOption) (NovelService_WatchGenerationProgressClient, error)
+	// 获取项目知识库索引的新鲜度
+	GetIndexStatus(ctx context.Context, in *GetIndexStatusRequest, opts ...grpc.CallOption) (*GetIndexStatusResponse, error)
+	// 重新索引项目知识库
+	ReindexProject(ctx context.Context, in *ReindexProjectRequest, opts ...grpc.CallOption) (*ReindexProjectResponse, error)
+	// 用自然语言问题语义检索项目知识库
+	SearchProjectKnowledge(ctx context.Context, in *SearchProjectKnowledgeRequest, opts ...grpc.CallOption) (*SearchProjectKnowledgeResponse, error)
+	// 获取章节结束时的故事状态：人物所在与伤势、道具持有人与位置、地点和时间线
+	GetStoryState(ctx context.Context, in *GetStoryStateRequest, opts ...grpc.CallOption) (*GetStoryStateResponse, error)
+	// 将问题的修正建议应用到章节正文中对应的原文片段
+	ApplyCorrection(ctx context.Context, in *ApplyCorrectionRequest, opts ...grpc.CallOption) (*ApplyCorrectionResponse, error)
+	// 列出章节的质量与一致性检查历史
+	ListQualityReports(ctx context.Context, in *ListQualityReportsRequest, opts ...grpc.CallOption) (*ListQualityReportsResponse, error)
+	// 获取项目的质量趋势与润色前后的分数对比
+	GetQualityTrends(ctx context.Context, in *GetQualityTrendsRequest, opts ...grpc.CallOption) (*GetQualityTrendsResponse, error)
+	// 列出项目的情节线索：大纲转折点与关键道具，以及章节中埋下的伏笔
+	ListPlotThreads(ctx context.Context, in *ListPlotThreadsRequest, opts ...grpc.CallOption) (*ListPlotThreadsResponse, error)
+	// 检查即将生成的章节前未回收的伏笔与缺少铺垫的回收
+	CheckPlotThreads(ctx context.Context, in *CheckPlotThreadsRequest, opts ...grpc.CallOption) (*CheckPlotThreadsResponse, error)
+	// 根据反馈优化章节大纲，可附带尚未回收的情节线索
+	RefineOutline(ctx context.Context, in *RefineOutlineRequest, opts ...grpc.CallOption) (*RefineOutlineResponse, error)
+	// 内容安全审核：按章节标注暴力、色情、政治、未成年人风险并给出内容分级
+	ReviewContent(ctx context.Context, in *ReviewContentRequest, opts ...grpc.CallOption) (*ReviewContentResponse, error)
 }
 
 type novelServiceClient struct {
@@ -345,6 +411,227 @@ func (c *novelServiceClient) ListModels(ctx context.Context, in *ListModelsReque
 	return out, nil
 }
 
+func (c *novelServiceClient) ExportProjectBundle(ctx context.Context, in *ExportProjectBundleRequest, opts ...grpc.CallOption) (*ExportProjectBundleResponse, error) {
+	out := new(ExportProjectBundleResponse)
+	err := c.cc.Invoke(ctx, NovelService_ExportProjectBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) ImportProjectBundle(ctx context.Context, in *ImportProjectBundleRequest, opts ...grpc.CallOption) (*ImportProjectBundleResponse, error) {
+	out := new(ImportProjectBundleResponse)
+	err := c.cc.Invoke(ctx, NovelService_ImportProjectBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) SearchContent(ctx context.Context, in *SearchContentRequest, opts ...grpc.CallOption) (*SearchContentResponse, error) {
+	out := new(SearchContentResponse)
+	err := c.cc.Invoke(ctx, NovelService_SearchContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, NovelService_DeleteProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) DeleteChapter(ctx context.Context, in *DeleteChapterRequest, opts ...grpc.CallOption) (*DeleteChapterResponse, error) {
+	out := new(DeleteChapterResponse)
+	err := c.cc.Invoke(ctx, NovelService_DeleteChapter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, NovelService_ListTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...grpc.CallOption) (*RestoreProjectResponse, error) {
+	out := new(RestoreProjectResponse)
+	err := c.cc.Invoke(ctx, NovelService_RestoreProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) RestoreChapter(ctx context.Context, in *RestoreChapterRequest, opts ...grpc.CallOption) (*RestoreChapterResponse, error) {
+	out := new(RestoreChapterResponse)
+	err := c.cc.Invoke(ctx, NovelService_RestoreChapter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) PurgeProject(ctx context.Context, in *PurgeProjectRequest, opts ...grpc.CallOption) (*PurgeProjectResponse, error) {
+	out := new(PurgeProjectResponse)
+	err := c.cc.Invoke(ctx, NovelService_PurgeProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) PurgeChapter(ctx context.Context, in *PurgeChapterRequest, opts ...grpc.CallOption) (*PurgeChapterResponse, error) {
+	out := new(PurgeChapterResponse)
+	err := c.cc.Invoke(ctx, NovelService_PurgeChapter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) WatchGenerationProgress(ctx context.Context, in *WatchGenerationProgressRequest, opts ...grpc.CallOption) (NovelService_WatchGenerationProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &NovelService_ServiceDesc.Streams[2], NovelService_WatchGenerationProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &novelServiceWatchGenerationProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NovelService_WatchGenerationProgressClient interface {
+	Recv() (*GenerateNovelResponse, error)
+	grpc.ClientStream
+}
+
+type novelServiceWatchGenerationProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *novelServiceWatchGenerationProgressClient) Recv() (*GenerateNovelResponse, error) {
+	m := new(GenerateNovelResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *novelServiceClient) GetIndexStatus(ctx context.Context, in *GetIndexStatusRequest, opts ...grpc.CallOption) (*GetIndexStatusResponse, error) {
+	out := new(GetIndexStatusResponse)
+	err := c.cc.Invoke(ctx, NovelService_GetIndexStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) ReindexProject(ctx context.Context, in *ReindexProjectRequest, opts ...grpc.CallOption) (*ReindexProjectResponse, error) {
+	out := new(ReindexProjectResponse)
+	err := c.cc.Invoke(ctx, NovelService_ReindexProject_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) SearchProjectKnowledge(ctx context.Context, in *SearchProjectKnowledgeRequest, opts ...grpc.CallOption) (*SearchProjectKnowledgeResponse, error) {
+	out := new(SearchProjectKnowledgeResponse)
+	err := c.cc.Invoke(ctx, NovelService_SearchProjectKnowledge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) GetStoryState(ctx context.Context, in *GetStoryStateRequest, opts ...grpc.CallOption) (*GetStoryStateResponse, error) {
+	out := new(GetStoryStateResponse)
+	err := c.cc.Invoke(ctx, NovelService_GetStoryState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) ApplyCorrection(ctx context.Context, in *ApplyCorrectionRequest, opts ...grpc.CallOption) (*ApplyCorrectionResponse, error) {
+	out := new(ApplyCorrectionResponse)
+	err := c.cc.Invoke(ctx, NovelService_ApplyCorrection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) ListQualityReports(ctx context.Context, in *ListQualityReportsRequest, opts ...grpc.CallOption) (*ListQualityReportsResponse, error) {
+	out := new(ListQualityReportsResponse)
+	err := c.cc.Invoke(ctx, NovelService_ListQualityReports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) GetQualityTrends(ctx context.Context, in *GetQualityTrendsRequest, opts ...grpc.CallOption) (*GetQualityTrendsResponse, error) {
+	out := new(GetQualityTrendsResponse)
+	err := c.cc.Invoke(ctx, NovelService_GetQualityTrends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) ListPlotThreads(ctx context.Context, in *ListPlotThreadsRequest, opts ...grpc.CallOption) (*ListPlotThreadsResponse, error) {
+	out := new(ListPlotThreadsResponse)
+	err := c.cc.Invoke(ctx, NovelService_ListPlotThreads_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) CheckPlotThreads(ctx context.Context, in *CheckPlotThreadsRequest, opts ...grpc.CallOption) (*CheckPlotThreadsResponse, error) {
+	out := new(CheckPlotThreadsResponse)
+	err := c.cc.Invoke(ctx, NovelService_CheckPlotThreads_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) RefineOutline(ctx context.Context, in *RefineOutlineRequest, opts ...grpc.CallOption) (*RefineOutlineResponse, error) {
+	out := new(RefineOutlineResponse)
+	err := c.cc.Invoke(ctx, NovelService_RefineOutline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *novelServiceClient) ReviewContent(ctx context.Context, in *ReviewContentRequest, opts ...grpc.CallOption) (*ReviewContentResponse, error) {
+	out := new(ReviewContentResponse)
+	err := c.cc.Invoke(ctx, NovelService_ReviewContent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NovelServiceServer is the server API for NovelService service.
 // All implementations must embed UnimplementedNovelServiceServer
 // for forward compatibility
@@ -393,6 +680,50 @@ type NovelServiceServer interface {
 	SwitchModel(context.Context, *SwitchModelRequest) (*SwitchModelResponse, error)
 	// 获取可用模型列表
 	ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error)
+	// 导出项目数据包（含世界观、人物、大纲、章节与视频脚本）
+	ExportProjectBundle(context.Context, *ExportProjectBundleRequest) (*ExportProjectBundleResponse, error)
+	// 导入项目数据包
+	ImportProjectBundle(context.Context, *ImportProjectBundleRequest) (*ImportProjectBundleResponse, error)
+	// 全文检索章节正文、摘要、人物卡与大纲
+	SearchContent(context.Context, *SearchContentRequest) (*SearchContentResponse, error)
+	// 删除项目（移入回收站，章节与视频脚本随之删除）
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	// 删除章节（移入回收站）
+	DeleteChapter(context.Context, *DeleteChapterRequest) (*DeleteChapterResponse, error)
+	// 列出回收站中的项目与章节
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// 从回收站恢复项目（连同章节与视频脚本）
+	RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error)
+	// 从回收站恢复章节
+	RestoreChapter(context.Context, *RestoreChapterRequest) (*RestoreChapterResponse, error)
+	// 彻底删除回收站中的项目
+	PurgeProject(context.Context, *PurgeProjectRequest) (*PurgeProjectResponse, error)
+	// 彻底删除回收站中的章节
+	PurgeChapter(context.Context, *PurgeChapterRequest) (*PurgeChapterResponse, error)
+	// 订阅项目生成进度（可在任意实例上订阅）
+	WatchGenerationProgress(*WatchGenerationProgressRequest, NovelService_WatchGenerationProgressServer) error
+	// 获取项目知识库索引的新鲜度
+	GetIndexStatus(context.Context, *GetIndexStatusRequest) (*GetIndexStatusResponse, error)
+	// 重新索引项目知识库
+	ReindexProject(context.Context, *ReindexProjectRequest) (*ReindexProjectResponse, error)
+	// 用自然语言问题语义检索项目知识库
+	SearchProjectKnowledge(context.Context, *SearchProjectKnowledgeRequest) (*SearchProjectKnowledgeResponse, error)
+	// 获取章节结束时的故事状态：人物所在与伤势、道具持有人与位置、地点和时间线
+	GetStoryState(context.Context, *GetStoryStateRequest) (*GetStoryStateResponse, error)
+	// 将问题的修正建议应用到章节正文中对应的原文片段
+	ApplyCorrection(context.Context, *ApplyCorrectionRequest) (*ApplyCorrectionResponse, error)
+	// 列出章节的质量与一致性检查历史
+	ListQualityReports(context.Context, *ListQualityReportsRequest) (*ListQualityReportsResponse, error)
+	// 获取项目的质量趋势与润色前后的分数对比
+	GetQualityTrends(context.Context, *GetQualityTrendsRequest) (*GetQualityTrendsResponse, error)
+	// 列出项目的情节线索：大纲转折点与关键道具，以及章节中埋下的伏笔
+	ListPlotThreads(context.Context, *ListPlotThreadsRequest) (*ListPlotThreadsResponse, error)
+	// 检查即将生成的章节前未回收的伏笔与缺少铺垫的回收
+	CheckPlotThreads(context.Context, *CheckPlotThreadsRequest) (*CheckPlotThreadsResponse, error)
+	// 根据反馈优化章节大纲，可附带尚未回收的情节线索
+	RefineOutline(context.Context, *RefineOutlineRequest) (*RefineOutlineResponse, error)
+	// 内容安全审核：按章节标注暴力、色情、政治、未成年人风险并给出内容分级
+	ReviewContent(context.Context, *ReviewContentRequest) (*ReviewContentResponse, error)
 	mustEmbedUnimplementedNovelServiceServer()
 }
 
@@ -466,6 +797,72 @@ func (UnimplementedNovelServiceServer) SwitchModel(context.Context, *SwitchModel
 func (UnimplementedNovelServiceServer) ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListModels not implemented")
 }
+func (UnimplementedNovelServiceServer) ExportProjectBundle(context.Context, *ExportProjectBundleRequest) (*ExportProjectBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportProjectBundle not implemented")
+}
+func (UnimplementedNovelServiceServer) ImportProjectBundle(context.Context, *ImportProjectBundleRequest) (*ImportProjectBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProjectBundle not implemented")
+}
+func (UnimplementedNovelServiceServer) SearchContent(context.Context, *SearchContentRequest) (*SearchContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchContent not implemented")
+}
+func (UnimplementedNovelServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedNovelServiceServer) DeleteChapter(context.Context, *DeleteChapterRequest) (*DeleteChapterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChapter not implemented")
+}
+func (UnimplementedNovelServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedNovelServiceServer) RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProject not implemented")
+}
+func (UnimplementedNovelServiceServer) RestoreChapter(context.Context, *RestoreChapterRequest) (*RestoreChapterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreChapter not implemented")
+}
+func (UnimplementedNovelServiceServer) PurgeProject(context.Context, *PurgeProjectRequest) (*PurgeProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeProject not implemented")
+}
+func (UnimplementedNovelServiceServer) PurgeChapter(context.Context, *PurgeChapterRequest) (*PurgeChapterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeChapter not implemented")
+}
+func (UnimplementedNovelServiceServer) WatchGenerationProgress(*WatchGenerationProgressRequest, NovelService_WatchGenerationProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGenerationProgress not implemented")
+}
+func (UnimplementedNovelServiceServer) GetIndexStatus(context.Context, *GetIndexStatusRequest) (*GetIndexStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIndexStatus not implemented")
+}
+func (UnimplementedNovelServiceServer) ReindexProject(context.Context, *ReindexProjectRequest) (*ReindexProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReindexProject not implemented")
+}
+func (UnimplementedNovelServiceServer) SearchProjectKnowledge(context.Context, *SearchProjectKnowledgeRequest) (*SearchProjectKnowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProjectKnowledge not implemented")
+}
+func (UnimplementedNovelServiceServer) GetStoryState(context.Context, *GetStoryStateRequest) (*GetStoryStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoryState not implemented")
+}
+func (UnimplementedNovelServiceServer) ApplyCorrection(context.Context, *ApplyCorrectionRequest) (*ApplyCorrectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCorrection not implemented")
+}
+func (UnimplementedNovelServiceServer) ListQualityReports(context.Context, *ListQualityReportsRequest) (*ListQualityReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQualityReports not implemented")
+}
+func (UnimplementedNovelServiceServer) GetQualityTrends(context.Context, *GetQualityTrendsRequest) (*GetQualityTrendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQualityTrends not implemented")
+}
+func (UnimplementedNovelServiceServer) ListPlotThreads(context.Context, *ListPlotThreadsRequest) (*ListPlotThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlotThreads not implemented")
+}
+func (UnimplementedNovelServiceServer) CheckPlotThreads(context.Context, *CheckPlotThreadsRequest) (*CheckPlotThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPlotThreads not implemented")
+}
+func (UnimplementedNovelServiceServer) RefineOutline(context.Context, *RefineOutlineRequest) (*RefineOutlineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefineOutline not implemented")
+}
+func (UnimplementedNovelServiceServer) ReviewContent(context.Context, *ReviewContentRequest) (*ReviewContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewContent not implemented")
+}
 func (UnimplementedNovelServiceServer) mustEmbedUnimplementedNovelServiceServer() {}
 
 // UnsafeNovelServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -881,6 +1278,405 @@ func _NovelService_ListModels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NovelService_ExportProjectBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportProjectBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).ExportProjectBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_ExportProjectBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).ExportProjectBundle(ctx, req.(*ExportProjectBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_ImportProjectBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProjectBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).ImportProjectBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_ImportProjectBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).ImportProjectBundle(ctx, req.(*ImportProjectBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_SearchContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).SearchContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_SearchContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).SearchContent(ctx, req.(*SearchContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_DeleteChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).DeleteChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_DeleteChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).DeleteChapter(ctx, req.(*DeleteChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_RestoreProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).RestoreProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_RestoreProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).RestoreProject(ctx, req.(*RestoreProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_RestoreChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).RestoreChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_RestoreChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).RestoreChapter(ctx, req.(*RestoreChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_PurgeProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).PurgeProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_PurgeProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).PurgeProject(ctx, req.(*PurgeProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_PurgeChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).PurgeChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_PurgeChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).PurgeChapter(ctx, req.(*PurgeChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_WatchGenerationProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGenerationProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NovelServiceServer).WatchGenerationProgress(m, &novelServiceWatchGenerationProgressServer{stream})
+}
+
+type NovelService_WatchGenerationProgressServer interface {
+	Send(*GenerateNovelResponse) error
+	grpc.ServerStream
+}
+
+type novelServiceWatchGenerationProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *novelServiceWatchGenerationProgressServer) Send(m *GenerateNovelResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NovelService_GetIndexStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).GetIndexStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_GetIndexStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).GetIndexStatus(ctx, req.(*GetIndexStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_ReindexProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).ReindexProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_ReindexProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).ReindexProject(ctx, req.(*ReindexProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_SearchProjectKnowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProjectKnowledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).SearchProjectKnowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_SearchProjectKnowledge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).SearchProjectKnowledge(ctx, req.(*SearchProjectKnowledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_GetStoryState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoryStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).GetStoryState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_GetStoryState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).GetStoryState(ctx, req.(*GetStoryStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_ApplyCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).ApplyCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_ApplyCorrection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).ApplyCorrection(ctx, req.(*ApplyCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_ListQualityReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQualityReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).ListQualityReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_ListQualityReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).ListQualityReports(ctx, req.(*ListQualityReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_GetQualityTrends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQualityTrendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).GetQualityTrends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_GetQualityTrends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).GetQualityTrends(ctx, req.(*GetQualityTrendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_ListPlotThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlotThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).ListPlotThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_ListPlotThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).ListPlotThreads(ctx, req.(*ListPlotThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_CheckPlotThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPlotThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).CheckPlotThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_CheckPlotThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).CheckPlotThreads(ctx, req.(*CheckPlotThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_RefineOutline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefineOutlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).RefineOutline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_RefineOutline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).RefineOutline(ctx, req.(*RefineOutlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NovelService_ReviewContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NovelServiceServer).ReviewContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NovelService_ReviewContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NovelServiceServer).ReviewContent(ctx, req.(*ReviewContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NovelService_ServiceDesc is the grpc.ServiceDesc for NovelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -968,6 +1764,90 @@ var NovelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListModels",
 			Handler:    _NovelService_ListModels_Handler,
 		},
+		{
+			MethodName: "ExportProjectBundle",
+			Handler:    _NovelService_ExportProjectBundle_Handler,
+		},
+		{
+			MethodName: "ImportProjectBundle",
+			Handler:    _NovelService_ImportProjectBundle_Handler,
+		},
+		{
+			MethodName: "SearchContent",
+			Handler:    _NovelService_SearchContent_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _NovelService_DeleteProject_Handler,
+		},
+		{
+			MethodName: "DeleteChapter",
+			Handler:    _NovelService_DeleteChapter_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _NovelService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreProject",
+			Handler:    _NovelService_RestoreProject_Handler,
+		},
+		{
+			MethodName: "RestoreChapter",
+			Handler:    _NovelService_RestoreChapter_Handler,
+		},
+		{
+			MethodName: "PurgeProject",
+			Handler:    _NovelService_PurgeProject_Handler,
+		},
+		{
+			MethodName: "PurgeChapter",
+			Handler:    _NovelService_PurgeChapter_Handler,
+		},
+		{
+			MethodName: "GetIndexStatus",
+			Handler:    _NovelService_GetIndexStatus_Handler,
+		},
+		{
+			MethodName: "ReindexProject",
+			Handler:    _NovelService_ReindexProject_Handler,
+		},
+		{
+			MethodName: "SearchProjectKnowledge",
+			Handler:    _NovelService_SearchProjectKnowledge_Handler,
+		},
+		{
+			MethodName: "GetStoryState",
+			Handler:    _NovelService_GetStoryState_Handler,
+		},
+		{
+			MethodName: "ApplyCorrection",
+			Handler:    _NovelService_ApplyCorrection_Handler,
+		},
+		{
+			MethodName: "ListQualityReports",
+			Handler:    _NovelService_ListQualityReports_Handler,
+		},
+		{
+			MethodName: "GetQualityTrends",
+			Handler:    _NovelService_GetQualityTrends_Handler,
+		},
+		{
+			MethodName: "ListPlotThreads",
+			Handler:    _NovelService_ListPlotThreads_Handler,
+		},
+		{
+			MethodName: "CheckPlotThreads",
+			Handler:    _NovelService_CheckPlotThreads_Handler,
+		},
+		{
+			MethodName: "RefineOutline",
+			Handler:    _NovelService_RefineOutline_Handler,
+		},
+		{
+			MethodName: "ReviewContent",
+			Handler:    _NovelService_ReviewContent_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -980,6 +1860,11 @@ var NovelService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _NovelService_GenerateNovel_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchGenerationProgress",
+			Handler:       _NovelService_WatchGenerationProgress_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "novel/v1/novel.proto",
 }
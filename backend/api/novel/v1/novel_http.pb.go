@@ -19,40 +19,71 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationNovelServiceApplyCorrection = "/novel.v1.NovelService/ApplyCorrection"
 const OperationNovelServiceBatchCheckQuality = "/novel.v1.NovelService/BatchCheckQuality"
 const OperationNovelServiceCheckConsistency = "/novel.v1.NovelService/CheckConsistency"
+const OperationNovelServiceCheckPlotThreads = "/novel.v1.NovelService/CheckPlotThreads"
 const OperationNovelServiceCheckQuality = "/novel.v1.NovelService/CheckQuality"
 const OperationNovelServiceCreateProject = "/novel.v1.NovelService/CreateProject"
+const OperationNovelServiceDeleteChapter = "/novel.v1.NovelService/DeleteChapter"
 const OperationNovelServiceDeleteChapterOutline = "/novel.v1.NovelService/DeleteChapterOutline"
+const OperationNovelServiceDeleteProject = "/novel.v1.NovelService/DeleteProject"
 const OperationNovelServiceExportNovel = "/novel.v1.NovelService/ExportNovel"
+const OperationNovelServiceExportProjectBundle = "/novel.v1.NovelService/ExportProjectBundle"
 const OperationNovelServiceGenerateChapter = "/novel.v1.NovelService/GenerateChapter"
 const OperationNovelServiceGenerateCharacters = "/novel.v1.NovelService/GenerateCharacters"
 const OperationNovelServiceGenerateOutline = "/novel.v1.NovelService/GenerateOutline"
 const OperationNovelServiceGenerateVideoScript = "/novel.v1.NovelService/GenerateVideoScript"
 const OperationNovelServiceGenerateWorldView = "/novel.v1.NovelService/GenerateWorldView"
+const OperationNovelServiceGetIndexStatus = "/novel.v1.NovelService/GetIndexStatus"
 const OperationNovelServiceGetProject = "/novel.v1.NovelService/GetProject"
+const OperationNovelServiceGetQualityTrends = "/novel.v1.NovelService/GetQualityTrends"
 const OperationNovelServiceGetStats = "/novel.v1.NovelService/GetStats"
+const OperationNovelServiceGetStoryState = "/novel.v1.NovelService/GetStoryState"
+const OperationNovelServiceImportProjectBundle = "/novel.v1.NovelService/ImportProjectBundle"
 const OperationNovelServiceListModels = "/novel.v1.NovelService/ListModels"
+const OperationNovelServiceListPlotThreads = "/novel.v1.NovelService/ListPlotThreads"
 const OperationNovelServiceListProjects = "/novel.v1.NovelService/ListProjects"
+const OperationNovelServiceListQualityReports = "/novel.v1.NovelService/ListQualityReports"
+const OperationNovelServiceListTrash = "/novel.v1.NovelService/ListTrash"
 const OperationNovelServicePolishChapter = "/novel.v1.NovelService/PolishChapter"
+const OperationNovelServicePurgeChapter = "/novel.v1.NovelService/PurgeChapter"
+const OperationNovelServicePurgeProject = "/novel.v1.NovelService/PurgeProject"
+const OperationNovelServiceRefineOutline = "/novel.v1.NovelService/RefineOutline"
+const OperationNovelServiceReindexProject = "/novel.v1.NovelService/ReindexProject"
 const OperationNovelServiceReorderChapterOutline = "/novel.v1.NovelService/ReorderChapterOutline"
+const OperationNovelServiceRestoreChapter = "/novel.v1.NovelService/RestoreChapter"
+const OperationNovelServiceRestoreProject = "/novel.v1.NovelService/RestoreProject"
+const OperationNovelServiceReviewContent = "/novel.v1.NovelService/ReviewContent"
+const OperationNovelServiceSearchContent = "/novel.v1.NovelService/SearchContent"
+const OperationNovelServiceSearchProjectKnowledge = "/novel.v1.NovelService/SearchProjectKnowledge"
 const OperationNovelServiceSwitchModel = "/novel.v1.NovelService/SwitchModel"
 const OperationNovelServiceUpdateChapterOutline = "/novel.v1.NovelService/UpdateChapterOutline"
 const OperationNovelServiceUpdateProject = "/novel.v1.NovelService/UpdateProject"
 
 type NovelServiceHTTPServer interface {
+	// ApplyCorrection 将问题的修正建议应用到章节正文中对应的原文片段
+	ApplyCorrection(context.Context, *ApplyCorrectionRequest) (*ApplyCorrectionResponse, error)
 	// BatchCheckQuality 批量质量检测
 	BatchCheckQuality(context.Context, *BatchCheckQualityRequest) (*BatchCheckQualityResponse, error)
 	// CheckConsistency 一致性检查
 	CheckConsistency(context.Context, *CheckConsistencyRequest) (*CheckConsistencyResponse, error)
+	// CheckPlotThreads 检查即将生成的章节前未回收的伏笔与缺少铺垫的回收
+	CheckPlotThreads(context.Context, *CheckPlotThreadsRequest) (*CheckPlotThreadsResponse, error)
 	// CheckQuality 质量检测
 	CheckQuality(context.Context, *CheckQualityRequest) (*CheckQualityResponse, error)
 	// CreateProject 创建小说项目
 	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// DeleteChapter 删除章节（移入回收站）
+	DeleteChapter(context.Context, *DeleteChapterRequest) (*DeleteChapterResponse, error)
 	// DeleteChapterOutline 删除章节大纲
 	DeleteChapterOutline(context.Context, *DeleteChapterOutlineRequest) (*DeleteChapterOutlineResponse, error)
+	// DeleteProject 删除项目（移入回收站，章节与视频脚本随之删除）
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
 	// ExportNovel 导出小说
 	ExportNovel(context.Context, *ExportNovelRequest) (*ExportNovelResponse, error)
+	// ExportProjectBundle 导出项目数据包（含世界观、人物、大纲、章节与视频脚本）
+	ExportProjectBundle(context.Context, *ExportProjectBundleRequest) (*ExportProjectBundleResponse, error)
 	// GenerateChapter 生成章节内容
 	GenerateChapter(context.Context, *GenerateChapterRequest) (*GenerateChapterResponse, error)
 	// GenerateCharacters 生成人物卡
@@ -63,18 +94,50 @@ type NovelServiceHTTPServer interface {
 	GenerateVideoScript(context.Context, *GenerateVideoScriptRequest) (*GenerateVideoScriptResponse, error)
 	// GenerateWorldView 生成世界观
 	GenerateWorldView(context.Context, *GenerateWorldViewRequest) (*GenerateWorldViewResponse, error)
+	// GetIndexStatus 获取项目知识库索引的新鲜度
+	GetIndexStatus(context.Context, *GetIndexStatusRequest) (*GetIndexStatusResponse, error)
 	// GetProject 获取项目详情
 	GetProject(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
+	// GetQualityTrends 获取项目的质量趋势与润色前后的分数对比
+	GetQualityTrends(context.Context, *GetQualityTrendsRequest) (*GetQualityTrendsResponse, error)
 	// GetStats 获取统计信息
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	// GetStoryState 获取章节结束时的故事状态：人物所在与伤势、道具持有人与位置、地点和时间线
+	GetStoryState(context.Context, *GetStoryStateRequest) (*GetStoryStateResponse, error)
+	// ImportProjectBundle 导入项目数据包
+	ImportProjectBundle(context.Context, *ImportProjectBundleRequest) (*ImportProjectBundleResponse, error)
 	// ListModels 获取可用模型列表
 	ListModels(context.Context, *ListModelsRequest) (*ListModelsResponse, error)
+	// ListPlotThreads 列出项目的情节线索：大纲转折点与关键道具，以及章节中埋下的伏笔
+	ListPlotThreads(context.Context, *ListPlotThreadsRequest) (*ListPlotThreadsResponse, error)
 	// ListProjects 列出项目
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	// ListQualityReports 列出章节的质量与一致性检查历史
+	ListQualityReports(context.Context, *ListQualityReportsRequest) (*ListQualityReportsResponse, error)
+	// ListTrash 列出回收站中的项目与章节
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
 	// PolishChapter 润色章节
 	PolishChapter(context.Context, *PolishChapterRequest) (*PolishChapterResponse, error)
+	// PurgeChapter 彻底删除回收站中的章节
+	PurgeChapter(context.Context, *PurgeChapterRequest) (*PurgeChapterResponse, error)
+	// PurgeProject 彻底删除回收站中的项目
+	PurgeProject(context.Context, *PurgeProjectRequest) (*PurgeProjectResponse, error)
+	// RefineOutline 根据反馈优化章节大纲，可附带尚未回收的情节线索
+	RefineOutline(context.Context, *RefineOutlineRequest) (*RefineOutlineResponse, error)
+	// ReindexProject 重新索引项目知识库
+	ReindexProject(context.Context, *ReindexProjectRequest) (*ReindexProjectResponse, error)
 	// ReorderChapterOutline 重排序章节大纲
 	ReorderChapterOutline(context.Context, *ReorderChapterOutlineRequest) (*ReorderChapterOutlineResponse, error)
+	// RestoreChapter 从回收站恢复章节
+	RestoreChapter(context.Context, *RestoreChapterRequest) (*RestoreChapterResponse, error)
+	// RestoreProject 从回收站恢复项目（连同章节与视频脚本）
+	RestoreProject(context.Context, *RestoreProjectRequest) (*RestoreProjectResponse, error)
+	// ReviewContent 内容安全审核：按章节标注暴力、色情、政治、未成年人风险并给出内容分级
+	ReviewContent(context.Context, *ReviewContentRequest) (*ReviewContentResponse, error)
+	// SearchContent 全文检索章节正文、摘要、人物卡与大纲
+	SearchContent(context.Context, *SearchContentRequest) (*SearchContentResponse, error)
+	// SearchProjectKnowledge 用自然语言问题语义检索项目知识库
+	SearchProjectKnowledge(context.Context, *SearchProjectKnowledgeRequest) (*SearchProjectKnowledgeResponse, error)
 	// SwitchModel 切换AI模型
 	SwitchModel(context.Context, *SwitchModelRequest) (*SwitchModelResponse, error)
 	// UpdateChapterOutline 更新章节大纲
@@ -105,6 +168,27 @@ func RegisterNovelServiceHTTPServer(s *http.Server, srv NovelServiceHTTPServer)
 	r.POST("/api/v1/novel/projects/{project_id}/video-script", _NovelService_GenerateVideoScript0_HTTP_Handler(srv))
 	r.POST("/api/v1/novel/switch-model", _NovelService_SwitchModel0_HTTP_Handler(srv))
 	r.GET("/api/v1/novel/models", _NovelService_ListModels0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/projects/{project_id}/bundle", _NovelService_ExportProjectBundle0_HTTP_Handler(srv))
+	r.POST("/api/v1/novel/projects/import", _NovelService_ImportProjectBundle0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/search", _NovelService_SearchContent0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/novel/projects/{project_id}", _NovelService_DeleteProject0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/novel/projects/{project_id}/chapters/{chapter_id}", _NovelService_DeleteChapter0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/trash", _NovelService_ListTrash0_HTTP_Handler(srv))
+	r.POST("/api/v1/novel/trash/projects/{project_id}/restore", _NovelService_RestoreProject0_HTTP_Handler(srv))
+	r.POST("/api/v1/novel/trash/chapters/{chapter_id}/restore", _NovelService_RestoreChapter0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/novel/trash/projects/{project_id}", _NovelService_PurgeProject0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/novel/trash/chapters/{chapter_id}", _NovelService_PurgeChapter0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/projects/{project_id}/index", _NovelService_GetIndexStatus0_HTTP_Handler(srv))
+	r.POST("/api/v1/novel/projects/{project_id}/index/reindex", _NovelService_ReindexProject0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/projects/{project_id}/knowledge/search", _NovelService_SearchProjectKnowledge0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/projects/{project_id}/story-state", _NovelService_GetStoryState0_HTTP_Handler(srv))
+	r.POST("/api/v1/novel/projects/{project_id}/chapters/{chapter_id}/corrections", _NovelService_ApplyCorrection0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/projects/{project_id}/chapters/{chapter_id}/quality-reports", _NovelService_ListQualityReports0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/projects/{project_id}/quality-trends", _NovelService_GetQualityTrends0_HTTP_Handler(srv))
+	r.GET("/api/v1/novel/projects/{project_id}/plot-threads", _NovelService_ListPlotThreads0_HTTP_Handler(srv))
+	r.POST("/api/v1/novel/projects/{project_id}/plot-threads/check", _NovelService_CheckPlotThreads0_HTTP_Handler(srv))
+	r.POST("/api/v1/novel/projects/{project_id}/outline/refine", _NovelService_RefineOutline0_HTTP_Handler(srv))
+	r.POST("/api/v1/novel/projects/{project_id}/safety/review", _NovelService_ReviewContent0_HTTP_Handler(srv))
 }
 
 func _NovelService_CreateProject0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
@@ -577,24 +661,522 @@ func _NovelService_ListModels0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx
 	}
 }
 
+func _NovelService_ExportProjectBundle0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportProjectBundleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceExportProjectBundle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportProjectBundle(ctx, req.(*ExportProjectBundleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportProjectBundleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_ImportProjectBundle0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportProjectBundleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceImportProjectBundle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportProjectBundle(ctx, req.(*ImportProjectBundleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportProjectBundleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_SearchContent0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchContentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceSearchContent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchContent(ctx, req.(*SearchContentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchContentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_DeleteProject0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteProjectRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceDeleteProject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteProject(ctx, req.(*DeleteProjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteProjectResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_DeleteChapter0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteChapterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceDeleteChapter)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteChapter(ctx, req.(*DeleteChapterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteChapterResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_ListTrash0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTrashRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceListTrash)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTrash(ctx, req.(*ListTrashRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTrashResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_RestoreProject0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreProjectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceRestoreProject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreProject(ctx, req.(*RestoreProjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreProjectResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_RestoreChapter0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreChapterRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceRestoreChapter)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreChapter(ctx, req.(*RestoreChapterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreChapterResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_PurgeProject0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeProjectRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServicePurgeProject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeProject(ctx, req.(*PurgeProjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeProjectResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_PurgeChapter0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PurgeChapterRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServicePurgeChapter)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PurgeChapter(ctx, req.(*PurgeChapterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PurgeChapterResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_GetIndexStatus0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetIndexStatusRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceGetIndexStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetIndexStatus(ctx, req.(*GetIndexStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetIndexStatusResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_ReindexProject0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReindexProjectRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceReindexProject)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReindexProject(ctx, req.(*ReindexProjectRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReindexProjectResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_SearchProjectKnowledge0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchProjectKnowledgeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceSearchProjectKnowledge)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchProjectKnowledge(ctx, req.(*SearchProjectKnowledgeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchProjectKnowledgeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_GetStoryState0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetStoryStateRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceGetStoryState)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetStoryState(ctx, req.(*GetStoryStateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetStoryStateResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_ApplyCorrection0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApplyCorrectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceApplyCorrection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApplyCorrection(ctx, req.(*ApplyCorrectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ApplyCorrectionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_ListQualityReports0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListQualityReportsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceListQualityReports)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListQualityReports(ctx, req.(*ListQualityReportsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListQualityReportsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_GetQualityTrends0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetQualityTrendsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceGetQualityTrends)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetQualityTrends(ctx, req.(*GetQualityTrendsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetQualityTrendsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_ListPlotThreads0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPlotThreadsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceListPlotThreads)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPlotThreads(ctx, req.(*ListPlotThreadsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPlotThreadsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_CheckPlotThreads0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckPlotThreadsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceCheckPlotThreads)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckPlotThreads(ctx, req.(*CheckPlotThreadsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckPlotThreadsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_RefineOutline0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefineOutlineRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceRefineOutline)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefineOutline(ctx, req.(*RefineOutlineRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefineOutlineResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _NovelService_ReviewContent0_HTTP_Handler(srv NovelServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReviewContentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNovelServiceReviewContent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReviewContent(ctx, req.(*ReviewContentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewContentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type NovelServiceHTTPClient interface {
+	ApplyCorrection(ctx context.Context, req *ApplyCorrectionRequest, opts ...http.CallOption) (rsp *ApplyCorrectionResponse, err error)
 	BatchCheckQuality(ctx context.Context, req *BatchCheckQualityRequest, opts ...http.CallOption) (rsp *BatchCheckQualityResponse, err error)
 	CheckConsistency(ctx context.Context, req *CheckConsistencyRequest, opts ...http.CallOption) (rsp *CheckConsistencyResponse, err error)
+	CheckPlotThreads(ctx context.Context, req *CheckPlotThreadsRequest, opts ...http.CallOption) (rsp *CheckPlotThreadsResponse, err error)
 	CheckQuality(ctx context.Context, req *CheckQualityRequest, opts ...http.CallOption) (rsp *CheckQualityResponse, err error)
 	CreateProject(ctx context.Context, req *CreateProjectRequest, opts ...http.CallOption) (rsp *CreateProjectResponse, err error)
+	DeleteChapter(ctx context.Context, req *DeleteChapterRequest, opts ...http.CallOption) (rsp *DeleteChapterResponse, err error)
 	DeleteChapterOutline(ctx context.Context, req *DeleteChapterOutlineRequest, opts ...http.CallOption) (rsp *DeleteChapterOutlineResponse, err error)
+	DeleteProject(ctx context.Context, req *DeleteProjectRequest, opts ...http.CallOption) (rsp *DeleteProjectResponse, err error)
 	ExportNovel(ctx context.Context, req *ExportNovelRequest, opts ...http.CallOption) (rsp *ExportNovelResponse, err error)
+	ExportProjectBundle(ctx context.Context, req *ExportProjectBundleRequest, opts ...http.CallOption) (rsp *ExportProjectBundleResponse, err error)
 	GenerateChapter(ctx context.Context, req *GenerateChapterRequest, opts ...http.CallOption) (rsp *GenerateChapterResponse, err error)
 	GenerateCharacters(ctx context.Context, req *GenerateCharactersRequest, opts ...http.CallOption) (rsp *GenerateCharactersResponse, err error)
 	GenerateOutline(ctx context.Context, req *GenerateOutlineRequest, opts ...http.CallOption) (rsp *GenerateOutlineResponse, err error)
 	GenerateVideoScript(ctx context.Context, req *GenerateVideoScriptRequest, opts ...http.CallOption) (rsp *GenerateVideoScriptResponse, err error)
 	GenerateWorldView(ctx context.Context, req *GenerateWorldViewRequest, opts ...http.CallOption) (rsp *GenerateWorldViewResponse, err error)
+	GetIndexStatus(ctx context.Context, req *GetIndexStatusRequest, opts ...http.CallOption) (rsp *GetIndexStatusResponse, err error)
 	GetProject(ctx context.Context, req *GetProjectRequest, opts ...http.CallOption) (rsp *GetProjectResponse, err error)
+	GetQualityTrends(ctx context.Context, req *GetQualityTrendsRequest, opts ...http.CallOption) (rsp *GetQualityTrendsResponse, err error)
 	GetStats(ctx context.Context, req *GetStatsRequest, opts ...http.CallOption) (rsp *GetStatsResponse, err error)
+	GetStoryState(ctx context.Context, req *GetStoryStateRequest, opts ...http.CallOption) (rsp *GetStoryStateResponse, err error)
+	ImportProjectBundle(ctx context.Context, req *ImportProjectBundleRequest, opts ...http.CallOption) (rsp *ImportProjectBundleResponse, err error)
 	ListModels(ctx context.Context, req *ListModelsRequest, opts ...http.CallOption) (rsp *ListModelsResponse, err error)
+	ListPlotThreads(ctx context.Context, req *ListPlotThreadsRequest, opts ...http.CallOption) (rsp *ListPlotThreadsResponse, err error)
 	ListProjects(ctx context.Context, req *ListProjectsRequest, opts ...http.CallOption) (rsp *ListProjectsResponse, err error)
+	ListQualityReports(ctx context.Context, req *ListQualityReportsRequest, opts ...http.CallOption) (rsp *ListQualityReportsResponse, err error)
+	ListTrash(ctx context.Context, req *ListTrashRequest, opts ...http.CallOption) (rsp *ListTrashResponse, err error)
 	PolishChapter(ctx context.Context, req *PolishChapterRequest, opts ...http.CallOption) (rsp *PolishChapterResponse, err error)
+	PurgeChapter(ctx context.Context, req *PurgeChapterRequest, opts ...http.CallOption) (rsp *PurgeChapterResponse, err error)
+	PurgeProject(ctx context.Context, req *PurgeProjectRequest, opts ...http.CallOption) (rsp *PurgeProjectResponse, err error)
+	RefineOutline(ctx context.Context, req *RefineOutlineRequest, opts ...http.CallOption) (rsp *RefineOutlineResponse, err error)
+	ReindexProject(ctx context.Context, req *ReindexProjectRequest, opts ...http.CallOption) (rsp *ReindexProjectResponse, err error)
 	ReorderChapterOutline(ctx context.Context, req *ReorderChapterOutlineRequest, opts ...http.CallOption) (rsp *ReorderChapterOutlineResponse, err error)
+	RestoreChapter(ctx context.Context, req *RestoreChapterRequest, opts ...http.CallOption) (rsp *RestoreChapterResponse, err error)
+	RestoreProject(ctx context.Context, req *RestoreProjectRequest, opts ...http.CallOption) (rsp *RestoreProjectResponse, err error)
+	ReviewContent(ctx context.Context, req *ReviewContentRequest, opts ...http.CallOption) (rsp *ReviewContentResponse, err error)
+	SearchContent(ctx context.Context, req *SearchContentRequest, opts ...http.CallOption) (rsp *SearchContentResponse, err error)
+	SearchProjectKnowledge(ctx context.Context, req *SearchProjectKnowledgeRequest, opts ...http.CallOption) (rsp *SearchProjectKnowledgeResponse, err error)
 	SwitchModel(ctx context.Context, req *SwitchModelRequest, opts ...http.CallOption) (rsp *SwitchModelResponse, err error)
 	UpdateChapterOutline(ctx context.Context, req *UpdateChapterOutlineRequest, opts ...http.CallOption) (rsp *UpdateChapterOutlineResponse, err error)
 	UpdateProject(ctx context.Context, req *UpdateProjectRequest, opts ...http.CallOption) (rsp *UpdateProjectResponse, err error)
@@ -608,6 +1190,19 @@ func NewNovelServiceHTTPClient(client *http.Client) NovelServiceHTTPClient {
 	return &NovelServiceHTTPClientImpl{client}
 }
 
+func (c *NovelServiceHTTPClientImpl) ApplyCorrection(ctx context.Context, in *ApplyCorrectionRequest, opts ...http.CallOption) (*ApplyCorrectionResponse, error) {
+	var out ApplyCorrectionResponse
+	pattern := "/api/v1/novel/projects/{project_id}/chapters/{chapter_id}/corrections"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNovelServiceApplyCorrection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) BatchCheckQuality(ctx context.Context, in *BatchCheckQualityRequest, opts ...http.CallOption) (*BatchCheckQualityResponse, error) {
 	var out BatchCheckQualityResponse
 	pattern := "/api/v1/novel/projects/{project_id}/quality/batch"
@@ -634,6 +1229,19 @@ func (c *NovelServiceHTTPClientImpl) CheckConsistency(ctx context.Context, in *C
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) CheckPlotThreads(ctx context.Context, in *CheckPlotThreadsRequest, opts ...http.CallOption) (*CheckPlotThreadsResponse, error) {
+	var out CheckPlotThreadsResponse
+	pattern := "/api/v1/novel/projects/{project_id}/plot-threads/check"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNovelServiceCheckPlotThreads))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) CheckQuality(ctx context.Context, in *CheckQualityRequest, opts ...http.CallOption) (*CheckQualityResponse, error) {
 	var out CheckQualityResponse
 	pattern := "/api/v1/novel/projects/{project_id}/chapters/{chapter_id}/quality"
@@ -660,6 +1268,19 @@ func (c *NovelServiceHTTPClientImpl) CreateProject(ctx context.Context, in *Crea
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) DeleteChapter(ctx context.Context, in *DeleteChapterRequest, opts ...http.CallOption) (*DeleteChapterResponse, error) {
+	var out DeleteChapterResponse
+	pattern := "/api/v1/novel/projects/{project_id}/chapters/{chapter_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceDeleteChapter))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) DeleteChapterOutline(ctx context.Context, in *DeleteChapterOutlineRequest, opts ...http.CallOption) (*DeleteChapterOutlineResponse, error) {
 	var out DeleteChapterOutlineResponse
 	pattern := "/api/v1/novel/projects/{project_id}/outline/chapters/{chapter_index}"
@@ -673,6 +1294,19 @@ func (c *NovelServiceHTTPClientImpl) DeleteChapterOutline(ctx context.Context, i
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...http.CallOption) (*DeleteProjectResponse, error) {
+	var out DeleteProjectResponse
+	pattern := "/api/v1/novel/projects/{project_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceDeleteProject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) ExportNovel(ctx context.Context, in *ExportNovelRequest, opts ...http.CallOption) (*ExportNovelResponse, error) {
 	var out ExportNovelResponse
 	pattern := "/api/v1/novel/projects/{project_id}/export"
@@ -686,6 +1320,19 @@ func (c *NovelServiceHTTPClientImpl) ExportNovel(ctx context.Context, in *Export
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) ExportProjectBundle(ctx context.Context, in *ExportProjectBundleRequest, opts ...http.CallOption) (*ExportProjectBundleResponse, error) {
+	var out ExportProjectBundleResponse
+	pattern := "/api/v1/novel/projects/{project_id}/bundle"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceExportProjectBundle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) GenerateChapter(ctx context.Context, in *GenerateChapterRequest, opts ...http.CallOption) (*GenerateChapterResponse, error) {
 	var out GenerateChapterResponse
 	pattern := "/api/v1/novel/projects/{project_id}/chapters"
@@ -751,6 +1398,19 @@ func (c *NovelServiceHTTPClientImpl) GenerateWorldView(ctx context.Context, in *
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) GetIndexStatus(ctx context.Context, in *GetIndexStatusRequest, opts ...http.CallOption) (*GetIndexStatusResponse, error) {
+	var out GetIndexStatusResponse
+	pattern := "/api/v1/novel/projects/{project_id}/index"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceGetIndexStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) GetProject(ctx context.Context, in *GetProjectRequest, opts ...http.CallOption) (*GetProjectResponse, error) {
 	var out GetProjectResponse
 	pattern := "/api/v1/novel/projects/{project_id}"
@@ -764,6 +1424,19 @@ func (c *NovelServiceHTTPClientImpl) GetProject(ctx context.Context, in *GetProj
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) GetQualityTrends(ctx context.Context, in *GetQualityTrendsRequest, opts ...http.CallOption) (*GetQualityTrendsResponse, error) {
+	var out GetQualityTrendsResponse
+	pattern := "/api/v1/novel/projects/{project_id}/quality-trends"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceGetQualityTrends))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) GetStats(ctx context.Context, in *GetStatsRequest, opts ...http.CallOption) (*GetStatsResponse, error) {
 	var out GetStatsResponse
 	pattern := "/api/v1/novel/stats"
@@ -777,6 +1450,32 @@ func (c *NovelServiceHTTPClientImpl) GetStats(ctx context.Context, in *GetStatsR
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) GetStoryState(ctx context.Context, in *GetStoryStateRequest, opts ...http.CallOption) (*GetStoryStateResponse, error) {
+	var out GetStoryStateResponse
+	pattern := "/api/v1/novel/projects/{project_id}/story-state"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceGetStoryState))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) ImportProjectBundle(ctx context.Context, in *ImportProjectBundleRequest, opts ...http.CallOption) (*ImportProjectBundleResponse, error) {
+	var out ImportProjectBundleResponse
+	pattern := "/api/v1/novel/projects/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNovelServiceImportProjectBundle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) ListModels(ctx context.Context, in *ListModelsRequest, opts ...http.CallOption) (*ListModelsResponse, error) {
 	var out ListModelsResponse
 	pattern := "/api/v1/novel/models"
@@ -790,6 +1489,19 @@ func (c *NovelServiceHTTPClientImpl) ListModels(ctx context.Context, in *ListMod
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) ListPlotThreads(ctx context.Context, in *ListPlotThreadsRequest, opts ...http.CallOption) (*ListPlotThreadsResponse, error) {
+	var out ListPlotThreadsResponse
+	pattern := "/api/v1/novel/projects/{project_id}/plot-threads"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceListPlotThreads))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...http.CallOption) (*ListProjectsResponse, error) {
 	var out ListProjectsResponse
 	pattern := "/api/v1/novel/projects"
@@ -803,6 +1515,32 @@ func (c *NovelServiceHTTPClientImpl) ListProjects(ctx context.Context, in *ListP
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) ListQualityReports(ctx context.Context, in *ListQualityReportsRequest, opts ...http.CallOption) (*ListQualityReportsResponse, error) {
+	var out ListQualityReportsResponse
+	pattern := "/api/v1/novel/projects/{project_id}/chapters/{chapter_id}/quality-reports"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceListQualityReports))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...http.CallOption) (*ListTrashResponse, error) {
+	var out ListTrashResponse
+	pattern := "/api/v1/novel/trash"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceListTrash))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) PolishChapter(ctx context.Context, in *PolishChapterRequest, opts ...http.CallOption) (*PolishChapterResponse, error) {
 	var out PolishChapterResponse
 	pattern := "/api/v1/novel/projects/{project_id}/chapters/{chapter_id}/polish"
@@ -816,6 +1554,58 @@ func (c *NovelServiceHTTPClientImpl) PolishChapter(ctx context.Context, in *Poli
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) PurgeChapter(ctx context.Context, in *PurgeChapterRequest, opts ...http.CallOption) (*PurgeChapterResponse, error) {
+	var out PurgeChapterResponse
+	pattern := "/api/v1/novel/trash/chapters/{chapter_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServicePurgeChapter))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) PurgeProject(ctx context.Context, in *PurgeProjectRequest, opts ...http.CallOption) (*PurgeProjectResponse, error) {
+	var out PurgeProjectResponse
+	pattern := "/api/v1/novel/trash/projects/{project_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServicePurgeProject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) RefineOutline(ctx context.Context, in *RefineOutlineRequest, opts ...http.CallOption) (*RefineOutlineResponse, error) {
+	var out RefineOutlineResponse
+	pattern := "/api/v1/novel/projects/{project_id}/outline/refine"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNovelServiceRefineOutline))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) ReindexProject(ctx context.Context, in *ReindexProjectRequest, opts ...http.CallOption) (*ReindexProjectResponse, error) {
+	var out ReindexProjectResponse
+	pattern := "/api/v1/novel/projects/{project_id}/index/reindex"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNovelServiceReindexProject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) ReorderChapterOutline(ctx context.Context, in *ReorderChapterOutlineRequest, opts ...http.CallOption) (*ReorderChapterOutlineResponse, error) {
 	var out ReorderChapterOutlineResponse
 	pattern := "/api/v1/novel/projects/{project_id}/outline/reorder"
@@ -829,6 +1619,71 @@ func (c *NovelServiceHTTPClientImpl) ReorderChapterOutline(ctx context.Context,
 	return &out, nil
 }
 
+func (c *NovelServiceHTTPClientImpl) RestoreChapter(ctx context.Context, in *RestoreChapterRequest, opts ...http.CallOption) (*RestoreChapterResponse, error) {
+	var out RestoreChapterResponse
+	pattern := "/api/v1/novel/trash/chapters/{chapter_id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNovelServiceRestoreChapter))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) RestoreProject(ctx context.Context, in *RestoreProjectRequest, opts ...http.CallOption) (*RestoreProjectResponse, error) {
+	var out RestoreProjectResponse
+	pattern := "/api/v1/novel/trash/projects/{project_id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNovelServiceRestoreProject))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) ReviewContent(ctx context.Context, in *ReviewContentRequest, opts ...http.CallOption) (*ReviewContentResponse, error) {
+	var out ReviewContentResponse
+	pattern := "/api/v1/novel/projects/{project_id}/safety/review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNovelServiceReviewContent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) SearchContent(ctx context.Context, in *SearchContentRequest, opts ...http.CallOption) (*SearchContentResponse, error) {
+	var out SearchContentResponse
+	pattern := "/api/v1/novel/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceSearchContent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NovelServiceHTTPClientImpl) SearchProjectKnowledge(ctx context.Context, in *SearchProjectKnowledgeRequest, opts ...http.CallOption) (*SearchProjectKnowledgeResponse, error) {
+	var out SearchProjectKnowledgeResponse
+	pattern := "/api/v1/novel/projects/{project_id}/knowledge/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNovelServiceSearchProjectKnowledge))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NovelServiceHTTPClientImpl) SwitchModel(ctx context.Context, in *SwitchModelRequest, opts ...http.CallOption) (*SwitchModelResponse, error) {
 	var out SwitchModelResponse
 	pattern := "/api/v1/novel/switch-model"
@@ -25,6 +25,8 @@ const (
 	VideoScriptService_ListVideoScripts_FullMethodName         = "/api.video_script.v1.VideoScriptService/ListVideoScripts"
 	VideoScriptService_GetVideoScript_FullMethodName           = "/api.video_script.v1.VideoScriptService/GetVideoScript"
 	VideoScriptService_DeleteVideoScript_FullMethodName        = "/api.video_script.v1.VideoScriptService/DeleteVideoScript"
+	VideoScriptService_ReviewVideoScript_FullMethodName        = "/api.video_script.v1.VideoScriptService/ReviewVideoScript"
+	VideoScriptService_PublishVideoScript_FullMethodName       = "/api.video_script.v1.VideoScriptService/PublishVideoScript"
 )
 
 // VideoScriptServiceClient is the client API for VideoScriptService service.
@@ -43,6 +45,10 @@ type VideoScriptServiceClient interface {
 	GetVideoScript(ctx context.Context, in *GetVideoScriptRequest, opts ...grpc.CallOption) (*GetVideoScriptResponse, error)
 	// 删除视频脚本
 	DeleteVideoScript(ctx context.Context, in *DeleteVideoScriptRequest, opts ...grpc.CallOption) (*DeleteVideoScriptResponse, error)
+	// 内容安全审核：按分镜标注暴力、色情、政治、未成年人风险并给出内容分级
+	ReviewVideoScript(ctx context.Context, in *ReviewVideoScriptRequest, opts ...grpc.CallOption) (*ReviewVideoScriptResponse, error)
+	// 发布视频脚本，发布前进行内容安全审核，分级达到阈值时拒绝发布
+	PublishVideoScript(ctx context.Context, in *PublishVideoScriptRequest, opts ...grpc.CallOption) (*PublishVideoScriptResponse, error)
 }
 
 type videoScriptServiceClient struct {
@@ -107,6 +113,24 @@ func (c *videoScriptServiceClient) DeleteVideoScript(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *videoScriptServiceClient) ReviewVideoScript(ctx context.Context, in *ReviewVideoScriptRequest, opts ...grpc.CallOption) (*ReviewVideoScriptResponse, error) {
+	out := new(ReviewVideoScriptResponse)
+	err := c.cc.Invoke(ctx, VideoScriptService_ReviewVideoScript_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoScriptServiceClient) PublishVideoScript(ctx context.Context, in *PublishVideoScriptRequest, opts ...grpc.CallOption) (*PublishVideoScriptResponse, error) {
+	out := new(PublishVideoScriptResponse)
+	err := c.cc.Invoke(ctx, VideoScriptService_PublishVideoScript_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoScriptServiceServer is the server API for VideoScriptService service.
 // All implementations must embed UnimplementedVideoScriptServiceServer
 // for forward compatibility
@@ -123,6 +147,10 @@ type VideoScriptServiceServer interface {
 	GetVideoScript(context.Context, *GetVideoScriptRequest) (*GetVideoScriptResponse, error)
 	// 删除视频脚本
 	DeleteVideoScript(context.Context, *DeleteVideoScriptRequest) (*DeleteVideoScriptResponse, error)
+	// 内容安全审核：按分镜标注暴力、色情、政治、未成年人风险并给出内容分级
+	ReviewVideoScript(context.Context, *ReviewVideoScriptRequest) (*ReviewVideoScriptResponse, error)
+	// 发布视频脚本，发布前进行内容安全审核，分级达到阈值时拒绝发布
+	PublishVideoScript(context.Context, *PublishVideoScriptRequest) (*PublishVideoScriptResponse, error)
 	mustEmbedUnimplementedVideoScriptServiceServer()
 }
 
@@ -148,6 +176,12 @@ func (UnimplementedVideoScriptServiceServer) GetVideoScript(context.Context, *Ge
 func (UnimplementedVideoScriptServiceServer) DeleteVideoScript(context.Context, *DeleteVideoScriptRequest) (*DeleteVideoScriptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVideoScript not implemented")
 }
+func (UnimplementedVideoScriptServiceServer) ReviewVideoScript(context.Context, *ReviewVideoScriptRequest) (*ReviewVideoScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewVideoScript not implemented")
+}
+func (UnimplementedVideoScriptServiceServer) PublishVideoScript(context.Context, *PublishVideoScriptRequest) (*PublishVideoScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishVideoScript not implemented")
+}
 func (UnimplementedVideoScriptServiceServer) mustEmbedUnimplementedVideoScriptServiceServer() {}
 
 // UnsafeVideoScriptServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +303,42 @@ func _VideoScriptService_DeleteVideoScript_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoScriptService_ReviewVideoScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewVideoScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoScriptServiceServer).ReviewVideoScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoScriptService_ReviewVideoScript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoScriptServiceServer).ReviewVideoScript(ctx, req.(*ReviewVideoScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoScriptService_PublishVideoScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishVideoScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoScriptServiceServer).PublishVideoScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoScriptService_PublishVideoScript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoScriptServiceServer).PublishVideoScript(ctx, req.(*PublishVideoScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VideoScriptService_ServiceDesc is the grpc.ServiceDesc for VideoScriptService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -300,6 +370,14 @@ var VideoScriptService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVideoScript",
 			Handler:    _VideoScriptService_DeleteVideoScript_Handler,
 		},
+		{
+			MethodName: "ReviewVideoScript",
+			Handler:    _VideoScriptService_ReviewVideoScript_Handler,
+		},
+		{
+			MethodName: "PublishVideoScript",
+			Handler:    _VideoScriptService_PublishVideoScript_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "video_script/v1/video_script.proto",
@@ -25,6 +25,8 @@ const OperationVideoScriptServiceGenerateVideoScript = "/api.video_script.v1.Vid
 const OperationVideoScriptServiceGetVideoScript = "/api.video_script.v1.VideoScriptService/GetVideoScript"
 const OperationVideoScriptServiceListVideoScripts = "/api.video_script.v1.VideoScriptService/ListVideoScripts"
 const OperationVideoScriptServiceOptimizeVideoScript = "/api.video_script.v1.VideoScriptService/OptimizeVideoScript"
+const OperationVideoScriptServicePublishVideoScript = "/api.video_script.v1.VideoScriptService/PublishVideoScript"
+const OperationVideoScriptServiceReviewVideoScript = "/api.video_script.v1.VideoScriptService/ReviewVideoScript"
 
 type VideoScriptServiceHTTPServer interface {
 	// DeleteVideoScript 删除视频脚本
@@ -39,6 +41,10 @@ type VideoScriptServiceHTTPServer interface {
 	ListVideoScripts(context.Context, *ListVideoScriptsRequest) (*ListVideoScriptsResponse, error)
 	// OptimizeVideoScript 优化短视频脚本
 	OptimizeVideoScript(context.Context, *OptimizeVideoScriptRequest) (*OptimizeVideoScriptResponse, error)
+	// PublishVideoScript 发布视频脚本，发布前进行内容安全审核，分级达到阈值时拒绝发布
+	PublishVideoScript(context.Context, *PublishVideoScriptRequest) (*PublishVideoScriptResponse, error)
+	// ReviewVideoScript 内容安全审核：按分镜标注暴力、色情、政治、未成年人风险并给出内容分级
+	ReviewVideoScript(context.Context, *ReviewVideoScriptRequest) (*ReviewVideoScriptResponse, error)
 }
 
 func RegisterVideoScriptServiceHTTPServer(s *http.Server, srv VideoScriptServiceHTTPServer) {
@@ -49,6 +55,8 @@ func RegisterVideoScriptServiceHTTPServer(s *http.Server, srv VideoScriptService
 	r.GET("/api/v1/projects/{project_id}/video-scripts", _VideoScriptService_ListVideoScripts0_HTTP_Handler(srv))
 	r.GET("/api/v1/video-scripts/{script_id}", _VideoScriptService_GetVideoScript0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/video-scripts/{script_id}", _VideoScriptService_DeleteVideoScript0_HTTP_Handler(srv))
+	r.POST("/api/v1/video-scripts/{script_id}/safety/review", _VideoScriptService_ReviewVideoScript0_HTTP_Handler(srv))
+	r.POST("/api/v1/video-scripts/{script_id}/publish", _VideoScriptService_PublishVideoScript0_HTTP_Handler(srv))
 }
 
 func _VideoScriptService_GenerateVideoScript1_HTTP_Handler(srv VideoScriptServiceHTTPServer) func(ctx http.Context) error {
@@ -189,6 +197,56 @@ func _VideoScriptService_DeleteVideoScript0_HTTP_Handler(srv VideoScriptServiceH
 	}
 }
 
+func _VideoScriptService_ReviewVideoScript0_HTTP_Handler(srv VideoScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReviewVideoScriptRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoScriptServiceReviewVideoScript)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReviewVideoScript(ctx, req.(*ReviewVideoScriptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReviewVideoScriptResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoScriptService_PublishVideoScript0_HTTP_Handler(srv VideoScriptServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PublishVideoScriptRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoScriptServicePublishVideoScript)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PublishVideoScript(ctx, req.(*PublishVideoScriptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PublishVideoScriptResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type VideoScriptServiceHTTPClient interface {
 	DeleteVideoScript(ctx context.Context, req *DeleteVideoScriptRequest, opts ...http.CallOption) (rsp *DeleteVideoScriptResponse, err error)
 	GeneratePlatformVariants(ctx context.Context, req *GeneratePlatformVariantsRequest, opts ...http.CallOption) (rsp *GeneratePlatformVariantsResponse, err error)
@@ -196,6 +254,8 @@ type VideoScriptServiceHTTPClient interface {
 	GetVideoScript(ctx context.Context, req *GetVideoScriptRequest, opts ...http.CallOption) (rsp *GetVideoScriptResponse, err error)
 	ListVideoScripts(ctx context.Context, req *ListVideoScriptsRequest, opts ...http.CallOption) (rsp *ListVideoScriptsResponse, err error)
 	OptimizeVideoScript(ctx context.Context, req *OptimizeVideoScriptRequest, opts ...http.CallOption) (rsp *OptimizeVideoScriptResponse, err error)
+	PublishVideoScript(ctx context.Context, req *PublishVideoScriptRequest, opts ...http.CallOption) (rsp *PublishVideoScriptResponse, err error)
+	ReviewVideoScript(ctx context.Context, req *ReviewVideoScriptRequest, opts ...http.CallOption) (rsp *ReviewVideoScriptResponse, err error)
 }
 
 type VideoScriptServiceHTTPClientImpl struct {
@@ -283,3 +343,29 @@ func (c *VideoScriptServiceHTTPClientImpl) OptimizeVideoScript(ctx context.Conte
 	}
 	return &out, nil
 }
+
+func (c *VideoScriptServiceHTTPClientImpl) PublishVideoScript(ctx context.Context, in *PublishVideoScriptRequest, opts ...http.CallOption) (*PublishVideoScriptResponse, error) {
+	var out PublishVideoScriptResponse
+	pattern := "/api/v1/video-scripts/{script_id}/publish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoScriptServicePublishVideoScript))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoScriptServiceHTTPClientImpl) ReviewVideoScript(ctx context.Context, in *ReviewVideoScriptRequest, opts ...http.CallOption) (*ReviewVideoScriptResponse, error) {
+	var out ReviewVideoScriptResponse
+	pattern := "/api/v1/video-scripts/{script_id}/safety/review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoScriptServiceReviewVideoScript))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
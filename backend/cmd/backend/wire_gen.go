@@ -38,37 +38,64 @@ func wireApp(confServer *conf.Server, confData *conf.Data, ai *conf.AI, logger l
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
 	videoScriptRepo := data.NewVideoScriptRepo(dataData, logger)
-	videoScriptUseCase := biz.NewVideoScriptUseCase(videoScriptRepo, logger)
+	novelRepo := data.NewNovelRepo(dataData, logger)
+	userRepo := data.NewUserRepo(dataData, logger)
+	projectAuthorizer := biz.NewProjectAuthorizer(novelRepo, userRepo)
+	videoScriptUseCase := biz.NewVideoScriptUseCaseWithAccess(videoScriptRepo, projectAuthorizer, logger)
 	einoLLMClient, err := eino.NewDefaultEinoClient(ai, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
 	einoVideoScriptAgent := video_script.NewEinoVideoScriptAgent(einoLLMClient)
-	videoScriptService := service.NewVideoScriptService(videoScriptUseCase, einoVideoScriptAgent, logger)
-	novelRepo := data.NewNovelRepo(dataData, logger)
+	safetyUsecase := biz.NewSafetyUsecase(confData, logger)
+	llmClient := llm.NewRealLLMClient(einoLLMClient)
+	videoScriptService := service.NewVideoScriptServiceWithSafety(videoScriptUseCase, einoVideoScriptAgent, safetyUsecase, llmClient, logger)
 	exportService := service.NewExportService(logger)
 	bizVideoScriptService := biz.NewVideoScriptServiceImpl(logger)
-	novelUsecase := biz.NewNovelUsecase(novelRepo, exportService, bizVideoScriptService, logger)
-	llmClient := llm.NewRealLLMClient(einoLLMClient)
-	orchestratorAgent := orchestrator.NewOrchestratorAgentProvider(llmClient, logger)
-	chapterAgent := chapter.NewChapterAgent(llmClient)
-	ragService, err := vector.NewRAGServiceProvider(confData, ai)
+	ragService, cleanup2, err := vector.NewRAGServiceProvider(confData, ai, llmClient)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
+	indexStateRepo := data.NewIndexStateRepo(dataData, logger)
+	knowledgeIndexer := data.NewKnowledgeIndexer(ragService)
+	indexUsecase := biz.NewIndexUsecase(novelRepo, indexStateRepo, knowledgeIndexer, projectAuthorizer, logger)
+	novelUsecase := biz.NewNovelUsecaseWithIndex(novelRepo, exportService, bizVideoScriptService, projectAuthorizer, indexUsecase, logger)
+	searchRepo := data.NewSearchRepo(dataData, logger)
+	searchUsecase := biz.NewSearchUsecase(searchRepo, projectAuthorizer, logger)
+	trashRepo := data.NewTrashRepo(dataData, logger)
+	trashUsecase := biz.NewTrashUsecaseWithIndex(confData, trashRepo, projectAuthorizer, indexUsecase, logger)
+	generationRepo := data.NewGenerationRepo(dataData, logger)
+	generationUsecase := biz.NewGenerationUsecase(generationRepo, projectAuthorizer, logger)
+	chapterAgent := chapter.NewChapterAgentWithRAG(llmClient, ragService)
+	orchestratorAgent := orchestrator.NewOrchestratorAgentProvider(llmClient, chapterAgent, logger)
+	knowledgeRepo := data.NewKnowledgeRepo(ragService)
+	knowledgeUsecase := biz.NewKnowledgeUsecase(knowledgeRepo, projectAuthorizer, logger)
+	storyStateRepo := data.NewStoryStateRepo(dataData, logger)
+	storyStateUsecase := biz.NewStoryStateUsecase(storyStateRepo, projectAuthorizer, logger)
+	qualityReportRepo := data.NewQualityReportRepo(dataData, logger)
+	qualityReportUsecase := biz.NewQualityReportUsecase(qualityReportRepo, projectAuthorizer, logger)
+	plotThreadRepo := data.NewPlotThreadRepo(dataData, logger)
+	plotThreadUsecase := biz.NewPlotThreadUsecase(plotThreadRepo, projectAuthorizer, logger)
+	projectBundleUsecase := biz.NewProjectBundleUsecaseWithIndex(novelRepo, videoScriptRepo, storyStateRepo, qualityReportRepo, plotThreadRepo, trashRepo, projectAuthorizer, indexUsecase, logger)
 	modelFactory, err := eino.NewModelFactory(ai)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	modelSwitcher := eino.NewModelSwitcher(modelFactory)
-	novelService := service.NewNovelServiceWithRAG(novelUsecase, orchestratorAgent, chapterAgent, einoLLMClient, ragService, llmClient, modelSwitcher, logger)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, videoScriptService, novelService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, videoScriptService, novelService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	novelService := service.NewNovelServiceWithRAG(novelUsecase, projectBundleUsecase, searchUsecase, trashUsecase, generationUsecase, indexUsecase, knowledgeUsecase, storyStateUsecase, qualityReportUsecase, plotThreadUsecase, safetyUsecase, orchestratorAgent, chapterAgent, einoLLMClient, ragService, llmClient, modelSwitcher, logger)
+	userUsecase := biz.NewUserUsecase(userRepo, projectAuthorizer, logger)
+	userService := service.NewUserService(userUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, greeterService, videoScriptService, novelService, userService, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, videoScriptService, novelService, userService, logger)
+	trashCleaner := server.NewTrashCleaner(confData, trashUsecase, logger)
+	indexWorker := server.NewIndexWorker(indexUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, trashCleaner, indexWorker)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
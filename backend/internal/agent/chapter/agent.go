@@ -7,6 +7,7 @@ import (
 
 	"backend/internal/pkg/llm"
 	"backend/internal/pkg/models"
+	"backend/internal/pkg/vector"
 	"github.com/google/wire"
 )
 
@@ -20,7 +21,8 @@ type GenerateChapterRequest struct {
 }
 
 type GenerateChapterResponse struct {
-	Chapter *models.Chapter `json:"chapter"`
+	Chapter   *models.Chapter          `json:"chapter"`
+	Grounding []*models.RetrievedChunk `json:"grounding"` // 写入提示词的前文片段
 }
 
 type RefineChapterRequest struct {
@@ -51,10 +53,16 @@ type StreamCallback interface {
 	OnError(err error) error
 }
 
+// GroundingCallback 可选的回调接口，实现后可在生成前收到检索到的前文片段
+type GroundingCallback interface {
+	OnGrounding(chunks []*models.RetrievedChunk) error
+}
+
 // ChapterAgent 章节生成 Agent
 type ChapterAgent struct {
-	llmClient llm.LLMClient
-	templates *llm.PromptTemplates
+	llmClient  llm.LLMClient
+	templates  *llm.PromptTemplates
+	ragService *vector.RAGService // 为 nil 时不检索前文
 }
 
 // NewChapterAgent 创建章节生成代理
@@ -68,6 +76,13 @@ func NewChapterAgent(llmClient llm.LLMClient) *ChapterAgent {
 	}
 }
 
+// NewChapterAgentWithRAG 创建在生成前检索相关前文的章节生成代理
+func NewChapterAgentWithRAG(llmClient llm.LLMClient, ragService *vector.RAGService) *ChapterAgent {
+	agent := NewChapterAgent(llmClient)
+	agent.ragService = ragService
+	return agent
+}
+
 // GenerateChapter 生成章节内容
 func (a *ChapterAgent) GenerateChapter(ctx context.Context, req *GenerateChapterRequest) (*GenerateChapterResponse, error) {
 	// 添加空值检查
@@ -100,7 +115,7 @@ func (a *ChapterAgent) GenerateChapter(ctx context.Context, req *GenerateChapter
 	timelineInfo := make([]string, len(req.Context.Timeline))
 	for i, event := range req.Context.Timeline {
 		if event != nil {
-			timelineInfo[i] = fmt.Sprintf("%s：%s", event.Timestamp, event.Event)
+			timelineInfo[i] = formatTimelineEvent(event)
 		}
 	}
 
@@ -108,10 +123,12 @@ func (a *ChapterAgent) GenerateChapter(ctx context.Context, req *GenerateChapter
 	propsInfo := make([]string, len(req.Context.Props))
 	for i, prop := range req.Context.Props {
 		if prop != nil {
-			propsInfo[i] = fmt.Sprintf("%s：%s", prop.Name, prop.Description)
+			propsInfo[i] = formatProp(prop)
 		}
 	}
 
+	grounding := a.retrieveGrounding(ctx, req)
+
 	prompt := fmt.Sprintf(`
 基于以下信息生成第 %d 章的小说内容：
 
@@ -121,7 +138,7 @@ func (a *ChapterAgent) GenerateChapter(ctx context.Context, req *GenerateChapter
 %s
 
 前情摘要：%s
-
+%s%s
 本章大纲：
 标题：%s
 概要：%s
@@ -149,7 +166,7 @@ func (a *ChapterAgent) GenerateChapter(ctx context.Context, req *GenerateChapter
 
 请直接生成章节内容，不要包含任何格式标记。
 `, req.ChapterOutline.Index, formatWorldView(req.Context.WorldView),
-		joinStrings(charactersInfo, "\n"), req.Context.PreviousSummary,
+		joinStrings(charactersInfo, "\n"), req.Context.PreviousSummary, formatCharacterStatesSection(req.Context.CharacterStates), formatGroundingSection(grounding),
 		req.ChapterOutline.Title, req.ChapterOutline.Summary,
 		req.ChapterOutline.Goal, req.ChapterOutline.TwistHint,
 		req.ChapterOutline.ImportantItems,
@@ -175,7 +192,8 @@ func (a *ChapterAgent) GenerateChapter(ctx context.Context, req *GenerateChapter
 	}
 
 	return &GenerateChapterResponse{
-		Chapter: chapter,
+		Chapter:   chapter,
+		Grounding: grounding.Chunks,
 	}, nil
 }
 
@@ -217,6 +235,7 @@ func (a *ChapterAgent) RefineChapter(ctx context.Context, req *RefineChapterRequ
 		Summary:         req.Chapter.Summary,
 		WordCount:       len([]rune(strings.ReplaceAll(refinedContent, " ", ""))),
 		Status:          req.Chapter.Status,
+		Version:         req.Chapter.Version,
 	}
 
 	return &RefineChapterResponse{
@@ -267,6 +286,7 @@ func (a *ChapterAgent) ExpandChapter(ctx context.Context, req *ExpandChapterRequ
 		Summary:         req.Chapter.Summary,
 		WordCount:       len([]rune(strings.ReplaceAll(expandedContent, " ", ""))),
 		Status:          req.Chapter.Status,
+		Version:         req.Chapter.Version,
 	}
 
 	return &ExpandChapterResponse{
@@ -317,19 +337,26 @@ func (a *ChapterAgent) GenerateChapterStream(ctx context.Context, req *GenerateC
 	// 构建时间线信息
 	timelineInfo := make([]string, len(req.Context.Timeline))
 	for i, event := range req.Context.Timeline {
-		timelineInfo[i] = fmt.Sprintf("%s：%s", event.Timestamp, event.Event)
+		timelineInfo[i] = formatTimelineEvent(event)
 	}
 
 	// 构建道具信息
 	propsInfo := make([]string, len(req.Context.Props))
 	for i, prop := range req.Context.Props {
-		propsInfo[i] = fmt.Sprintf("%s：%s", prop.Name, prop.Description)
+		propsInfo[i] = formatProp(prop)
 	}
 
 	if err := callback.OnProgress("构建提示词", 10); err != nil {
 		return err
 	}
 
+	grounding := a.retrieveGrounding(ctx, req)
+	if groundingCallback, ok := callback.(GroundingCallback); ok && len(grounding.Chunks) > 0 {
+		if err := groundingCallback.OnGrounding(grounding.Chunks); err != nil {
+			return err
+		}
+	}
+
 	prompt := fmt.Sprintf(`
 基于以下信息生成第 %d 章的小说内容：
 
@@ -339,7 +366,7 @@ func (a *ChapterAgent) GenerateChapterStream(ctx context.Context, req *GenerateC
 %s
 
 前情摘要：%s
-
+%s%s
 本章大纲：
 标题：%s
 概要：%s
@@ -367,7 +394,7 @@ func (a *ChapterAgent) GenerateChapterStream(ctx context.Context, req *GenerateC
 
 请直接生成章节内容，不要包含任何格式标记。
 `, req.ChapterOutline.Index, formatWorldView(req.Context.WorldView),
-		joinStrings(charactersInfo, "\n"), req.Context.PreviousSummary,
+		joinStrings(charactersInfo, "\n"), req.Context.PreviousSummary, formatCharacterStatesSection(req.Context.CharacterStates), formatGroundingSection(grounding),
 		req.ChapterOutline.Title, req.ChapterOutline.Summary,
 		req.ChapterOutline.Goal, req.ChapterOutline.TwistHint,
 		req.ChapterOutline.ImportantItems,
@@ -444,7 +471,78 @@ func (a *ChapterAgent) GetCapabilities() map[string]interface{} {
 	}
 }
 
+// retrieveGrounding 检索本章需要参考的前文片段，检索失败时不影响生成
+func (a *ChapterAgent) retrieveGrounding(ctx context.Context, req *GenerateChapterRequest) *vector.Grounding {
+	if a.ragService == nil {
+		return &vector.Grounding{}
+	}
+
+	grounding, err := a.ragService.RetrieveChapterGrounding(ctx, &vector.GroundingRequest{
+		ProjectID:  req.ProjectID,
+		Outline:    req.ChapterOutline,
+		Characters: req.Context.Characters,
+	})
+	if err != nil {
+		return &vector.Grounding{}
+	}
+	return grounding
+}
+
 // 辅助函数
+func formatGroundingSection(grounding *vector.Grounding) string {
+	if grounding.Prompt == "" {
+		return ""
+	}
+	return "\n相关前文（写作时保持与以下内容一致）：\n" + grounding.Prompt
+}
+
+// formatCharacterStatesSection 拼接上一章结束时人物所在与状态
+func formatCharacterStatesSection(states []*models.CharacterState) string {
+	var lines []string
+	for _, state := range states {
+		if state == nil || state.Name == "" {
+			continue
+		}
+		var details []string
+		if state.Location != "" {
+			details = append(details, "位于"+state.Location)
+		}
+		if state.Condition != "" {
+			details = append(details, state.Condition)
+		}
+		if state.Status != "" {
+			details = append(details, state.Status)
+		}
+		if len(details) > 0 {
+			lines = append(lines, fmt.Sprintf("- %s：%s", state.Name, joinStrings(details, "，")))
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n人物当前状态（上一章结束时）：\n" + joinStrings(lines, "\n") + "\n"
+}
+
+// formatTimelineEvent 格式化时间线事件，已知章节时注明出处
+func formatTimelineEvent(event *models.TimelineEvent) string {
+	if event.ChapterIndex > 0 {
+		return fmt.Sprintf("第%d章 %s：%s", event.ChapterIndex, event.Timestamp, event.Event)
+	}
+	return fmt.Sprintf("%s：%s", event.Timestamp, event.Event)
+}
+
+// formatProp 格式化道具，附上持有人与当前位置
+func formatProp(prop *models.PropItem) string {
+	line := fmt.Sprintf("%s：%s", prop.Name, prop.Description)
+	if prop.Holder != "" {
+		line += "（持有人：" + prop.Holder + "）"
+	}
+	if prop.Location != "" {
+		line += "（位置：" + prop.Location + "）"
+	}
+	return line
+}
+
 func formatWorldView(worldView *models.WorldView) string {
 	if worldView == nil {
 		return "暂无世界观设定"
@@ -466,4 +564,4 @@ func joinStrings(strs []string, sep string) string {
 }
 
 // ProviderSet is chapter agent providers.
-var ProviderSet = wire.NewSet(NewChapterAgent)
\ No newline at end of file
+var ProviderSet = wire.NewSet(NewChapterAgentWithRAG)
\ No newline at end of file
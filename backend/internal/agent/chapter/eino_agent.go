@@ -312,6 +312,7 @@ func (a *EinoChapterAgent) RefineChapter(ctx context.Context, req *RefineChapter
 		Summary:         req.Chapter.Summary,
 		WordCount:       len([]rune(strings.ReplaceAll(refinedContent, " ", ""))),
 		Status:          req.Chapter.Status,
+		Version:         req.Chapter.Version,
 	}
 
 	return &RefineChapterResponse{
@@ -362,6 +363,7 @@ func (a *EinoChapterAgent) ExpandChapter(ctx context.Context, req *ExpandChapter
 		Summary:         req.Chapter.Summary,
 		WordCount:       len([]rune(strings.ReplaceAll(expandedContent, " ", ""))),
 		Status:          req.Chapter.Status,
+		Version:         req.Chapter.Version,
 	}
 
 	return &ExpandChapterResponse{
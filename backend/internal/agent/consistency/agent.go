@@ -13,10 +13,12 @@ import (
 
 // 请求和响应结构
 type CheckConsistencyRequest struct {
-	Project   *models.NovelProject `json:"project"`
-	Chapters  []*models.Chapter    `json:"chapters"`
-	CheckType string               `json:"check_type"` // character/plot/world/timeline/all
-	Options   *llm.GenerateOptions `json:"options"`
+	Project   *models.NovelProject    `json:"project"`
+	Chapters  []*models.Chapter       `json:"chapters"`
+	CheckType string                  `json:"check_type"` // character/plot/world/timeline/all/rules
+	Options   *llm.GenerateOptions    `json:"options"`
+	Timeline  []*models.TimelineEvent `json:"timeline"` // 已记录的时间线，用于规则检查年龄
+	Props     []*models.PropItem      `json:"props"`    // 已记录的道具，用于规则检查道具损毁
 }
 
 type CheckConsistencyResponse struct {
@@ -26,22 +28,27 @@ type CheckConsistencyResponse struct {
 }
 
 type ConsistencyIssue struct {
-	Type        string `json:"type"`        // character/plot/world/timeline
-	Severity    string `json:"severity"`    // high/medium/low
-	Description string `json:"description"`
-	Location    string `json:"location"`    // 章节位置
-	Suggestion  string `json:"suggestion"`
+	Type         string           `json:"type"`     // character/plot/world/timeline
+	Severity     string           `json:"severity"` // high/medium/low
+	Description  string           `json:"description"`
+	Location     string           `json:"location"` // 章节位置
+	Suggestion   string           `json:"suggestion"`
+	ChapterIndex int              `json:"chapter_index"`  // 问题所在章节，0表示未知
+	Quote        string           `json:"quote"`          // 能说明问题的原文
+	Span         *models.TextSpan `json:"span,omitempty"` // 引文在正文中的位置，由 LocateIssues 校验后填写
 }
 
 type ValidateCharacterRequest struct {
-	Character *models.Character `json:"character"`
-	Chapters  []*models.Chapter `json:"chapters"`
-	Options   *llm.GenerateOptions `json:"options"`
+	Character  *models.Character    `json:"character"`
+	Characters []*models.Character  `json:"characters"` // 全部人物，用于判断台词的说话人；为空时只识别 Character
+	Chapters   []*models.Chapter    `json:"chapters"`
+	Options    *llm.GenerateOptions `json:"options"`
 }
 
 type ValidateCharacterResponse struct {
-	Issues      []ConsistencyIssue `json:"issues"`
-	IsConsistent bool              `json:"is_consistent"`
+	Issues       []ConsistencyIssue `json:"issues"`
+	IsConsistent bool               `json:"is_consistent"`
+	Profile      *VoiceProfile      `json:"profile"` // 由已定稿章节建立的台词画像
 }
 
 type CheckTimelineRequest struct {
@@ -60,6 +67,7 @@ type ConsistencyAgent struct {
 	llmClient llm.LLMClient
 	templates *llm.PromptTemplates
 	ragAgent  *RAGConsistencyAgent // 新增RAG一致性检查代理
+	rules     *RuleChecker         // 不依赖模型的规则检查
 }
 
 // NewConsistencyAgent 创建一致性检查代理
@@ -67,6 +75,7 @@ func NewConsistencyAgent(llmClient llm.LLMClient) *ConsistencyAgent {
 	return &ConsistencyAgent{
 		llmClient: llmClient,
 		templates: &llm.PromptTemplates{},
+		rules:     NewRuleChecker(),
 	}
 }
 
@@ -77,18 +86,48 @@ func NewConsistencyAgentWithRAG(llmClient llm.LLMClient, einoClient eino.EinoLLM
 		llmClient: llmClient,
 		templates: &llm.PromptTemplates{},
 		ragAgent:  ragAgent,
+		rules:     NewRuleChecker(),
 	}
 }
 
 // CheckConsistency 检查整体一致性
+// 规则检查的问题排在模型检查的问题之前；检查类型为 rules 时只运行规则检查
 func (a *ConsistencyAgent) CheckConsistency(ctx context.Context, req *CheckConsistencyRequest) (*CheckConsistencyResponse, error) {
-	// 如果有RAG代理，优先使用RAG进行检查
+	ruleIssues := filterIssues(a.rules.Check(req), req.CheckType)
+	if req.CheckType == CheckTypeRules {
+		return &CheckConsistencyResponse{
+			Issues:       ruleIssues,
+			Suggestions:  []string{},
+			OverallScore: RuleScore(ruleIssues),
+		}, nil
+	}
+
+	var resp *CheckConsistencyResponse
+	var err error
 	if a.ragAgent != nil {
-		return a.ragAgent.CheckConsistencyWithRAG(ctx, req)
+		// 如果有RAG代理，优先使用RAG进行检查
+		resp, err = a.ragAgent.CheckConsistencyWithRAG(ctx, req)
+	} else {
+		// 否则使用传统的LLM检查方式
+		resp, err = a.checkConsistencyWithLLM(ctx, req)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	// 否则使用传统的LLM检查方式
-	return a.checkConsistencyWithLLM(ctx, req)
+	// 模型给出的引文需在正文中校验后才有可用的位置
+	chapters := req.Chapters
+	if chapters == nil && req.Project != nil {
+		chapters = req.Project.Chapters
+	}
+	LocateIssues(resp.Issues, chapters)
+	resp.Issues = append(ruleIssues, resp.Issues...)
+	return resp, nil
+}
+
+// CheckRules 只运行规则检查
+func (a *ConsistencyAgent) CheckRules(req *CheckConsistencyRequest) []ConsistencyIssue {
+	return a.rules.Check(req)
 }
 
 // checkConsistencyWithLLM 使用传统LLM方式检查一致性
@@ -152,7 +191,9 @@ func (a *ConsistencyAgent) checkConsistencyWithLLM(ctx context.Context, req *Che
       "severity": "严重程度",
       "description": "问题描述",
       "location": "位置信息",
-      "suggestion": "修改建议"
+      "suggestion": "修改建议",
+      "chapter_index": 1,
+      "quote": "能说明问题的原文，逐字摘录"
     }
   ],
   "suggestions": ["整体建议1", "整体建议2"],
@@ -179,26 +220,48 @@ func (a *ConsistencyAgent) checkConsistencyWithLLM(ctx context.Context, req *Che
 
 // ValidateCharacterConsistency 验证人物一致性
 func (a *ConsistencyAgent) ValidateCharacterConsistency(ctx context.Context, req *ValidateCharacterRequest) (*ValidateCharacterResponse, error) {
-	// 构建人物信息
+	characters := req.Characters
+	if len(characters) == 0 {
+		characters = []*models.Character{req.Character}
+	}
+	names := characterNames(characters)
+	profile := BuildVoiceProfiles(characters, req.Chapters)[req.Character.Name]
+	if profile == nil {
+		profile = newVoiceProfile(req.Character.Name, nil)
+		profile.SpeechTone = req.Character.SpeechTone
+	}
+
+	// 构建人物信息，说话方式取自已定稿章节的台词画像，台词不足时参考人物卡
 	charInfo := fmt.Sprintf(`
 人物：%s
 角色：%s
 背景：%s
-说话风格：%s
+说话方式：%s
 `, req.Character.Name, req.Character.Role, req.Character.Background,
-		req.Character.SpeechTone)
+		profile.Describe())
 
-	// 构建章节中的人物表现
+	// 构建章节中的人物表现，并列出未定稿章节中该人物的台词
 	appearances := make([]string, 0)
-	for _, chapter := range req.Chapters {
-		content := chapter.PolishedContent
-		if content == "" {
-			content = chapter.RawContent
+	lines := make([]string, 0)
+	texts := newChapterTexts(req.Chapters)
+	for _, text := range texts {
+		if strings.Contains(text.content, req.Character.Name) {
+			appearances = append(appearances, fmt.Sprintf("第%d章：%s", text.chapter.Index, text.chapter.Title))
 		}
-		if strings.Contains(content, req.Character.Name) {
-			appearances = append(appearances, fmt.Sprintf("第%d章：%s", chapter.Index, chapter.Title))
+		if IsApprovedChapter(text.chapter) {
+			continue
 		}
+		for _, line := range extractDialogue(text, names) {
+			if line.Speaker == req.Character.Name && len(lines) < maxPromptLines {
+				lines = append(lines, fmt.Sprintf("第%d章：“%s”", line.ChapterIndex, line.Text))
+			}
+		}
+	}
+	if len(lines) > 0 {
+		appearances = append(appearances, "\n待检查的台词：")
+		appearances = append(appearances, lines...)
 	}
+	flagged := voiceIssues(map[string]*VoiceProfile{req.Character.Name: profile}, names, texts)
 
 	prompt := fmt.Sprintf(`
 请检查人物在各章节中的表现是否一致：
@@ -222,7 +285,9 @@ func (a *ConsistencyAgent) ValidateCharacterConsistency(ctx context.Context, req
       "severity": "严重程度",
       "description": "问题描述",
       "location": "章节位置",
-      "suggestion": "修改建议"
+      "suggestion": "修改建议",
+      "chapter_index": 1,
+      "quote": "能说明问题的原文，逐字摘录"
     }
   ],
   "is_consistent": true
@@ -235,11 +300,13 @@ func (a *ConsistencyAgent) ValidateCharacterConsistency(ctx context.Context, req
 	}
 
 	issues := parseConsistencyIssues(jsonResult["issues"])
-	isConsistent := getBoolFromJSON(jsonResult, "is_consistent")
+	LocateIssues(issues, req.Chapters)
+	isConsistent := getBoolFromJSON(jsonResult, "is_consistent") && len(flagged) == 0
 
 	return &ValidateCharacterResponse{
-		Issues:       issues,
+		Issues:       append(flagged, issues...),
 		IsConsistent: isConsistent,
+		Profile:      profile,
 	}, nil
 }
 
@@ -281,7 +348,9 @@ func (a *ConsistencyAgent) CheckTimelineConsistency(ctx context.Context, req *Ch
       "severity": "严重程度", 
       "description": "问题描述",
       "location": "位置信息",
-      "suggestion": "修改建议"
+      "suggestion": "修改建议",
+      "chapter_index": 1,
+      "quote": "能说明问题的原文，逐字摘录"
     }
   ],
   "conflict_count": 0
@@ -294,6 +363,7 @@ func (a *ConsistencyAgent) CheckTimelineConsistency(ctx context.Context, req *Ch
 	}
 
 	issues := parseConsistencyIssues(jsonResult["issues"])
+	LocateIssues(issues, req.Chapters)
 	conflictCount := getIntFromJSON(jsonResult, "conflict_count")
 
 	return &CheckTimelineResponse{
@@ -342,7 +412,9 @@ func (a *ConsistencyAgent) AnalyzeWorldConsistency(ctx context.Context, worldVie
 		return nil, fmt.Errorf("failed to analyze world consistency: %w", err)
 	}
 
-	return parseConsistencyIssues(jsonResult["issues"]), nil
+	issues := parseConsistencyIssues(jsonResult["issues"])
+	LocateIssues(issues, chapters)
+	return issues, nil
 }
 
 // GetCapabilities 返回代理能力描述
@@ -356,6 +428,7 @@ func (a *ConsistencyAgent) GetCapabilities() map[string]interface{} {
 			"validate_character_consistency",
 			"check_timeline_consistency",
 			"analyze_world_consistency",
+			"rule_based_consistency_check",
 		},
 	}
 }
@@ -412,11 +485,13 @@ func parseConsistencyIssues(data interface{}) []ConsistencyIssue {
 		for i, issue := range issues {
 			if issueMap, ok := issue.(map[string]interface{}); ok {
 				result[i] = ConsistencyIssue{
-					Type:        getStringFromMap(issueMap, "type"),
-					Severity:    getStringFromMap(issueMap, "severity"),
-					Description: getStringFromMap(issueMap, "description"),
-					Location:    getStringFromMap(issueMap, "location"),
-					Suggestion:  getStringFromMap(issueMap, "suggestion"),
+					Type:         getStringFromMap(issueMap, "type"),
+					Severity:     getStringFromMap(issueMap, "severity"),
+					Description:  getStringFromMap(issueMap, "description"),
+					Location:     getStringFromMap(issueMap, "location"),
+					Suggestion:   getStringFromMap(issueMap, "suggestion"),
+					ChapterIndex: getIntFromJSON(issueMap, "chapter_index"),
+					Quote:        getStringFromMap(issueMap, "quote"),
 				}
 			}
 		}
@@ -0,0 +1,62 @@
+package consistency
+
+import (
+	"strings"
+
+	"backend/internal/pkg/models"
+)
+
+// LocateIssues 校验问题引用的原文，填写问题在章节正文中的位置
+// 先在问题标注的章节中查找引文，找不到时再查其他章节；仍找不到时保留未校验（Verified=false）的位置，
+// 没有引文的问题只标注所在章节。
+func LocateIssues(issues []ConsistencyIssue, chapters []*models.Chapter) {
+	for i := range issues {
+		if issues[i].Span != nil && issues[i].Span.Verified {
+			continue
+		}
+		issues[i].Span = locateIssue(&issues[i], chapters)
+	}
+}
+
+// locateIssue 定位单个问题
+func locateIssue(issue *ConsistencyIssue, chapters []*models.Chapter) *models.TextSpan {
+	var labelled *models.Chapter
+	if issue.ChapterIndex > 0 {
+		for _, chapter := range chapters {
+			if chapter != nil && chapter.Index == issue.ChapterIndex {
+				labelled = chapter
+				break
+			}
+		}
+	}
+
+	quote := strings.TrimSpace(issue.Quote)
+	if quote == "" {
+		if labelled == nil {
+			return nil
+		}
+		return &models.TextSpan{ChapterID: labelled.ID}
+	}
+
+	if labelled != nil {
+		if span := models.LocateSpan(labelled, quote, -1); span.Verified {
+			return span
+		}
+	}
+	for _, chapter := range chapters {
+		if chapter == nil || chapter == labelled {
+			continue
+		}
+		if span := models.LocateSpan(chapter, quote, -1); span.Verified {
+			// 模型标错了章节，以引文实际所在的章节为准
+			issue.ChapterIndex = chapter.Index
+			return span
+		}
+	}
+
+	span := &models.TextSpan{Quote: quote}
+	if labelled != nil {
+		span.ChapterID = labelled.ID
+	}
+	return span
+}
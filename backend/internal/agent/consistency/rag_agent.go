@@ -84,7 +84,9 @@ func (a *RAGConsistencyAgent) checkCharacterConsistencyWithRAG(ctx context.Conte
       "severity": "high/medium/low",
       "description": "问题描述",
       "location": "章节位置",
-      "suggestion": "修改建议"
+      "suggestion": "修改建议",
+      "chapter_index": 1,
+      "quote": "能说明问题的原文，逐字摘录"
     }
   ],
   "suggestions": ["建议1", "建议2"],
@@ -170,7 +172,9 @@ func (a *RAGConsistencyAgent) checkWorldConsistencyWithRAG(ctx context.Context,
       "severity": "high/medium/low",
       "description": "问题描述",
       "location": "章节位置",
-      "suggestion": "修改建议"
+      "suggestion": "修改建议",
+      "chapter_index": 1,
+      "quote": "能说明问题的原文，逐字摘录"
     }
   ],
   "suggestions": ["建议1", "建议2"],
@@ -225,7 +229,9 @@ func (a *RAGConsistencyAgent) checkTimelineConsistencyWithRAG(ctx context.Contex
       "severity": "high/medium/low",
       "description": "问题描述",
       "location": "章节位置",
-      "suggestion": "修改建议"
+      "suggestion": "修改建议",
+      "chapter_index": 1,
+      "quote": "能说明问题的原文，逐字摘录"
     }
   ],
   "suggestions": ["建议1", "建议2"],
@@ -0,0 +1,601 @@
+package consistency
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"backend/internal/pkg/models"
+)
+
+// CheckTypeRules 只运行规则检查、不调用模型的检查类型
+const CheckTypeRules = "rules"
+
+// 规则检查问题的扣分，用于仅规则检查时的评分
+var ruleSeverityPenalty = map[string]float64{
+	"high":   0.15,
+	"medium": 0.08,
+	"low":    0.03,
+}
+
+var (
+	// speakerPattern 对白与动作的主语："林晚说"、"沈舟笑道"，人名取2~3个汉字
+	speakerPattern = regexp.MustCompile(`(?:^|[，。！？；：、“”"‘’\s])(\p{Han}{2,3}?)(?:说|道|问|答|喊|叫|笑|叹|想|点头|摇头|转身|皱眉|看着)`)
+	// agePattern 年龄描述："十七岁"、"23岁"
+	agePattern = regexp.MustCompile(`([0-9]{1,3}|[零一二两三四五六七八九十百]{1,4})岁`)
+	// timeSkipPattern 时间跳跃："三年后"、"五年过去了"
+	timeSkipPattern = regexp.MustCompile(`([0-9]{1,3}|[一二两三四五六七八九十]{1,3})年(?:后|之后|以后|过去|过后)`)
+	// destroyPattern 道具损毁
+	destroyPattern = regexp.MustCompile(`碎了|碎成|摔碎|粉碎|崩碎|裂成|毁了|被毁|毁掉|烧毁|烧成灰|化为灰烬|化作飞灰|折断|断成两截|熔化|熔成`)
+	// repairPattern 道具被修复，之后可以继续使用
+	repairPattern = regexp.MustCompile(`修复|修好|复原|重铸`)
+	// remnantPattern 回忆或提及残骸，此时的提及不算损毁后使用
+	remnantPattern = regexp.MustCompile(`碎片|残片|残骸|想起|回忆|记得|曾经`)
+)
+
+// maxAgeGap 人名与年龄之间允许的最大字数
+const maxAgeGap = 6
+
+// nameStopChars 代词与虚字，包含这些字的候选不视为人名
+const nameStopChars = "他她它我你您们这那的了着是在有个就都也又便还却被把将和与"
+
+// nameStopWords 常出现在对白与动作之前的副词、动词和泛称，以这些词开头的候选不视为人名
+var nameStopWords = []string{
+	// 说话的方式
+	"低声", "轻声", "大声", "小声", "高声", "沉声", "冷声", "厉声", "柔声", "颤声", "朗声", "喃喃", "自语",
+	"冷笑", "苦笑", "微笑", "轻笑", "大笑", "嗤笑", "笑着", "轻叹", "长叹", "叹息",
+	"淡淡", "冷冷", "缓缓", "轻轻", "默默", "微微", "连连", "慢慢", "悄悄", "暗暗", "暗自", "心中", "心里",
+	// 动作与连接
+	"忽然", "突然", "终于", "于是", "随即", "随后", "然后", "接着", "只是", "不禁", "不由", "忍不住",
+	"连忙", "急忙", "赶紧", "立刻", "马上", "低头", "抬头", "回头", "开口", "沉默", "沉吟", "继续", "一边",
+	// 泛称
+	"老人", "老者", "老头", "老妇", "妇人", "少年", "少女", "男人", "女人", "孩子", "汉子", "众人", "大家",
+	"对方", "来人", "旁人", "路人", "客人", "掌柜", "小二", "店家", "老板", "管家", "侍卫", "护卫", "守卫",
+	"士兵", "丫鬟", "公子", "小姐", "夫人", "老爷", "少爷", "大人", "先生", "师父", "师傅", "父亲", "母亲",
+}
+
+// chapterText 章节正文及其段落位置
+type chapterText struct {
+	chapter    *models.Chapter
+	content    string
+	paragraphs []int // 各段起始的字节偏移
+}
+
+// sentence 章节中的一句话
+type sentence struct {
+	text  string
+	start int // 在章节正文中的字节偏移
+}
+
+// RuleChecker 基于规则的一致性检查，不调用模型，同样的输入总是得到同样的结果
+// 检查人名（未登记与疑似错写）、年龄与时间线、道具损毁后再次使用、章节与大纲的偏离、台词与人物说话方式的偏离
+type RuleChecker struct{}
+
+// NewRuleChecker 创建规则检查器
+func NewRuleChecker() *RuleChecker {
+	return &RuleChecker{}
+}
+
+// Check 运行全部规则，问题按规则分组，组内按章节顺序排列
+func (c *RuleChecker) Check(req *CheckConsistencyRequest) []ConsistencyIssue {
+	if req == nil || req.Project == nil {
+		return nil
+	}
+	chapters := req.Chapters
+	if chapters == nil {
+		chapters = req.Project.Chapters
+	}
+	texts := newChapterTexts(chapters)
+
+	var issues []ConsistencyIssue
+	issues = append(issues, c.checkNames(req.Project.Characters, texts)...)
+	issues = append(issues, c.checkAges(req.Project.Characters, req.Timeline, texts)...)
+	issues = append(issues, c.checkDestroyedItems(destructibleItems(req), texts)...)
+	issues = append(issues, c.checkOutline(req.Project.Outline, chapters)...)
+	issues = append(issues, c.checkVoices(req.Project.Characters, chapters, texts)...)
+	return issues
+}
+
+// checkNames 检查对白与动作主语中未登记的人名，以及与已知人名只差一个字的疑似错写
+func (c *RuleChecker) checkNames(characters []*models.Character, texts []*chapterText) []ConsistencyIssue {
+	known := make([]string, 0, len(characters))
+	knownSet := make(map[string]bool, len(characters))
+	for _, character := range characters {
+		if character == nil || character.Name == "" {
+			continue
+		}
+		known = append(known, character.Name)
+		knownSet[character.Name] = true
+	}
+	if len(known) == 0 {
+		return nil
+	}
+
+	type firstUse struct {
+		text   *chapterText
+		offset int
+		count  int
+	}
+	var order []string
+	unknown := make(map[string]*firstUse)
+	for _, text := range texts {
+		for _, match := range speakerPattern.FindAllStringSubmatchIndex(text.content, -1) {
+			name := text.content[match[2]:match[3]]
+			if knownSet[name] || !looksLikeName(name) || containsAny(name, known) {
+				continue
+			}
+			if use, ok := unknown[name]; ok {
+				use.count++
+				continue
+			}
+			unknown[name] = &firstUse{text: text, offset: match[2], count: 1}
+			order = append(order, name)
+		}
+	}
+
+	var issues []ConsistencyIssue
+	for _, name := range order {
+		use := unknown[name]
+		if similar := nearMiss(name, known); similar != "" {
+			issues = append(issues, use.text.at(ConsistencyIssue{
+				Type:        "character",
+				Severity:    "medium",
+				Description: fmt.Sprintf("人名「%s」与人物「%s」只差一个字，疑似错写（共出现%d次）", name, similar, use.count),
+				Suggestion:  fmt.Sprintf("确认是否应为「%s」", similar),
+			}, use.offset, name))
+			continue
+		}
+		issues = append(issues, use.text.at(ConsistencyIssue{
+			Type:        "character",
+			Severity:    "low",
+			Description: fmt.Sprintf("人物「%s」不在人物列表中（共出现%d次）", name, use.count),
+			Suggestion:  fmt.Sprintf("为「%s」补充人物卡，或改用已有人物", name),
+		}, use.offset, name))
+	}
+	return issues
+}
+
+// checkAges 检查人物年龄是否与人物卡和时间推移相符
+// 人物卡的年龄视为故事开始时的年龄，每经过一次"N年后"的时间跳跃预期年龄增加N岁，允许一岁的误差
+func (c *RuleChecker) checkAges(characters []*models.Character, timeline []*models.TimelineEvent, texts []*chapterText) []ConsistencyIssue {
+	timelineSkips := make(map[int]int)
+	for _, event := range timeline {
+		if event == nil || event.ChapterIndex <= 0 {
+			continue
+		}
+		if years := timeSkipYears(event.Timestamp + "，" + event.Event); years > timelineSkips[event.ChapterIndex] {
+			timelineSkips[event.ChapterIndex] = years
+		}
+	}
+
+	var issues []ConsistencyIssue
+	for _, character := range characters {
+		if character == nil || character.Name == "" {
+			continue
+		}
+		base := character.Age
+		baseSource := "人物卡"
+		elapsed := 0
+		for _, text := range texts {
+			chapterSkip := 0
+			for _, s := range text.sentences() {
+				chapterSkip += timeSkipYears(s.text)
+				age, offset, quote, ok := ageOf(s.text, character.Name, characters)
+				if !ok {
+					continue
+				}
+				years := elapsed + chapterSkip
+				if base <= 0 {
+					base = age - years
+					baseSource = fmt.Sprintf("第%d章", text.chapter.Index)
+					continue
+				}
+				expected := base + years
+				if age >= expected && age <= expected+1 {
+					continue
+				}
+				issues = append(issues, text.at(ConsistencyIssue{
+					Type:     "timeline",
+					Severity: "high",
+					Description: fmt.Sprintf("「%s」此处为%d岁，按%s的%d岁和此前经过的%d年应为%d岁左右",
+						character.Name, age, baseSource, base, years, expected),
+					Suggestion: fmt.Sprintf("将年龄改为%d岁，或补充交代时间的推移", expected),
+				}, s.start+offset, quote))
+			}
+			// 时间线与正文可能记录同一次跳跃，取较大者
+			if timelineSkips[text.chapter.Index] > chapterSkip {
+				chapterSkip = timelineSkips[text.chapter.Index]
+			}
+			elapsed += chapterSkip
+		}
+	}
+	return issues
+}
+
+// checkDestroyedItems 检查道具在损毁之后是否又被使用
+func (c *RuleChecker) checkDestroyedItems(items []string, texts []*chapterText) []ConsistencyIssue {
+	var issues []ConsistencyIssue
+	for _, item := range items {
+		destroyedAt := ""
+		for _, text := range texts {
+			reported := false
+			for _, s := range text.sentences() {
+				index := strings.Index(s.text, item)
+				if index < 0 {
+					continue
+				}
+				after := s.text[index+len(item):]
+				switch {
+				case destroyPattern.MatchString(after):
+					destroyedAt = text.location(s.start + index)
+				case repairPattern.MatchString(s.text):
+					destroyedAt = ""
+				case remnantPattern.MatchString(s.text):
+				case destroyedAt != "" && !reported:
+					issues = append(issues, text.at(ConsistencyIssue{
+						Type:        "plot",
+						Severity:    "high",
+						Description: fmt.Sprintf("道具「%s」已在%s损毁，此处又被使用", item, destroyedAt),
+						Suggestion:  fmt.Sprintf("删去此处对「%s」的使用，或先交代它如何被修复", item),
+					}, s.start+index, item))
+					reported = true
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// checkOutline 检查章节标题、序号与大纲是否一致
+func (c *RuleChecker) checkOutline(outline *models.Outline, chapters []*models.Chapter) []ConsistencyIssue {
+	if outline == nil || len(outline.Chapters) == 0 {
+		return nil
+	}
+	byIndex := make(map[int]*models.ChapterOutline, len(outline.Chapters))
+	byTitle := make(map[string]*models.ChapterOutline, len(outline.Chapters))
+	for _, entry := range outline.Chapters {
+		if entry == nil {
+			continue
+		}
+		byIndex[entry.Index] = entry
+		byTitle[normalizeTitle(entry.Title)] = entry
+	}
+
+	sorted := make([]*models.Chapter, 0, len(chapters))
+	for _, chapter := range chapters {
+		if chapter != nil {
+			sorted = append(sorted, chapter)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+
+	var issues []ConsistencyIssue
+	written := make(map[int]bool, len(sorted))
+	maxIndex := 0
+	for _, chapter := range sorted {
+		location := chapterLocation(chapter)
+		if written[chapter.Index] {
+			issues = append(issues, ConsistencyIssue{
+				Type:         "plot",
+				Severity:     "high",
+				Description:  fmt.Sprintf("第%d章重复出现", chapter.Index),
+				Location:     location,
+				ChapterIndex: chapter.Index,
+				Suggestion:   "删除重复的章节或调整章节序号",
+			})
+			continue
+		}
+		written[chapter.Index] = true
+		if chapter.Index > maxIndex {
+			maxIndex = chapter.Index
+		}
+
+		entry, ok := byIndex[chapter.Index]
+		title := normalizeTitle(chapter.Title)
+		if moved, found := byTitle[title]; found && title != "" && moved.Index != chapter.Index {
+			issues = append(issues, ConsistencyIssue{
+				Type:         "plot",
+				Severity:     "high",
+				Description:  fmt.Sprintf("《%s》在大纲中是第%d章，实际位于第%d章", chapter.Title, moved.Index, chapter.Index),
+				Location:     location,
+				ChapterIndex: chapter.Index,
+				Suggestion:   fmt.Sprintf("将章节移回第%d章，或同步修改大纲", moved.Index),
+			})
+			continue
+		}
+		if !ok {
+			issues = append(issues, ConsistencyIssue{
+				Type:         "plot",
+				Severity:     "low",
+				Description:  fmt.Sprintf("第%d章不在大纲中", chapter.Index),
+				Location:     location,
+				ChapterIndex: chapter.Index,
+				Suggestion:   "为该章补充大纲，或确认章节序号",
+			})
+			continue
+		}
+		if title != normalizeTitle(entry.Title) {
+			issues = append(issues, ConsistencyIssue{
+				Type:         "plot",
+				Severity:     "medium",
+				Description:  fmt.Sprintf("第%d章标题《%s》与大纲《%s》不一致", chapter.Index, chapter.Title, entry.Title),
+				Location:     location,
+				ChapterIndex: chapter.Index,
+				Suggestion:   fmt.Sprintf("将标题改为《%s》，或同步修改大纲", entry.Title),
+			})
+		}
+	}
+
+	// 已写到的位置之前缺失的大纲章节
+	indices := make([]int, 0, len(byIndex))
+	for index := range byIndex {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	for _, index := range indices {
+		if index >= maxIndex || written[index] {
+			continue
+		}
+		issues = append(issues, ConsistencyIssue{
+			Type:         "plot",
+			Severity:     "medium",
+			Description:  fmt.Sprintf("大纲中的第%d章《%s》没有对应章节", index, byIndex[index].Title),
+			Location:     fmt.Sprintf("第%d章", index),
+			ChapterIndex: index,
+			Suggestion:   "补写该章或从大纲中移除",
+		})
+	}
+	return issues
+}
+
+// RuleScore 按规则问题的严重程度计算一致性评分
+func RuleScore(issues []ConsistencyIssue) float64 {
+	score := 1.0
+	for _, issue := range issues {
+		score -= ruleSeverityPenalty[issue.Severity]
+	}
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+// filterIssues 按检查类型筛选问题，all 或空类型返回全部
+func filterIssues(issues []ConsistencyIssue, checkType string) []ConsistencyIssue {
+	switch checkType {
+	case "character", "plot", "world", "timeline":
+	default:
+		return issues
+	}
+	var filtered []ConsistencyIssue
+	for _, issue := range issues {
+		if issue.Type == checkType {
+			filtered = append(filtered, issue)
+		}
+	}
+	return filtered
+}
+
+// destructibleItems 需要检查损毁的道具：大纲中的关键道具与故事状态中的道具
+func destructibleItems(req *CheckConsistencyRequest) []string {
+	seen := make(map[string]bool)
+	var items []string
+	add := func(name string) {
+		name = strings.TrimSpace(name)
+		if utf8.RuneCountInString(name) >= 2 && !seen[name] {
+			seen[name] = true
+			items = append(items, name)
+		}
+	}
+	if req.Project.Outline != nil {
+		for _, entry := range req.Project.Outline.Chapters {
+			if entry == nil {
+				continue
+			}
+			for _, item := range entry.ImportantItems {
+				add(item)
+			}
+		}
+	}
+	for _, prop := range req.Props {
+		if prop != nil {
+			add(prop.Name)
+		}
+	}
+	return items
+}
+
+func newChapterTexts(chapters []*models.Chapter) []*chapterText {
+	texts := make([]*chapterText, 0, len(chapters))
+	for _, chapter := range chapters {
+		if chapter == nil {
+			continue
+		}
+		content := chapter.PolishedContent
+		if content == "" {
+			content = chapter.RawContent
+		}
+		text := &chapterText{chapter: chapter, content: content}
+		start := 0
+		for i, line := range strings.SplitAfter(content, "\n") {
+			if strings.TrimSpace(line) != "" || i == 0 {
+				text.paragraphs = append(text.paragraphs, start)
+			}
+			start += len(line)
+		}
+		texts = append(texts, text)
+	}
+	sort.SliceStable(texts, func(i, j int) bool { return texts[i].chapter.Index < texts[j].chapter.Index })
+	return texts
+}
+
+// location 将字节偏移转换为"第N章《标题》第M段（第K字）"
+func (t *chapterText) location(offset int) string {
+	paragraph := sort.Search(len(t.paragraphs), func(i int) bool { return t.paragraphs[i] > offset })
+	return fmt.Sprintf("%s第%d段（第%d字）", chapterLocation(t.chapter), paragraph,
+		utf8.RuneCountInString(t.content[:offset])+1)
+}
+
+// at 为问题填写章节、引文与位置，offset 为引文在正文中的字节偏移
+func (t *chapterText) at(issue ConsistencyIssue, offset int, quote string) ConsistencyIssue {
+	start := utf8.RuneCountInString(t.content[:offset])
+	issue.Location = t.location(offset)
+	issue.ChapterIndex = t.chapter.Index
+	issue.Quote = quote
+	issue.Span = &models.TextSpan{
+		ChapterID: t.chapter.ID,
+		Start:     start,
+		End:       start + utf8.RuneCountInString(quote),
+		Quote:     quote,
+		Verified:  true,
+	}
+	return issue
+}
+
+// sentences 按句末标点与换行切分句子
+func (t *chapterText) sentences() []sentence {
+	var sentences []sentence
+	start := 0
+	for i, r := range t.content {
+		switch r {
+		case '。', '！', '？', '!', '?', '\n':
+			end := i + utf8.RuneLen(r)
+			if strings.TrimSpace(t.content[start:end]) != "" {
+				sentences = append(sentences, sentence{text: t.content[start:end], start: start})
+			}
+			start = end
+		}
+	}
+	if strings.TrimSpace(t.content[start:]) != "" {
+		sentences = append(sentences, sentence{text: t.content[start:], start: start})
+	}
+	return sentences
+}
+
+func chapterLocation(chapter *models.Chapter) string {
+	if chapter.Title == "" {
+		return fmt.Sprintf("第%d章", chapter.Index)
+	}
+	return fmt.Sprintf("第%d章《%s》", chapter.Index, chapter.Title)
+}
+
+// ageOf 查找句中紧随人名之后的年龄，返回年龄、年龄描述在句中的偏移和原文，中间出现其他人物时不算
+func ageOf(text, name string, characters []*models.Character) (int, int, string, bool) {
+	index := strings.Index(text, name)
+	if index < 0 {
+		return 0, 0, "", false
+	}
+	rest := text[index+len(name):]
+	match := agePattern.FindStringSubmatchIndex(rest)
+	if match == nil {
+		return 0, 0, "", false
+	}
+	// 人名与年龄之间只允许"今年已经"一类的短修饰
+	between := rest[:match[0]]
+	if utf8.RuneCountInString(between) > maxAgeGap || strings.ContainsAny(between, "，,；;：") {
+		return 0, 0, "", false
+	}
+	for _, other := range characters {
+		if other != nil && other.Name != "" && other.Name != name && strings.Contains(between, other.Name) {
+			return 0, 0, "", false
+		}
+	}
+	age, ok := parseNumber(rest[match[2]:match[3]])
+	if !ok || age <= 0 {
+		return 0, 0, "", false
+	}
+	return age, index + len(name) + match[0], rest[match[0]:match[1]], true
+}
+
+// timeSkipYears 统计文本中"N年后"一类时间跳跃的年数
+func timeSkipYears(text string) int {
+	years := 0
+	for _, match := range timeSkipPattern.FindAllStringSubmatch(text, -1) {
+		if n, ok := parseNumber(match[1]); ok {
+			years += n
+		}
+	}
+	return years
+}
+
+// parseNumber 解析阿拉伯数字或一百以内的中文数字
+func parseNumber(s string) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, true
+	}
+	digits := map[rune]int{'零': 0, '一': 1, '二': 2, '两': 2, '三': 3, '四': 4, '五': 5, '六': 6, '七': 7, '八': 8, '九': 9}
+	total, current := 0, 0
+	for _, r := range s {
+		switch r {
+		case '十':
+			if current == 0 {
+				current = 1
+			}
+			total += current * 10
+			current = 0
+		case '百':
+			if current == 0 {
+				current = 1
+			}
+			total += current * 100
+			current = 0
+		default:
+			d, ok := digits[r]
+			if !ok {
+				return 0, false
+			}
+			current = d
+		}
+	}
+	return total + current, true
+}
+
+// looksLikeName 排除含代词与虚字、以副词动词或泛称开头的候选
+func looksLikeName(candidate string) bool {
+	if strings.ContainsAny(candidate, nameStopChars) {
+		return false
+	}
+	for _, word := range nameStopWords {
+		if strings.HasPrefix(candidate, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// nearMiss 返回与候选长度相同且只差一个字的已知人名
+func nearMiss(candidate string, known []string) string {
+	a := []rune(candidate)
+	for _, name := range known {
+		b := []rune(name)
+		if len(a) != len(b) {
+			continue
+		}
+		diff := 0
+		for i := range a {
+			if a[i] != b[i] {
+				diff++
+			}
+		}
+		if diff == 1 {
+			return name
+		}
+	}
+	return ""
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, sub := range substrs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeTitle(title string) string {
+	return strings.Trim(strings.TrimSpace(title), "《》")
+}
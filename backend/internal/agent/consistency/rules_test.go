@@ -0,0 +1,235 @@
+package consistency
+
+import (
+	"reflect"
+	"testing"
+
+	"backend/internal/pkg/models"
+)
+
+// ruleChapters 按序号1、2、3……创建章节
+func ruleChapters(contents ...string) []*models.Chapter {
+	chapters := make([]*models.Chapter, 0, len(contents))
+	for i, content := range contents {
+		chapters = append(chapters, &models.Chapter{ID: "c" + string(rune('1'+i)), Index: i + 1, RawContent: content})
+	}
+	return chapters
+}
+
+// issueDescriptions 问题描述与位置，便于整体比较
+func issueDescriptions(issues []ConsistencyIssue) []string {
+	descriptions := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		descriptions = append(descriptions, issue.Description+" @ "+issue.Location)
+	}
+	return descriptions
+}
+
+func TestRuleChecker_CheckNames(t *testing.T) {
+	characters := []*models.Character{{Name: "林晚"}, {Name: "沈舟"}}
+
+	tests := []struct {
+		name     string
+		chapters []*models.Chapter
+		want     []string
+	}{
+		{
+			name:     "已登记人物",
+			chapters: ruleChapters("“走吧。”沈舟说。\n林晚点头。"),
+			want:     []string{},
+		},
+		{
+			name:     "说话方式与泛称不是人名",
+			chapters: ruleChapters("“走吧。”低声说。\n“你也配？”冷笑道。\n“回来了。”老人叹道。\n“快走！”少年喊道。\n“客官。”掌柜笑道。\n“好。”众人点头。"),
+			want:     []string{},
+		},
+		{
+			name:     "代词开头不是人名",
+			chapters: ruleChapters("“走吧。”他说。\n“好。”她们点头。"),
+			want:     []string{},
+		},
+		{
+			name:     "未登记人物统计出现次数",
+			chapters: ruleChapters("“站住！”赵七喊道。", "“又是你。”赵七笑道。"),
+			want:     []string{"人物「赵七」不在人物列表中（共出现2次） @ 第1章第1段（第6字）"},
+		},
+		{
+			name:     "只差一个字的疑似错写",
+			chapters: ruleChapters("“你来了。”沈洲笑道。"),
+			want:     []string{"人名「沈洲」与人物「沈舟」只差一个字，疑似错写（共出现1次） @ 第1章第1段（第7字）"},
+		},
+	}
+
+	checker := NewRuleChecker()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueDescriptions(checker.checkNames(characters, newChapterTexts(tt.chapters)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRuleChecker_CheckAges(t *testing.T) {
+	tests := []struct {
+		name       string
+		characters []*models.Character
+		timeline   []*models.TimelineEvent
+		chapters   []*models.Chapter
+		want       []string
+	}{
+		{
+			name:       "与人物卡一致",
+			characters: []*models.Character{{Name: "林晚", Age: 17}},
+			chapters:   ruleChapters("林晚今年十七岁。"),
+			want:       []string{},
+		},
+		{
+			name:       "与人物卡不符",
+			characters: []*models.Character{{Name: "林晚", Age: 17}},
+			chapters:   ruleChapters("林晚今年二十岁。"),
+			want:       []string{"「林晚」此处为20岁，按人物卡的17岁和此前经过的0年应为17岁左右 @ 第1章第1段（第5字）"},
+		},
+		{
+			name:       "正文交代的时间跳跃",
+			characters: []*models.Character{{Name: "林晚", Age: 17}},
+			chapters:   ruleChapters("林晚十七岁。", "三年后，林晚已经二十岁了。"),
+			want:       []string{},
+		},
+		{
+			name:       "时间线记录的时间跳跃",
+			characters: []*models.Character{{Name: "林晚", Age: 17}},
+			timeline:   []*models.TimelineEvent{{ChapterIndex: 1, Timestamp: "五年后"}},
+			chapters:   ruleChapters("林晚十七岁。", "林晚二十二岁了。"),
+			want:       []string{},
+		},
+		{
+			name:       "人物卡无年龄时以首次出现为准",
+			characters: []*models.Character{{Name: "沈舟"}},
+			chapters:   ruleChapters("沈舟二十岁。", "沈舟二十五岁。"),
+			want:       []string{"「沈舟」此处为25岁，按第1章的20岁和此前经过的0年应为20岁左右 @ 第2章第1段（第3字）"},
+		},
+		{
+			name:       "年龄属于中间的其他人物",
+			characters: []*models.Character{{Name: "林晚", Age: 17}, {Name: "沈舟", Age: 30}},
+			chapters:   ruleChapters("林晚望着沈舟三十岁的背影。"),
+			want:       []string{},
+		},
+	}
+
+	checker := NewRuleChecker()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueDescriptions(checker.checkAges(tt.characters, tt.timeline, newChapterTexts(tt.chapters)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRuleChecker_CheckDestroyedItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		chapters []*models.Chapter
+		want     []string
+	}{
+		{
+			name:     "损毁后再次使用",
+			chapters: ruleChapters("玉佩摔在石阶上，碎成了几瓣。", "林晚握紧玉佩。\n玉佩发出微光。"),
+			want:     []string{"道具「玉佩」已在第1章第1段（第1字）损毁，此处又被使用 @ 第2章第1段（第5字）"},
+		},
+		{
+			name:     "修复后可以继续使用",
+			chapters: ruleChapters("玉佩碎了。", "师父将玉佩修复如初。林晚握紧玉佩。"),
+			want:     []string{},
+		},
+		{
+			name:     "提及碎片或回忆不算使用",
+			chapters: ruleChapters("玉佩碎了。", "林晚拾起玉佩的碎片。林晚想起那块玉佩。"),
+			want:     []string{},
+		},
+		{
+			name:     "未损毁",
+			chapters: ruleChapters("林晚握紧玉佩。", "玉佩发出微光。"),
+			want:     []string{},
+		},
+	}
+
+	checker := NewRuleChecker()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueDescriptions(checker.checkDestroyedItems([]string{"玉佩"}, newChapterTexts(tt.chapters)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRuleChecker_CheckOutline(t *testing.T) {
+	outline := &models.Outline{Chapters: []*models.ChapterOutline{
+		{Index: 1, Title: "下山"},
+		{Index: 2, Title: "断桥"},
+		{Index: 3, Title: "重逢"},
+	}}
+
+	tests := []struct {
+		name     string
+		chapters []*models.Chapter
+		want     []string
+	}{
+		{
+			name: "与大纲一致",
+			chapters: []*models.Chapter{
+				{Index: 1, Title: "下山"},
+				{Index: 2, Title: "《断桥》"},
+			},
+			want: []string{},
+		},
+		{
+			name: "标题不一致与缺失章节",
+			chapters: []*models.Chapter{
+				{Index: 1, Title: "下山"},
+				{Index: 3, Title: "雨夜"},
+			},
+			want: []string{
+				"第3章标题《雨夜》与大纲《重逢》不一致 @ 第3章《雨夜》",
+				"大纲中的第2章《断桥》没有对应章节 @ 第2章",
+			},
+		},
+		{
+			name: "章节错位",
+			chapters: []*models.Chapter{
+				{Index: 1, Title: "下山"},
+				{Index: 2, Title: "重逢"},
+			},
+			want: []string{"《重逢》在大纲中是第3章，实际位于第2章 @ 第2章《重逢》"},
+		},
+		{
+			name: "重复章节与大纲外章节",
+			chapters: []*models.Chapter{
+				{Index: 1, Title: "下山"},
+				{Index: 1, Title: "下山"},
+				{Index: 2, Title: "断桥"},
+				{Index: 3, Title: "重逢"},
+				{Index: 4, Title: "归来"},
+			},
+			want: []string{
+				"第1章重复出现 @ 第1章《下山》",
+				"第4章不在大纲中 @ 第4章《归来》",
+			},
+		},
+	}
+
+	checker := NewRuleChecker()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueDescriptions(checker.checkOutline(outline, tt.chapters))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
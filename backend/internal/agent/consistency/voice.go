@@ -0,0 +1,450 @@
+package consistency
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/internal/pkg/models"
+)
+
+// 台词画像参数
+const (
+	minProfileLines    = 5    // 画像至少需要的台词数，不足时不做偏离检查
+	maxVocabulary      = 20   // 画像列出的高频词数
+	maxCatchphrases    = 5    // 画像列出的口头禅数
+	catchphraseShare   = 0.2  // 口头禅至少出现在多少比例的台词中
+	minVocabularyCheck = 8    // 参与用词比较的台词至少包含的词元数
+	minVocabularyShare = 0.15 // 台词中见于画像的词元比例低于此值视为用词偏离
+	minVoiceDeviations = 2    // 同时出现几种偏离才报告
+	maxAttributionGap  = 12   // 引号之后的说话人描述最多读取的字数
+	maxPromptLines     = 30   // 人物一致性检查的提示词中最多列出的台词数
+)
+
+// modalParticles 句末语气词
+const modalParticles = "吧呢啊嘛呀哦啦罢哈呗咯嘞么"
+
+// quotePattern 引号内的台词
+var quotePattern = regexp.MustCompile(`[“「]([^”」\n]+)[”」]`)
+
+// DialogueLine 一句台词及其说话人
+type DialogueLine struct {
+	Speaker      string `json:"speaker"`       // 说话人，无法判断时为空
+	Text         string `json:"text"`          // 引号内的台词
+	ChapterID    string `json:"chapter_id"`    // 章节ID
+	ChapterIndex int    `json:"chapter_index"` // 章节索引
+	Offset       int    `json:"offset"`        // 台词在章节正文中的字节偏移
+}
+
+// VoiceProfile 人物的台词画像，取自已定稿章节中归属于该人物的台词
+type VoiceProfile struct {
+	Character        string         `json:"character"`           // 人物姓名
+	SpeechTone       string         `json:"speech_tone"`         // 人物卡中的说话风格
+	LineCount        int            `json:"line_count"`          // 台词数
+	AvgLineLength    float64        `json:"avg_line_length"`     // 平均每句台词的字数
+	LineLengthStdDev float64        `json:"line_length_std_dev"` // 台词字数的标准差
+	Vocabulary       []string       `json:"vocabulary"`          // 高频词
+	Catchphrases     []string       `json:"catchphrases"`        // 口头禅
+	Particles        map[string]int `json:"particles"`           // 句末语气词的使用次数
+
+	bigrams map[string]int // 全部台词词元的出现次数
+}
+
+// IsApprovedChapter 判断章节是否已定稿，台词画像只取自已定稿的章节
+func IsApprovedChapter(chapter *models.Chapter) bool {
+	return chapter != nil && chapter.Status == "completed"
+}
+
+// ExtractDialogue 提取章节中引号内的台词，并按引号前后的叙述判断说话人
+// 先看引号之后的"某某说"，再看引号之前所在分句的主语，都没有时若整段只提到一个人物则归属于他
+func ExtractDialogue(chapter *models.Chapter, characters []*models.Character) []DialogueLine {
+	text := newChapterTexts([]*models.Chapter{chapter})
+	if len(text) == 0 {
+		return nil
+	}
+	return extractDialogue(text[0], characterNames(characters))
+}
+
+// BuildVoiceProfiles 由已定稿章节中的台词为每个人物建立台词画像
+func BuildVoiceProfiles(characters []*models.Character, chapters []*models.Chapter) map[string]*VoiceProfile {
+	names := characterNames(characters)
+	lines := make(map[string][]string)
+	for _, text := range newChapterTexts(chapters) {
+		if !IsApprovedChapter(text.chapter) {
+			continue
+		}
+		for _, line := range extractDialogue(text, names) {
+			if line.Speaker != "" {
+				lines[line.Speaker] = append(lines[line.Speaker], line.Text)
+			}
+		}
+	}
+
+	profiles := make(map[string]*VoiceProfile)
+	for _, character := range characters {
+		if character == nil || character.Name == "" {
+			continue
+		}
+		profile := newVoiceProfile(character.Name, lines[character.Name])
+		profile.SpeechTone = character.SpeechTone
+		profiles[character.Name] = profile
+	}
+	return profiles
+}
+
+// Deviations 返回台词偏离画像之处，画像的台词不足时不做判断
+func (p *VoiceProfile) Deviations(line string) []string {
+	if p == nil || p.LineCount < minProfileLines {
+		return nil
+	}
+
+	var reasons []string
+	length := float64(lineLength(line))
+	if length > 2*p.AvgLineLength && length > p.AvgLineLength+3*p.LineLengthStdDev {
+		reasons = append(reasons, fmt.Sprintf("台词长达%.0f字，以往平均每句%.0f字", length, p.AvgLineLength))
+	}
+	if particle := endingParticle(line); particle != "" && p.Particles[particle] == 0 {
+		reasons = append(reasons, fmt.Sprintf("以往台词从不以「%s」结尾", particle))
+	}
+	bigrams := lineBigrams(line)
+	if len(bigrams) >= minVocabularyCheck {
+		shared := 0
+		for _, bigram := range bigrams {
+			if p.bigrams[bigram] > 0 {
+				shared++
+			}
+		}
+		if float64(shared)/float64(len(bigrams)) < minVocabularyShare {
+			reasons = append(reasons, "用词与以往台词几乎没有重合")
+		}
+	}
+	return reasons
+}
+
+// Describe 将画像整理为提示词中的人物说话方式
+func (p *VoiceProfile) Describe() string {
+	if p == nil || p.LineCount < minProfileLines {
+		tone := ""
+		if p != nil {
+			tone = p.SpeechTone
+		}
+		return fmt.Sprintf("已定稿章节中的台词不足%d句，参考人物卡的说话风格：%s", minProfileLines, tone)
+	}
+
+	particles := make([]string, 0, len(p.Particles))
+	for particle := range p.Particles {
+		particles = append(particles, particle)
+	}
+	sort.Slice(particles, func(i, j int) bool {
+		if p.Particles[particles[i]] != p.Particles[particles[j]] {
+			return p.Particles[particles[i]] > p.Particles[particles[j]]
+		}
+		return particles[i] < particles[j]
+	})
+	return fmt.Sprintf("来自已定稿章节的%d句台词：平均每句%.0f字；常用词：%s；口头禅：%s；句末语气词：%s",
+		p.LineCount, p.AvgLineLength, joinOrNone(p.Vocabulary), joinOrNone(p.Catchphrases), joinOrNone(particles))
+}
+
+// checkVoices 以已定稿章节建立的台词画像检查其余章节的台词
+func (c *RuleChecker) checkVoices(characters []*models.Character, chapters []*models.Chapter, texts []*chapterText) []ConsistencyIssue {
+	return voiceIssues(BuildVoiceProfiles(characters, chapters), characterNames(characters), texts)
+}
+
+// voiceIssues 报告偏离说话人画像的台词，已定稿章节不检查
+func voiceIssues(profiles map[string]*VoiceProfile, names []string, texts []*chapterText) []ConsistencyIssue {
+	var issues []ConsistencyIssue
+	for _, text := range texts {
+		if IsApprovedChapter(text.chapter) {
+			continue
+		}
+		for _, line := range extractDialogue(text, names) {
+			profile := profiles[line.Speaker]
+			if profile == nil {
+				continue
+			}
+			reasons := profile.Deviations(line.Text)
+			if len(reasons) < minVoiceDeviations {
+				continue
+			}
+			severity := "low"
+			if len(reasons) > minVoiceDeviations {
+				severity = "medium"
+			}
+			issues = append(issues, text.at(ConsistencyIssue{
+				Type:        "character",
+				Severity:    severity,
+				Description: fmt.Sprintf("「%s」的台词与以往的说话方式不符：%s", line.Speaker, strings.Join(reasons, "；")),
+				Suggestion:  fmt.Sprintf("按「%s」的说话习惯改写这句台词", line.Speaker),
+			}, line.Offset, line.Text))
+		}
+	}
+	return issues
+}
+
+// extractDialogue 提取章节中的台词并判断说话人
+func extractDialogue(text *chapterText, names []string) []DialogueLine {
+	var lines []DialogueLine
+	content := text.content
+	for _, match := range quotePattern.FindAllStringSubmatchIndex(content, -1) {
+		paragraphStart := strings.LastIndex(content[:match[0]], "\n") + 1
+		paragraphEnd := len(content)
+		if i := strings.Index(content[match[1]:], "\n"); i >= 0 {
+			paragraphEnd = match[1] + i
+		}
+
+		// 引号之后到下一个引号或句末，且不超过 maxAttributionGap 个字
+		after := content[match[1]:paragraphEnd]
+		if i := strings.IndexAny(after, "“「。！？"); i >= 0 {
+			after = after[:i]
+		}
+		after = truncateRunes(after, maxAttributionGap)
+		// 引号之前所在的分句，从上一个引号或句末开始
+		before := content[paragraphStart:match[0]]
+		if i := lastIndexAny(before, "”」。！？"); i >= 0 {
+			before = before[i:]
+		}
+
+		speaker := firstName(after, names)
+		if speaker == "" {
+			speaker = firstName(before, names)
+		}
+		if speaker == "" {
+			speaker = onlyName(quotePattern.ReplaceAllString(content[paragraphStart:paragraphEnd], ""), names)
+		}
+		lines = append(lines, DialogueLine{
+			Speaker:      speaker,
+			Text:         content[match[2]:match[3]],
+			ChapterID:    text.chapter.ID,
+			ChapterIndex: text.chapter.Index,
+			Offset:       match[2],
+		})
+	}
+	return lines
+}
+
+// newVoiceProfile 统计台词的字数、用词、口头禅与语气词
+func newVoiceProfile(name string, lines []string) *VoiceProfile {
+	profile := &VoiceProfile{
+		Character: name,
+		LineCount: len(lines),
+		Particles: make(map[string]int),
+		bigrams:   make(map[string]int),
+	}
+	if len(lines) == 0 {
+		return profile
+	}
+
+	total := 0
+	lineGrams := make(map[string]int)
+	for _, line := range lines {
+		total += lineLength(line)
+		for _, bigram := range lineBigrams(line) {
+			profile.bigrams[bigram]++
+		}
+		for gram := range lineNGrams(line) {
+			lineGrams[gram]++
+		}
+		if particle := endingParticle(line); particle != "" {
+			profile.Particles[particle]++
+		}
+	}
+	profile.AvgLineLength = float64(total) / float64(len(lines))
+	variance := 0.0
+	for _, line := range lines {
+		variance += math.Pow(float64(lineLength(line))-profile.AvgLineLength, 2)
+	}
+	profile.LineLengthStdDev = math.Sqrt(variance / float64(len(lines)))
+
+	profile.Vocabulary = topKeys(profile.bigrams, maxVocabulary, func(gram string, count int) bool {
+		return count >= 2 && !strings.ContainsAny(gram, nameStopChars)
+	})
+
+	// 口头禅：出现在足够多台词中的片段，被更长的口头禅包含且出现次数相同的不再单列
+	minLines := int(math.Ceil(catchphraseShare * float64(len(lines))))
+	if minLines < 2 {
+		minLines = 2
+	}
+	candidates := topKeys(lineGrams, len(lineGrams), func(gram string, count int) bool {
+		return count >= minLines && !strings.ContainsAny(gram, nameStopChars)
+	})
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if lineGrams[candidates[i]] != lineGrams[candidates[j]] {
+			return lineGrams[candidates[i]] > lineGrams[candidates[j]]
+		}
+		return utf8.RuneCountInString(candidates[i]) > utf8.RuneCountInString(candidates[j])
+	})
+	for _, candidate := range candidates {
+		if len(profile.Catchphrases) == maxCatchphrases {
+			break
+		}
+		covered := false
+		for _, kept := range profile.Catchphrases {
+			if strings.Contains(kept, candidate) && lineGrams[kept] == lineGrams[candidate] {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			profile.Catchphrases = append(profile.Catchphrases, candidate)
+		}
+	}
+	return profile
+}
+
+// characterNames 返回人物姓名，较长的姓名排在前面以免被较短的姓名抢先匹配
+func characterNames(characters []*models.Character) []string {
+	var names []string
+	for _, character := range characters {
+		if character != nil && character.Name != "" {
+			names = append(names, character.Name)
+		}
+	}
+	sort.SliceStable(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+	return names
+}
+
+// firstName 返回文本中最先出现的人名
+func firstName(text string, names []string) string {
+	first, position := "", len(text)
+	for _, name := range names {
+		if i := strings.Index(text, name); i >= 0 && i < position {
+			first, position = name, i
+		}
+	}
+	return first
+}
+
+// onlyName 文本中只出现一个人物时返回其姓名
+func onlyName(text string, names []string) string {
+	found := ""
+	for _, name := range names {
+		if !strings.Contains(text, name) {
+			continue
+		}
+		if found != "" && !strings.Contains(found, name) {
+			return ""
+		}
+		if found == "" {
+			found = name
+		}
+	}
+	return found
+}
+
+// lineLength 台词的字数，不含标点空白
+func lineLength(line string) int {
+	length := 0
+	for _, r := range line {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			length++
+		}
+	}
+	return length
+}
+
+// lineBigrams 台词中连续汉字的二字词元
+func lineBigrams(line string) []string {
+	var bigrams []string
+	for _, run := range hanRuns(line) {
+		for i := 0; i+2 <= len(run); i++ {
+			bigrams = append(bigrams, string(run[i:i+2]))
+		}
+	}
+	return bigrams
+}
+
+// lineNGrams 台词中连续汉字的二到四字片段，同一句中重复的只计一次
+func lineNGrams(line string) map[string]bool {
+	grams := make(map[string]bool)
+	for _, run := range hanRuns(line) {
+		for size := 2; size <= 4; size++ {
+			for i := 0; i+size <= len(run); i++ {
+				grams[string(run[i:i+size])] = true
+			}
+		}
+	}
+	return grams
+}
+
+// hanRuns 按非汉字切分出连续的汉字
+func hanRuns(text string) [][]rune {
+	var runs [][]rune
+	var run []rune
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) {
+			run = append(run, r)
+			continue
+		}
+		if len(run) > 0 {
+			runs = append(runs, run)
+			run = nil
+		}
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
+// endingParticle 返回台词句末的语气词，没有时返回空
+func endingParticle(line string) string {
+	runes := []rune(strings.TrimRightFunc(line, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSpace(r) }))
+	if len(runes) == 0 || !strings.ContainsRune(modalParticles, runes[len(runes)-1]) {
+		return ""
+	}
+	return string(runes[len(runes)-1])
+}
+
+// topKeys 返回满足条件且次数最多的 n 个键，次数相同时按键排序
+func topKeys(counts map[string]int, n int, keep func(string, int) bool) []string {
+	var keys []string
+	for key, count := range counts {
+		if keep(key, count) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+	return keys
+}
+
+// truncateRunes 截取前 n 个字
+func truncateRunes(text string, n int) string {
+	count := 0
+	for i := range text {
+		if count == n {
+			return text[:i]
+		}
+		count++
+	}
+	return text
+}
+
+// lastIndexAny 返回最后一个属于 chars 的字符之后的字节偏移，没有时返回 -1
+func lastIndexAny(text, chars string) int {
+	i := strings.LastIndexAny(text, chars)
+	if i < 0 {
+		return -1
+	}
+	_, size := utf8.DecodeRuneInString(text[i:])
+	return i + size
+}
+
+// joinOrNone 用顿号连接，没有内容时返回"无"
+func joinOrNone(items []string) string {
+	if len(items) == 0 {
+		return "无"
+	}
+	return strings.Join(items, "、")
+}
@@ -0,0 +1,233 @@
+package consistency
+
+import (
+	"reflect"
+	"testing"
+
+	"backend/internal/pkg/models"
+)
+
+// voiceCharacters 台词测试使用的人物
+var voiceCharacters = []*models.Character{{Name: "林晚", SpeechTone: "干脆利落"}, {Name: "沈舟", SpeechTone: "沉稳"}}
+
+// approvedVoice 已定稿章节中林晚的六句台词，都以“说到底”开头、以“吧”结尾
+const approvedVoice = "“说到底，走吧。”林晚说。\n“说到底，别管了吧。”林晚说。\n“说到底，我去吧。”林晚说。\n" +
+	"“说到底，你留下吧。”林晚说。\n“说到底，明天再来吧。”林晚说。\n“说到底，师父不会怪我们吧。”林晚说。"
+
+// speakerLines 台词的说话人与内容，便于整体比较
+func speakerLines(lines []DialogueLine) []string {
+	speakers := make([]string, 0, len(lines))
+	for _, line := range lines {
+		speakers = append(speakers, line.Speaker+"："+line.Text)
+	}
+	return speakers
+}
+
+func TestExtractDialogue(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{name: "引号之后的说话人", content: "“走吧。”林晚说。", want: []string{"林晚：走吧。"}},
+		{name: "引号之前分句的主语", content: "沈舟皱眉：“等等。”", want: []string{"沈舟：等等。"}},
+		{name: "引号之后优先于之前", content: "沈舟回头。“走吧。”林晚说。", want: []string{"林晚：走吧。"}},
+		{name: "段落中只提到一个人物", content: "林晚看着他。“你先走吧。”", want: []string{"林晚：你先走吧。"}},
+		{name: "段落中有多个人物时无法判断", content: "林晚和沈舟对视。“谁？”", want: []string{"：谁？"}},
+		{name: "没有任何人物", content: "“好。”", want: []string{"：好。"}},
+		{name: "直角引号", content: "「走吧。」林晚说。", want: []string{"林晚：走吧。"}},
+		{name: "没有台词", content: "林晚推开山门。", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := ExtractDialogue(&models.Chapter{ID: "c1", Index: 1, RawContent: tt.content}, voiceCharacters)
+			if got := speakerLines(lines); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExtractDialogue_Offsets(t *testing.T) {
+	chapter := &models.Chapter{ID: "c1", Index: 3, RawContent: "“走吧。”林晚说。\n沈舟皱眉：“等等。”"}
+
+	lines := ExtractDialogue(chapter, voiceCharacters)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if line.ChapterID != "c1" || line.ChapterIndex != 3 {
+			t.Fatalf("Expected chapter c1 #3, got %s #%d", line.ChapterID, line.ChapterIndex)
+		}
+		// 偏移按字节计，指向引号内台词的开头
+		if got := chapter.RawContent[line.Offset : line.Offset+len(line.Text)]; got != line.Text {
+			t.Fatalf("Expected offset %d to point at %q, got %q", line.Offset, line.Text, got)
+		}
+	}
+}
+
+func TestBuildVoiceProfiles(t *testing.T) {
+	chapters := []*models.Chapter{
+		{ID: "c1", Index: 1, Status: "completed", RawContent: approvedVoice},
+		{ID: "c2", Index: 2, Status: "draft", RawContent: "“嗯呢。”林晚说。"},
+	}
+
+	profiles := BuildVoiceProfiles(voiceCharacters, chapters)
+	if len(profiles) != 2 {
+		t.Fatalf("Expected a profile for every character, got %d", len(profiles))
+	}
+
+	// 只统计已定稿章节中的台词
+	profile := profiles["林晚"]
+	if profile.LineCount != 6 || profile.SpeechTone != "干脆利落" {
+		t.Fatalf("Expected 6 lines with the character's tone, got %d lines, tone %q", profile.LineCount, profile.SpeechTone)
+	}
+	if profile.AvgLineLength < 7.3 || profile.AvgLineLength > 7.4 {
+		t.Fatalf("Expected average line length about 7.3, got %.2f", profile.AvgLineLength)
+	}
+	if want := []string{"说到底"}; !reflect.DeepEqual(profile.Catchphrases, want) {
+		t.Fatalf("Expected catchphrases %v, got %v", want, profile.Catchphrases)
+	}
+	if want := []string{"到底", "说到"}; !reflect.DeepEqual(profile.Vocabulary, want) {
+		t.Fatalf("Expected vocabulary %v, got %v", want, profile.Vocabulary)
+	}
+	if want := map[string]int{"吧": 6}; !reflect.DeepEqual(profile.Particles, want) {
+		t.Fatalf("Expected particles %v, got %v", want, profile.Particles)
+	}
+
+	if other := profiles["沈舟"]; other.LineCount != 0 {
+		t.Fatalf("Expected no lines for 沈舟, got %d", other.LineCount)
+	}
+}
+
+func TestVoiceProfile_Deviations(t *testing.T) {
+	profile := BuildVoiceProfiles(voiceCharacters, []*models.Chapter{{ID: "c1", Index: 1, Status: "completed", RawContent: approvedVoice}})["林晚"]
+
+	tests := []struct {
+		name    string
+		profile *VoiceProfile
+		line    string
+		want    []string
+	}{
+		{
+			name:    "符合以往说话方式",
+			profile: profile,
+			line:    "说到底，走吧。",
+		},
+		{
+			name:    "只换了句末语气词",
+			profile: profile,
+			line:    "说到底，走呢。",
+			want:    []string{"以往台词从不以「呢」结尾"},
+		},
+		{
+			name:    "长度、语气词与用词都偏离",
+			profile: profile,
+			line:    "依照宗门律例第三十七条规定诸位弟子务必即刻返回各自洞府静候长老传唤呢",
+			want: []string{
+				"台词长达34字，以往平均每句7字",
+				"以往台词从不以「呢」结尾",
+				"用词与以往台词几乎没有重合",
+			},
+		},
+		{
+			name:    "台词不足时不做判断",
+			profile: newVoiceProfile("沈舟", []string{"走吧。", "等等。"}),
+			line:    "依照宗门律例第三十七条规定诸位弟子务必即刻返回各自洞府静候长老传唤呢",
+		},
+		{
+			name: "没有画像",
+			line: "走呢。",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.profile.Deviations(tt.line); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestVoiceProfile_Describe(t *testing.T) {
+	profiles := BuildVoiceProfiles(voiceCharacters, []*models.Chapter{{ID: "c1", Index: 1, Status: "completed", RawContent: approvedVoice}})
+
+	tests := []struct {
+		name    string
+		profile *VoiceProfile
+		want    string
+	}{
+		{
+			name:    "台词充足时描述画像",
+			profile: profiles["林晚"],
+			want:    "来自已定稿章节的6句台词：平均每句7字；常用词：到底、说到；口头禅：说到底；句末语气词：吧",
+		},
+		{
+			name:    "台词不足时参考人物卡",
+			profile: profiles["沈舟"],
+			want:    "已定稿章节中的台词不足5句，参考人物卡的说话风格：沉稳",
+		},
+		{
+			name: "没有画像",
+			want: "已定稿章节中的台词不足5句，参考人物卡的说话风格：",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.profile.Describe(); got != tt.want {
+				t.Fatalf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRuleChecker_CheckVoices(t *testing.T) {
+	drift := "“依照宗门律例第三十七条规定诸位弟子务必即刻返回各自洞府静候长老传唤呢。”林晚说。"
+
+	tests := []struct {
+		name     string
+		chapters []*models.Chapter
+		want     []string
+	}{
+		{
+			name: "未定稿章节中偏离画像的台词",
+			chapters: []*models.Chapter{
+				{ID: "c1", Index: 1, Status: "completed", RawContent: approvedVoice},
+				{ID: "c2", Index: 2, Status: "draft", RawContent: "“说到底，走呢。”林晚说。\n" + drift},
+			},
+			want: []string{"「林晚」的台词与以往的说话方式不符：台词长达34字，以往平均每句7字；以往台词从不以「呢」结尾；用词与以往台词几乎没有重合 @ 第2章第2段（第16字）"},
+		},
+		{
+			name: "已定稿章节不检查",
+			chapters: []*models.Chapter{
+				{ID: "c1", Index: 1, Status: "completed", RawContent: approvedVoice + "\n" + drift},
+			},
+			want: []string{},
+		},
+		{
+			name: "没有定稿章节时不检查",
+			chapters: []*models.Chapter{
+				{ID: "c1", Index: 1, Status: "draft", RawContent: approvedVoice + "\n" + drift},
+			},
+			want: []string{},
+		},
+	}
+
+	checker := NewRuleChecker()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := checker.checkVoices(voiceCharacters, tt.chapters, newChapterTexts(tt.chapters))
+			if got := issueDescriptions(issues); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+			for _, issue := range issues {
+				if issue.Type != "character" || issue.Severity != "medium" {
+					t.Fatalf("Expected a medium character issue, got %s/%s", issue.Type, issue.Severity)
+				}
+			}
+		})
+	}
+}
@@ -9,7 +9,10 @@ import (
 	"backend/internal/agent/chapter"
 	"backend/internal/agent/consistency"
 	"backend/internal/agent/outline"
+	"backend/internal/agent/plotthread"
 	"backend/internal/agent/polish"
+	"backend/internal/agent/quality"
+	"backend/internal/agent/storystate"
 	"backend/internal/agent/worldbuilding"
 	"backend/internal/pkg/llm"
 	"backend/internal/pkg/models"
@@ -27,6 +30,9 @@ type GenerateOptions struct {
 	WordsPerChapter  int                  `json:"words_per_chapter"`  // 每章字数
 	PolishEnabled    bool                 `json:"polish_enabled"`     // 是否启用润色
 	ConsistencyCheck bool                 `json:"consistency_check"`  // 是否检查一致性
+	QualityGate      bool                 `json:"quality_gate"`       // 是否在每章生成后进行质量门禁评审
+	QualityThreshold float64              `json:"quality_threshold"`  // 质量门禁通过阈值 0-1，默认0.7
+	MaxRevisions     int                  `json:"max_revisions"`      // 未通过时最多改写次数，默认2
 	LLMOptions       *llm.GenerateOptions `json:"llm_options"`
 }
 
@@ -38,6 +44,14 @@ type GenerateNovelResponse struct {
 	Chapters  []*models.Chapter    `json:"chapters"`
 	Issues    []string             `json:"issues"`
 	Completed bool                 `json:"completed"`
+	// 每章结束时的故事状态，与 Chapters 一一对应，提取失败的章节沿用上一章状态
+	StoryStates []*models.StoryState `json:"story_states"`
+	// 启用质量门禁时每章的评审记录
+	QualityGates []*models.QualityGateResult `json:"quality_gates"`
+	// 全部章节生成后的情节线索
+	PlotThreads []*models.PlotThread `json:"plot_threads"`
+	// 生成收尾章节前检查出的未回收伏笔与缺少铺垫的回收
+	PlotThreadIssues []*models.PlotThreadIssue `json:"plot_thread_issues"`
 }
 
 type WorkflowStatus struct {
@@ -57,19 +71,27 @@ type OrchestratorAgent struct {
 	chapterAgent      *chapter.ChapterAgent
 	polishAgent       *polish.PolishAgent
 	consistencyAgent  *consistency.ConsistencyAgent
+	storyStateAgent   *storystate.StoryStateAgent
+	plotThreadAgent   *plotthread.PlotThreadAgent
+	qualityAgent      *quality.QualityAgent
 	statusCallback    func(*WorkflowStatus)
 }
 
 // NewOrchestratorAgent 创建主调度代理
 func NewOrchestratorAgent(llmClient llm.LLMClient, logger log.Logger) *OrchestratorAgent {
+	polishAgent := polish.NewPolishAgent(llmClient)
+	consistencyAgent := consistency.NewConsistencyAgent(llmClient)
 	return &OrchestratorAgent{
 		llmClient:        llmClient,
 		worldAgent:       worldbuilding.NewWorldBuildingAgent(llmClient, logger),
 		characterAgent:   character.NewCharacterAgent(llmClient),
 		outlineAgent:     outline.NewOutlineAgent(llmClient),
 		chapterAgent:     chapter.NewChapterAgent(llmClient),
-		polishAgent:      polish.NewPolishAgent(llmClient),
-		consistencyAgent: consistency.NewConsistencyAgent(llmClient),
+		polishAgent:      polishAgent,
+		consistencyAgent: consistencyAgent,
+		storyStateAgent:  storystate.NewStoryStateAgent(llmClient),
+		plotThreadAgent:  plotthread.NewPlotThreadAgent(llmClient),
+		qualityAgent:     quality.NewQualityAgent(llmClient, polishAgent, consistencyAgent),
 	}
 }
 
@@ -103,7 +125,7 @@ func (a *OrchestratorAgent) GenerateNovel(ctx context.Context, req *GenerateNove
 	}
 
 	// 阶段1：生成世界观
-	a.updateStatus("worldbuilding", 0.1, "正在生成世界观设定...", "")
+	a.updateStatus(ctx, "worldbuilding", 0.1, "正在生成世界观设定...", "")
 	worldView, err := a.generateWorldView(ctx, project, options.LLMOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate world view: %w", err)
@@ -111,7 +133,7 @@ func (a *OrchestratorAgent) GenerateNovel(ctx context.Context, req *GenerateNove
 	project.WorldView = worldView
 
 	// 阶段2：生成人物卡
-	a.updateStatus("character", 0.2, "正在生成人物设定...", "")
+	a.updateStatus(ctx, "character", 0.2, "正在生成人物设定...", "")
 	characters, err := a.generateCharacters(ctx, project, options.LLMOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate characters: %w", err)
@@ -119,7 +141,7 @@ func (a *OrchestratorAgent) GenerateNovel(ctx context.Context, req *GenerateNove
 	project.Characters = characters
 
 	// 阶段3：生成章节大纲
-	a.updateStatus("outline", 0.3, "正在生成章节大纲...", "")
+	a.updateStatus(ctx, "outline", 0.3, "正在生成章节大纲...", "")
 	outline, err := a.generateOutline(ctx, project, options.MaxChapters, options.LLMOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate outline: %w", err)
@@ -127,17 +149,30 @@ func (a *OrchestratorAgent) GenerateNovel(ctx context.Context, req *GenerateNove
 	project.Outline = outline
 
 	// 阶段4：生成章节内容
-	a.updateStatus("chapter", 0.4, "正在生成章节内容...", "")
-	chapters, err := a.generateChapters(ctx, project, options)
+	a.updateStatus(ctx, "chapter", 0.4, "正在生成章节内容...", "")
+	run, err := a.generateChapters(ctx, project, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate chapters: %w", err)
 	}
+	chapters, states := run.chapters, run.states
 	project.Chapters = chapters
 	response.Chapters = chapters
+	response.StoryStates = states
+	response.QualityGates = run.gates
+	response.PlotThreads = run.threads
+	response.PlotThreadIssues = run.threadIssues
+	for _, gate := range run.gates {
+		if gate.NeedsReview {
+			response.Issues = append(response.Issues, fmt.Sprintf("第%d章未通过质量门禁，需要人工审核", gate.ChapterIndex))
+		}
+	}
+	for _, issue := range run.threadIssues {
+		response.Issues = append(response.Issues, "情节线索："+issue.Description)
+	}
 
 	// 阶段5：润色处理（可选）
 	if options.PolishEnabled {
-		a.updateStatus("polish", 0.8, "正在润色章节内容...", "")
+		a.updateStatus(ctx, "polish", 0.8, "正在润色章节内容...", "")
 		polishedChapters, err := a.polishChapters(ctx, chapters, options.LLMOptions)
 		if err != nil {
 			response.Issues = append(response.Issues, fmt.Sprintf("润色失败: %v", err))
@@ -149,8 +184,12 @@ func (a *OrchestratorAgent) GenerateNovel(ctx context.Context, req *GenerateNove
 
 	// 阶段6：一致性检查（可选）
 	if options.ConsistencyCheck {
-		a.updateStatus("consistency", 0.9, "正在检查内容一致性...", "")
-		issues, err := a.checkConsistency(ctx, project, options.LLMOptions)
+		a.updateStatus(ctx, "consistency", 0.9, "正在检查内容一致性...", "")
+		var lastState *models.StoryState
+		if len(states) > 0 {
+			lastState = states[len(states)-1]
+		}
+		issues, err := a.checkConsistency(ctx, project, lastState, options.LLMOptions)
 		if err != nil {
 			response.Issues = append(response.Issues, fmt.Sprintf("一致性检查失败: %v", err))
 		} else {
@@ -166,7 +205,7 @@ func (a *OrchestratorAgent) GenerateNovel(ctx context.Context, req *GenerateNove
 	response.Message = "小说生成完成"
 	response.Completed = true
 
-	a.updateStatus("completed", 1.0, "小说生成完成", "")
+	a.updateStatus(ctx, "completed", 1.0, "小说生成完成", "")
 
 	return response, nil
 }
@@ -222,14 +261,38 @@ func (a *OrchestratorAgent) generateOutline(ctx context.Context, project *models
 	return resp.Outline, nil
 }
 
-// generateChapters 生成章节内容
-func (a *OrchestratorAgent) generateChapters(ctx context.Context, project *models.NovelProject, options *GenerateOptions) ([]*models.Chapter, error) {
-	chapters := make([]*models.Chapter, 0, len(project.Outline.Chapters))
-	
+// chapterRun 章节生成阶段的结果
+type chapterRun struct {
+	chapters     []*models.Chapter
+	states       []*models.StoryState
+	gates        []*models.QualityGateResult
+	threads      []*models.PlotThread
+	threadIssues []*models.PlotThreadIssue
+}
+
+// generateChapters 生成章节内容，启用质量门禁时每章先通过评审，再提取故事状态与情节线索供后续章节使用
+// 进入收尾章节前检查一次情节线索，报告未回收的伏笔与缺少铺垫的回收
+func (a *OrchestratorAgent) generateChapters(ctx context.Context, project *models.NovelProject, options *GenerateOptions) (*chapterRun, error) {
+	total := len(project.Outline.Chapters)
+	run := &chapterRun{
+		chapters: make([]*models.Chapter, 0, total),
+		states:   make([]*models.StoryState, 0, total),
+		threads:  plotthread.ThreadsFromOutline(project.ID, project.Outline, nil),
+	}
+	var state *models.StoryState
+	threadsChecked := false
+
 	for i, chapterOutline := range project.Outline.Chapters {
 		// 更新进度
-		progress := 0.4 + (0.4 * float64(i) / float64(len(project.Outline.Chapters)))
-		a.updateStatus("chapter", progress, fmt.Sprintf("正在生成第%d章：%s", i+1, chapterOutline.Title), "")
+		progress := 0.4 + (0.4 * float64(i) / float64(total))
+		if !threadsChecked && plotthread.IsFinale(total, chapterOutline.Index) {
+			threadsChecked = true
+			run.threadIssues = plotthread.Analyze(project.ID, run.threads, total, chapterOutline.Index).Issues
+			if len(run.threadIssues) > 0 {
+				a.updateStatus(ctx, "chapter", progress, fmt.Sprintf("进入收尾章节，发现%d个情节线索问题", len(run.threadIssues)), "")
+			}
+		}
+		a.updateStatus(ctx, "chapter", progress, fmt.Sprintf("正在生成第%d章：%s", i+1, chapterOutline.Title), "")
 
 		// 构建生成上下文
 		context := &models.GenerationContext{
@@ -239,10 +302,11 @@ func (a *OrchestratorAgent) generateChapters(ctx context.Context, project *model
 			ChapterGoal: chapterOutline.Goal,
 		}
 
-		// 添加前情摘要
+		// 添加前情摘要与上一章结束时的故事状态
 		if i > 0 {
-			context.PreviousSummary = chapters[i-1].Summary
+			context.PreviousSummary = run.chapters[i-1].Summary
 		}
+		storystate.ApplyToContext(context, state)
 
 		req := &chapter.GenerateChapterRequest{
 			ProjectID:       project.ID,
@@ -257,10 +321,64 @@ func (a *OrchestratorAgent) generateChapters(ctx context.Context, project *model
 			return nil, fmt.Errorf("failed to generate chapter %d: %w", i+1, err)
 		}
 
-		chapters = append(chapters, resp.Chapter)
+		generated := resp.Chapter
+		if options.QualityGate {
+			var gate *models.QualityGateResult
+			generated, gate = a.reviewChapter(ctx, project, generated, progress, options)
+			run.gates = append(run.gates, gate)
+		}
+
+		run.chapters = append(run.chapters, generated)
+		state = a.trackStoryState(ctx, project, generated, state, options.LLMOptions)
+		run.states = append(run.states, state)
+		run.threads = a.trackPlotThreads(ctx, generated, run.threads, options.LLMOptions)
 	}
 
-	return chapters, nil
+	return run, nil
+}
+
+// trackPlotThreads 提取章节中的伏笔埋设与回收，失败时沿用之前的线索，不中断生成
+func (a *OrchestratorAgent) trackPlotThreads(ctx context.Context, chapter *models.Chapter, threads []*models.PlotThread, options *llm.GenerateOptions) []*models.PlotThread {
+	if a.plotThreadAgent == nil {
+		return threads
+	}
+	tracked, err := a.plotThreadAgent.TrackChapter(ctx, &plotthread.TrackChapterRequest{
+		Chapter: chapter,
+		Threads: threads,
+		Options: options,
+	})
+	if err != nil {
+		return threads
+	}
+	return tracked
+}
+
+// trackStoryState 提取章节结束时的故事状态，失败时沿用上一章状态，不中断生成
+func (a *OrchestratorAgent) trackStoryState(ctx context.Context, project *models.NovelProject, chapter *models.Chapter, previous *models.StoryState, options *llm.GenerateOptions) *models.StoryState {
+	if a.storyStateAgent != nil {
+		state, err := a.storyStateAgent.ExtractStoryState(ctx, &storystate.ExtractStoryStateRequest{
+			Chapter:    chapter,
+			Previous:   previous,
+			Characters: project.Characters,
+			Options:    options,
+		})
+		if err == nil {
+			return state
+		}
+	}
+
+	carried := &models.StoryState{
+		ProjectID:    project.ID,
+		ChapterIndex: chapter.Index,
+		UpdatedAt:    time.Now(),
+	}
+	if previous != nil {
+		carried.Characters = previous.Characters
+		carried.Locations = previous.Locations
+		carried.Props = previous.Props
+		carried.Timeline = previous.Timeline
+	}
+	return carried
 }
 
 // polishChapters 润色章节
@@ -270,7 +388,7 @@ func (a *OrchestratorAgent) polishChapters(ctx context.Context, chapters []*mode
 	for i, chapter := range chapters {
 		// 更新进度
 		progress := 0.8 + (0.1 * float64(i) / float64(len(chapters)))
-		a.updateStatus("polish", progress, fmt.Sprintf("正在润色第%d章：%s", i+1, chapter.Title), "")
+		a.updateStatus(ctx, "polish", progress, fmt.Sprintf("正在润色第%d章：%s", i+1, chapter.Title), "")
 
 		req := &polish.PolishChapterRequest{
 			Chapter: chapter,
@@ -284,20 +402,28 @@ func (a *OrchestratorAgent) polishChapters(ctx context.Context, chapters []*mode
 			return nil, fmt.Errorf("failed to polish chapter %d: %w", i+1, err)
 		}
 
+		// 润色不解除人工审核标记
+		if chapter.Status == models.ChapterStatusNeedsReview {
+			resp.Chapter.Status = chapter.Status
+		}
 		polishedChapters[i] = resp.Chapter
 	}
 
 	return polishedChapters, nil
 }
 
-// checkConsistency 检查一致性
-func (a *OrchestratorAgent) checkConsistency(ctx context.Context, project *models.NovelProject, options *llm.GenerateOptions) ([]string, error) {
+// checkConsistency 检查一致性，最后一章的故事状态提供规则检查所需的时间线和道具
+func (a *OrchestratorAgent) checkConsistency(ctx context.Context, project *models.NovelProject, state *models.StoryState, options *llm.GenerateOptions) ([]string, error) {
 	req := &consistency.CheckConsistencyRequest{
 		Project:   project,
 		Chapters:  project.Chapters,
 		CheckType: "all",
 		Options:   options,
 	}
+	if state != nil {
+		req.Timeline = state.Timeline
+		req.Props = state.Props
+	}
 
 	resp, err := a.consistencyAgent.CheckConsistency(ctx, req)
 	if err != nil {
@@ -313,18 +439,34 @@ func (a *OrchestratorAgent) checkConsistency(ctx context.Context, project *model
 	return issues, nil
 }
 
+// statusCallbackKey 请求级状态回调在 context 中的键
+type statusCallbackKey struct{}
+
+// WithStatusCallback 为单次生成设置状态回调，与 SetStatusCallback 设置的全局回调同时生效
+func WithStatusCallback(ctx context.Context, callback func(*WorkflowStatus)) context.Context {
+	return context.WithValue(ctx, statusCallbackKey{}, callback)
+}
+
 // updateStatus 更新状态
-func (a *OrchestratorAgent) updateStatus(stage string, progress float64, message, errorMsg string) {
+func (a *OrchestratorAgent) updateStatus(ctx context.Context, stage string, progress float64, message, errorMsg string) {
+	callback, _ := ctx.Value(statusCallbackKey{}).(func(*WorkflowStatus))
+	if a.statusCallback == nil && callback == nil {
+		return
+	}
+
+	status := &WorkflowStatus{
+		Stage:       stage,
+		Progress:    progress,
+		Message:     message,
+		CurrentStep: stage,
+		Error:       errorMsg,
+	}
 	if a.statusCallback != nil {
-		status := &WorkflowStatus{
-			Stage:       stage,
-			Progress:    progress,
-			Message:     message,
-			CurrentStep: stage,
-			Error:       errorMsg,
-		}
 		a.statusCallback(status)
 	}
+	if callback != nil {
+		callback(status)
+	}
 }
 
 // GetWorkflowStages 获取工作流阶段信息
@@ -369,6 +511,8 @@ func (a *OrchestratorAgent) GetCapabilities() map[string]interface{} {
 			"track_progress",
 			"handle_errors",
 			"estimate_time",
+			"quality_gate",
+			"track_plot_threads",
 		},
 		"sub_agents": []string{
 			"WorldBuildingAgent",
@@ -377,6 +521,9 @@ func (a *OrchestratorAgent) GetCapabilities() map[string]interface{} {
 			"ChapterAgent",
 			"PolishAgent",
 			"ConsistencyAgent",
+			"StoryStateAgent",
+			"PlotThreadAgent",
+			"QualityAgent",
 		},
 	}
 }
\ No newline at end of file
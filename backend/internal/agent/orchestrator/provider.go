@@ -1,6 +1,7 @@
 package orchestrator
 
 import (
+	"backend/internal/agent/chapter"
 	"backend/internal/pkg/llm"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -9,7 +10,9 @@ import (
 // ProviderSet is orchestrator providers.
 var ProviderSet = wire.NewSet(NewOrchestratorAgentProvider)
 
-// NewOrchestratorAgentProvider 创建主调度代理（用于依赖注入）
-func NewOrchestratorAgentProvider(llmClient llm.LLMClient, logger log.Logger) *OrchestratorAgent {
-	return NewOrchestratorAgent(llmClient, logger)
+// NewOrchestratorAgentProvider 创建主调度代理（用于依赖注入），章节生成与服务共用检索前文的章节代理
+func NewOrchestratorAgentProvider(llmClient llm.LLMClient, chapterAgent *chapter.ChapterAgent, logger log.Logger) *OrchestratorAgent {
+	agent := NewOrchestratorAgent(llmClient, logger)
+	agent.chapterAgent = chapterAgent
+	return agent
 }
\ No newline at end of file
@@ -0,0 +1,95 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/agent/chapter"
+	"backend/internal/agent/quality"
+	"backend/internal/pkg/models"
+)
+
+// 质量门禁默认值
+const (
+	defaultQualityThreshold = 0.7
+	defaultMaxRevisions     = 2
+)
+
+// reviewChapter 对新生成的章节进行质量门禁评审
+// 评分低于阈值时将评审的改进建议交给章节代理改写并重新评审，改写次数用尽仍未通过时标记为需人工审核
+// 评审或改写失败不中断生成，同样标记为需人工审核
+func (a *OrchestratorAgent) reviewChapter(ctx context.Context, project *models.NovelProject, current *models.Chapter, progress float64, options *GenerateOptions) (*models.Chapter, *models.QualityGateResult) {
+	threshold := options.QualityThreshold
+	if threshold <= 0 {
+		threshold = defaultQualityThreshold
+	}
+	maxRevisions := options.MaxRevisions
+	if maxRevisions <= 0 {
+		maxRevisions = defaultMaxRevisions
+	}
+
+	gate := &models.QualityGateResult{
+		ChapterID:    current.ID,
+		ChapterIndex: current.Index,
+		Threshold:    threshold,
+	}
+	for attempt := 1; ; attempt++ {
+		record := &models.QualityGateAttempt{Attempt: attempt, CheckedAt: time.Now()}
+		gate.Attempts = append(gate.Attempts, record)
+
+		result, err := a.qualityAgent.CheckQuality(ctx, &quality.QualityCheckRequest{
+			Project:   project,
+			Chapter:   current,
+			CheckType: "critique",
+			Options:   options.LLMOptions,
+		})
+		if err != nil {
+			record.Error = fmt.Sprintf("评审失败: %v", err)
+			break
+		}
+		record.Score = result.OverallScore
+		record.Critique = result.CritiqueResult
+		if record.Score >= threshold {
+			record.Passed = true
+			gate.Passed = true
+			return current, gate
+		}
+		if attempt > maxRevisions {
+			break
+		}
+
+		record.Feedback = revisionFeedback(result.CritiqueResult)
+		a.updateStatus(ctx, "chapter", progress, fmt.Sprintf("第%d章评分%.2f低于%.2f，正在第%d次改写", current.Index, record.Score, threshold, attempt), "")
+		refined, err := a.chapterAgent.RefineChapter(ctx, &chapter.RefineChapterRequest{
+			Chapter:  current,
+			Feedback: record.Feedback,
+			Options:  options.LLMOptions,
+		})
+		if err != nil {
+			record.Error = fmt.Sprintf("改写失败: %v", err)
+			break
+		}
+		current = refined.Chapter
+	}
+
+	gate.NeedsReview = true
+	current.Status = models.ChapterStatusNeedsReview
+	return current, gate
+}
+
+// revisionFeedback 由评审结果生成改写意见，优先使用改进建议，没有建议时使用发现的问题
+func revisionFeedback(critique *models.CritiqueResult) string {
+	if critique == nil {
+		return "请全面提升章节质量"
+	}
+	feedback := critique.Improvements
+	if len(feedback) == 0 {
+		feedback = append(append(append([]string{}, critique.LogicalIssues...), critique.CharacterIssues...), critique.PacingIssues...)
+	}
+	if len(feedback) == 0 {
+		return "请全面提升章节质量"
+	}
+	return "- " + strings.Join(feedback, "\n- ")
+}
@@ -0,0 +1,166 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"backend/internal/pkg/llm"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// stubCritic 按顺序返回评审分数（1-10分），改写时返回带序号的正文
+type stubCritic struct {
+	scores   []int
+	reviews  int
+	rewrites int
+}
+
+func (c *stubCritic) GenerateText(ctx context.Context, prompt string, opts *llm.GenerateOptions) (string, error) {
+	c.rewrites++
+	return fmt.Sprintf("第%d次改写的正文", c.rewrites), nil
+}
+
+func (c *stubCritic) GenerateJSON(ctx context.Context, prompt string, opts *llm.GenerateOptions) (map[string]interface{}, error) {
+	if c.reviews >= len(c.scores) {
+		return nil, errors.New("critic unavailable")
+	}
+	score := c.scores[c.reviews]
+	c.reviews++
+	return map[string]interface{}{
+		"logical_issues": []interface{}{"动机交代不足"},
+		"improvements":   []interface{}{"加强冲突"},
+		"overall_score":  float64(score),
+	}, nil
+}
+
+func (c *stubCritic) GenerateWithTemplate(ctx context.Context, template string, data map[string]interface{}, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+func TestOrchestratorAgent_ReviewChapter(t *testing.T) {
+	tests := []struct {
+		name         string
+		scores       []int
+		options      *GenerateOptions
+		wantPassed   bool
+		wantAttempts int
+		wantRewrites int
+		wantContent  string
+		wantStatus   string
+	}{
+		{
+			name:         "首次评审通过",
+			scores:       []int{8},
+			options:      &GenerateOptions{},
+			wantPassed:   true,
+			wantAttempts: 1,
+			wantContent:  "初稿",
+			wantStatus:   "draft",
+		},
+		{
+			name:         "改写后恰好达到默认阈值",
+			scores:       []int{5, 7},
+			options:      &GenerateOptions{},
+			wantPassed:   true,
+			wantAttempts: 2,
+			wantRewrites: 1,
+			wantContent:  "第1次改写的正文",
+			wantStatus:   "draft",
+		},
+		{
+			name:         "默认改写次数用尽后交给人工审核",
+			scores:       []int{3, 4, 6},
+			options:      &GenerateOptions{},
+			wantAttempts: 3,
+			wantRewrites: 2,
+			wantContent:  "第2次改写的正文",
+			wantStatus:   models.ChapterStatusNeedsReview,
+		},
+		{
+			name:         "自定义阈值与改写次数",
+			scores:       []int{8, 8},
+			options:      &GenerateOptions{QualityThreshold: 0.9, MaxRevisions: 1},
+			wantAttempts: 2,
+			wantRewrites: 1,
+			wantContent:  "第1次改写的正文",
+			wantStatus:   models.ChapterStatusNeedsReview,
+		},
+		{
+			name:         "评审失败时交给人工审核",
+			options:      &GenerateOptions{},
+			wantAttempts: 1,
+			wantContent:  "初稿",
+			wantStatus:   models.ChapterStatusNeedsReview,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			critic := &stubCritic{scores: tt.scores}
+			agent := NewOrchestratorAgent(critic, log.DefaultLogger)
+			current := &models.Chapter{Index: 3, Title: "雨夜", RawContent: "初稿", Status: "draft"}
+
+			reviewed, gate := agent.reviewChapter(context.Background(), &models.NovelProject{ID: "test-id"}, current, 0.5, tt.options)
+
+			if gate.Passed != tt.wantPassed || gate.NeedsReview == tt.wantPassed {
+				t.Fatalf("Expected passed=%v, got passed=%v needs_review=%v", tt.wantPassed, gate.Passed, gate.NeedsReview)
+			}
+			if len(gate.Attempts) != tt.wantAttempts {
+				t.Fatalf("Expected %d attempts, got %d", tt.wantAttempts, len(gate.Attempts))
+			}
+			if critic.rewrites != tt.wantRewrites {
+				t.Fatalf("Expected %d rewrites, got %d", tt.wantRewrites, critic.rewrites)
+			}
+			if reviewed.RawContent != tt.wantContent {
+				t.Fatalf("Expected content %q, got %q", tt.wantContent, reviewed.RawContent)
+			}
+			if reviewed.Status != tt.wantStatus {
+				t.Fatalf("Expected status %q, got %q", tt.wantStatus, reviewed.Status)
+			}
+			if gate.ChapterIndex != 3 {
+				t.Fatalf("Expected chapter index 3, got %d", gate.ChapterIndex)
+			}
+
+			// 每次未通过且仍可改写的评审都带有改写意见，分数为评审分的十分之一
+			for i, attempt := range gate.Attempts {
+				if attempt.Attempt != i+1 {
+					t.Fatalf("Expected attempt %d, got %d", i+1, attempt.Attempt)
+				}
+				if i < len(tt.scores) && attempt.Score != float64(tt.scores[i])/10 {
+					t.Fatalf("Expected score %.1f, got %.2f", float64(tt.scores[i])/10, attempt.Score)
+				}
+				if i < tt.wantRewrites && attempt.Feedback != "- 加强冲突" {
+					t.Fatalf("Expected revision feedback on attempt %d, got %q", attempt.Attempt, attempt.Feedback)
+				}
+			}
+			if last := gate.Attempts[len(gate.Attempts)-1]; last.Passed != tt.wantPassed {
+				t.Fatalf("Expected last attempt passed=%v", tt.wantPassed)
+			}
+		})
+	}
+}
+
+func TestRevisionFeedback(t *testing.T) {
+	tests := []struct {
+		name     string
+		critique *models.CritiqueResult
+		want     string
+	}{
+		{name: "没有评审结果", want: "请全面提升章节质量"},
+		{name: "优先使用改进建议", critique: &models.CritiqueResult{Improvements: []string{"加强冲突", "收紧节奏"}, LogicalIssues: []string{"动机不足"}}, want: "- 加强冲突\n- 收紧节奏"},
+		{name: "没有建议时使用问题", critique: &models.CritiqueResult{LogicalIssues: []string{"动机不足"}, PacingIssues: []string{"节奏拖沓"}}, want: "- 动机不足\n- 节奏拖沓"},
+		{name: "没有建议也没有问题", critique: &models.CritiqueResult{}, want: "请全面提升章节质量"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := revisionFeedback(tt.critique); got != tt.want {
+				t.Fatalf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"backend/internal/agent/plotthread"
 	"backend/internal/pkg/llm"
 	"backend/internal/pkg/models"
 )
@@ -23,9 +24,10 @@ type GenerateOutlineResponse struct {
 }
 
 type RefineOutlineRequest struct {
-	Outline  *models.Outline      `json:"outline"`
-	Feedback string               `json:"feedback"`
-	Options  *llm.GenerateOptions `json:"options"`
+	Outline     *models.Outline      `json:"outline"`
+	Feedback    string               `json:"feedback"`
+	OpenThreads []*models.PlotThread `json:"open_threads"` // 尚未回收的情节线索，非空时要求在大纲中安排铺垫与回收
+	Options     *llm.GenerateOptions `json:"options"`
 }
 
 type RefineOutlineResponse struct {
@@ -141,6 +143,13 @@ func (a *OutlineAgent) RefineOutline(ctx context.Context, req *RefineOutlineRequ
 			chapter.Index, chapter.Title, chapter.Summary, chapter.Goal)
 	}
 
+	// 尚未回收的情节线索
+	threadsInfo, threadsRequirement := "", ""
+	if len(req.OpenThreads) > 0 {
+		threadsInfo = fmt.Sprintf("\n尚未回收的情节线索：\n%s", plotthread.FormatOpenThreads(req.OpenThreads))
+		threadsRequirement = "\n5. 为尚未回收的情节线索安排铺垫与回收的章节，写入对应章节的 twist_hint 或 important_items"
+	}
+
 	prompt := fmt.Sprintf(`
 请根据以下反馈优化章节大纲：
 
@@ -148,15 +157,15 @@ func (a *OutlineAgent) RefineOutline(ctx context.Context, req *RefineOutlineRequ
 %s
 
 反馈意见：%s
-
+%s
 要求：
 1. 保持章节数量不变
 2. 优化章节间的逻辑连贯性
 3. 加强冲突和转折点
-4. 确保每章都有明确目标
+4. 确保每章都有明确目标%s
 
 请以JSON格式返回优化后的大纲，格式与原始格式相同。
-`, joinStrings(outlineInfo, "\n"), req.Feedback)
+`, joinStrings(outlineInfo, "\n"), req.Feedback, threadsInfo, threadsRequirement)
 
 	jsonResult, err := a.llmClient.GenerateJSON(ctx, prompt, req.Options)
 	if err != nil {
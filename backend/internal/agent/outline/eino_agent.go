@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"backend/internal/agent/plotthread"
 	"backend/internal/pkg/eino"
 	"backend/internal/pkg/models"
 )
@@ -109,6 +110,13 @@ func (a *EinoOutlineAgent) RefineOutline(ctx context.Context, req *RefineOutline
 			chapter.Index, chapter.Title, chapter.Summary, chapter.Goal)
 	}
 
+	// 尚未回收的情节线索
+	threadsInfo, threadsRequirement := "", ""
+	if len(req.OpenThreads) > 0 {
+		threadsInfo = fmt.Sprintf("\n尚未回收的情节线索：\n%s", plotthread.FormatOpenThreads(req.OpenThreads))
+		threadsRequirement = "\n5. 为尚未回收的情节线索安排铺垫与回收的章节，写入对应章节的 twist_hint 或 important_items"
+	}
+
 	prompt := fmt.Sprintf(`
 请根据以下反馈优化章节大纲：
 
@@ -116,15 +124,15 @@ func (a *EinoOutlineAgent) RefineOutline(ctx context.Context, req *RefineOutline
 %s
 
 反馈意见：%s
-
+%s
 要求：
 1. 保持章节数量不变
 2. 优化章节间的逻辑连贯性
 3. 加强冲突和转折点
-4. 确保每章都有明确目标
+4. 确保每章都有明确目标%s
 
 请以JSON格式返回优化后的大纲，格式与原始格式相同。
-`, joinStrings(outlineInfo, "\n"), req.Feedback)
+`, joinStrings(outlineInfo, "\n"), req.Feedback, threadsInfo, threadsRequirement)
 
 	content, err := a.client.GenerateText(ctx, prompt)
 	if err != nil {
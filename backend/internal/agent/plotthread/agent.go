@@ -0,0 +1,397 @@
+package plotthread
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"backend/internal/pkg/llm"
+	"backend/internal/pkg/models"
+)
+
+// 线索检查参数
+const (
+	finalChapters  = 3  // 最后几章视为收尾阶段，此时仍未回收且未计划回收章节的线索视为未回收
+	prepareWindow  = 3  // 提前几章提醒即将到来的转折缺少铺垫
+	maxOpenThreads = 30 // 提示词中最多列出的未回收线索数
+)
+
+// TrackChapterRequest 章节线索跟踪请求
+type TrackChapterRequest struct {
+	Chapter *models.Chapter      `json:"chapter"`
+	Threads []*models.PlotThread `json:"threads"` // 此前记录的全部线索
+	Options *llm.GenerateOptions `json:"options"`
+}
+
+// threadDelta 模型从单章中提取的线索变化
+type threadDelta struct {
+	NewThreads []struct {
+		Kind        string `json:"kind"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"new_threads"`
+	Mentions    []string `json:"mentions"`
+	Resolutions []struct {
+		ThreadID   string `json:"thread_id"`
+		Resolution string `json:"resolution"`
+	} `json:"resolutions"`
+	UnpreparedPayoffs []struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"unprepared_payoffs"`
+}
+
+// PlotThreadAgent 情节线索跟踪 Agent
+// 由大纲的转折点与关键道具生成待回收的线索，每章生成后提取新埋下的伏笔、对已有线索的提及与回收，
+// 并在生成收尾章节前报告未回收的伏笔和缺少铺垫的回收
+type PlotThreadAgent struct {
+	llmClient llm.LLMClient
+}
+
+// NewPlotThreadAgent 创建情节线索跟踪代理
+func NewPlotThreadAgent(llmClient llm.LLMClient) *PlotThreadAgent {
+	return &PlotThreadAgent{
+		llmClient: llmClient,
+	}
+}
+
+// TrackChapter 提取章节中的线索变化，返回合并后的全部线索
+func (a *PlotThreadAgent) TrackChapter(ctx context.Context, req *TrackChapterRequest) ([]*models.PlotThread, error) {
+	if req == nil || req.Chapter == nil {
+		return nil, fmt.Errorf("chapter cannot be nil")
+	}
+	content := models.ChapterContent(req.Chapter)
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("chapter content is empty")
+	}
+
+	prompt := fmt.Sprintf(`
+请阅读第 %d 章《%s》，记录本章的伏笔与线索变化。
+
+此前尚未回收的线索（方括号内为线索ID）：
+%s
+
+本章内容：
+%s
+
+请记录：
+1. new_threads：本章新埋下、需要在后文交代的线索，kind 为 mystery（悬念谜团）、promise（承诺约定）或 clue（线索道具）
+2. mentions：本章再次提及或推进、但没有回收的已有线索ID
+3. resolutions：本章揭晓或兑现的已有线索ID及回收方式
+4. unprepared_payoffs：本章揭晓或兑现、但不对应任何已有线索的情节
+
+请以JSON格式返回：
+{
+  "new_threads": [{"kind": "mystery", "title": "线索名称", "description": "线索内容"}],
+  "mentions": ["线索ID"],
+  "resolutions": [{"thread_id": "线索ID", "resolution": "回收方式"}],
+  "unprepared_payoffs": [{"title": "情节", "description": "揭晓或兑现的内容"}]
+}
+`, req.Chapter.Index, req.Chapter.Title, FormatOpenThreads(req.Threads), content)
+
+	options := req.Options
+	if options == nil {
+		options = llm.PreciseOptions()
+	}
+	jsonResult, err := a.llmClient.GenerateJSON(ctx, prompt, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to track plot threads: %w", err)
+	}
+
+	raw, err := json.Marshal(jsonResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse plot threads: %w", err)
+	}
+	var delta threadDelta
+	if err := json.Unmarshal(raw, &delta); err != nil {
+		return nil, fmt.Errorf("failed to parse plot threads: %w", err)
+	}
+	return mergeThreads(req.Threads, req.Chapter, &delta), nil
+}
+
+// ThreadsFromOutline 由大纲生成线索：关键道具为在首次出现的章节埋下的线索，转折点为计划在该章回收、需要提前铺垫的线索
+// existing 中同一线索的埋设、回收记录保留；已从大纲中删除且尚未回收的大纲线索随之删除
+func ThreadsFromOutline(projectID string, outline *models.Outline, existing []*models.PlotThread) []*models.PlotThread {
+	previous := make(map[string]*models.PlotThread, len(existing))
+	for _, thread := range existing {
+		previous[thread.ID] = thread
+	}
+
+	var threads []*models.PlotThread
+	planned := make(map[string]*models.PlotThread)
+	plan := func(id, kind, title, description string) *models.PlotThread {
+		if thread, ok := planned[id]; ok {
+			return thread
+		}
+		thread := &models.PlotThread{
+			ID:          id,
+			ProjectID:   projectID,
+			Kind:        kind,
+			Title:       title,
+			Description: description,
+			Source:      models.PlotThreadFromOutline,
+			Status:      models.PlotThreadOpen,
+			UpdatedAt:   time.Now(),
+		}
+		if old, ok := previous[id]; ok {
+			thread.PlantedChapters = append([]int(nil), old.PlantedChapters...)
+			thread.ResolvedChapter = old.ResolvedChapter
+			thread.Resolution = old.Resolution
+			thread.Status = old.Status
+		}
+		planned[id] = thread
+		threads = append(threads, thread)
+		return thread
+	}
+
+	if outline != nil {
+		for _, chapter := range outline.Chapters {
+			if chapter == nil {
+				continue
+			}
+			for _, item := range chapter.ImportantItems {
+				if item = strings.TrimSpace(item); item != "" {
+					thread := plan("clue:"+item, models.PlotThreadClue, item, fmt.Sprintf("第%d章大纲中的关键道具/线索", chapter.Index))
+					thread.PlantedChapters = addChapter(thread.PlantedChapters, chapter.Index)
+				}
+			}
+			if hint := strings.TrimSpace(chapter.TwistHint); hint != "" {
+				thread := plan(fmt.Sprintf("twist:%d", chapter.Index), models.PlotThreadTwist, chapter.Title, hint)
+				thread.PayoffChapter = chapter.Index
+			}
+		}
+	}
+
+	for _, thread := range existing {
+		if _, ok := planned[thread.ID]; ok {
+			continue
+		}
+		if thread.Source == models.PlotThreadFromOutline && thread.Status != models.PlotThreadResolved {
+			continue
+		}
+		threads = append(threads, thread)
+	}
+	return threads
+}
+
+// Analyze 检查即将生成第 nextChapter 章时的线索
+// 计划回收章节已过仍未回收、或进入收尾阶段仍未回收的线索报告为未回收；
+// 回收前没有埋下过的线索，以及即将到来但此前没有铺垫的转折报告为缺少铺垫
+func Analyze(projectID string, threads []*models.PlotThread, totalChapters, nextChapter int) *models.PlotThreadReport {
+	report := &models.PlotThreadReport{
+		ProjectID:     projectID,
+		NextChapter:   nextChapter,
+		TotalChapters: totalChapters,
+		OpenThreads:   make([]*models.PlotThread, 0),
+		Issues:        make([]*models.PlotThreadIssue, 0),
+	}
+	finale := IsFinale(totalChapters, nextChapter)
+
+	for _, thread := range threads {
+		if thread.Status == models.PlotThreadResolved {
+			if !thread.PreparedBefore(thread.ResolvedChapter) {
+				report.Issues = append(report.Issues, &models.PlotThreadIssue{
+					ThreadID:     thread.ID,
+					Type:         models.PlotThreadUnprepared,
+					Title:        thread.Title,
+					ChapterIndex: thread.ResolvedChapter,
+					Description:  fmt.Sprintf("第%d章回收的「%s」此前没有铺垫", thread.ResolvedChapter, thread.Title),
+					Suggestion:   fmt.Sprintf("在第%d章之前埋下相关伏笔", thread.ResolvedChapter),
+				})
+			}
+			continue
+		}
+
+		report.OpenThreads = append(report.OpenThreads, thread)
+		switch {
+		case thread.PayoffChapter > 0 && thread.PayoffChapter < nextChapter:
+			report.Issues = append(report.Issues, &models.PlotThreadIssue{
+				ThreadID:     thread.ID,
+				Type:         models.PlotThreadDangling,
+				Title:        thread.Title,
+				ChapterIndex: thread.PayoffChapter,
+				Description:  fmt.Sprintf("计划在第%d章回收的「%s」尚未回收", thread.PayoffChapter, thread.Title),
+				Suggestion:   fmt.Sprintf("在第%d章或后续章节中交代「%s」", thread.PayoffChapter, thread.Title),
+			})
+		case thread.PayoffChapter == 0 && finale && thread.PreparedBefore(nextChapter):
+			report.Issues = append(report.Issues, &models.PlotThreadIssue{
+				ThreadID:     thread.ID,
+				Type:         models.PlotThreadDangling,
+				Title:        thread.Title,
+				ChapterIndex: thread.PlantedChapters[0],
+				Description:  fmt.Sprintf("第%d章埋下的「%s」在故事收尾前仍未回收", thread.PlantedChapters[0], thread.Title),
+				Suggestion:   fmt.Sprintf("在最后%d章中交代「%s」，或删去这条伏笔", totalChapters-nextChapter+1, thread.Title),
+			})
+		case thread.PayoffChapter >= nextChapter && thread.PayoffChapter < nextChapter+prepareWindow && !thread.PreparedBefore(thread.PayoffChapter):
+			report.Issues = append(report.Issues, &models.PlotThreadIssue{
+				ThreadID:     thread.ID,
+				Type:         models.PlotThreadUnprepared,
+				Title:        thread.Title,
+				ChapterIndex: thread.PayoffChapter,
+				Description:  fmt.Sprintf("第%d章的转折「%s」此前没有铺垫", thread.PayoffChapter, thread.Description),
+				Suggestion:   fmt.Sprintf("在第%d章之前埋下相关伏笔", thread.PayoffChapter),
+			})
+		}
+	}
+
+	sortThreads(report.OpenThreads)
+	sort.SliceStable(report.Issues, func(i, j int) bool { return report.Issues[i].ChapterIndex < report.Issues[j].ChapterIndex })
+	return report
+}
+
+// IsFinale 判断第 chapterIndex 章是否属于收尾阶段
+func IsFinale(totalChapters, chapterIndex int) bool {
+	return totalChapters > 0 && chapterIndex > totalChapters-finalChapters
+}
+
+// FormatOpenThreads 将尚未回收的线索格式化为提示词文本
+func FormatOpenThreads(threads []*models.PlotThread) string {
+	var b strings.Builder
+	count := 0
+	for _, thread := range threads {
+		if thread.Status == models.PlotThreadResolved {
+			continue
+		}
+		if count == maxOpenThreads {
+			b.WriteString("- ……\n")
+			break
+		}
+		count++
+		fmt.Fprintf(&b, "- [%s] %s：%s", thread.ID, thread.Title, thread.Description)
+		if len(thread.PlantedChapters) > 0 {
+			fmt.Fprintf(&b, "（第%s章埋下", joinChapters(thread.PlantedChapters))
+		} else {
+			b.WriteString("（尚未铺垫")
+		}
+		if thread.PayoffChapter > 0 {
+			fmt.Fprintf(&b, "，计划第%d章回收", thread.PayoffChapter)
+		}
+		b.WriteString("）\n")
+	}
+	if count == 0 {
+		return "暂无"
+	}
+	return b.String()
+}
+
+// mergeThreads 将本章的线索变化合并到已有线索上，同一章重复跟踪时结果不变
+func mergeThreads(previous []*models.PlotThread, chapter *models.Chapter, delta *threadDelta) []*models.PlotThread {
+	now := time.Now()
+	threads := make([]*models.PlotThread, 0, len(previous)+len(delta.NewThreads))
+	byID := make(map[string]*models.PlotThread, len(previous))
+	byTitle := make(map[string]*models.PlotThread, len(previous))
+	for _, thread := range previous {
+		copied := *thread
+		copied.PlantedChapters = append([]int(nil), thread.PlantedChapters...)
+		threads = append(threads, &copied)
+		byID[copied.ID] = &copied
+		byTitle[copied.Title] = &copied
+	}
+
+	for _, id := range delta.Mentions {
+		if thread, ok := byID[strings.TrimSpace(id)]; ok {
+			thread.PlantedChapters = addChapter(thread.PlantedChapters, chapter.Index)
+			thread.UpdatedAt = now
+		}
+	}
+
+	for _, resolution := range delta.Resolutions {
+		thread, ok := byID[strings.TrimSpace(resolution.ThreadID)]
+		if !ok || (thread.Status == models.PlotThreadResolved && thread.ResolvedChapter != chapter.Index) {
+			continue
+		}
+		thread.Status = models.PlotThreadResolved
+		thread.ResolvedChapter = chapter.Index
+		thread.Resolution = strings.TrimSpace(resolution.Resolution)
+		thread.UpdatedAt = now
+	}
+
+	for i, item := range delta.NewThreads {
+		title := strings.TrimSpace(item.Title)
+		if title == "" {
+			continue
+		}
+		if thread, ok := byTitle[title]; ok {
+			thread.PlantedChapters = addChapter(thread.PlantedChapters, chapter.Index)
+			continue
+		}
+		kind := item.Kind
+		switch kind {
+		case models.PlotThreadMystery, models.PlotThreadPromise, models.PlotThreadClue:
+		default:
+			kind = models.PlotThreadMystery
+		}
+		thread := &models.PlotThread{
+			ID:              fmt.Sprintf("pt_%d_%d_%d", now.UnixNano(), chapter.Index, i),
+			ProjectID:       chapter.ProjectID,
+			Kind:            kind,
+			Title:           title,
+			Description:     strings.TrimSpace(item.Description),
+			Source:          models.PlotThreadFromChapter,
+			PlantedChapters: []int{chapter.Index},
+			Status:          models.PlotThreadOpen,
+			UpdatedAt:       now,
+		}
+		threads = append(threads, thread)
+		byTitle[title] = thread
+	}
+
+	// 不对应任何线索的回收记为本章埋下并回收，检查时报告为缺少铺垫
+	for i, item := range delta.UnpreparedPayoffs {
+		title := strings.TrimSpace(item.Title)
+		if title == "" || byTitle[title] != nil {
+			continue
+		}
+		thread := &models.PlotThread{
+			ID:              fmt.Sprintf("pt_%d_%d_payoff_%d", now.UnixNano(), chapter.Index, i),
+			ProjectID:       chapter.ProjectID,
+			Kind:            models.PlotThreadTwist,
+			Title:           title,
+			Description:     strings.TrimSpace(item.Description),
+			Source:          models.PlotThreadFromChapter,
+			ResolvedChapter: chapter.Index,
+			Resolution:      strings.TrimSpace(item.Description),
+			Status:          models.PlotThreadResolved,
+			UpdatedAt:       now,
+		}
+		threads = append(threads, thread)
+		byTitle[title] = thread
+	}
+
+	return threads
+}
+
+// sortThreads 按埋下或计划回收的章节排序
+func sortThreads(threads []*models.PlotThread) {
+	first := func(thread *models.PlotThread) int {
+		if len(thread.PlantedChapters) > 0 {
+			return thread.PlantedChapters[0]
+		}
+		return thread.PayoffChapter
+	}
+	sort.SliceStable(threads, func(i, j int) bool { return first(threads[i]) < first(threads[j]) })
+}
+
+// addChapter 将章节索引加入升序列表，已存在时不重复加入
+func addChapter(chapters []int, index int) []int {
+	i := sort.SearchInts(chapters, index)
+	if i < len(chapters) && chapters[i] == index {
+		return chapters
+	}
+	chapters = append(chapters, 0)
+	copy(chapters[i+1:], chapters[i:])
+	chapters[i] = index
+	return chapters
+}
+
+// joinChapters 用顿号连接章节索引
+func joinChapters(chapters []int) string {
+	parts := make([]string, len(chapters))
+	for i, index := range chapters {
+		parts[i] = fmt.Sprint(index)
+	}
+	return strings.Join(parts, "、")
+}
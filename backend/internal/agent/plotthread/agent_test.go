@@ -0,0 +1,319 @@
+package plotthread
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"backend/internal/pkg/llm"
+	"backend/internal/pkg/models"
+)
+
+// stubTracker 返回固定的线索变化，并记录收到的提示词
+type stubTracker struct {
+	delta  map[string]interface{}
+	err    error
+	prompt string
+}
+
+func (s *stubTracker) GenerateText(ctx context.Context, prompt string, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+func (s *stubTracker) GenerateJSON(ctx context.Context, prompt string, opts *llm.GenerateOptions) (map[string]interface{}, error) {
+	s.prompt = prompt
+	return s.delta, s.err
+}
+
+func (s *stubTracker) GenerateWithTemplate(ctx context.Context, template string, data map[string]interface{}, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+// threadStates 线索的标题、状态与埋下、回收章节，便于整体比较
+func threadStates(threads []*models.PlotThread) []string {
+	states := make([]string, 0, len(threads))
+	for _, thread := range threads {
+		state := thread.Title + "/" + thread.Kind + "/" + thread.Status
+		if len(thread.PlantedChapters) > 0 {
+			state += "/埋下" + joinChapters(thread.PlantedChapters)
+		}
+		if thread.ResolvedChapter > 0 {
+			state += "/回收" + joinChapters([]int{thread.ResolvedChapter})
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+func TestPlotThreadAgent_TrackChapter(t *testing.T) {
+	previous := []*models.PlotThread{
+		{ID: "t1", Kind: models.PlotThreadMystery, Title: "黑衣人", Status: models.PlotThreadOpen, PlantedChapters: []int{1}},
+		{ID: "t2", Kind: models.PlotThreadPromise, Title: "三年之约", Status: models.PlotThreadOpen, PlantedChapters: []int{2}},
+		{ID: "t3", Kind: models.PlotThreadClue, Title: "玉佩", Status: models.PlotThreadResolved, PlantedChapters: []int{1}, ResolvedChapter: 3},
+	}
+	chapter := &models.Chapter{ProjectID: "test-id", Index: 4, Title: "雨夜", RawContent: "林晚推开山门。"}
+
+	tests := []struct {
+		name  string
+		delta map[string]interface{}
+		want  []string
+	}{
+		{
+			name:  "没有变化",
+			delta: map[string]interface{}{},
+			want:  []string{"黑衣人/mystery/open/埋下1", "三年之约/promise/open/埋下2", "玉佩/clue/resolved/埋下1/回收3"},
+		},
+		{
+			name: "新埋下的线索，未知类型按悬念处理",
+			delta: map[string]interface{}{"new_threads": []interface{}{
+				map[string]interface{}{"kind": "clue", "title": " 断剑 ", "description": "剑上的缺口"},
+				map[string]interface{}{"kind": "omen", "title": "雨夜的钟声"},
+				map[string]interface{}{"kind": "clue", "title": ""},
+			}},
+			want: []string{
+				"黑衣人/mystery/open/埋下1", "三年之约/promise/open/埋下2", "玉佩/clue/resolved/埋下1/回收3",
+				"断剑/clue/open/埋下4", "雨夜的钟声/mystery/open/埋下4",
+			},
+		},
+		{
+			name: "提及推进已有线索，同名的新线索并入已有线索",
+			delta: map[string]interface{}{
+				"mentions":    []interface{}{"t1", "unknown"},
+				"new_threads": []interface{}{map[string]interface{}{"kind": "promise", "title": "三年之约"}},
+			},
+			want: []string{"黑衣人/mystery/open/埋下1、4", "三年之约/promise/open/埋下2、4", "玉佩/clue/resolved/埋下1/回收3"},
+		},
+		{
+			name: "回收已有线索，已在其他章节回收的不再改写",
+			delta: map[string]interface{}{"resolutions": []interface{}{
+				map[string]interface{}{"thread_id": "t2", "resolution": "如约归来"},
+				map[string]interface{}{"thread_id": "t3", "resolution": "再次回收"},
+			}},
+			want: []string{"黑衣人/mystery/open/埋下1", "三年之约/promise/resolved/埋下2/回收4", "玉佩/clue/resolved/埋下1/回收3"},
+		},
+		{
+			name: "不对应任何线索的回收记为本章回收的转折",
+			delta: map[string]interface{}{"unprepared_payoffs": []interface{}{
+				map[string]interface{}{"title": "师父是卧底", "description": "师父原是魔教中人"},
+				map[string]interface{}{"title": "黑衣人", "description": "已有线索"},
+			}},
+			want: []string{
+				"黑衣人/mystery/open/埋下1", "三年之约/promise/open/埋下2", "玉佩/clue/resolved/埋下1/回收3",
+				"师父是卧底/twist/resolved/回收4",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := &stubTracker{delta: tt.delta}
+			agent := NewPlotThreadAgent(tracker)
+
+			threads, err := agent.TrackChapter(context.Background(), &TrackChapterRequest{Chapter: chapter, Threads: previous})
+			if err != nil {
+				t.Fatalf("TrackChapter failed: %v", err)
+			}
+			if got := threadStates(threads); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+			// 提示词只列出未回收的线索，原有线索不被修改
+			if !strings.Contains(tracker.prompt, "[t1] 黑衣人") || strings.Contains(tracker.prompt, "[t3]") {
+				t.Fatalf("Expected prompt to list only open threads, got %q", tracker.prompt)
+			}
+			if previous[0].Status != models.PlotThreadOpen || len(previous[0].PlantedChapters) != 1 || previous[1].Status != models.PlotThreadOpen {
+				t.Fatalf("Expected previous threads to be left unchanged")
+			}
+			for _, thread := range threads[len(previous):] {
+				if thread.ProjectID != "test-id" || thread.Source != models.PlotThreadFromChapter || thread.ID == "" {
+					t.Fatalf("Expected new thread from chapter with an ID, got %+v", thread)
+				}
+			}
+		})
+	}
+}
+
+func TestPlotThreadAgent_TrackChapter_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *TrackChapterRequest
+		llmErr  error
+		wantErr string
+	}{
+		{name: "没有章节", req: &TrackChapterRequest{}, wantErr: "chapter cannot be nil"},
+		{name: "正文为空", req: &TrackChapterRequest{Chapter: &models.Chapter{Index: 1, RawContent: "  "}}, wantErr: "chapter content is empty"},
+		{name: "模型失败", req: &TrackChapterRequest{Chapter: &models.Chapter{Index: 1, RawContent: "正文"}}, llmErr: errors.New("timeout"), wantErr: "failed to track plot threads"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewPlotThreadAgent(&stubTracker{err: tt.llmErr})
+			if _, err := agent.TrackChapter(context.Background(), tt.req); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestThreadsFromOutline(t *testing.T) {
+	outline := &models.Outline{Chapters: []*models.ChapterOutline{
+		{Index: 1, Title: "下山", ImportantItems: []string{"玉佩", " "}},
+		{Index: 3, Title: "断桥", ImportantItems: []string{"玉佩"}, TwistHint: "师兄叛变"},
+	}}
+	existing := []*models.PlotThread{
+		{ID: "clue:玉佩", Title: "玉佩", Source: models.PlotThreadFromOutline, Status: models.PlotThreadResolved, PlantedChapters: []int{1, 2}, ResolvedChapter: 4, Resolution: "玉佩碎了"},
+		{ID: "twist:5", Title: "旧转折", Source: models.PlotThreadFromOutline, Status: models.PlotThreadOpen, PayoffChapter: 5},
+		{ID: "twist:6", Title: "已回收的旧转折", Source: models.PlotThreadFromOutline, Status: models.PlotThreadResolved, ResolvedChapter: 6},
+		{ID: "pt_1", Title: "黑衣人", Source: models.PlotThreadFromChapter, Status: models.PlotThreadOpen, PlantedChapters: []int{2}},
+	}
+
+	threads := ThreadsFromOutline("test-id", outline, existing)
+
+	// 大纲线索保留已有的埋设与回收记录；从大纲删除且未回收的转折随之删除
+	want := []string{
+		"玉佩/clue/resolved/埋下1、2、3/回收4",
+		"断桥/twist/open",
+		"已回收的旧转折//resolved/回收6",
+		"黑衣人//open/埋下2",
+	}
+	if got := threadStates(threads); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	if threads[1].ID != "twist:3" || threads[1].PayoffChapter != 3 || threads[1].Description != "师兄叛变" {
+		t.Fatalf("Expected twist planned for chapter 3, got %+v", threads[1])
+	}
+	if threads[0].Resolution != "玉佩碎了" || threads[0].ProjectID != "test-id" {
+		t.Fatalf("Expected resolution and project to be kept, got %+v", threads[0])
+	}
+	if existing[0].PlantedChapters[len(existing[0].PlantedChapters)-1] != 2 {
+		t.Fatalf("Expected existing threads to be left unchanged")
+	}
+
+	if threads := ThreadsFromOutline("test-id", nil, nil); len(threads) != 0 {
+		t.Fatalf("Expected no threads without an outline, got %d", len(threads))
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name       string
+		thread     *models.PlotThread
+		total      int
+		next       int
+		wantOpen   bool
+		wantIssues []string
+	}{
+		{
+			name:     "已埋下尚未到回收时机",
+			thread:   &models.PlotThread{ID: "t1", Title: "黑衣人", Status: models.PlotThreadOpen, PlantedChapters: []int{1}},
+			total:    20,
+			next:     5,
+			wantOpen: true,
+		},
+		{
+			name:     "多次推进仍在计划回收章节之前",
+			thread:   &models.PlotThread{ID: "t1", Title: "三年之约", Status: models.PlotThreadOpen, PlantedChapters: []int{1, 3, 4}, PayoffChapter: 10},
+			total:    20,
+			next:     5,
+			wantOpen: true,
+		},
+		{
+			name:   "铺垫后回收",
+			thread: &models.PlotThread{ID: "t1", Title: "玉佩", Status: models.PlotThreadResolved, PlantedChapters: []int{1}, ResolvedChapter: 4},
+			total:  20,
+			next:   5,
+		},
+		{
+			name:       "没有铺垫的回收",
+			thread:     &models.PlotThread{ID: "t1", Title: "师父是卧底", Status: models.PlotThreadResolved, ResolvedChapter: 4},
+			total:      20,
+			next:       5,
+			wantIssues: []string{"unprepared 第4章：第4章回收的「师父是卧底」此前没有铺垫"},
+		},
+		{
+			name:       "计划回收章节已过仍未回收",
+			thread:     &models.PlotThread{ID: "t1", Title: "三年之约", Status: models.PlotThreadOpen, PlantedChapters: []int{1}, PayoffChapter: 4},
+			total:      20,
+			next:       5,
+			wantOpen:   true,
+			wantIssues: []string{"dangling 第4章：计划在第4章回收的「三年之约」尚未回收"},
+		},
+		{
+			name:       "进入收尾阶段仍未回收",
+			thread:     &models.PlotThread{ID: "t1", Title: "黑衣人", Status: models.PlotThreadOpen, PlantedChapters: []int{2}},
+			total:      20,
+			next:       18,
+			wantOpen:   true,
+			wantIssues: []string{"dangling 第2章：第2章埋下的「黑衣人」在故事收尾前仍未回收"},
+		},
+		{
+			name:       "即将到来的转折没有铺垫",
+			thread:     &models.PlotThread{ID: "twist:7", Title: "断桥", Description: "师兄叛变", Status: models.PlotThreadOpen, PayoffChapter: 7},
+			total:      20,
+			next:       5,
+			wantOpen:   true,
+			wantIssues: []string{"unprepared 第7章：第7章的转折「师兄叛变」此前没有铺垫"},
+		},
+		{
+			name:     "较远的转折暂不提醒",
+			thread:   &models.PlotThread{ID: "twist:12", Title: "断桥", Description: "师兄叛变", Status: models.PlotThreadOpen, PayoffChapter: 12},
+			total:    20,
+			next:     5,
+			wantOpen: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := Analyze("test-id", []*models.PlotThread{tt.thread}, tt.total, tt.next)
+			if (len(report.OpenThreads) == 1) != tt.wantOpen {
+				t.Fatalf("Expected open=%v, got %d open threads", tt.wantOpen, len(report.OpenThreads))
+			}
+			issues := make([]string, 0, len(report.Issues))
+			for _, issue := range report.Issues {
+				issues = append(issues, issue.Type+" 第"+joinChapters([]int{issue.ChapterIndex})+"章："+issue.Description)
+			}
+			if len(issues) != len(tt.wantIssues) || (len(issues) > 0 && !reflect.DeepEqual(issues, tt.wantIssues)) {
+				t.Fatalf("Expected issues %v, got %v", tt.wantIssues, issues)
+			}
+		})
+	}
+}
+
+func TestFormatOpenThreads(t *testing.T) {
+	threads := []*models.PlotThread{
+		{ID: "t1", Title: "黑衣人", Description: "雨夜出现", Status: models.PlotThreadOpen, PlantedChapters: []int{1, 3}},
+		{ID: "t2", Title: "玉佩", Description: "碎了", Status: models.PlotThreadResolved, PlantedChapters: []int{1}},
+		{ID: "twist:7", Title: "断桥", Description: "师兄叛变", Status: models.PlotThreadOpen, PayoffChapter: 7},
+	}
+
+	want := "- [t1] 黑衣人：雨夜出现（第1、3章埋下）\n- [twist:7] 断桥：师兄叛变（尚未铺垫，计划第7章回收）\n"
+	if got := FormatOpenThreads(threads); got != want {
+		t.Fatalf("Expected %q, got %q", want, got)
+	}
+	if got := FormatOpenThreads(threads[1:2]); got != "暂无" {
+		t.Fatalf("Expected 暂无 when all threads are resolved, got %q", got)
+	}
+}
+
+func TestAddChapter(t *testing.T) {
+	tests := []struct {
+		name     string
+		chapters []int
+		index    int
+		want     []int
+	}{
+		{name: "空列表", index: 3, want: []int{3}},
+		{name: "插入中间", chapters: []int{1, 5}, index: 3, want: []int{1, 3, 5}},
+		{name: "已存在", chapters: []int{1, 3}, index: 3, want: []int{1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := addChapter(tt.chapters, tt.index); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
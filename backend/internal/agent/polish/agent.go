@@ -99,7 +99,8 @@ func (a *PolishAgent) PolishChapter(ctx context.Context, req *PolishChapterReque
 		PolishedContent: polishedContent,
 		Summary:         req.Chapter.Summary,
 		WordCount:       len([]rune(strings.ReplaceAll(polishedContent, " ", ""))),
-		Status:          "polished",
+		Status:          models.ChapterStatusPolished,
+		Version:         req.Chapter.Version,
 	}
 
 	return &PolishChapterResponse{
@@ -3,9 +3,11 @@ package quality
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"backend/internal/pkg/llm"
 	"backend/internal/pkg/models"
+	"backend/internal/pkg/textmetrics"
 	"backend/internal/agent/polish"
 	"backend/internal/agent/consistency"
 )
@@ -14,7 +16,7 @@ import (
 type QualityCheckRequest struct {
 	Chapter   *models.Chapter      `json:"chapter"`
 	Project   *models.NovelProject `json:"project"`
-	CheckType string               `json:"check_type"` // polish/proofread/critique/all
+	CheckType string               `json:"check_type"` // polish/proofread/critique/consistency/metrics/all
 	Options   *llm.GenerateOptions `json:"options"`
 }
 
@@ -25,6 +27,8 @@ type QualityCheckResponse struct {
 	ConsistencyIssues  []consistency.ConsistencyIssue `json:"consistency_issues,omitempty"`
 	OverallScore       float64              `json:"overall_score"`
 	Recommendations    []string             `json:"recommendations"`
+	Metrics            *textmetrics.Metrics `json:"metrics,omitempty"` // 被检测正文的客观指标
+	Repetitions        []*RepetitionPair    `json:"repetitions,omitempty"` // 与其他章节相似的段落，仅重复检测返回
 }
 
 type ProofreadResult struct {
@@ -34,12 +38,13 @@ type ProofreadResult struct {
 }
 
 type Issue struct {
-	Type        string `json:"type"`        // grammar/punctuation/spelling/style
-	Severity    string `json:"severity"`    // high/medium/low
-	Description string `json:"description"`
-	Position    string `json:"position"`    // 位置描述
-	Original    string `json:"original"`    // 原文
-	Corrected   string `json:"corrected"`   // 修正后
+	Type        string           `json:"type"`     // grammar/punctuation/spelling/style
+	Severity    string           `json:"severity"` // high/medium/low
+	Description string           `json:"description"`
+	Position    string           `json:"position"`       // 位置描述
+	Original    string           `json:"original"`       // 原文
+	Corrected   string           `json:"corrected"`      // 修正后
+	Span        *models.TextSpan `json:"span,omitempty"` // 原文在正文中的位置，校验后填写
 }
 
 type BatchQualityCheckRequest struct {
@@ -53,6 +58,7 @@ type BatchQualityCheckResponse struct {
 	Results      []*QualityCheckResponse `json:"results"`
 	Summary      *QualitySummary         `json:"summary"`
 	OverallScore float64                 `json:"overall_score"`
+	Repetitions  []*RepetitionPair       `json:"repetitions,omitempty"` // 重复检测发现的全部相似段落
 }
 
 type QualitySummary struct {
@@ -120,6 +126,10 @@ func (a *QualityAgent) CheckQuality(ctx context.Context, req *QualityCheckReques
 		response.ConsistencyIssues = result
 		response.OverallScore = a.calculateConsistencyScore(result)
 
+	case "metrics":
+		response.Metrics = textmetrics.Analyze(models.ChapterContent(req.Chapter))
+		response.OverallScore = response.Metrics.Score
+
 	case "all":
 		return a.comprehensiveQualityCheck(ctx, req)
 
@@ -127,6 +137,15 @@ func (a *QualityAgent) CheckQuality(ctx context.Context, req *QualityCheckReques
 		return nil, fmt.Errorf("unsupported check type: %s", req.CheckType)
 	}
 
+	// 客观指标不依赖模型，每种检测都附带
+	if response.Metrics == nil {
+		checked := req.Chapter
+		if response.PolishedChapter != nil {
+			checked = response.PolishedChapter
+		}
+		response.Metrics = textmetrics.Analyze(models.ChapterContent(checked))
+	}
+
 	// 生成建议
 	response.Recommendations = a.generateRecommendations(response)
 
@@ -135,6 +154,11 @@ func (a *QualityAgent) CheckQuality(ctx context.Context, req *QualityCheckReques
 
 // BatchCheckQuality 批量检查质量
 func (a *QualityAgent) BatchCheckQuality(ctx context.Context, req *BatchQualityCheckRequest) (*BatchQualityCheckResponse, error) {
+	// 重复检测需要同时比较所有章节，不逐章调用模型
+	if req.CheckType == CheckTypeRepetition {
+		return a.checkRepetition(req), nil
+	}
+
 	results := make([]*QualityCheckResponse, len(req.Chapters))
 	totalScore := 0.0
 
@@ -229,8 +253,9 @@ func (a *QualityAgent) proofreadChapter(ctx context.Context, req *QualityCheckRe
 		return nil, fmt.Errorf("failed to proofread chapter: %w", err)
 	}
 
-	// 解析结果
+	// 解析结果，逐条在正文中校验原文，按顺序查找使重复出现的原文对应到各自的位置
 	issues := make([]Issue, 0)
+	hint := 0
 	if issuesData, ok := jsonResult["issues"].([]interface{}); ok {
 		for _, issueData := range issuesData {
 			if issueMap, ok := issueData.(map[string]interface{}); ok {
@@ -242,6 +267,12 @@ func (a *QualityAgent) proofreadChapter(ctx context.Context, req *QualityCheckRe
 					Original:    getStringFromMap(issueMap, "original"),
 					Corrected:   getStringFromMap(issueMap, "corrected"),
 				}
+				if issue.Original != "" {
+					issue.Span = models.LocateSpan(req.Chapter, issue.Original, hint)
+					if issue.Span.Verified {
+						hint = issue.Span.End
+					}
+				}
 				issues = append(issues, issue)
 			}
 		}
@@ -282,7 +313,10 @@ func (a *QualityAgent) critiqueChapter(ctx context.Context, req *QualityCheckReq
   "pacing_issues": ["节奏问题1", "节奏问题2"],
   "improvements": ["改进建议1", "改进建议2"],
   "fixed_example": "修订示例（50-150字）",
-  "overall_score": 8
+  "overall_score": 8,
+  "evidence": [
+    {"category": "logical/character/pacing", "issue": "对应的问题", "quote": "能说明问题的原文，逐字摘录"}
+  ]
 }
 
 评分标准（1-10分）：
@@ -305,9 +339,35 @@ func (a *QualityAgent) critiqueChapter(ctx context.Context, req *QualityCheckReq
 		Improvements:    getStringArrayFromMap(jsonResult, "improvements"),
 		FixedExample:    getStringFromMap(jsonResult, "fixed_example"),
 		OverallScore:    getIntFromMap(jsonResult, "overall_score"),
+		Evidence:        parseCritiqueEvidence(jsonResult["evidence"], req.Chapter),
 	}, nil
 }
 
+// parseCritiqueEvidence 解析审查问题的原文依据并在正文中校验
+func parseCritiqueEvidence(data interface{}, chapter *models.Chapter) []*models.CritiqueEvidence {
+	items, ok := data.([]interface{})
+	if !ok {
+		return nil
+	}
+	evidence := make([]*models.CritiqueEvidence, 0, len(items))
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		quote := getStringFromMap(itemMap, "quote")
+		if quote == "" {
+			continue
+		}
+		evidence = append(evidence, &models.CritiqueEvidence{
+			Category: getStringFromMap(itemMap, "category"),
+			Issue:    getStringFromMap(itemMap, "issue"),
+			Span:     models.LocateSpan(chapter, quote, -1),
+		})
+	}
+	return evidence
+}
+
 // checkConsistency 检查一致性
 func (a *QualityAgent) checkConsistency(ctx context.Context, req *QualityCheckRequest) ([]consistency.ConsistencyIssue, error) {
 	consistencyReq := &consistency.CheckConsistencyRequest{
@@ -364,6 +424,9 @@ func (a *QualityAgent) comprehensiveQualityCheck(ctx context.Context, req *Quali
 	}
 	response.ConsistencyIssues = consistencyIssues
 
+	// 5. 润色后正文的客观指标
+	response.Metrics = textmetrics.Analyze(models.ChapterContent(polishedChapter))
+
 	// 计算综合评分
 	response.OverallScore = a.calculateOverallScore(response)
 
@@ -374,23 +437,18 @@ func (a *QualityAgent) comprehensiveQualityCheck(ctx context.Context, req *Quali
 }
 
 // 计算评分的辅助方法
+// calculatePolishScore 以润色后正文的客观指标评分，指标比润色前变差时按变差的幅度扣分
 func (a *QualityAgent) calculatePolishScore(original, polished *models.Chapter) float64 {
-	// 基于内容改进程度计算评分
-	originalLen := len(original.RawContent)
-	polishedLen := len(polished.PolishedContent)
-	
-	if originalLen == 0 {
+	if models.ChapterContent(polished) == "" {
 		return 0.5
 	}
-	
-	// 简单的改进度评估
-	improvementRatio := float64(polishedLen) / float64(originalLen)
-	if improvementRatio > 0.8 && improvementRatio < 1.2 {
-		return 0.8 // 适度改进
-	} else if improvementRatio > 1.2 {
-		return 0.9 // 显著改进
-	}
-	return 0.6 // 改进有限
+
+	before := textmetrics.Analyze(models.ChapterContent(original)).Score
+	after := textmetrics.Analyze(models.ChapterContent(polished)).Score
+	if after < before {
+		return math.Max(0, after-(before-after))
+	}
+	return after
 }
 
 func (a *QualityAgent) calculateProofreadScore(result *ProofreadResult) float64 {
@@ -462,8 +520,9 @@ func (a *QualityAgent) calculateConsistencyScore(issues []consistency.Consistenc
 func (a *QualityAgent) calculateOverallScore(response *QualityCheckResponse) float64 {
 	scores := make([]float64, 0)
 	
-	if response.PolishedChapter != nil {
-		scores = append(scores, 0.8) // 润色基础分
+	// 润色效果由润色后正文的客观指标体现
+	if response.Metrics != nil {
+		scores = append(scores, response.Metrics.Score)
 	}
 	
 	if response.ProofreadResult != nil {
@@ -507,6 +566,10 @@ func (a *QualityAgent) generateRecommendations(response *QualityCheckResponse) [
 		recommendations = append(recommendations, fmt.Sprintf("发现%d个一致性问题，建议检查人物设定和情节逻辑", len(response.ConsistencyIssues)))
 	}
 	
+	if response.Metrics != nil {
+		recommendations = append(recommendations, response.Metrics.Suggestions()...)
+	}
+	
 	if response.OverallScore < 0.6 {
 		recommendations = append(recommendations, "整体质量偏低，建议进行全面修改")
 	} else if response.OverallScore > 0.8 {
@@ -0,0 +1,291 @@
+package quality
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"unicode"
+
+	"backend/internal/pkg/models"
+)
+
+// CheckTypeRepetition 跨章节重复检测，只能用于批量检测
+const CheckTypeRepetition = "repetition"
+
+// 重复检测参数
+const (
+	shingleSize         = 5   // 指纹片段的字数
+	minPassageLength    = 15  // 参与比较的段落最少字数（不含标点空白）
+	minHashSize         = 64  // MinHash 签名长度
+	lshRows             = 4   // LSH 每个分段的签名行数，共 minHashSize/lshRows 段
+	repetitionThreshold = 0.5 // 判定为重复的最低相似度
+)
+
+// RepetitionPair 两个章节中相似的一对段落
+type RepetitionPair struct {
+	Source     *models.TextSpan `json:"source"`     // 较早章节中的段落
+	Target     *models.TextSpan `json:"target"`     // 较晚章节中的段落
+	Similarity float64          `json:"similarity"` // 指纹片段集合的 Jaccard 相似度 0-1
+}
+
+// passage 参与比较的段落
+type passage struct {
+	chapter   int                 // 章节在列表中的下标
+	start     int                 // 段落在正文中的起始偏移（含）
+	end       int                 // 段落在正文中的结束偏移（不含）
+	shingles  map[uint64]struct{} // 指纹片段的哈希
+	signature []uint64            // MinHash 签名
+}
+
+// minHashSeeds MinHash 各行使用的种子
+var minHashSeeds = func() []uint64 {
+	seeds := make([]uint64, minHashSize)
+	for i := range seeds {
+		seeds[i] = mix64(uint64(i) + 1)
+	}
+	return seeds
+}()
+
+// DetectRepetitions 查找不同章节之间近似重复的段落
+// 段落去掉标点空白后按字切成指纹片段，先用 MinHash 与 LSH 找出候选段落对，再按片段集合的 Jaccard 相似度确认
+func DetectRepetitions(chapters []*models.Chapter) []*RepetitionPair {
+	var passages []*passage
+	for i, chapter := range chapters {
+		if chapter != nil {
+			passages = append(passages, splitPassages(i, models.ChapterContent(chapter))...)
+		}
+	}
+
+	// 签名在任一分段上完全相同的段落成为候选
+	buckets := make(map[uint64][]int)
+	for id, p := range passages {
+		for band := 0; band < minHashSize/lshRows; band++ {
+			key := bandKey(band, p.signature[band*lshRows:(band+1)*lshRows])
+			buckets[key] = append(buckets[key], id)
+		}
+	}
+	candidates := make(map[[2]int]bool)
+	for _, ids := range buckets {
+		for i := 0; i < len(ids); i++ {
+			for j := i + 1; j < len(ids); j++ {
+				if passages[ids[i]].chapter != passages[ids[j]].chapter {
+					candidates[[2]int{ids[i], ids[j]}] = true
+				}
+			}
+		}
+	}
+
+	var pairs []*RepetitionPair
+	for candidate := range candidates {
+		source, target := passages[candidate[0]], passages[candidate[1]]
+		similarity := jaccard(source.shingles, target.shingles)
+		if similarity < repetitionThreshold {
+			continue
+		}
+		if target.chapter < source.chapter {
+			source, target = target, source
+		}
+		pairs = append(pairs, &RepetitionPair{
+			Source:     passageSpan(chapters[source.chapter], source),
+			Target:     passageSpan(chapters[target.chapter], target),
+			Similarity: similarity,
+		})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Similarity != pairs[j].Similarity {
+			return pairs[i].Similarity > pairs[j].Similarity
+		}
+		if pairs[i].Source.ChapterID != pairs[j].Source.ChapterID {
+			return pairs[i].Source.ChapterID < pairs[j].Source.ChapterID
+		}
+		return pairs[i].Source.Start < pairs[j].Source.Start
+	})
+	return pairs
+}
+
+// checkRepetition 批量检测各章节之间的重复段落，章节得分为未重复正文所占的比例
+func (a *QualityAgent) checkRepetition(req *BatchQualityCheckRequest) *BatchQualityCheckResponse {
+	pairs := DetectRepetitions(req.Chapters)
+
+	results := make([]*QualityCheckResponse, len(req.Chapters))
+	totalScore := 0.0
+	for i, chapter := range req.Chapters {
+		result := &QualityCheckResponse{Recommendations: make([]string, 0)}
+		var spans []*models.TextSpan
+		others := make(map[string]int)
+		for _, pair := range pairs {
+			switch chapter.ID {
+			case pair.Source.ChapterID:
+				spans = append(spans, pair.Source)
+				others[pair.Target.ChapterID]++
+			case pair.Target.ChapterID:
+				spans = append(spans, pair.Target)
+				others[pair.Source.ChapterID]++
+			default:
+				continue
+			}
+			result.Repetitions = append(result.Repetitions, pair)
+		}
+
+		result.OverallScore = 1.0
+		if length := len([]rune(models.ChapterContent(chapter))); length > 0 {
+			result.OverallScore = 1 - float64(coveredLength(spans))/float64(length)
+		}
+		for _, other := range req.Chapters {
+			if count := others[other.ID]; count > 0 {
+				result.Recommendations = append(result.Recommendations, fmt.Sprintf("与第%d章有%d处相似段落，建议改写", other.Index, count))
+			}
+		}
+		results[i] = result
+		totalScore += result.OverallScore
+	}
+
+	summary := a.generateQualitySummary(results)
+	if len(pairs) > 0 {
+		summary.IssuesByType[CheckTypeRepetition] = len(pairs)
+		summary.IssuesBySeverity["medium"] += len(pairs)
+		summary.TotalIssues += len(pairs)
+	}
+
+	response := &BatchQualityCheckResponse{
+		Results:     results,
+		Summary:     summary,
+		Repetitions: pairs,
+	}
+	if len(req.Chapters) > 0 {
+		response.OverallScore = totalScore / float64(len(req.Chapters))
+	}
+	return response
+}
+
+// splitPassages 按段落切分正文并计算指纹，过短的段落不参与比较
+func splitPassages(chapter int, content string) []*passage {
+	var passages []*passage
+	runes := []rune(content)
+	start := 0
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && runes[i] != '\n' {
+			continue
+		}
+		if p := newPassage(chapter, runes, start, i); p != nil {
+			passages = append(passages, p)
+		}
+		start = i + 1
+	}
+	return passages
+}
+
+// newPassage 计算段落 [start, end) 的指纹片段与 MinHash 签名，首尾空白不计入段落
+func newPassage(chapter int, runes []rune, start, end int) *passage {
+	for start < end && unicode.IsSpace(runes[start]) {
+		start++
+	}
+	for end > start && unicode.IsSpace(runes[end-1]) {
+		end--
+	}
+
+	var normalized []rune
+	for _, r := range runes[start:end] {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			normalized = append(normalized, r)
+		}
+	}
+	if len(normalized) < minPassageLength {
+		return nil
+	}
+
+	p := &passage{
+		chapter:   chapter,
+		start:     start,
+		end:       end,
+		shingles:  make(map[uint64]struct{}),
+		signature: make([]uint64, minHashSize),
+	}
+	for i := range p.signature {
+		p.signature[i] = ^uint64(0)
+	}
+	for i := 0; i+shingleSize <= len(normalized); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(string(normalized[i : i+shingleSize])))
+		shingle := h.Sum64()
+		if _, ok := p.shingles[shingle]; ok {
+			continue
+		}
+		p.shingles[shingle] = struct{}{}
+		for j, seed := range minHashSeeds {
+			if v := mix64(shingle ^ seed); v < p.signature[j] {
+				p.signature[j] = v
+			}
+		}
+	}
+	return p
+}
+
+// passageSpan 返回段落在章节正文中的位置
+func passageSpan(chapter *models.Chapter, p *passage) *models.TextSpan {
+	return &models.TextSpan{
+		ChapterID: chapter.ID,
+		Start:     p.start,
+		End:       p.end,
+		Quote:     string([]rune(models.ChapterContent(chapter))[p.start:p.end]),
+		Verified:  true,
+	}
+}
+
+// bandKey 计算 LSH 分段的桶键
+func bandKey(band int, rows []uint64) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(band))
+	h.Write(buf[:])
+	for _, row := range rows {
+		binary.LittleEndian.PutUint64(buf[:], row)
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+// jaccard 计算两个指纹片段集合的 Jaccard 相似度
+func jaccard(a, b map[uint64]struct{}) float64 {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	shared := 0
+	for shingle := range a {
+		if _, ok := b[shingle]; ok {
+			shared++
+		}
+	}
+	union := len(a) + len(b) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+// coveredLength 计算若干片段覆盖的总字数，重叠部分只计一次
+func coveredLength(spans []*models.TextSpan) int {
+	sorted := append([]*models.TextSpan(nil), spans...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+	covered, end := 0, 0
+	for _, span := range sorted {
+		start := span.Start
+		if start < end {
+			start = end
+		}
+		if span.End > start {
+			covered += span.End - start
+			end = span.End
+		}
+	}
+	return covered
+}
+
+// mix64 splitmix64 混合函数，用于由同一哈希派生 MinHash 各行的取值
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,161 @@
+package quality
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"backend/internal/pkg/models"
+)
+
+// repetitionChapters 按序号1、2、3……创建章节
+func repetitionChapters(contents ...string) []*models.Chapter {
+	chapters := make([]*models.Chapter, 0, len(contents))
+	for i, content := range contents {
+		chapters = append(chapters, &models.Chapter{ID: "c" + string(rune('1'+i)), Index: i + 1, RawContent: content})
+	}
+	return chapters
+}
+
+// pairQuotes 段落对的章节与原文，便于整体比较
+func pairQuotes(pairs []*RepetitionPair) []string {
+	quotes := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		quotes = append(quotes, pair.Source.ChapterID+":"+pair.Source.Quote+" | "+pair.Target.ChapterID+":"+pair.Target.Quote)
+	}
+	return quotes
+}
+
+func TestDetectRepetitions(t *testing.T) {
+	const (
+		rain  = "林晚推开山门，看见师兄沈舟站在雨里，手里握着那柄断了的长剑。"
+		snow  = "林晚推开山门，看见师兄沈舟站在雪里，手里握着那柄断了的长剑。"
+		eaves = "林晚推开山门，看见师兄沈舟坐在檐下，低头擦拭一柄新铸的短刀。"
+		short = "青云宗山门前的雨足足下了整整三天。"
+	)
+
+	tests := []struct {
+		name           string
+		chapters       []*models.Chapter
+		want           []string
+		wantSimilarity float64
+	}{
+		{
+			name:           "完全相同的段落",
+			chapters:       repetitionChapters("开篇。\n"+rain, rain),
+			want:           []string{"c1:" + rain + " | c2:" + rain},
+			wantSimilarity: 1,
+		},
+		{
+			name:           "只改一个字的段落高于阈值",
+			chapters:       repetitionChapters(rain, snow),
+			want:           []string{"c1:" + rain + " | c2:" + snow},
+			wantSimilarity: 9.0 / 14,
+		},
+		{
+			name:     "只有开头相同的段落低于阈值",
+			chapters: repetitionChapters(rain, eaves),
+			want:     []string{},
+		},
+		{
+			name:           "指纹片段少于签名长度的短段落",
+			chapters:       repetitionChapters(short, "  "+short+"  "),
+			want:           []string{"c1:" + short + " | c2:" + short},
+			wantSimilarity: 1,
+		},
+		{
+			name:     "过短的段落不参与比较",
+			chapters: repetitionChapters("青云宗的雨下了三天。", "青云宗的雨下了三天。"),
+			want:     []string{},
+		},
+		{
+			name:     "同一章节内的重复不计入",
+			chapters: repetitionChapters(rain+"\n"+rain, "另起一章，写的是别的事情，与前文并无关系。"),
+			want:     []string{},
+		},
+		{
+			name:     "空章节",
+			chapters: []*models.Chapter{nil, {ID: "c2", Index: 2}},
+			want:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs := DetectRepetitions(tt.chapters)
+			if got := pairQuotes(pairs); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+			if len(pairs) > 0 && pairs[0].Similarity != tt.wantSimilarity {
+				t.Fatalf("Expected similarity %.4f, got %.4f", tt.wantSimilarity, pairs[0].Similarity)
+			}
+		})
+	}
+}
+
+func TestDetectRepetitions_SpanOffsets(t *testing.T) {
+	const rain = "林晚推开山门，看见师兄沈舟站在雨里，手里握着那柄断了的长剑。"
+	chapters := repetitionChapters("第一段很短。\n  "+rain+"\n结尾。", rain)
+
+	pairs := DetectRepetitions(chapters)
+	if len(pairs) != 1 {
+		t.Fatalf("Expected 1 pair, got %d", len(pairs))
+	}
+	// 偏移按字符计，不含段首空白
+	if pairs[0].Source.Start != 9 || pairs[0].Source.End != 39 {
+		t.Fatalf("Expected source span [9, 39), got [%d, %d)", pairs[0].Source.Start, pairs[0].Source.End)
+	}
+	if pairs[0].Target.Start != 0 || pairs[0].Target.End != 30 {
+		t.Fatalf("Expected target span [0, 30), got [%d, %d)", pairs[0].Target.Start, pairs[0].Target.End)
+	}
+}
+
+func TestQualityAgent_CheckRepetition(t *testing.T) {
+	const rain = "林晚推开山门，看见师兄沈舟站在雨里，手里握着那柄断了的长剑。"
+	agent := NewQualityAgent(nil, nil, nil)
+	chapters := repetitionChapters(rain+"\n"+rain, rain, "另起一章，写的是别的事情，与前文并无关系。")
+
+	resp, err := agent.BatchCheckQuality(context.Background(), &BatchQualityCheckRequest{Chapters: chapters, CheckType: CheckTypeRepetition})
+	if err != nil {
+		t.Fatalf("BatchCheckQuality failed: %v", err)
+	}
+	if len(resp.Repetitions) != 2 {
+		t.Fatalf("Expected 2 repetition pairs, got %d", len(resp.Repetitions))
+	}
+
+	// 第1章两段都与第2章重复，只剩换行未被覆盖；第2章整段重复；第3章没有重复
+	wantScores := []float64{1 - 60.0/61, 0, 1}
+	for i, want := range wantScores {
+		if got := resp.Results[i].OverallScore; math.Abs(got-want) > 1e-9 {
+			t.Fatalf("Expected chapter %d score %.4f, got %.4f", i+1, want, got)
+		}
+	}
+	if want := []string{"与第2章有2处相似段落，建议改写"}; !reflect.DeepEqual(resp.Results[0].Recommendations, want) {
+		t.Fatalf("Expected %v, got %v", want, resp.Results[0].Recommendations)
+	}
+	if resp.Summary.IssuesByType[CheckTypeRepetition] != 2 {
+		t.Fatalf("Expected 2 repetition issues in summary, got %d", resp.Summary.IssuesByType[CheckTypeRepetition])
+	}
+}
+
+func TestCoveredLength(t *testing.T) {
+	tests := []struct {
+		name  string
+		spans []*models.TextSpan
+		want  int
+	}{
+		{name: "没有片段", want: 0},
+		{name: "不相交", spans: []*models.TextSpan{{Start: 10, End: 20}, {Start: 0, End: 5}}, want: 15},
+		{name: "重叠只计一次", spans: []*models.TextSpan{{Start: 0, End: 10}, {Start: 5, End: 15}}, want: 15},
+		{name: "包含", spans: []*models.TextSpan{{Start: 0, End: 20}, {Start: 5, End: 10}}, want: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := coveredLength(tt.spans); got != tt.want {
+				t.Fatalf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
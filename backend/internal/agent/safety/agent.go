@@ -0,0 +1,245 @@
+package safety
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"backend/internal/pkg/llm"
+	"backend/internal/pkg/models"
+	"backend/internal/pkg/moderation"
+	"backend/internal/pkg/textutil"
+)
+
+// maxLLMQuotes 每个类别最多采纳的模型引文数
+const maxLLMQuotes = 5
+
+// ReviewChaptersRequest 章节内容安全审核请求
+type ReviewChaptersRequest struct {
+	ProjectID  string                 `json:"project_id"`
+	Chapters   []*models.Chapter      `json:"chapters"`
+	Dictionary *moderation.Dictionary `json:"-"`       // 审核词库，为空时只使用模型分类
+	UseLLM     bool                   `json:"use_llm"` // 是否调用模型分类
+	Options    *llm.GenerateOptions   `json:"options"`
+}
+
+// ReviewScriptRequest 视频脚本内容安全审核请求
+type ReviewScriptRequest struct {
+	Script     *models.VideoScript    `json:"script"`
+	Dictionary *moderation.Dictionary `json:"-"`
+	UseLLM     bool                   `json:"use_llm"`
+	Options    *llm.GenerateOptions   `json:"options"`
+}
+
+// classification 模型对一段文本的分类结果
+type classification struct {
+	Labels []struct {
+		Category string   `json:"category"`
+		Level    string   `json:"level"`
+		Reason   string   `json:"reason"`
+		Quotes   []string `json:"quotes"`
+	} `json:"labels"`
+}
+
+// SafetyAgent 内容安全审核 Agent
+// 先用词库扫描命中的风险内容，再按需调用模型判断词库覆盖不到的描写轻重，
+// 两者按类别取较高的风险等级，最后给出内容分级
+type SafetyAgent struct {
+	llmClient llm.LLMClient
+}
+
+// NewSafetyAgent 创建内容安全审核代理
+func NewSafetyAgent(llmClient llm.LLMClient) *SafetyAgent {
+	return &SafetyAgent{
+		llmClient: llmClient,
+	}
+}
+
+// ReviewChapters 逐章审核，审核文本为章节正文
+func (a *SafetyAgent) ReviewChapters(ctx context.Context, req *ReviewChaptersRequest) (*models.SafetyReport, error) {
+	if req == nil {
+		return nil, fmt.Errorf("review request cannot be nil")
+	}
+	report := newReport(req.ProjectID, req.UseLLM)
+	for _, chapter := range req.Chapters {
+		if chapter == nil {
+			continue
+		}
+		review := &models.SafetyReview{
+			TargetType:   models.SafetyTargetChapter,
+			TargetID:     chapter.ID,
+			ChapterIndex: chapter.Index,
+		}
+		subject := fmt.Sprintf("小说第%d章《%s》", chapter.Index, chapter.Title)
+		if err := a.review(ctx, review, subject, models.ChapterContent(chapter), req.Dictionary, req.UseLLM, req.Options); err != nil {
+			return nil, fmt.Errorf("failed to review chapter %d: %w", chapter.Index, err)
+		}
+		addReview(report, review)
+	}
+	return report, nil
+}
+
+// ReviewScript 逐个分镜审核，审核文本为 SceneText 拼接的画面描述、旁白与字幕
+func (a *SafetyAgent) ReviewScript(ctx context.Context, req *ReviewScriptRequest) (*models.SafetyReport, error) {
+	if req == nil || req.Script == nil {
+		return nil, fmt.Errorf("video script cannot be nil")
+	}
+	script := req.Script
+	report := newReport(script.ProjectID, req.UseLLM)
+	for _, scene := range script.Scenes {
+		if scene == nil {
+			continue
+		}
+		review := &models.SafetyReview{
+			TargetType: models.SafetyTargetScene,
+			TargetID:   script.ID,
+			SceneIndex: scene.Index,
+		}
+		subject := fmt.Sprintf("短视频《%s》的第%d个分镜（画面描述、旁白与字幕）", script.Title, scene.Index)
+		if err := a.review(ctx, review, subject, SceneText(scene), req.Dictionary, req.UseLLM, req.Options); err != nil {
+			return nil, fmt.Errorf("failed to review scene %d: %w", scene.Index, err)
+		}
+		addReview(report, review)
+	}
+	return report, nil
+}
+
+// SceneText 分镜的审核文本：画面描述、旁白与字幕按行拼接
+func SceneText(scene *models.VideoScriptScene) string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{scene.VisualDescription, scene.Narration, scene.Subtitle} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// review 审核一段文本，结果写入 review
+func (a *SafetyAgent) review(ctx context.Context, review *models.SafetyReview, subject, text string, dictionary *moderation.Dictionary, useLLM bool, options *llm.GenerateOptions) error {
+	var hits []*models.SafetyHit
+	if dictionary != nil {
+		hits = dictionary.Scan(text)
+	}
+	labels := moderation.Labels(hits)
+
+	if useLLM && strings.TrimSpace(text) != "" {
+		result, err := a.classify(ctx, subject, text, options)
+		if err != nil {
+			return err
+		}
+		hits = append(hits, mergeClassification(labels, result, text)...)
+		sort.SliceStable(hits, func(i, j int) bool {
+			return hits[i].Start < hits[j].Start
+		})
+	}
+
+	if hits == nil {
+		hits = make([]*models.SafetyHit, 0)
+	}
+	review.Labels = labels
+	review.Hits = hits
+	review.Rating = moderation.Rate(labels)
+	return nil
+}
+
+// classify 调用模型判断各类别的风险等级
+func (a *SafetyAgent) classify(ctx context.Context, subject, text string, options *llm.GenerateOptions) (*classification, error) {
+	prompt := fmt.Sprintf(`
+你是国内网文与短视频平台的内容审核员。请审核以下%s，按平台规范判断各类风险的轻重。
+
+风险类别：
+- violence：暴力血腥，如残忍的伤害、虐待、血腥细节
+- sexual：色情低俗，如露骨的性描写、性暗示
+- politics：政治敏感，如影射现实政治、煽动颠覆、恐怖主义
+- minors：涉及未成年人的风险内容，如未成年人遭受性侵害、模仿危险行为、不良示范
+
+风险等级：none（无）、low（轻微，题材需要的一般描写）、medium（明显，需要删改或限制受众）、high（严重，不允许发布）
+
+审核内容：
+%s
+
+请逐个类别给出等级和理由，level 不为 none 时在 quotes 中摘录不超过%d处原文（逐字摘录）。
+请以JSON格式返回：
+{
+  "labels": [{"category": "violence", "level": "low", "reason": "理由", "quotes": ["原文"]}]
+}
+`, subject, text, maxLLMQuotes)
+
+	if options == nil {
+		options = llm.PreciseOptions()
+	}
+	jsonResult, err := a.llmClient.GenerateJSON(ctx, prompt, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to classify content: %w", err)
+	}
+
+	raw, err := json.Marshal(jsonResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse content classification: %w", err)
+	}
+	var result classification
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse content classification: %w", err)
+	}
+	return &result, nil
+}
+
+// mergeClassification 把模型分类合并进词库结论，按类别取较高等级，返回能在原文中定位到的模型引文
+func mergeClassification(labels []*models.SafetyLabel, result *classification, text string) []*models.SafetyHit {
+	byCategory := make(map[string]*models.SafetyLabel, len(labels))
+	for _, label := range labels {
+		byCategory[label.Category] = label
+	}
+
+	var hits []*models.SafetyHit
+	for _, item := range result.Labels {
+		label, ok := byCategory[strings.TrimSpace(item.Category)]
+		level := strings.TrimSpace(item.Level)
+		if !ok || moderation.LevelRank(level) == 0 {
+			continue
+		}
+		label.Level = moderation.MaxLevel(label.Level, level)
+		label.Reason = strings.TrimSpace(item.Reason)
+
+		quotes := item.Quotes
+		if len(quotes) > maxLLMQuotes {
+			quotes = quotes[:maxLLMQuotes]
+		}
+		for _, quote := range quotes {
+			start, end, found := textutil.LocateQuote(text, strings.TrimSpace(quote), -1)
+			if !found {
+				continue
+			}
+			hits = append(hits, &models.SafetyHit{
+				Category: label.Category,
+				Severity: level,
+				Quote:    string([]rune(text)[start:end]),
+				Start:    start,
+				End:      end,
+				Source:   "llm",
+			})
+		}
+	}
+	return hits
+}
+
+// newReport 创建空的审核报告
+func newReport(projectID string, usedLLM bool) *models.SafetyReport {
+	return &models.SafetyReport{
+		ProjectID: projectID,
+		Rating:    models.ContentRatingGeneral,
+		UsedLLM:   usedLLM,
+		Reviews:   make([]*models.SafetyReview, 0),
+		CheckedAt: time.Now(),
+	}
+}
+
+// addReview 追加审核结果并更新报告的总体分级
+func addReview(report *models.SafetyReport, review *models.SafetyReview) {
+	report.Reviews = append(report.Reviews, review)
+	report.Rating = moderation.MaxRating(report.Rating, review.Rating)
+}
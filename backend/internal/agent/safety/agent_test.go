@@ -0,0 +1,232 @@
+package safety
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"backend/internal/pkg/llm"
+	"backend/internal/pkg/models"
+	"backend/internal/pkg/moderation"
+)
+
+// stubClassifier 按调用顺序返回模型分类结果
+type stubClassifier struct {
+	results []map[string]interface{}
+	err     error
+	calls   int
+}
+
+func (s *stubClassifier) GenerateText(ctx context.Context, prompt string, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+func (s *stubClassifier) GenerateJSON(ctx context.Context, prompt string, opts *llm.GenerateOptions) (map[string]interface{}, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	result := map[string]interface{}{}
+	if s.calls < len(s.results) {
+		result = s.results[s.calls]
+	}
+	s.calls++
+	return result, nil
+}
+
+func (s *stubClassifier) GenerateWithTemplate(ctx context.Context, template string, data map[string]interface{}, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+// labelLevel 返回审核结果中某个类别的等级
+func labelLevel(review *models.SafetyReview, category string) string {
+	for _, label := range review.Labels {
+		if label.Category == category {
+			return label.Level
+		}
+	}
+	return ""
+}
+
+// newTestDictionary 编译内置词库
+func newTestDictionary(t *testing.T) *moderation.Dictionary {
+	dictionary, err := moderation.NewDictionary(moderation.DefaultRules())
+	if err != nil {
+		t.Fatalf("NewDictionary failed: %v", err)
+	}
+	return dictionary
+}
+
+func TestSafetyAgent_ReviewChapters_Dictionary(t *testing.T) {
+	dictionary := newTestDictionary(t)
+
+	tests := []struct {
+		name        string
+		chapters    []*models.Chapter
+		wantRatings []string
+		wantRating  string
+	}{
+		{
+			name: "没有章节命中",
+			chapters: []*models.Chapter{
+				{ID: "c1", Index: 1, RawContent: "林晚推开山门。"},
+				{ID: "c2", Index: 2, RawContent: "雨下了三天。"},
+			},
+			wantRatings: []string{models.ContentRatingGeneral, models.ContentRatingGeneral},
+			wantRating:  models.ContentRatingGeneral,
+		},
+		{
+			name: "只有部分章节命中",
+			chapters: []*models.Chapter{
+				{ID: "c1", Index: 1, RawContent: "林晚推开山门。"},
+				{ID: "c2", Index: 2, RawContent: "刑场上斩首示众。"},
+				{ID: "c3", Index: 3, RawContent: "雨下了三天。"},
+			},
+			wantRatings: []string{models.ContentRatingGeneral, models.ContentRatingMature, models.ContentRatingGeneral},
+			wantRating:  models.ContentRatingMature,
+		},
+		{
+			name: "报告取各章最高分级，空章节跳过",
+			chapters: []*models.Chapter{
+				{ID: "c1", Index: 1, RawContent: "鲜血淋漓。"},
+				nil,
+				{ID: "c3", Index: 3, RawContent: "邪教头目下令碎尸。"},
+			},
+			wantRatings: []string{models.ContentRatingTeen, models.ContentRatingRestricted},
+			wantRating:  models.ContentRatingRestricted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewSafetyAgent(nil)
+			report, err := agent.ReviewChapters(context.Background(), &ReviewChaptersRequest{ProjectID: "test-id", Chapters: tt.chapters, Dictionary: dictionary})
+			if err != nil {
+				t.Fatalf("ReviewChapters failed: %v", err)
+			}
+
+			ratings := make([]string, 0, len(report.Reviews))
+			for _, review := range report.Reviews {
+				ratings = append(ratings, review.Rating)
+				if review.TargetType != models.SafetyTargetChapter || review.Hits == nil {
+					t.Fatalf("Expected chapter review with hits slice, got %+v", review)
+				}
+				// 未命中的章节各类别都为 none
+				if review.Rating == models.ContentRatingGeneral && (len(review.Hits) != 0 || labelLevel(review, models.SafetyViolence) != models.SafetyLevelNone) {
+					t.Fatalf("Expected clean chapter %s to have no hits, got %+v", review.TargetID, review.Hits)
+				}
+			}
+			if !reflect.DeepEqual(ratings, tt.wantRatings) {
+				t.Fatalf("Expected chapter ratings %v, got %v", tt.wantRatings, ratings)
+			}
+			if report.Rating != tt.wantRating || report.ProjectID != "test-id" || report.UsedLLM {
+				t.Fatalf("Expected report rating %s, got %s", tt.wantRating, report.Rating)
+			}
+		})
+	}
+}
+
+func TestSafetyAgent_ReviewChapters_LLM(t *testing.T) {
+	classifier := &stubClassifier{results: []map[string]interface{}{
+		{"labels": []interface{}{
+			map[string]interface{}{"category": "violence", "level": "medium", "reason": "打斗描写血腥", "quotes": []interface{}{"剑锋划开他的胸口", "不存在的引文"}},
+			map[string]interface{}{"category": "sexual", "level": "none"},
+			map[string]interface{}{"category": "gambling", "level": "high"},
+		}},
+		{"labels": []interface{}{
+			map[string]interface{}{"category": "violence", "level": "low", "reason": "轻微打斗"},
+		}},
+	}}
+	agent := NewSafetyAgent(classifier)
+	chapters := []*models.Chapter{
+		{ID: "c1", Index: 1, RawContent: "鲜血淋漓。剑锋划开他的胸口。"},
+		{ID: "c2", Index: 2, RawContent: "刑场上斩首示众。"},
+		{ID: "c3", Index: 3, RawContent: "  "},
+	}
+
+	report, err := agent.ReviewChapters(context.Background(), &ReviewChaptersRequest{
+		Chapters:   chapters,
+		Dictionary: newTestDictionary(t),
+		UseLLM:     true,
+	})
+	if err != nil {
+		t.Fatalf("ReviewChapters failed: %v", err)
+	}
+	// 空白章节不调用模型
+	if classifier.calls != 2 {
+		t.Fatalf("Expected 2 classifier calls, got %d", classifier.calls)
+	}
+
+	// 第1章：词库 low、模型 medium，取较高等级；只采纳能定位到的引文
+	first := report.Reviews[0]
+	if labelLevel(first, models.SafetyViolence) != models.SafetyLevelMedium || first.Rating != models.ContentRatingMature {
+		t.Fatalf("Expected chapter 1 violence medium/mature, got %s/%s", labelLevel(first, models.SafetyViolence), first.Rating)
+	}
+	if len(first.Hits) != 2 || first.Hits[0].Source != "dictionary" || first.Hits[1].Source != "llm" || first.Hits[1].Start != 5 {
+		t.Fatalf("Expected dictionary hit then located llm quote, got %+v", first.Hits)
+	}
+	if first.Labels[0].Reason != "打斗描写血腥" {
+		t.Fatalf("Expected llm reason on violence label, got %q", first.Labels[0].Reason)
+	}
+
+	// 第2章：模型等级低于词库时保留词库等级
+	if second := report.Reviews[1]; labelLevel(second, models.SafetyViolence) != models.SafetyLevelMedium {
+		t.Fatalf("Expected chapter 2 to keep dictionary level, got %s", labelLevel(second, models.SafetyViolence))
+	}
+	if third := report.Reviews[2]; third.Rating != models.ContentRatingGeneral {
+		t.Fatalf("Expected blank chapter to be general, got %s", third.Rating)
+	}
+	if !report.UsedLLM || report.Rating != models.ContentRatingMature {
+		t.Fatalf("Expected llm report rated mature, got used_llm=%v rating=%s", report.UsedLLM, report.Rating)
+	}
+}
+
+func TestSafetyAgent_ReviewChapters_Errors(t *testing.T) {
+	agent := NewSafetyAgent(&stubClassifier{err: errors.New("timeout")})
+
+	if _, err := agent.ReviewChapters(context.Background(), nil); err == nil {
+		t.Fatalf("Expected error for nil request")
+	}
+	_, err := agent.ReviewChapters(context.Background(), &ReviewChaptersRequest{
+		Chapters: []*models.Chapter{{ID: "c1", Index: 4, RawContent: "正文"}},
+		UseLLM:   true,
+	})
+	if err == nil || !strings.Contains(err.Error(), "failed to review chapter 4") {
+		t.Fatalf("Expected chapter review error, got %v", err)
+	}
+}
+
+func TestSafetyAgent_ReviewScript(t *testing.T) {
+	agent := NewSafetyAgent(nil)
+	script := &models.VideoScript{
+		ID:        "script-1",
+		ProjectID: "test-id",
+		Title:     "雨夜",
+		Scenes: []*models.VideoScriptScene{
+			{Index: 1, VisualDescription: "山门外下着雨", Narration: " "},
+			{Index: 2, VisualDescription: "刑场", Narration: "斩首示众", Subtitle: "行刑"},
+		},
+	}
+
+	report, err := agent.ReviewScript(context.Background(), &ReviewScriptRequest{Script: script, Dictionary: newTestDictionary(t)})
+	if err != nil {
+		t.Fatalf("ReviewScript failed: %v", err)
+	}
+	if len(report.Reviews) != 2 || report.Reviews[0].Rating != models.ContentRatingGeneral || report.Reviews[1].Rating != models.ContentRatingMature {
+		t.Fatalf("Expected only the second scene to be flagged, got %+v", report.Reviews)
+	}
+	if review := report.Reviews[1]; review.TargetType != models.SafetyTargetScene || review.TargetID != "script-1" || review.SceneIndex != 2 {
+		t.Fatalf("Expected scene review for script-1 #2, got %+v", review)
+	}
+	if report.Rating != models.ContentRatingMature || report.ProjectID != "test-id" {
+		t.Fatalf("Expected mature report for project, got %s", report.Rating)
+	}
+
+	if got := SceneText(script.Scenes[1]); got != "刑场\n斩首示众\n行刑" {
+		t.Fatalf("Expected scene text joined by lines, got %q", got)
+	}
+	if _, err := agent.ReviewScript(context.Background(), &ReviewScriptRequest{}); err == nil {
+		t.Fatalf("Expected error for missing script")
+	}
+}
@@ -0,0 +1,271 @@
+package storystate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/pkg/llm"
+	"backend/internal/pkg/models"
+)
+
+// maxContextEvents 写入生成上下文的最近时间线事件数
+const maxContextEvents = 20
+
+// noHolder 模型用于表示道具无人持有的取值
+const noHolder = "无"
+
+// ExtractStoryStateRequest 故事状态提取请求
+type ExtractStoryStateRequest struct {
+	Chapter    *models.Chapter      `json:"chapter"`
+	Previous   *models.StoryState   `json:"previous"`   // 上一章结束时的状态，第一章为 nil
+	Characters []*models.Character  `json:"characters"` // 项目人物，用于统一人物姓名
+	Options    *llm.GenerateOptions `json:"options"`
+}
+
+// storyStateDelta 模型从单章中提取的状态变化
+type storyStateDelta struct {
+	Characters []*models.CharacterState `json:"characters"`
+	Locations  []string                 `json:"locations"`
+	Props      []*models.PropItem       `json:"props"`
+	Events     []*models.TimelineEvent  `json:"events"`
+}
+
+// StoryStateAgent 故事状态跟踪 Agent
+// 每章生成后提取人物所在与伤势、道具的持有人与位置、地点和带时间的事件，与上一章的状态合并后供下一章使用
+type StoryStateAgent struct {
+	llmClient llm.LLMClient
+}
+
+// NewStoryStateAgent 创建故事状态跟踪代理
+func NewStoryStateAgent(llmClient llm.LLMClient) *StoryStateAgent {
+	return &StoryStateAgent{
+		llmClient: llmClient,
+	}
+}
+
+// ExtractStoryState 提取章节结束时的故事状态，结果包含此前各章累积的状态
+func (a *StoryStateAgent) ExtractStoryState(ctx context.Context, req *ExtractStoryStateRequest) (*models.StoryState, error) {
+	if req == nil || req.Chapter == nil {
+		return nil, fmt.Errorf("chapter cannot be nil")
+	}
+	content := req.Chapter.PolishedContent
+	if content == "" {
+		content = req.Chapter.RawContent
+	}
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("chapter content is empty")
+	}
+
+	names := make([]string, 0, len(req.Characters))
+	for _, character := range req.Characters {
+		if character != nil {
+			names = append(names, character.Name)
+		}
+	}
+
+	prompt := fmt.Sprintf(`
+请阅读第 %d 章《%s》，记录本章结束时的故事状态变化。
+
+已知人物：%s
+
+上一章结束时的状态：
+%s
+
+本章内容：
+%s
+
+请只记录本章出现或发生变化的内容：
+1. characters：本章出场人物结束时所在的地点（location）、伤势与身体状况（condition）、处境（status），人物姓名使用已知人物中的写法
+2. locations：本章出现的地点
+3. props：本章出现或易手的道具，写明当前所在位置（location）和持有人（holder），无人持有时 holder 写"无"
+4. events：本章发生的关键事件，timestamp 写故事内的时间（如"第三日清晨"），无法确定时写本章的相对时间
+
+请以JSON格式返回：
+{
+  "characters": [{"name": "姓名", "location": "地点", "condition": "伤势", "status": "处境"}],
+  "locations": ["地点"],
+  "props": [{"name": "道具", "description": "描述", "location": "位置", "holder": "持有人"}],
+  "events": [{"timestamp": "时间", "event": "事件", "description": "经过"}]
+}
+`, req.Chapter.Index, req.Chapter.Title, strings.Join(names, "、"), FormatStoryState(req.Previous), content)
+
+	options := req.Options
+	if options == nil {
+		options = llm.PreciseOptions()
+	}
+	jsonResult, err := a.llmClient.GenerateJSON(ctx, prompt, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract story state: %w", err)
+	}
+
+	delta, err := parseStoryStateDelta(jsonResult)
+	if err != nil {
+		return nil, err
+	}
+	return mergeStoryState(req.Previous, req.Chapter, delta), nil
+}
+
+// parseStoryStateDelta 解析模型返回的状态变化
+func parseStoryStateDelta(jsonResult map[string]interface{}) (*storyStateDelta, error) {
+	raw, err := json.Marshal(jsonResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse story state: %w", err)
+	}
+	var delta storyStateDelta
+	if err := json.Unmarshal(raw, &delta); err != nil {
+		return nil, fmt.Errorf("failed to parse story state: %w", err)
+	}
+	return &delta, nil
+}
+
+// mergeStoryState 将本章的变化合并到上一章的状态上
+// 人物与道具按名称覆盖，未给出的字段沿用之前的值；地点去重累积；事件按章节顺序追加
+func mergeStoryState(previous *models.StoryState, chapter *models.Chapter, delta *storyStateDelta) *models.StoryState {
+	state := &models.StoryState{
+		ProjectID:    chapter.ProjectID,
+		ChapterID:    chapter.ID,
+		ChapterIndex: chapter.Index,
+		UpdatedAt:    time.Now(),
+	}
+
+	characters := make(map[string]*models.CharacterState)
+	props := make(map[string]*models.PropItem)
+	locations := make(map[string]bool)
+	if previous != nil {
+		for _, character := range previous.Characters {
+			copied := *character
+			characters[character.Name] = &copied
+			state.Characters = append(state.Characters, &copied)
+		}
+		for _, prop := range previous.Props {
+			copied := *prop
+			props[prop.Name] = &copied
+			state.Props = append(state.Props, &copied)
+		}
+		for _, location := range previous.Locations {
+			locations[location] = true
+			state.Locations = append(state.Locations, location)
+		}
+		state.Timeline = append(state.Timeline, previous.Timeline...)
+	}
+
+	for _, update := range delta.Characters {
+		if update == nil || strings.TrimSpace(update.Name) == "" {
+			continue
+		}
+		name := strings.TrimSpace(update.Name)
+		existing, ok := characters[name]
+		if !ok {
+			existing = &models.CharacterState{Name: name}
+			characters[name] = existing
+			state.Characters = append(state.Characters, existing)
+		}
+		existing.Location = firstNonEmpty(update.Location, existing.Location)
+		existing.Condition = firstNonEmpty(update.Condition, existing.Condition)
+		existing.Status = firstNonEmpty(update.Status, existing.Status)
+	}
+
+	for _, update := range delta.Props {
+		if update == nil || strings.TrimSpace(update.Name) == "" {
+			continue
+		}
+		name := strings.TrimSpace(update.Name)
+		existing, ok := props[name]
+		if !ok {
+			existing = &models.PropItem{Name: name}
+			props[name] = existing
+			state.Props = append(state.Props, existing)
+		}
+		existing.Description = firstNonEmpty(update.Description, existing.Description)
+		existing.Location = firstNonEmpty(update.Location, existing.Location)
+		switch holder := strings.TrimSpace(update.Holder); holder {
+		case "":
+		case noHolder:
+			existing.Holder = ""
+		default:
+			existing.Holder = holder
+		}
+	}
+
+	for _, location := range delta.Locations {
+		location = strings.TrimSpace(location)
+		if location != "" && !locations[location] {
+			locations[location] = true
+			state.Locations = append(state.Locations, location)
+		}
+	}
+
+	for _, event := range delta.Events {
+		if event == nil || strings.TrimSpace(event.Event) == "" {
+			continue
+		}
+		copied := *event
+		copied.ChapterIndex = chapter.Index
+		state.Timeline = append(state.Timeline, &copied)
+	}
+
+	return state
+}
+
+// ApplyToContext 将故事状态写入下一章的生成上下文，调用方已显式提供的时间线和道具保持不变
+func ApplyToContext(genCtx *models.GenerationContext, state *models.StoryState) {
+	if genCtx == nil || state == nil {
+		return
+	}
+	if len(genCtx.CharacterStates) == 0 {
+		genCtx.CharacterStates = state.Characters
+	}
+	if len(genCtx.Props) == 0 {
+		genCtx.Props = state.Props
+	}
+	if len(genCtx.Timeline) == 0 {
+		timeline := state.Timeline
+		if len(timeline) > maxContextEvents {
+			timeline = timeline[len(timeline)-maxContextEvents:]
+		}
+		genCtx.Timeline = timeline
+	}
+}
+
+// FormatStoryState 将故事状态格式化为提示词文本
+func FormatStoryState(state *models.StoryState) string {
+	if state == nil {
+		return "暂无（这是第一章）"
+	}
+
+	var b strings.Builder
+	if len(state.Characters) > 0 {
+		b.WriteString("人物：\n")
+		for _, character := range state.Characters {
+			fmt.Fprintf(&b, "- %s：位于%s；%s；%s\n", character.Name,
+				firstNonEmpty(character.Location, "未知"), firstNonEmpty(character.Condition, "状况未知"),
+				firstNonEmpty(character.Status, "处境未知"))
+		}
+	}
+	if len(state.Props) > 0 {
+		b.WriteString("道具：\n")
+		for _, prop := range state.Props {
+			fmt.Fprintf(&b, "- %s：位于%s，持有人%s\n", prop.Name,
+				firstNonEmpty(prop.Location, "未知"), firstNonEmpty(prop.Holder, noHolder))
+		}
+	}
+	if len(state.Locations) > 0 {
+		fmt.Fprintf(&b, "地点：%s\n", strings.Join(state.Locations, "、"))
+	}
+	if b.Len() == 0 {
+		return "暂无"
+	}
+	return b.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
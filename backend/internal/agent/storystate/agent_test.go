@@ -0,0 +1,236 @@
+package storystate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"backend/internal/pkg/llm"
+	"backend/internal/pkg/models"
+)
+
+// stubExtractor 按调用顺序返回各章的状态变化，并记录最后一次的提示词
+type stubExtractor struct {
+	deltas []map[string]interface{}
+	err    error
+	calls  int
+	prompt string
+}
+
+func (s *stubExtractor) GenerateText(ctx context.Context, prompt string, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+func (s *stubExtractor) GenerateJSON(ctx context.Context, prompt string, opts *llm.GenerateOptions) (map[string]interface{}, error) {
+	s.prompt = prompt
+	if s.err != nil {
+		return nil, s.err
+	}
+	delta := s.deltas[s.calls]
+	s.calls++
+	return delta, nil
+}
+
+func (s *stubExtractor) GenerateWithTemplate(ctx context.Context, template string, data map[string]interface{}, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+// characterStates 人物状态，便于整体比较
+func characterStates(state *models.StoryState) []string {
+	states := make([]string, 0, len(state.Characters))
+	for _, character := range state.Characters {
+		states = append(states, fmt.Sprintf("%s|%s|%s|%s", character.Name, character.Location, character.Condition, character.Status))
+	}
+	return states
+}
+
+// propStates 道具状态，便于整体比较
+func propStates(state *models.StoryState) []string {
+	states := make([]string, 0, len(state.Props))
+	for _, prop := range state.Props {
+		states = append(states, fmt.Sprintf("%s|%s|%s", prop.Name, prop.Location, prop.Holder))
+	}
+	return states
+}
+
+// timelineEvents 时间线事件及所在章节，便于整体比较
+func timelineEvents(state *models.StoryState) []string {
+	events := make([]string, 0, len(state.Timeline))
+	for _, event := range state.Timeline {
+		events = append(events, fmt.Sprintf("%d:%s %s", event.ChapterIndex, event.Timestamp, event.Event))
+	}
+	return events
+}
+
+func TestStoryStateAgent_ExtractStoryState_ConsecutiveChapters(t *testing.T) {
+	extractor := &stubExtractor{deltas: []map[string]interface{}{
+		{
+			"characters": []interface{}{
+				map[string]interface{}{"name": "林晚", "location": "青云宗", "condition": "无伤", "status": "初入山门"},
+				map[string]interface{}{"name": "沈舟", "location": "青云宗", "status": "师兄"},
+			},
+			"locations": []interface{}{"青云宗", "山门"},
+			"props":     []interface{}{map[string]interface{}{"name": "玉佩", "description": "母亲遗物", "location": "林晚身上", "holder": "林晚"}},
+			"events":    []interface{}{map[string]interface{}{"timestamp": "第一日清晨", "event": "林晚拜入青云宗"}},
+		},
+		{
+			"characters": []interface{}{
+				map[string]interface{}{"name": " 林晚 ", "location": "断桥", "condition": "左臂受伤"},
+				map[string]interface{}{"name": "赵七", "location": "断桥", "status": "黑衣人"},
+				map[string]interface{}{"name": ""},
+			},
+			"locations": []interface{}{"断桥", "青云宗", " "},
+			"props": []interface{}{
+				map[string]interface{}{"name": "玉佩", "location": "断桥下", "holder": "无"},
+				map[string]interface{}{"name": "断剑", "location": "沈舟手中", "holder": "沈舟"},
+			},
+			"events": []interface{}{
+				map[string]interface{}{"timestamp": "第三日夜", "event": "断桥遇袭", "chapter_index": 9},
+				map[string]interface{}{"timestamp": "第三日夜", "event": ""},
+			},
+		},
+	}}
+	agent := NewStoryStateAgent(extractor)
+	characters := []*models.Character{{Name: "林晚"}, {Name: "沈舟"}}
+
+	first, err := agent.ExtractStoryState(context.Background(), &ExtractStoryStateRequest{
+		Chapter:    &models.Chapter{ID: "c1", ProjectID: "test-id", Index: 1, Title: "下山", RawContent: "林晚拜入青云宗。"},
+		Characters: characters,
+	})
+	if err != nil {
+		t.Fatalf("ExtractStoryState chapter 1 failed: %v", err)
+	}
+	if !strings.Contains(extractor.prompt, "暂无（这是第一章）") || !strings.Contains(extractor.prompt, "已知人物：林晚、沈舟") {
+		t.Fatalf("Expected first chapter prompt without previous state, got %q", extractor.prompt)
+	}
+
+	second, err := agent.ExtractStoryState(context.Background(), &ExtractStoryStateRequest{
+		Chapter:  &models.Chapter{ID: "c2", ProjectID: "test-id", Index: 2, Title: "断桥", RawContent: "草稿", PolishedContent: "断桥遇袭。"},
+		Previous: first,
+	})
+	if err != nil {
+		t.Fatalf("ExtractStoryState chapter 2 failed: %v", err)
+	}
+	if !strings.Contains(extractor.prompt, "- 林晚：位于青云宗；无伤；初入山门") || !strings.Contains(extractor.prompt, "断桥遇袭。") {
+		t.Fatalf("Expected second chapter prompt with previous state and polished content, got %q", extractor.prompt)
+	}
+
+	if second.ProjectID != "test-id" || second.ChapterID != "c2" || second.ChapterIndex != 2 {
+		t.Fatalf("Expected state for chapter c2 #2, got %s #%d", second.ChapterID, second.ChapterIndex)
+	}
+	// 人物按名称覆盖，未给出的字段沿用上一章；新人物追加在后
+	if want := []string{"林晚|断桥|左臂受伤|初入山门", "沈舟|青云宗||师兄", "赵七|断桥||黑衣人"}; !reflect.DeepEqual(characterStates(second), want) {
+		t.Fatalf("Expected characters %v, got %v", want, characterStates(second))
+	}
+	// 持有人为“无”时清空，未给出描述时沿用
+	if want := []string{"玉佩|断桥下|", "断剑|沈舟手中|沈舟"}; !reflect.DeepEqual(propStates(second), want) {
+		t.Fatalf("Expected props %v, got %v", want, propStates(second))
+	}
+	if second.Props[0].Description != "母亲遗物" {
+		t.Fatalf("Expected prop description to carry over, got %q", second.Props[0].Description)
+	}
+	if want := []string{"青云宗", "山门", "断桥"}; !reflect.DeepEqual(second.Locations, want) {
+		t.Fatalf("Expected locations %v, got %v", want, second.Locations)
+	}
+	// 事件按章节追加，章节索引以所在章节为准
+	if want := []string{"1:第一日清晨 林晚拜入青云宗", "2:第三日夜 断桥遇袭"}; !reflect.DeepEqual(timelineEvents(second), want) {
+		t.Fatalf("Expected timeline %v, got %v", want, timelineEvents(second))
+	}
+
+	// 上一章的状态保持不变
+	if want := []string{"林晚|青云宗|无伤|初入山门", "沈舟|青云宗||师兄"}; !reflect.DeepEqual(characterStates(first), want) {
+		t.Fatalf("Expected previous characters to be unchanged, got %v", characterStates(first))
+	}
+	if want := []string{"玉佩|林晚身上|林晚"}; !reflect.DeepEqual(propStates(first), want) {
+		t.Fatalf("Expected previous props to be unchanged, got %v", propStates(first))
+	}
+	if len(first.Locations) != 2 || len(first.Timeline) != 1 {
+		t.Fatalf("Expected previous locations and timeline to be unchanged, got %v %v", first.Locations, timelineEvents(first))
+	}
+}
+
+func TestStoryStateAgent_ExtractStoryState_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *ExtractStoryStateRequest
+		llmErr  error
+		wantErr string
+	}{
+		{name: "没有请求", wantErr: "chapter cannot be nil"},
+		{name: "没有章节", req: &ExtractStoryStateRequest{}, wantErr: "chapter cannot be nil"},
+		{name: "正文为空", req: &ExtractStoryStateRequest{Chapter: &models.Chapter{Index: 1, RawContent: " \n"}}, wantErr: "chapter content is empty"},
+		{name: "模型失败", req: &ExtractStoryStateRequest{Chapter: &models.Chapter{Index: 1, RawContent: "正文"}}, llmErr: errors.New("timeout"), wantErr: "failed to extract story state"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := NewStoryStateAgent(&stubExtractor{err: tt.llmErr})
+			if _, err := agent.ExtractStoryState(context.Background(), tt.req); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestApplyToContext(t *testing.T) {
+	timeline := make([]*models.TimelineEvent, 0, maxContextEvents+5)
+	for i := 1; i <= maxContextEvents+5; i++ {
+		timeline = append(timeline, &models.TimelineEvent{ChapterIndex: i, Event: fmt.Sprintf("事件%d", i)})
+	}
+	state := &models.StoryState{
+		Characters: []*models.CharacterState{{Name: "林晚", Location: "断桥"}},
+		Props:      []*models.PropItem{{Name: "玉佩"}},
+		Timeline:   timeline,
+	}
+
+	// 只保留最近的事件
+	genCtx := &models.GenerationContext{}
+	ApplyToContext(genCtx, state)
+	if len(genCtx.CharacterStates) != 1 || len(genCtx.Props) != 1 {
+		t.Fatalf("Expected characters and props from state, got %d/%d", len(genCtx.CharacterStates), len(genCtx.Props))
+	}
+	if len(genCtx.Timeline) != maxContextEvents || genCtx.Timeline[0].ChapterIndex != 6 {
+		t.Fatalf("Expected the last %d events starting at chapter 6, got %d", maxContextEvents, len(genCtx.Timeline))
+	}
+
+	// 调用方已提供的内容保持不变
+	explicit := &models.GenerationContext{Props: []*models.PropItem{{Name: "断剑"}}}
+	ApplyToContext(explicit, state)
+	if len(explicit.Props) != 1 || explicit.Props[0].Name != "断剑" {
+		t.Fatalf("Expected explicit props to be kept, got %+v", explicit.Props)
+	}
+
+	ApplyToContext(nil, state)
+	ApplyToContext(genCtx, nil)
+}
+
+func TestFormatStoryState(t *testing.T) {
+	tests := []struct {
+		name  string
+		state *models.StoryState
+		want  string
+	}{
+		{name: "第一章", want: "暂无（这是第一章）"},
+		{name: "空状态", state: &models.StoryState{}, want: "暂无"},
+		{
+			name: "未知字段使用占位",
+			state: &models.StoryState{
+				Characters: []*models.CharacterState{{Name: "林晚", Location: "断桥"}},
+				Props:      []*models.PropItem{{Name: "玉佩", Location: "断桥下"}},
+				Locations:  []string{"青云宗", "断桥"},
+			},
+			want: "人物：\n- 林晚：位于断桥；状况未知；处境未知\n道具：\n- 玉佩：位于断桥下，持有人无\n地点：青云宗、断桥\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatStoryState(tt.state); got != tt.want {
+				t.Fatalf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewGreeterUsecase, NewNovelUsecase, NewVideoScriptUseCase, NewVideoScriptServiceImpl)
+var ProviderSet = wire.NewSet(NewGreeterUsecase, NewNovelUsecaseWithIndex, NewVideoScriptUseCaseWithAccess, NewVideoScriptServiceImpl, NewProjectBundleUsecaseWithIndex, NewSearchUsecase, NewTrashUsecaseWithIndex, NewProjectAuthorizer, NewUserUsecase, NewGenerationUsecase, NewIndexUsecase, NewKnowledgeUsecase, NewStoryStateUsecase, NewQualityReportUsecase, NewPlotThreadUsecase, NewSafetyUsecase)
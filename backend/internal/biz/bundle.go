@@ -0,0 +1,384 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// bundleVideoScriptPageSize 导出时分页读取视频脚本的页大小
+const bundleVideoScriptPageSize = 100
+
+// ProjectBundleUsecase 项目数据包导入导出用例
+type ProjectBundleUsecase struct {
+	novelRepo         NovelRepo
+	videoScriptRepo   VideoScriptRepo
+	storyStateRepo    StoryStateRepo
+	qualityReportRepo QualityReportRepo
+	plotThreadRepo    PlotThreadRepo
+	trashRepo         TrashRepo
+	access            *ProjectAuthorizer
+	index             *IndexUsecase // 导入后建立知识库索引，为 nil 时不自动索引
+	log               *log.Helper
+}
+
+// NewProjectBundleUsecase 创建项目数据包用例
+func NewProjectBundleUsecase(
+	novelRepo NovelRepo,
+	videoScriptRepo VideoScriptRepo,
+	storyStateRepo StoryStateRepo,
+	qualityReportRepo QualityReportRepo,
+	plotThreadRepo PlotThreadRepo,
+	trashRepo TrashRepo,
+	access *ProjectAuthorizer,
+	logger log.Logger,
+) *ProjectBundleUsecase {
+	return &ProjectBundleUsecase{
+		novelRepo:         novelRepo,
+		videoScriptRepo:   videoScriptRepo,
+		storyStateRepo:    storyStateRepo,
+		qualityReportRepo: qualityReportRepo,
+		plotThreadRepo:    plotThreadRepo,
+		trashRepo:         trashRepo,
+		access:            access,
+		log:               log.NewHelper(logger),
+	}
+}
+
+// NewProjectBundleUsecaseWithIndex 创建导入后自动建立知识库索引的项目数据包用例
+func NewProjectBundleUsecaseWithIndex(
+	novelRepo NovelRepo,
+	videoScriptRepo VideoScriptRepo,
+	storyStateRepo StoryStateRepo,
+	qualityReportRepo QualityReportRepo,
+	plotThreadRepo PlotThreadRepo,
+	trashRepo TrashRepo,
+	access *ProjectAuthorizer,
+	index *IndexUsecase,
+	logger log.Logger,
+) *ProjectBundleUsecase {
+	uc := NewProjectBundleUsecase(novelRepo, videoScriptRepo, storyStateRepo, qualityReportRepo, plotThreadRepo, trashRepo, access, logger)
+	uc.index = index
+	return uc
+}
+
+// ExportProjectBundle 将项目及其章节、视频脚本、故事状态、质量报告与情节线索打包
+func (uc *ProjectBundleUsecase) ExportProjectBundle(ctx context.Context, projectID string) (*models.ProjectBundle, error) {
+	uc.log.WithContext(ctx).Infof("Exporting project bundle: %s", projectID)
+
+	project, err := uc.novelRepo.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if err := uc.access.Authorize(ctx, project, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	chapters, err := uc.novelRepo.ListChapters(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chapters: %w", err)
+	}
+
+	// 分页读取全部视频脚本
+	scripts := make([]*models.VideoScript, 0)
+	for page := 1; ; page++ {
+		batch, total, err := uc.videoScriptRepo.ListVideoScripts(ctx, projectID, page, bundleVideoScriptPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list video scripts: %w", err)
+		}
+		scripts = append(scripts, batch...)
+		if len(batch) < bundleVideoScriptPageSize || len(scripts) >= total {
+			break
+		}
+	}
+
+	states, err := uc.storyStateRepo.ListStoryStates(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list story states: %w", err)
+	}
+
+	// PageSize 为 0 时返回全部报告
+	reports, _, err := uc.qualityReportRepo.ListQualityReports(ctx, &models.QualityReportQuery{ProjectID: projectID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quality reports: %w", err)
+	}
+
+	threads, err := uc.plotThreadRepo.ListPlotThreads(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plot threads: %w", err)
+	}
+
+	// 章节单独存放，避免在项目中重复
+	project.Chapters = nil
+
+	return &models.ProjectBundle{
+		Format:         models.ProjectBundleFormat,
+		SchemaVersion:  models.ProjectBundleSchemaVersion,
+		ExportedAt:     time.Now(),
+		Project:        project,
+		Chapters:       chapters,
+		VideoScripts:   scripts,
+		StoryStates:    states,
+		QualityReports: reports,
+		PlotThreads:    threads,
+	}, nil
+}
+
+// MarshalProjectBundle 序列化项目数据包
+func (uc *ProjectBundleUsecase) MarshalProjectBundle(bundle *models.ProjectBundle) ([]byte, error) {
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal project bundle: %w", err)
+	}
+	return data, nil
+}
+
+// ParseProjectBundle 解析并校验项目数据包
+func (uc *ProjectBundleUsecase) ParseProjectBundle(data []byte) (*models.ProjectBundle, error) {
+	if len(data) == 0 {
+		return nil, ErrProjectBundleEmpty
+	}
+
+	var bundle models.ProjectBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProjectBundleInvalid, err)
+	}
+
+	if err := validateProjectBundle(&bundle); err != nil {
+		return nil, err
+	}
+
+	return &bundle, nil
+}
+
+// ImportProjectBundle 导入项目数据包，所有ID重新生成以避免与现有数据冲突
+func (uc *ProjectBundleUsecase) ImportProjectBundle(ctx context.Context, bundle *models.ProjectBundle, title string) (*models.ProjectBundleImportResult, error) {
+	if err := validateProjectBundle(bundle); err != nil {
+		return nil, err
+	}
+
+	ownerID, err := uc.access.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("Importing project bundle: %s (schema v%d)", bundle.Project.Title, bundle.SchemaVersion)
+
+	idMapping := make(map[string]string)
+	taken := make(map[string]struct{})
+
+	// 重建项目并重映射项目ID
+	project := *bundle.Project
+	project.ID = generateProjectID()
+	project.Chapters = nil
+	project.OwnerID = ownerID
+	if title != "" {
+		project.Title = title
+	}
+	if project.Status == "" {
+		project.Status = "draft"
+	}
+	idMapping[bundle.Project.ID] = project.ID
+
+	if project.WorldView != nil {
+		worldView := *project.WorldView
+		worldView.ProjectID = project.ID
+		project.WorldView = &worldView
+	}
+	if project.Characters != nil {
+		characters := make([]*models.Character, 0, len(project.Characters))
+		for _, char := range project.Characters {
+			if char == nil {
+				continue
+			}
+			c := *char
+			c.ProjectID = project.ID
+			characters = append(characters, &c)
+		}
+		project.Characters = characters
+	}
+	if project.Outline != nil {
+		outline := *project.Outline
+		outline.ProjectID = project.ID
+		project.Outline = &outline
+	}
+
+	createdProject, err := uc.novelRepo.CreateProject(ctx, &project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	result := &models.ProjectBundleImportResult{
+		Project:   createdProject,
+		IDMapping: idMapping,
+	}
+
+	// 导入章节
+	for _, src := range bundle.Chapters {
+		if src == nil {
+			continue
+		}
+		chapter := *src
+		chapter.ID = uniqueBundleID(taken, generateChapterID)
+		chapter.ProjectID = createdProject.ID
+		if chapter.Status == "" {
+			chapter.Status = "draft"
+		}
+		if src.ID != "" {
+			idMapping[src.ID] = chapter.ID
+		}
+
+		if _, err := uc.novelRepo.SaveChapter(ctx, &chapter); err != nil {
+			uc.rollbackImport(ctx, createdProject.ID, result)
+			return nil, fmt.Errorf("failed to import chapter %d: %w", src.Index, err)
+		}
+		result.ChapterCount++
+	}
+
+	// 导入视频脚本
+	for _, src := range bundle.VideoScripts {
+		if src == nil {
+			continue
+		}
+		script := *src
+		script.ID = uniqueBundleID(taken, generateVideoScriptID)
+		script.ProjectID = createdProject.ID
+		script.ChapterID = idMapping[src.ChapterID]
+		if src.ID != "" {
+			idMapping[src.ID] = script.ID
+		}
+
+		if _, err := uc.videoScriptRepo.SaveVideoScript(ctx, &script); err != nil {
+			uc.rollbackImport(ctx, createdProject.ID, result)
+			return nil, fmt.Errorf("failed to import video script %s: %w", src.Title, err)
+		}
+		result.VideoScriptCount++
+	}
+
+	if err := uc.importProjectState(ctx, bundle, createdProject.ID, idMapping, result); err != nil {
+		uc.rollbackImport(ctx, createdProject.ID, result)
+		return nil, err
+	}
+	uc.index.ProjectChanged(createdProject.ID)
+
+	uc.log.WithContext(ctx).Infof("Imported project bundle as %s: %d chapters, %d video scripts, %d story states, %d reports, %d plot threads",
+		createdProject.ID, result.ChapterCount, result.VideoScriptCount, result.StoryStateCount, result.ReportCount, result.PlotThreadCount)
+
+	return result, nil
+}
+
+// importProjectState 导入故事状态、质量报告与情节线索，章节ID按 idMapping 重映射
+func (uc *ProjectBundleUsecase) importProjectState(ctx context.Context, bundle *models.ProjectBundle, projectID string, idMapping map[string]string, result *models.ProjectBundleImportResult) error {
+	for _, src := range bundle.StoryStates {
+		if src == nil {
+			continue
+		}
+		state := *src
+		state.ProjectID = projectID
+		state.ChapterID = idMapping[src.ChapterID]
+		if err := uc.storyStateRepo.SaveStoryState(ctx, &state); err != nil {
+			return fmt.Errorf("failed to import story state of chapter %d: %w", src.ChapterIndex, err)
+		}
+		result.StoryStateCount++
+	}
+
+	// 报告ID全局唯一，需重新生成；所属章节不在数据包中的报告无法关联，直接跳过
+	reports := make([]*models.QualityReport, 0, len(bundle.QualityReports))
+	for i, src := range bundle.QualityReports {
+		if src == nil || idMapping[src.ChapterID] == "" {
+			continue
+		}
+		report := *src
+		report.ID = fmt.Sprintf("qr_%d_%d", time.Now().UnixNano(), i)
+		report.ProjectID = projectID
+		report.ChapterID = idMapping[src.ChapterID]
+		reports = append(reports, &report)
+	}
+	if len(reports) > 0 {
+		if err := uc.qualityReportRepo.SaveQualityReports(ctx, reports...); err != nil {
+			return fmt.Errorf("failed to import quality reports: %w", err)
+		}
+	}
+	result.ReportCount = len(reports)
+
+	// 线索ID仅在项目内唯一，保留原ID
+	threads := make([]*models.PlotThread, 0, len(bundle.PlotThreads))
+	for _, src := range bundle.PlotThreads {
+		if src == nil {
+			continue
+		}
+		thread := *src
+		thread.ProjectID = projectID
+		threads = append(threads, &thread)
+	}
+	if len(threads) > 0 {
+		if err := uc.plotThreadRepo.SavePlotThreads(ctx, projectID, threads); err != nil {
+			return fmt.Errorf("failed to import plot threads: %w", err)
+		}
+	}
+	result.PlotThreadCount = len(threads)
+
+	return nil
+}
+
+// rollbackImport 导入失败时彻底删除已写入的数据，删除项目只会移入回收站，需随后清除
+func (uc *ProjectBundleUsecase) rollbackImport(ctx context.Context, projectID string, result *models.ProjectBundleImportResult) {
+	if err := uc.novelRepo.DeleteProject(ctx, projectID); err != nil {
+		uc.log.WithContext(ctx).Warnf("Failed to rollback imported project %s: %v", projectID, err)
+	} else if err := uc.trashRepo.PurgeProject(ctx, projectID); err != nil {
+		uc.log.WithContext(ctx).Warnf("Failed to purge rolled back project %s: %v", projectID, err)
+	}
+	result.ChapterCount = 0
+	result.VideoScriptCount = 0
+	result.StoryStateCount = 0
+	result.ReportCount = 0
+	result.PlotThreadCount = 0
+}
+
+// validateProjectBundle 校验数据包格式与版本
+func validateProjectBundle(bundle *models.ProjectBundle) error {
+	if bundle == nil {
+		return ErrProjectBundleEmpty
+	}
+	if bundle.Format != "" && bundle.Format != models.ProjectBundleFormat {
+		return fmt.Errorf("%w: unexpected format %q", ErrProjectBundleInvalid, bundle.Format)
+	}
+	if bundle.SchemaVersion < 1 || bundle.SchemaVersion > models.ProjectBundleSchemaVersion {
+		return fmt.Errorf("%w: got %d, supported 1-%d", ErrProjectBundleVersionUnsupported,
+			bundle.SchemaVersion, models.ProjectBundleSchemaVersion)
+	}
+	if bundle.Project == nil {
+		return fmt.Errorf("%w: missing project", ErrProjectBundleInvalid)
+	}
+	if bundle.Project.Title == "" {
+		return fmt.Errorf("%w: missing project title", ErrProjectBundleInvalid)
+	}
+	return nil
+}
+
+// uniqueBundleID 生成在本次导入中不重复的ID
+func uniqueBundleID(taken map[string]struct{}, gen func() string) string {
+	for {
+		id := gen()
+		if _, ok := taken[id]; !ok {
+			taken[id] = struct{}{}
+			return id
+		}
+	}
+}
+
+func generateVideoScriptID() string {
+	return fmt.Sprintf("script-%d", time.Now().UnixNano())
+}
+
+// 错误定义
+var (
+	ErrProjectBundleEmpty              = fmt.Errorf("项目数据包为空")
+	ErrProjectBundleInvalid            = fmt.Errorf("项目数据包格式无效")
+	ErrProjectBundleVersionUnsupported = fmt.Errorf("不支持的项目数据包版本")
+)
@@ -0,0 +1,72 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "backend/api/novel/v1"
+	"backend/internal/pkg/models"
+	"backend/internal/pkg/textutil"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrCorrectionSpanMismatch 修正的原文已不在章节正文中
+var ErrCorrectionSpanMismatch = errors.Conflict(v1.ErrorReason_CORRECTION_SPAN_MISMATCH.String(), "the original text of the correction is no longer in the chapter")
+
+// ApplyCorrection 将修正应用到章节正文中对应的原文片段，返回修正后的章节和替换文本所在的位置
+// 偏移处的内容与期望的原文不一致时（例如前面的修正改变了长度），在正文中重新定位离原偏移最近的原文
+func (uc *NovelUsecase) ApplyCorrection(ctx context.Context, correction *models.Correction) (*models.Chapter, *models.TextSpan, error) {
+	uc.log.WithContext(ctx).Infof("Applying correction to chapter: %s", correction.ChapterID)
+
+	chapter, err := uc.GetChapter(ctx, correction.ChapterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if correction.ExpectedVersion > 0 {
+		chapter.Version = correction.ExpectedVersion
+	}
+
+	content := []rune(models.ChapterContent(chapter))
+	start, end := correction.Start, correction.End
+	if correction.Original == "" {
+		// 没有原文时只能按偏移插入或删除
+		if start < 0 || end < start || end > len(content) {
+			return nil, nil, fmt.Errorf("invalid correction span [%d, %d) for chapter of %d characters", start, end, len(content))
+		}
+	} else if start < 0 || end < start || end > len(content) || string(content[start:end]) != correction.Original {
+		var ok bool
+		start, end, ok = textutil.LocateQuote(string(content), correction.Original, start)
+		if !ok {
+			return nil, nil, ErrCorrectionSpanMismatch
+		}
+	}
+
+	replacement := []rune(correction.Replacement)
+	updated := make([]rune, 0, len(content)-(end-start)+len(replacement))
+	updated = append(updated, content[:start]...)
+	updated = append(updated, replacement...)
+	updated = append(updated, content[end:]...)
+
+	// 存储只保留一份正文，修正后的内容同时作为原文与润色内容
+	text := string(updated)
+	chapter.RawContent = text
+	if chapter.PolishedContent != "" {
+		chapter.PolishedContent = text
+	}
+	chapter.WordCount = len([]rune(strings.ReplaceAll(text, " ", "")))
+
+	saved, err := uc.UpdateChapter(ctx, chapter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return saved, &models.TextSpan{
+		ChapterID: saved.ID,
+		Start:     start,
+		End:       start + len(replacement),
+		Quote:     correction.Replacement,
+		Verified:  true,
+	}, nil
+}
@@ -0,0 +1,183 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "backend/api/novel/v1"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 生成锁租约时长与续期间隔，持有者崩溃后锁最多保留一个租约时长
+const (
+	generationLockTTL     = 30 * time.Second
+	generationLockRefresh = 10 * time.Second
+)
+
+var (
+	// ErrProjectGenerating 项目已有进行中的生成任务
+	ErrProjectGenerating = errors.Conflict(v1.ErrorReason_PROJECT_GENERATING.String(), "project is already being generated")
+	// ErrGenerationLockLost 生成锁已过期或被其他实例获取
+	ErrGenerationLockLost = errors.Conflict(v1.ErrorReason_PROJECT_GENERATING.String(), "generation lock was lost, the project may be generated by another request")
+)
+
+// GenerationLock 项目生成锁
+type GenerationLock interface {
+	// Refresh 续期锁，锁已丢失时返回 ErrGenerationLockLost
+	Refresh(ctx context.Context, ttl time.Duration) error
+	Unlock(ctx context.Context) error
+}
+
+// GenerationRepo 生成任务协调仓库，跨实例互斥并广播进度
+type GenerationRepo interface {
+	// LockProject 获取项目生成锁，已被占用时返回 ErrProjectGenerating
+	LockProject(ctx context.Context, projectID string, ttl time.Duration) (GenerationLock, error)
+	// PublishProgress 广播生成进度
+	PublishProgress(ctx context.Context, progress *models.GenerationProgress) error
+	// SubscribeProgress 订阅项目生成进度，ctx 结束后通道关闭
+	SubscribeProgress(ctx context.Context, projectID string) (<-chan *models.GenerationProgress, error)
+}
+
+// GenerationUsecase 生成任务协调用例
+type GenerationUsecase struct {
+	repo   GenerationRepo
+	access *ProjectAuthorizer
+	log    *log.Helper
+}
+
+// NewGenerationUsecase 创建生成任务协调用例
+func NewGenerationUsecase(repo GenerationRepo, access *ProjectAuthorizer, logger log.Logger) *GenerationUsecase {
+	return &GenerationUsecase{
+		repo:   repo,
+		access: access,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// Begin 开始项目生成任务，持有锁直到 End 被调用
+// 生成过程应使用会话的 Context，锁续期失败时该 Context 会被取消。
+func (uc *GenerationUsecase) Begin(ctx context.Context, projectID string) (*GenerationSession, error) {
+	lock, err := uc.repo.LockProject(ctx, projectID, generationLockTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionCtx, cancel := context.WithCancelCause(ctx)
+	session := &GenerationSession{
+		uc:        uc,
+		projectID: projectID,
+		lock:      lock,
+		ctx:       sessionCtx,
+		cancel:    cancel,
+		stop:      make(chan struct{}),
+	}
+	session.wg.Add(1)
+	go session.keepAlive()
+
+	uc.log.WithContext(ctx).Infof("Generation started: %s", projectID)
+	return session, nil
+}
+
+// WatchProgress 订阅项目生成进度
+func (uc *GenerationUsecase) WatchProgress(ctx context.Context, projectID string) (<-chan *models.GenerationProgress, error) {
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return uc.repo.SubscribeProgress(ctx, projectID)
+}
+
+// GenerationSession 进行中的生成任务
+type GenerationSession struct {
+	uc        *GenerationUsecase
+	projectID string
+	lock      GenerationLock
+	ctx       context.Context
+	cancel    context.CancelCauseFunc
+	stop      chan struct{}
+	once      sync.Once
+	failOnce  sync.Once
+	wg        sync.WaitGroup
+}
+
+// Context 生成任务的 Context，锁丢失时被取消，context.Cause 返回取消原因
+func (s *GenerationSession) Context() context.Context {
+	return s.ctx
+}
+
+// Publish 广播生成进度，失败只记录日志不影响生成
+func (s *GenerationSession) Publish(ctx context.Context, progress *models.GenerationProgress) {
+	progress.ProjectID = s.projectID
+	if progress.Timestamp.IsZero() {
+		progress.Timestamp = time.Now()
+	}
+	if err := s.uc.repo.PublishProgress(ctx, progress); err != nil {
+		s.uc.log.WithContext(ctx).Warnf("Failed to publish generation progress for %s: %v", s.projectID, err)
+	}
+}
+
+// Fail 广播生成失败，同一任务只广播一次
+func (s *GenerationSession) Fail(ctx context.Context, err error) {
+	s.failOnce.Do(func() {
+		s.Publish(ctx, &models.GenerationProgress{
+			Status:  models.GenerationStatusError,
+			Stage:   models.GenerationStatusError,
+			Message: "小说生成失败",
+			Error:   err.Error(),
+		})
+	})
+}
+
+// End 结束生成任务并释放锁
+func (s *GenerationSession) End(ctx context.Context) {
+	s.once.Do(func() {
+		close(s.stop)
+		s.wg.Wait()
+		s.cancel(nil)
+		if err := s.lock.Unlock(ctx); err != nil {
+			s.uc.log.WithContext(ctx).Warnf("Failed to release generation lock for %s: %v", s.projectID, err)
+		}
+		s.uc.log.WithContext(ctx).Infof("Generation finished: %s", s.projectID)
+	})
+}
+
+// keepAlive 定期续期生成锁，生成耗时可能远超租约时长
+// 续期失败时无法再保证互斥，取消生成任务并广播失败，避免与其他实例同时写入。
+func (s *GenerationSession) keepAlive() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(generationLockRefresh)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if err := s.refresh(); err != nil {
+				s.uc.log.Errorf("Failed to refresh generation lock for %s, cancelling generation: %v", s.projectID, err)
+				ctx, cancel := context.WithTimeout(context.Background(), generationLockRefresh)
+				s.Fail(ctx, err)
+				cancel()
+				s.cancel(err)
+				return
+			}
+		}
+	}
+}
+
+// refresh 续期一次生成锁
+func (s *GenerationSession) refresh() error {
+	ctx, cancel := context.WithTimeout(context.Background(), generationLockRefresh)
+	defer cancel()
+
+	err := s.lock.Refresh(ctx, generationLockTTL)
+	if err != nil && !errors.Is(err, ErrGenerationLockLost) {
+		return fmt.Errorf("failed to refresh generation lock: %w", err)
+	}
+	return err
+}
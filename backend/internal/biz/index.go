@@ -0,0 +1,497 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// indexJobTimeout 单个索引任务的超时时间
+const indexJobTimeout = 2 * time.Minute
+
+// 索引任务类型
+const (
+	indexJobSyncProject   = "sync_project"   // 对比内容摘要，增量同步项目全部条目
+	indexJobChapter       = "chapter"        // 同步单个章节
+	indexJobDeleteChapter = "delete_chapter" // 删除章节索引
+	indexJobDeleteProject = "delete_project" // 删除项目全部索引
+)
+
+// KnowledgeIndexer 知识库索引，由 data 层基于向量库实现
+type KnowledgeIndexer interface {
+	// IndexWorldView 写入或替换世界观设定
+	IndexWorldView(ctx context.Context, worldView *models.WorldView) error
+	// IndexCharacter 写入或替换人物卡
+	IndexCharacter(ctx context.Context, character *models.Character) error
+	// IndexChapter 写入或替换章节，characters 用于标注分块中出现的人物
+	IndexChapter(ctx context.Context, chapter *models.Chapter, characters []*models.Character) error
+	// RemoveItem 删除单个条目的索引
+	RemoveItem(ctx context.Context, itemType, itemID string) error
+	// RemoveProject 删除项目全部索引
+	RemoveProject(ctx context.Context, projectID string) error
+}
+
+// IndexStateRepo 索引记录仓库，用于增量索引与新鲜度统计
+type IndexStateRepo interface {
+	ListIndexStates(ctx context.Context, projectID string) ([]*models.IndexState, error)
+	SaveIndexState(ctx context.Context, state *models.IndexState) error
+	DeleteIndexState(ctx context.Context, itemType, itemID string) error
+	DeleteProjectIndexStates(ctx context.Context, projectID string) error
+}
+
+// indexJob 索引任务
+type indexJob struct {
+	kind      string
+	projectID string
+	itemID    string
+	force     bool // 忽略内容摘要，全部重新索引
+}
+
+// key 同一条目的任务只保留最新的一个，删除任务会覆盖尚未执行的写入任务
+func (j *indexJob) key() string {
+	switch j.kind {
+	case indexJobChapter, indexJobDeleteChapter:
+		return "chapter:" + j.itemID
+	default:
+		return "project:" + j.projectID
+	}
+}
+
+// IndexUsecase 知识库索引用例
+// 内容变更时由 NovelUsecase、TrashUsecase、ProjectBundleUsecase 调用钩子将任务放入队列，后台任务按内容摘要增量重新嵌入。
+// 所有钩子方法在接收者为 nil 时不做任何事，表示未启用自动索引。
+type IndexUsecase struct {
+	repo    NovelRepo
+	states  IndexStateRepo
+	indexer KnowledgeIndexer
+	access  *ProjectAuthorizer
+	log     *log.Helper
+
+	mu      sync.Mutex
+	pending map[string]*indexJob
+	order   []string
+	notify  chan struct{}
+}
+
+// NewIndexUsecase 创建知识库索引用例
+func NewIndexUsecase(repo NovelRepo, states IndexStateRepo, indexer KnowledgeIndexer, access *ProjectAuthorizer, logger log.Logger) *IndexUsecase {
+	return &IndexUsecase{
+		repo:    repo,
+		states:  states,
+		indexer: indexer,
+		access:  access,
+		log:     log.NewHelper(logger),
+		pending: make(map[string]*indexJob),
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// ProjectChanged 项目设定（世界观、人物）变更或项目被恢复
+func (uc *IndexUsecase) ProjectChanged(projectID string) {
+	if uc == nil {
+		return
+	}
+	uc.enqueue(&indexJob{kind: indexJobSyncProject, projectID: projectID})
+}
+
+// ChapterChanged 章节新增、更新或被恢复
+func (uc *IndexUsecase) ChapterChanged(projectID, chapterID string) {
+	if uc == nil {
+		return
+	}
+	uc.enqueue(&indexJob{kind: indexJobChapter, projectID: projectID, itemID: chapterID})
+}
+
+// ChapterRemoved 章节被删除
+func (uc *IndexUsecase) ChapterRemoved(projectID, chapterID string) {
+	if uc == nil {
+		return
+	}
+	uc.enqueue(&indexJob{kind: indexJobDeleteChapter, projectID: projectID, itemID: chapterID})
+}
+
+// ProjectRemoved 项目被删除
+func (uc *IndexUsecase) ProjectRemoved(projectID string) {
+	if uc == nil {
+		return
+	}
+	uc.enqueue(&indexJob{kind: indexJobDeleteProject, projectID: projectID})
+}
+
+// ReindexProject 重新索引项目，force 为 true 时忽略内容摘要全部重新嵌入
+func (uc *IndexUsecase) ReindexProject(ctx context.Context, projectID string, force bool) (*models.IndexStatus, error) {
+	uc.log.WithContext(ctx).Infof("Reindexing project: %s, force=%v", projectID, force)
+
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
+
+	uc.enqueue(&indexJob{kind: indexJobSyncProject, projectID: projectID, force: force})
+	return uc.status(ctx, projectID)
+}
+
+// GetIndexStatus 获取项目知识库索引的新鲜度
+func (uc *IndexUsecase) GetIndexStatus(ctx context.Context, projectID string) (*models.IndexStatus, error) {
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return uc.status(ctx, projectID)
+}
+
+// Run 持续处理索引任务直到 ctx 结束
+func (uc *IndexUsecase) Run(ctx context.Context) {
+	for {
+		uc.Drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-uc.notify:
+		}
+	}
+}
+
+// Drain 处理当前队列中的全部任务
+func (uc *IndexUsecase) Drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		job := uc.next()
+		if job == nil {
+			return
+		}
+
+		jobCtx, cancel := context.WithTimeout(ctx, indexJobTimeout)
+		if err := uc.process(jobCtx, job); err != nil {
+			uc.log.WithContext(ctx).Errorf("[Index] %s %s failed: %v", job.kind, job.key(), err)
+		}
+		cancel()
+	}
+}
+
+// enqueue 加入队列，同一条目已有待处理任务时替换为最新任务
+func (uc *IndexUsecase) enqueue(job *indexJob) {
+	uc.mu.Lock()
+	key := job.key()
+	if existing, ok := uc.pending[key]; ok {
+		// 强制重建的标记不被后续的普通同步覆盖
+		job.force = job.force || (existing.force && existing.kind == job.kind)
+	} else {
+		uc.order = append(uc.order, key)
+	}
+	uc.pending[key] = job
+	uc.mu.Unlock()
+
+	select {
+	case uc.notify <- struct{}{}:
+	default:
+	}
+}
+
+// next 取出最早的任务
+func (uc *IndexUsecase) next() *indexJob {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if len(uc.order) == 0 {
+		return nil
+	}
+	key := uc.order[0]
+	uc.order = uc.order[1:]
+	job := uc.pending[key]
+	delete(uc.pending, key)
+	return job
+}
+
+// pendingJobs 项目排队中的任务数
+func (uc *IndexUsecase) pendingJobs(projectID string) int {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	count := 0
+	for _, job := range uc.pending {
+		if job.projectID == projectID {
+			count++
+		}
+	}
+	return count
+}
+
+// process 执行索引任务
+func (uc *IndexUsecase) process(ctx context.Context, job *indexJob) error {
+	switch job.kind {
+	case indexJobSyncProject:
+		return uc.syncProject(ctx, job.projectID, job.force)
+	case indexJobChapter:
+		return uc.syncChapter(ctx, job.projectID, job.itemID)
+	case indexJobDeleteChapter:
+		if err := uc.indexer.RemoveItem(ctx, models.IndexItemChapter, job.itemID); err != nil {
+			return fmt.Errorf("failed to remove chapter index: %w", err)
+		}
+		return uc.states.DeleteIndexState(ctx, models.IndexItemChapter, job.itemID)
+	case indexJobDeleteProject:
+		if err := uc.indexer.RemoveProject(ctx, job.projectID); err != nil {
+			return fmt.Errorf("failed to remove project index: %w", err)
+		}
+		return uc.states.DeleteProjectIndexStates(ctx, job.projectID)
+	default:
+		return fmt.Errorf("unknown index job: %s", job.kind)
+	}
+}
+
+// indexItem 项目中需要索引的条目
+type indexItem struct {
+	itemType string
+	itemID   string
+	title    string
+	hash     string
+	index    func(ctx context.Context) error
+}
+
+// projectItems 收集项目当前需要索引的全部条目
+func (uc *IndexUsecase) projectItems(ctx context.Context, projectID string) ([]*indexItem, error) {
+	project, err := uc.repo.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	chapters, err := uc.repo.ListChapters(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chapters: %w", err)
+	}
+
+	var items []*indexItem
+	if project.WorldView != nil {
+		worldView := *project.WorldView
+		worldView.ProjectID = projectID
+		if worldView.ID == "" {
+			worldView.ID = projectID + "-worldview"
+		}
+		items = append(items, &indexItem{
+			itemType: models.IndexItemWorldView,
+			itemID:   worldView.ID,
+			title:    worldView.Title,
+			hash:     contentHash(&worldView),
+			index: func(ctx context.Context) error {
+				return uc.indexer.IndexWorldView(ctx, &worldView)
+			},
+		})
+	}
+
+	for i, c := range project.Characters {
+		character := *c
+		character.ProjectID = projectID
+		if character.ID == "" {
+			character.ID = fmt.Sprintf("%s-character-%d", projectID, i)
+		}
+		items = append(items, &indexItem{
+			itemType: models.IndexItemCharacter,
+			itemID:   character.ID,
+			title:    character.Name,
+			hash:     contentHash(&character),
+			index: func(ctx context.Context) error {
+				return uc.indexer.IndexCharacter(ctx, &character)
+			},
+		})
+	}
+
+	for _, chapter := range chapters {
+		items = append(items, uc.chapterItem(chapter, project.Characters))
+	}
+
+	return items, nil
+}
+
+// chapterItem 章节索引条目，人物名单变化会影响分块的人物标注，因此计入摘要
+func (uc *IndexUsecase) chapterItem(chapter *models.Chapter, characters []*models.Character) *indexItem {
+	names := make([]string, 0, len(characters))
+	for _, character := range characters {
+		names = append(names, character.Name)
+	}
+
+	return &indexItem{
+		itemType: models.IndexItemChapter,
+		itemID:   chapter.ID,
+		title:    chapter.Title,
+		hash: contentHash(struct {
+			Index      int      `json:"index"`
+			Title      string   `json:"title"`
+			Raw        string   `json:"raw"`
+			Polished   string   `json:"polished"`
+			WordCount  int      `json:"word_count"`
+			Characters []string `json:"characters"`
+		}{chapter.Index, chapter.Title, chapter.RawContent, chapter.PolishedContent, chapter.WordCount, names}),
+		index: func(ctx context.Context) error {
+			return uc.indexer.IndexChapter(ctx, chapter, characters)
+		},
+	}
+}
+
+// syncProject 对比索引记录增量同步项目，并删除已不存在条目的索引
+func (uc *IndexUsecase) syncProject(ctx context.Context, projectID string, force bool) error {
+	items, err := uc.projectItems(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	states, err := uc.loadStates(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	indexed, failed := 0, 0
+	for _, item := range items {
+		key := item.itemType + ":" + item.itemID
+		state := states[key]
+		delete(states, key)
+		if !force && state != nil && state.ContentHash == item.hash {
+			continue
+		}
+		if err := uc.indexItem(ctx, projectID, item); err != nil {
+			failed++
+			continue
+		}
+		indexed++
+	}
+
+	// 剩余的记录对应已删除的人物或章节
+	for _, state := range states {
+		if err := uc.indexer.RemoveItem(ctx, state.ItemType, state.ItemID); err != nil {
+			return fmt.Errorf("failed to remove %s index: %w", state.ItemType, err)
+		}
+		if err := uc.states.DeleteIndexState(ctx, state.ItemType, state.ItemID); err != nil {
+			return err
+		}
+	}
+
+	uc.log.WithContext(ctx).Infof("[Index] project %s synced: indexed=%d, failed=%d, removed=%d", projectID, indexed, failed, len(states))
+	if failed > 0 {
+		return fmt.Errorf("%d items failed to index", failed)
+	}
+	return nil
+}
+
+// syncChapter 同步单个章节，内容未变化时跳过
+func (uc *IndexUsecase) syncChapter(ctx context.Context, projectID, chapterID string) error {
+	chapter, err := uc.repo.GetChapter(ctx, chapterID)
+	if err != nil {
+		return fmt.Errorf("failed to get chapter: %w", err)
+	}
+	// 更新请求中可能不带项目ID，以数据库中的章节为准
+	projectID = chapter.ProjectID
+	project, err := uc.repo.GetProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	states, err := uc.loadStates(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	item := uc.chapterItem(chapter, project.Characters)
+	if state := states[item.itemType+":"+item.itemID]; state != nil && state.ContentHash == item.hash {
+		return nil
+	}
+	return uc.indexItem(ctx, projectID, item)
+}
+
+// indexItem 写入条目索引并记录结果，失败时记录错误以便新鲜度统计与下次重试
+func (uc *IndexUsecase) indexItem(ctx context.Context, projectID string, item *indexItem) error {
+	state := &models.IndexState{
+		ProjectID:   projectID,
+		ItemType:    item.itemType,
+		ItemID:      item.itemID,
+		ContentHash: item.hash,
+		IndexedAt:   time.Now(),
+	}
+
+	indexErr := item.index(ctx)
+	if indexErr != nil {
+		uc.log.WithContext(ctx).Warnf("[Index] failed to index %s %s: %v", item.itemType, item.itemID, indexErr)
+		state.ContentHash = ""
+		state.Error = indexErr.Error()
+	}
+	if err := uc.states.SaveIndexState(ctx, state); err != nil {
+		return err
+	}
+	return indexErr
+}
+
+// loadStates 按 类型:ID 加载项目的索引记录
+func (uc *IndexUsecase) loadStates(ctx context.Context, projectID string) (map[string]*models.IndexState, error) {
+	states, err := uc.states.ListIndexStates(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list index states: %w", err)
+	}
+	byKey := make(map[string]*models.IndexState, len(states))
+	for _, state := range states {
+		byKey[state.ItemType+":"+state.ItemID] = state
+	}
+	return byKey, nil
+}
+
+// status 对比当前内容与索引记录计算新鲜度
+func (uc *IndexUsecase) status(ctx context.Context, projectID string) (*models.IndexStatus, error) {
+	items, err := uc.projectItems(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	states, err := uc.loadStates(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.IndexStatus{
+		ProjectID: projectID,
+		Total:     len(items),
+		Pending:   uc.pendingJobs(projectID),
+		Items:     make([]*models.IndexItemStatus, 0, len(items)),
+	}
+	for _, item := range items {
+		itemStatus := &models.IndexItemStatus{
+			ItemType: item.itemType,
+			ItemID:   item.itemID,
+			Title:    item.title,
+		}
+		state := states[item.itemType+":"+item.itemID]
+		switch {
+		case state == nil:
+			itemStatus.State = models.IndexStateMissing
+			status.Missing++
+		case state.Error != "":
+			itemStatus.State = models.IndexStateFailed
+			itemStatus.Error = state.Error
+			status.Failed++
+		case state.ContentHash != item.hash:
+			itemStatus.State = models.IndexStateStale
+			status.Stale++
+		default:
+			itemStatus.State = models.IndexStateIndexed
+			status.Indexed++
+		}
+		if state != nil {
+			itemStatus.IndexedAt = state.IndexedAt
+			if state.IndexedAt.After(status.LastIndexedAt) {
+				status.LastIndexedAt = state.IndexedAt
+			}
+		}
+		status.Items = append(status.Items, itemStatus)
+	}
+	status.Fresh = status.Indexed == status.Total && status.Pending == 0
+
+	return status, nil
+}
+
+// contentHash 计算条目内容摘要
+func contentHash(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
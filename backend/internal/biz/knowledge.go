@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 知识库检索默认参数
+const (
+	defaultKnowledgeTopK = 10
+	maxKnowledgeTopK     = 50
+)
+
+// knowledgeContentTypes 支持检索的内容类型
+var knowledgeContentTypes = []string{models.KnowledgeWorldView, models.KnowledgeCharacter, models.KnowledgeChapter}
+
+// KnowledgeRepo 项目知识库检索，由 data 层基于向量库实现
+type KnowledgeRepo interface {
+	// SearchKnowledge 在单一内容类型中检索，返回按相关度降序排列的片段
+	SearchKnowledge(ctx context.Context, projectID, question, contentType string, topK int) ([]*models.KnowledgePassage, error)
+	// KnowledgeStats 统计各内容类型已索引的文档数
+	KnowledgeStats(ctx context.Context, projectID string) (map[string]int, error)
+}
+
+// KnowledgeUsecase 项目知识库语义检索用例
+type KnowledgeUsecase struct {
+	repo   KnowledgeRepo
+	access *ProjectAuthorizer
+	log    *log.Helper
+}
+
+// NewKnowledgeUsecase 创建项目知识库检索用例
+func NewKnowledgeUsecase(repo KnowledgeRepo, access *ProjectAuthorizer, logger log.Logger) *KnowledgeUsecase {
+	return &KnowledgeUsecase{
+		repo:   repo,
+		access: access,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// SearchProjectKnowledge 用自然语言问题检索项目的世界观、人物与章节片段
+// 多个内容类型的结果按相关度合并后截取前 TopK 条
+func (uc *KnowledgeUsecase) SearchProjectKnowledge(ctx context.Context, query *models.KnowledgeQuery) (*models.KnowledgeSearchResult, error) {
+	query.Question = strings.TrimSpace(query.Question)
+	if query.Question == "" {
+		return nil, ErrSearchQueryEmpty
+	}
+	if query.ProjectID == "" {
+		return nil, fmt.Errorf("project id is required")
+	}
+	if query.TopK <= 0 {
+		query.TopK = defaultKnowledgeTopK
+	}
+	if query.TopK > maxKnowledgeTopK {
+		query.TopK = maxKnowledgeTopK
+	}
+
+	contentTypes := query.ContentTypes
+	if len(contentTypes) == 0 {
+		contentTypes = knowledgeContentTypes
+	}
+	for _, contentType := range contentTypes {
+		if !isKnowledgeContentType(contentType) {
+			return nil, fmt.Errorf("unsupported content type: %s", contentType)
+		}
+	}
+
+	if err := uc.access.AuthorizeProject(ctx, query.ProjectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	uc.log.WithContext(ctx).Infof("Searching project knowledge: project=%s, question=%s, types=%v", query.ProjectID, query.Question, contentTypes)
+
+	var passages []*models.KnowledgePassage
+	for _, contentType := range contentTypes {
+		found, err := uc.repo.SearchKnowledge(ctx, query.ProjectID, query.Question, contentType, query.TopK)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search %s knowledge: %w", contentType, err)
+		}
+		passages = append(passages, found...)
+	}
+	sort.SliceStable(passages, func(i, j int) bool {
+		return passages[i].Score > passages[j].Score
+	})
+	if len(passages) > query.TopK {
+		passages = passages[:query.TopK]
+	}
+
+	stats, err := uc.repo.KnowledgeStats(ctx, query.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get knowledge stats: %w", err)
+	}
+
+	return &models.KnowledgeSearchResult{
+		Passages: passages,
+		Stats:    stats,
+	}, nil
+}
+
+// isKnowledgeContentType 判断是否为支持检索的内容类型
+func isKnowledgeContentType(contentType string) bool {
+	for _, t := range knowledgeContentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
@@ -3,10 +3,13 @@ package biz
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	v1 "backend/api/novel/v1"
 	"backend/internal/pkg/models"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -42,6 +45,8 @@ type NovelUsecase struct {
 	repo              NovelRepo
 	exportService     ExportService
 	videoScriptService VideoScriptService
+	access            *ProjectAuthorizer
+	index             *IndexUsecase // 内容变更时更新知识库索引，为 nil 时不自动索引
 	log               *log.Helper
 }
 
@@ -60,6 +65,33 @@ func NewNovelUsecase(
 	}
 }
 
+// NewNovelUsecaseWithAccess 创建启用项目访问控制的小说业务用例
+func NewNovelUsecaseWithAccess(
+	repo NovelRepo,
+	exportService ExportService,
+	videoScriptService VideoScriptService,
+	access *ProjectAuthorizer,
+	logger log.Logger,
+) *NovelUsecase {
+	uc := NewNovelUsecase(repo, exportService, videoScriptService, logger)
+	uc.access = access
+	return uc
+}
+
+// NewNovelUsecaseWithIndex 创建启用项目访问控制与知识库自动索引的小说业务用例
+func NewNovelUsecaseWithIndex(
+	repo NovelRepo,
+	exportService ExportService,
+	videoScriptService VideoScriptService,
+	access *ProjectAuthorizer,
+	index *IndexUsecase,
+	logger log.Logger,
+) *NovelUsecase {
+	uc := NewNovelUsecaseWithAccess(repo, exportService, videoScriptService, access, logger)
+	uc.index = index
+	return uc
+}
+
 // CreateProject 创建项目
 func (uc *NovelUsecase) CreateProject(ctx context.Context, project *models.NovelProject) (*models.NovelProject, error) {
 	uc.log.WithContext(ctx).Infof("Creating novel project: %s", project.Title)
@@ -74,6 +106,15 @@ func (uc *NovelUsecase) CreateProject(ctx context.Context, project *models.Novel
 		project.Status = "draft"
 	}
 
+	// 归属到当前调用者，启用访问控制时不允许匿名创建
+	ownerID, err := uc.access.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if project.OwnerID == "" {
+		project.OwnerID = ownerID
+	}
+
 	return uc.repo.CreateProject(ctx, project)
 }
 
@@ -81,21 +122,46 @@ func (uc *NovelUsecase) CreateProject(ctx context.Context, project *models.Novel
 func (uc *NovelUsecase) UpdateProject(ctx context.Context, project *models.NovelProject) (*models.NovelProject, error) {
 	uc.log.WithContext(ctx).Infof("Updating novel project: %s", project.ID)
 
+	if err := uc.access.AuthorizeProject(ctx, project.ID, models.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
+
 	// 更新时间
 	project.UpdatedAt = time.Now()
 
-	return uc.repo.UpdateProject(ctx, project)
+	updated, err := uc.repo.UpdateProject(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	uc.index.ProjectChanged(project.ID)
+
+	return updated, nil
 }
 
 // GetProject 获取项目
 func (uc *NovelUsecase) GetProject(ctx context.Context, projectID string) (*models.NovelProject, error) {
 	uc.log.WithContext(ctx).Infof("Getting novel project: %s", projectID)
 
+	return uc.getProject(ctx, projectID, models.ProjectRoleViewer)
+}
+
+// GetProjectForEdit 获取项目并要求调用者具备编辑权限，用于读取后会写回的操作
+func (uc *NovelUsecase) GetProjectForEdit(ctx context.Context, projectID string) (*models.NovelProject, error) {
+	uc.log.WithContext(ctx).Infof("Getting novel project for edit: %s", projectID)
+
+	return uc.getProject(ctx, projectID, models.ProjectRoleEditor)
+}
+
+func (uc *NovelUsecase) getProject(ctx context.Context, projectID string, role string) (*models.NovelProject, error) {
 	project, err := uc.repo.GetProject(ctx, projectID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
+	if err := uc.access.Authorize(ctx, project, role); err != nil {
+		return nil, err
+	}
+
 	// 加载章节列表
 	chapters, err := uc.repo.ListChapters(ctx, projectID)
 	if err != nil {
@@ -111,6 +177,15 @@ func (uc *NovelUsecase) GetProject(ctx context.Context, projectID string) (*mode
 func (uc *NovelUsecase) ListProjects(ctx context.Context, page, pageSize int) ([]*models.NovelProject, int, error) {
 	uc.log.WithContext(ctx).Infof("Listing novel projects: page=%d, pageSize=%d", page, pageSize)
 
+	// 启用访问控制时只列出调用者可见的项目
+	if uc.access != nil {
+		userID, err := uc.access.RequireUser(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		return uc.access.users.ListAccessibleProjects(ctx, userID, page, pageSize)
+	}
+
 	return uc.repo.ListProjects(ctx, page, pageSize)
 }
 
@@ -118,26 +193,27 @@ func (uc *NovelUsecase) ListProjects(ctx context.Context, page, pageSize int) ([
 func (uc *NovelUsecase) DeleteProject(ctx context.Context, projectID string) error {
 	uc.log.WithContext(ctx).Infof("Deleting novel project: %s", projectID)
 
-	// 删除所有章节
-	chapters, err := uc.repo.ListChapters(ctx, projectID)
-	if err != nil {
-		return fmt.Errorf("failed to list chapters: %w", err)
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleOwner); err != nil {
+		return err
 	}
 
-	for _, chapter := range chapters {
-		if err := uc.repo.DeleteChapter(ctx, chapter.ID); err != nil {
-			uc.log.WithContext(ctx).Warnf("Failed to delete chapter %s: %v", chapter.ID, err)
-		}
+	// 项目连同章节、视频脚本一起移入回收站，以便整体恢复
+	if err := uc.repo.DeleteProject(ctx, projectID); err != nil {
+		return err
 	}
+	uc.index.ProjectRemoved(projectID)
 
-	// 删除项目
-	return uc.repo.DeleteProject(ctx, projectID)
+	return nil
 }
 
 // SaveChapter 保存章节
 func (uc *NovelUsecase) SaveChapter(ctx context.Context, chapter *models.Chapter) (*models.Chapter, error) {
 	uc.log.WithContext(ctx).Infof("Saving chapter: %s", chapter.Title)
 
+	if err := uc.access.AuthorizeProject(ctx, chapter.ProjectID, models.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
+
 	// 生成章节ID
 	if chapter.ID == "" {
 		chapter.ID = generateChapterID()
@@ -153,30 +229,59 @@ func (uc *NovelUsecase) SaveChapter(ctx context.Context, chapter *models.Chapter
 		chapter.Status = "draft"
 	}
 
-	return uc.repo.SaveChapter(ctx, chapter)
+	saved, err := uc.repo.SaveChapter(ctx, chapter)
+	if err != nil {
+		return nil, err
+	}
+	uc.index.ChapterChanged(chapter.ProjectID, chapter.ID)
+
+	return saved, nil
 }
 
 // UpdateChapter 更新章节
 func (uc *NovelUsecase) UpdateChapter(ctx context.Context, chapter *models.Chapter) (*models.Chapter, error) {
 	uc.log.WithContext(ctx).Infof("Updating chapter: %s", chapter.ID)
 
+	if err := uc.access.AuthorizeChapter(ctx, chapter.ID, models.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
+
 	// 更新时间
 	chapter.UpdatedAt = time.Now()
 
-	return uc.repo.UpdateChapter(ctx, chapter)
+	updated, err := uc.repo.UpdateChapter(ctx, chapter)
+	if err != nil {
+		return nil, err
+	}
+	uc.index.ChapterChanged(chapter.ProjectID, chapter.ID)
+
+	return updated, nil
 }
 
 // GetChapter 获取章节
 func (uc *NovelUsecase) GetChapter(ctx context.Context, chapterID string) (*models.Chapter, error) {
 	uc.log.WithContext(ctx).Infof("Getting chapter: %s", chapterID)
 
-	return uc.repo.GetChapter(ctx, chapterID)
+	chapter, err := uc.repo.GetChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.AuthorizeProject(ctx, chapter.ProjectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	return chapter, nil
 }
 
 // ListChapters 列出章节
 func (uc *NovelUsecase) ListChapters(ctx context.Context, projectID string) ([]*models.Chapter, error) {
 	uc.log.WithContext(ctx).Infof("Listing chapters for project: %s", projectID)
 
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
 	return uc.repo.ListChapters(ctx, projectID)
 }
 
@@ -184,7 +289,24 @@ func (uc *NovelUsecase) ListChapters(ctx context.Context, projectID string) ([]*
 func (uc *NovelUsecase) DeleteChapter(ctx context.Context, chapterID string) error {
 	uc.log.WithContext(ctx).Infof("Deleting chapter: %s", chapterID)
 
-	return uc.repo.DeleteChapter(ctx, chapterID)
+	if err := uc.access.AuthorizeChapter(ctx, chapterID, models.ProjectRoleEditor); err != nil {
+		return err
+	}
+
+	// 删除前记录所属项目，用于清理知识库索引
+	projectID := ""
+	if uc.index != nil {
+		if chapter, err := uc.repo.GetChapter(ctx, chapterID); err == nil {
+			projectID = chapter.ProjectID
+		}
+	}
+
+	if err := uc.repo.DeleteChapter(ctx, chapterID); err != nil {
+		return err
+	}
+	uc.index.ChapterRemoved(projectID, chapterID)
+
+	return nil
 }
 
 // DeleteChapterOutline 删除章节大纲
@@ -197,6 +319,10 @@ func (uc *NovelUsecase) DeleteChapterOutline(ctx context.Context, projectID stri
 		return fmt.Errorf("failed to get project: %w", err)
 	}
 
+	if err := uc.access.Authorize(ctx, project, models.ProjectRoleEditor); err != nil {
+		return err
+	}
+
 	// 检查大纲是否存在
 	if project.Outline == nil || len(project.Outline.Chapters) == 0 {
 		return fmt.Errorf("project outline not found")
@@ -226,7 +352,8 @@ func (uc *NovelUsecase) DeleteChapterOutline(ctx context.Context, projectID stri
 }
 
 // ReorderChapterOutline 重排序章节大纲
-func (uc *NovelUsecase) ReorderChapterOutline(ctx context.Context, projectID string, indexMappings []struct {
+// expectedVersion 大于0时，仅在项目版本与之相同时才保存
+func (uc *NovelUsecase) ReorderChapterOutline(ctx context.Context, projectID string, expectedVersion int64, indexMappings []struct {
 	OldIndex int
 	NewIndex int
 }) error {
@@ -238,6 +365,10 @@ func (uc *NovelUsecase) ReorderChapterOutline(ctx context.Context, projectID str
 		return fmt.Errorf("failed to get project: %w", err)
 	}
 
+	if err := uc.access.Authorize(ctx, project, models.ProjectRoleEditor); err != nil {
+		return err
+	}
+
 	// 检查大纲是否存在
 	if project.Outline == nil || len(project.Outline.Chapters) == 0 {
 		return fmt.Errorf("project outline not found")
@@ -318,6 +449,9 @@ func (uc *NovelUsecase) ReorderChapterOutline(ctx context.Context, projectID str
 
 	// 更新项目
 	project.Outline.Chapters = newChapters
+	if expectedVersion > 0 {
+		project.Version = expectedVersion
+	}
 	_, err = uc.repo.UpdateProject(ctx, project)
 	if err != nil {
 		return fmt.Errorf("failed to update project: %w", err)
@@ -327,6 +461,122 @@ func (uc *NovelUsecase) ReorderChapterOutline(ctx context.Context, projectID str
 	return nil
 }
 
+// GenerationBaseline 生成开始时项目与各章节的版本号，保存生成结果时据此检测生成期间他人的修改
+type GenerationBaseline struct {
+	ProjectVersion  int64
+	ChapterVersions map[int]int64 // 按章节序号
+}
+
+// NewGenerationBaseline 记录项目及其已加载章节当前的版本号
+func NewGenerationBaseline(project *models.NovelProject) *GenerationBaseline {
+	baseline := &GenerationBaseline{
+		ProjectVersion:  project.Version,
+		ChapterVersions: make(map[int]int64, len(project.Chapters)),
+	}
+	for _, chapter := range project.Chapters {
+		if chapter != nil {
+			baseline.ChapterVersions[chapter.Index] = chapter.Version
+		}
+	}
+	return baseline
+}
+
+// SaveGeneratedNovel 保存整本生成的结果。生成耗时较长，期间项目或章节可能被他人修改，
+// 项目与同序号的已有章节都按生成开始时的版本做条件更新，有修改时返回版本冲突，不覆盖他人的修改
+func (uc *NovelUsecase) SaveGeneratedNovel(ctx context.Context, baseline *GenerationBaseline, generated *models.NovelProject, chapters []*models.Chapter) (*models.NovelProject, []*models.Chapter, error) {
+	uc.log.WithContext(ctx).Infof("Saving generated novel: %s, chapters=%d", generated.ID, len(chapters))
+
+	if err := uc.access.AuthorizeProject(ctx, generated.ID, models.ProjectRoleEditor); err != nil {
+		return nil, nil, err
+	}
+
+	existing, err := uc.repo.ListChapters(ctx, generated.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list chapters: %w", err)
+	}
+	byIndex := make(map[int]*models.Chapter, len(existing))
+	for _, chapter := range existing {
+		byIndex[chapter.Index] = chapter
+	}
+
+	// 写入前先检查要覆盖的章节，避免项目已保存后才发现章节冲突
+	for _, chapter := range chapters {
+		current, ok := byIndex[chapter.Index]
+		if !ok {
+			continue
+		}
+		if version, known := baseline.ChapterVersions[chapter.Index]; !known || version != current.Version {
+			return nil, nil, NewVersionConflictError("chapter", current.ID, current.Version)
+		}
+	}
+
+	generated.Version = baseline.ProjectVersion
+	generated.UpdatedAt = time.Now()
+	project, err := uc.repo.UpdateProject(ctx, generated)
+	if err != nil {
+		return nil, nil, err
+	}
+	uc.index.ProjectChanged(generated.ID)
+
+	saved := make([]*models.Chapter, 0, len(chapters))
+	for _, chapter := range chapters {
+		chapter.ProjectID = generated.ID
+		// 存储只保留一份正文，润色后的内容作为章节正文保存
+		chapter.RawContent = models.ChapterContent(chapter)
+		chapter.UpdatedAt = time.Now()
+
+		var result *models.Chapter
+		if current, ok := byIndex[chapter.Index]; ok {
+			// 同序号的章节已存在时覆盖其内容，仍按生成开始时的版本做条件更新
+			chapter.ID = current.ID
+			chapter.Version = baseline.ChapterVersions[chapter.Index]
+			chapter.CreatedAt = current.CreatedAt
+			result, err = uc.repo.UpdateChapter(ctx, chapter)
+		} else {
+			if chapter.ID == "" {
+				chapter.ID = generateChapterID()
+			}
+			chapter.CreatedAt = chapter.UpdatedAt
+			if chapter.Status == "" {
+				chapter.Status = "draft"
+			}
+			result, err = uc.repo.SaveChapter(ctx, chapter)
+		}
+		if err != nil {
+			if IsVersionConflict(err) {
+				return nil, nil, err
+			}
+			return nil, nil, fmt.Errorf("failed to save chapter %d: %w", chapter.Index, err)
+		}
+		uc.index.ChapterChanged(generated.ID, result.ID)
+		saved = append(saved, result)
+	}
+
+	return project, saved, nil
+}
+
+// GetStats 统计调用者可见项目的数量与字数
+func (uc *NovelUsecase) GetStats(ctx context.Context) (*models.ProjectStats, error) {
+	if uc.access == nil {
+		return nil, fmt.Errorf("project stats not available")
+	}
+
+	userID, err := uc.access.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	stats, err := uc.access.users.GetProjectStats(ctx, userID, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project stats: %w", err)
+	}
+
+	return stats, nil
+}
+
 // ExportNovel 导出小说
 func (uc *NovelUsecase) ExportNovel(ctx context.Context, project *models.NovelProject, format string, options *models.ExportOptions) (*models.ExportResult, error) {
 	uc.log.WithContext(ctx).Infof("Exporting novel: %s, format: %s", project.ID, format)
@@ -356,4 +606,21 @@ func generateProjectID() string {
 
 func generateChapterID() string {
 	return fmt.Sprintf("chap_%d", time.Now().UnixNano())
-}
\ No newline at end of file
+}
+
+// NewVersionConflictError 创建版本冲突错误，元数据中携带资源的当前版本号
+func NewVersionConflictError(resource, id string, currentVersion int64) error {
+	return errors.Conflict(
+		v1.ErrorReason_VERSION_CONFLICT.String(),
+		fmt.Sprintf("%s %s has been modified by another request, current version is %d", resource, id, currentVersion),
+	).WithMetadata(map[string]string{
+		"resource":        resource,
+		"id":              id,
+		"current_version": strconv.FormatInt(currentVersion, 10),
+	})
+}
+
+// IsVersionConflict 判断错误是否为版本冲突
+func IsVersionConflict(err error) bool {
+	return errors.Reason(err) == v1.ErrorReason_VERSION_CONFLICT.String()
+}
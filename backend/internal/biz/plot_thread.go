@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PlotThreadRepo 情节线索仓库
+type PlotThreadRepo interface {
+	// SavePlotThreads 以 threads 替换项目的全部线索
+	SavePlotThreads(ctx context.Context, projectID string, threads []*models.PlotThread) error
+	// ListPlotThreads 列出项目的全部线索
+	ListPlotThreads(ctx context.Context, projectID string) ([]*models.PlotThread, error)
+}
+
+// PlotThreadUsecase 情节线索跟踪用例
+type PlotThreadUsecase struct {
+	repo   PlotThreadRepo
+	access *ProjectAuthorizer
+	log    *log.Helper
+}
+
+// NewPlotThreadUsecase 创建情节线索跟踪用例
+func NewPlotThreadUsecase(repo PlotThreadRepo, access *ProjectAuthorizer, logger log.Logger) *PlotThreadUsecase {
+	return &PlotThreadUsecase{
+		repo:   repo,
+		access: access,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// ListThreads 列出项目的线索，status 为空时返回全部
+func (uc *PlotThreadUsecase) ListThreads(ctx context.Context, projectID, status string) ([]*models.PlotThread, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project id is required")
+	}
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	threads, err := uc.repo.ListPlotThreads(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if status == "" {
+		return threads, nil
+	}
+	filtered := make([]*models.PlotThread, 0, len(threads))
+	for _, thread := range threads {
+		if thread.Status == status {
+			filtered = append(filtered, thread)
+		}
+	}
+	return filtered, nil
+}
+
+// SaveThreads 以 threads 替换项目的全部线索
+func (uc *PlotThreadUsecase) SaveThreads(ctx context.Context, projectID string, threads []*models.PlotThread) error {
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleEditor); err != nil {
+		return err
+	}
+	return uc.repo.SavePlotThreads(ctx, projectID, threads)
+}
@@ -0,0 +1,183 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// QualityReportRepo 质量报告仓库，每次检查追加一份报告
+type QualityReportRepo interface {
+	// SaveQualityReports 追加保存检查报告
+	SaveQualityReports(ctx context.Context, reports ...*models.QualityReport) error
+	// ListQualityReports 按检查时间倒序列出报告，返回当前页与总数
+	ListQualityReports(ctx context.Context, query *models.QualityReportQuery) ([]*models.QualityReport, int, error)
+}
+
+// QualityReportUsecase 质量报告与趋势用例
+type QualityReportUsecase struct {
+	repo   QualityReportRepo
+	access *ProjectAuthorizer
+	log    *log.Helper
+}
+
+// NewQualityReportUsecase 创建质量报告用例
+func NewQualityReportUsecase(repo QualityReportRepo, access *ProjectAuthorizer, logger log.Logger) *QualityReportUsecase {
+	return &QualityReportUsecase{
+		repo:   repo,
+		access: access,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// RecordReports 保存检查报告，填写报告ID、检查时间与问题数
+func (uc *QualityReportUsecase) RecordReports(ctx context.Context, reports ...*models.QualityReport) error {
+	authorized := make(map[string]bool)
+	now := time.Now()
+	for i, report := range reports {
+		if !authorized[report.ProjectID] {
+			if err := uc.access.AuthorizeProject(ctx, report.ProjectID, models.ProjectRoleViewer); err != nil {
+				return err
+			}
+			authorized[report.ProjectID] = true
+		}
+		if report.ID == "" {
+			report.ID = fmt.Sprintf("qr_%d_%d", now.UnixNano(), i)
+		}
+		if report.CreatedAt.IsZero() {
+			report.CreatedAt = now
+		}
+		if report.IssueCount == 0 {
+			for _, count := range report.IssuesBySeverity {
+				report.IssueCount += count
+			}
+		}
+	}
+	return uc.repo.SaveQualityReports(ctx, reports...)
+}
+
+// ListChapterReports 列出章节的检查历史，按检查时间倒序
+func (uc *QualityReportUsecase) ListChapterReports(ctx context.Context, projectID, chapterID, kind string, page, pageSize int) ([]*models.QualityReport, int, error) {
+	if projectID == "" || chapterID == "" {
+		return nil, 0, fmt.Errorf("project id and chapter id are required")
+	}
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, 0, err
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	return uc.repo.ListQualityReports(ctx, &models.QualityReportQuery{
+		ProjectID: projectID,
+		ChapterID: chapterID,
+		Kind:      kind,
+		Page:      page,
+		PageSize:  pageSize,
+	})
+}
+
+// GetQualityTrends 统计项目最近 days 天（<=0 表示全部）的质量趋势
+// 按天给出报告平均分；按章节给出最近一次的分数，以及润色前后最近一次检查的分数对比
+func (uc *QualityReportUsecase) GetQualityTrends(ctx context.Context, projectID, kind string, days int) (*models.QualityTrends, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project id is required")
+	}
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	if kind == "" {
+		kind = models.QualityReportQuality
+	}
+
+	query := &models.QualityReportQuery{ProjectID: projectID, Kind: kind}
+	if days > 0 {
+		query.Since = time.Now().AddDate(0, 0, -days)
+	}
+	reports, _, err := uc.repo.ListQualityReports(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildQualityTrends(projectID, kind, reports), nil
+}
+
+// buildQualityTrends 由按时间倒序排列的报告计算趋势
+func buildQualityTrends(projectID, kind string, reports []*models.QualityReport) *models.QualityTrends {
+	trends := &models.QualityTrends{ProjectID: projectID, Kind: kind}
+
+	points := make(map[string]*models.QualityTrendPoint)
+	chapters := make(map[string]*models.ChapterQualityTrend)
+	hasBefore, hasAfter := make(map[string]bool), make(map[string]bool)
+	for _, report := range reports {
+		date := report.CreatedAt.Format("2006-01-02")
+		point, ok := points[date]
+		if !ok {
+			point = &models.QualityTrendPoint{Date: date}
+			points[date] = point
+		}
+		point.AverageScore += report.OverallScore
+		point.ReportCount++
+
+		// 尚未保存的章节没有ID，按章节索引区分
+		key := report.ChapterID
+		if key == "" {
+			key = fmt.Sprintf("#%d", report.ChapterIndex)
+		}
+		chapter, ok := chapters[key]
+		if !ok {
+			// 报告按时间倒序，第一份即最近一次检查
+			chapter = &models.ChapterQualityTrend{
+				ChapterID:    report.ChapterID,
+				ChapterIndex: report.ChapterIndex,
+				LatestScore:  report.OverallScore,
+			}
+			chapters[key] = chapter
+		}
+		chapter.ReportCount++
+		if report.Polished && !hasAfter[key] {
+			chapter.AfterPolishScore = report.OverallScore
+			hasAfter[key] = true
+		}
+		if !report.Polished && !hasBefore[key] {
+			chapter.BeforePolishScore = report.OverallScore
+			hasBefore[key] = true
+		}
+	}
+
+	for _, point := range points {
+		point.AverageScore /= float64(point.ReportCount)
+		trends.Points = append(trends.Points, point)
+	}
+	sort.Slice(trends.Points, func(i, j int) bool { return trends.Points[i].Date < trends.Points[j].Date })
+
+	for key, chapter := range chapters {
+		chapter.HasPolishCompare = hasBefore[key] && hasAfter[key]
+		if chapter.HasPolishCompare {
+			trends.AverageBefore += chapter.BeforePolishScore
+			trends.AverageAfter += chapter.AfterPolishScore
+			trends.PolishComparedCount++
+		}
+		trends.Chapters = append(trends.Chapters, chapter)
+	}
+	sort.Slice(trends.Chapters, func(i, j int) bool {
+		if trends.Chapters[i].ChapterIndex != trends.Chapters[j].ChapterIndex {
+			return trends.Chapters[i].ChapterIndex < trends.Chapters[j].ChapterIndex
+		}
+		return trends.Chapters[i].ChapterID < trends.Chapters[j].ChapterID
+	})
+	if trends.PolishComparedCount > 0 {
+		trends.AverageBefore /= float64(trends.PolishComparedCount)
+		trends.AverageAfter /= float64(trends.PolishComparedCount)
+	}
+
+	return trends
+}
@@ -0,0 +1,110 @@
+package biz
+
+import (
+	v1 "backend/api/novel/v1"
+	"backend/internal/conf"
+	"backend/internal/pkg/models"
+	"backend/internal/pkg/moderation"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrContentBlocked 内容分级达到阈值，阻止导出或发布
+var ErrContentBlocked = errors.Forbidden(v1.ErrorReason_CONTENT_BLOCKED.String(), "content rating reaches the block threshold")
+
+// SafetyUsecase 内容安全审核策略：审核词库、是否调用模型分类，以及阻止导出与发布的分级阈值
+type SafetyUsecase struct {
+	dictionary  *moderation.Dictionary
+	blockRating string
+	useLLM      bool
+	log         *log.Helper
+}
+
+// NewSafetyUsecase 根据配置创建内容安全审核策略，无效的词条与阈值会被忽略并记录日志
+func NewSafetyUsecase(c *conf.Data, logger log.Logger) *SafetyUsecase {
+	helper := log.NewHelper(logger)
+	cfg := c.GetSafety()
+
+	var rules []moderation.Rule
+	if !cfg.GetDisableBuiltin() {
+		rules = moderation.DefaultRules()
+	}
+	for _, rule := range cfg.GetRules() {
+		rules = append(rules, moderation.Rule{
+			Category: rule.GetCategory(),
+			Severity: rule.GetSeverity(),
+			Keywords: rule.GetKeywords(),
+			Patterns: rule.GetPatterns(),
+		})
+	}
+	dictionary, err := moderation.NewDictionary(rules)
+	if err != nil {
+		helper.Warnf("Failed to load some safety rules: %v", err)
+	}
+
+	blockRating := cfg.GetBlockRating()
+	if blockRating != "" && !moderation.ValidRating(blockRating) {
+		helper.Warnf("Invalid safety block rating %q, export and publish will not be blocked", blockRating)
+		blockRating = ""
+	}
+
+	return &SafetyUsecase{
+		dictionary:  dictionary,
+		blockRating: blockRating,
+		useLLM:      cfg.GetLlmClassifier(),
+		log:         helper,
+	}
+}
+
+// Dictionary 审核词库
+func (uc *SafetyUsecase) Dictionary() *moderation.Dictionary {
+	return uc.dictionary
+}
+
+// BlockRating 阻止导出与发布的分级阈值，为空表示不阻止
+func (uc *SafetyUsecase) BlockRating() string {
+	return uc.blockRating
+}
+
+// UseLLM 导出与发布前的审核是否调用模型分类
+func (uc *SafetyUsecase) UseLLM() bool {
+	return uc.useLLM
+}
+
+// Apply 按阈值标记报告中应阻止的审核对象
+func (uc *SafetyUsecase) Apply(report *models.SafetyReport) {
+	report.Blocked = uc.markReviews(report)
+}
+
+// ApplyPartial 按阈值标记只覆盖部分章节的报告，项目整体的分级与阻止状态无法确定，保持为空
+func (uc *SafetyUsecase) ApplyPartial(report *models.SafetyReport) {
+	uc.markReviews(report)
+	report.Rating = ""
+	report.Blocked = false
+}
+
+// markReviews 标记达到阈值的审核对象，返回是否有审核对象被阻止
+func (uc *SafetyUsecase) markReviews(report *models.SafetyReport) bool {
+	report.BlockRating = uc.blockRating
+	blocked := false
+	for _, review := range report.Reviews {
+		review.Blocked = moderation.RatingAtLeast(review.Rating, uc.blockRating)
+		if review.Blocked {
+			blocked = true
+		}
+	}
+	return blocked
+}
+
+// Enforce 按阈值标记报告，有审核对象达到阈值时返回 ErrContentBlocked
+func (uc *SafetyUsecase) Enforce(report *models.SafetyReport) error {
+	uc.Apply(report)
+	if !report.Blocked {
+		return nil
+	}
+	return ErrContentBlocked.WithMetadata(map[string]string{
+		"rating":       report.Rating,
+		"block_rating": report.BlockRating,
+	})
+}
@@ -0,0 +1,94 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"backend/internal/pkg/models"
+	"backend/internal/pkg/textutil"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// searchSnippetRadius 高亮片段在命中位置前后保留的字符数
+	searchSnippetRadius = 40
+	// searchHighlightPre 高亮起始标记
+	searchHighlightPre = "<mark>"
+	// searchHighlightPost 高亮结束标记
+	searchHighlightPost = "</mark>"
+)
+
+// SearchRepo 全文检索仓库接口
+// 索引由 NovelRepo 在保存章节、更新章节和更新项目时同步维护。
+type SearchRepo interface {
+	SearchContent(context.Context, *models.SearchQuery) ([]*models.SearchHit, int, error)
+}
+
+// SearchUsecase 全文检索用例
+type SearchUsecase struct {
+	repo   SearchRepo
+	access *ProjectAuthorizer
+	log    *log.Helper
+}
+
+// NewSearchUsecase 创建全文检索用例
+func NewSearchUsecase(repo SearchRepo, access *ProjectAuthorizer, logger log.Logger) *SearchUsecase {
+	return &SearchUsecase{
+		repo:   repo,
+		access: access,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// SearchContent 检索章节正文、摘要、人物卡和大纲，并生成高亮片段
+func (uc *SearchUsecase) SearchContent(ctx context.Context, query *models.SearchQuery) ([]*models.SearchHit, int, error) {
+	query.Query = strings.TrimSpace(query.Query)
+	if query.Query == "" {
+		return nil, 0, ErrSearchQueryEmpty
+	}
+	if len(textutil.Tokenize(query.Query)) == 0 {
+		return nil, 0, ErrSearchQueryEmpty
+	}
+	if query.ChapterFrom > 0 && query.ChapterTo > 0 && query.ChapterFrom > query.ChapterTo {
+		return nil, 0, fmt.Errorf("invalid chapter range: %d-%d", query.ChapterFrom, query.ChapterTo)
+	}
+	if query.Page <= 0 {
+		query.Page = 1
+	}
+	if query.PageSize <= 0 {
+		query.PageSize = 20
+	}
+
+	uc.log.WithContext(ctx).Infof("Searching content: query=%s, project=%s", query.Query, query.ProjectID)
+
+	// 指定项目时校验查看权限，未指定时只检索调用者可见的项目
+	if err := uc.access.AuthorizeProject(ctx, query.ProjectID, models.ProjectRoleViewer); err != nil {
+		return nil, 0, err
+	}
+	if uc.access != nil {
+		userID, err := uc.access.RequireUser(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		query.Scope = &models.ProjectScope{UserID: userID}
+	}
+
+	hits, total, err := uc.repo.SearchContent(ctx, query)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search content: %w", err)
+	}
+
+	terms := strings.Fields(query.Query)
+	for _, hit := range hits {
+		hit.Snippet = textutil.Snippet(hit.Content, terms, searchSnippetRadius, searchHighlightPre, searchHighlightPost)
+	}
+
+	return hits, total, nil
+}
+
+// 错误定义
+var (
+	ErrSearchQueryEmpty = fmt.Errorf("检索词不能为空")
+)
@@ -0,0 +1,82 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// StoryStateRepo 故事状态仓库，每章保存一份累积到该章结束时的状态
+type StoryStateRepo interface {
+	// SaveStoryState 写入或覆盖章节的故事状态
+	SaveStoryState(ctx context.Context, state *models.StoryState) error
+	// GetStoryState 获取章节索引不大于 chapterIndex 的最近一份状态，没有时返回 nil
+	GetStoryState(ctx context.Context, projectID string, chapterIndex int) (*models.StoryState, error)
+	// ListStoryStates 按章节索引升序列出项目的全部故事状态
+	ListStoryStates(ctx context.Context, projectID string) ([]*models.StoryState, error)
+}
+
+// StoryStateUsecase 故事状态跟踪用例
+type StoryStateUsecase struct {
+	repo   StoryStateRepo
+	access *ProjectAuthorizer
+	log    *log.Helper
+}
+
+// NewStoryStateUsecase 创建故事状态跟踪用例
+func NewStoryStateUsecase(repo StoryStateRepo, access *ProjectAuthorizer, logger log.Logger) *StoryStateUsecase {
+	return &StoryStateUsecase{
+		repo:   repo,
+		access: access,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// GetStoryState 获取第 chapterIndex 章结束时的故事状态，chapterIndex<=0 时返回最新状态
+func (uc *StoryStateUsecase) GetStoryState(ctx context.Context, projectID string, chapterIndex int) (*models.StoryState, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project id is required")
+	}
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	if chapterIndex <= 0 {
+		chapterIndex = math.MaxInt32
+	}
+	return uc.repo.GetStoryState(ctx, projectID, chapterIndex)
+}
+
+// StateBefore 获取生成第 chapterIndex 章时使用的状态，即此前最近一章结束时的状态，第一章返回 nil
+func (uc *StoryStateUsecase) StateBefore(ctx context.Context, projectID string, chapterIndex int) (*models.StoryState, error) {
+	if chapterIndex <= 1 {
+		return nil, nil
+	}
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+	return uc.repo.GetStoryState(ctx, projectID, chapterIndex-1)
+}
+
+// SaveStoryStates 保存章节结束时的故事状态
+func (uc *StoryStateUsecase) SaveStoryStates(ctx context.Context, states ...*models.StoryState) error {
+	authorized := make(map[string]bool)
+	for _, state := range states {
+		if state == nil {
+			continue
+		}
+		if !authorized[state.ProjectID] {
+			if err := uc.access.AuthorizeProject(ctx, state.ProjectID, models.ProjectRoleEditor); err != nil {
+				return err
+			}
+			authorized[state.ProjectID] = true
+		}
+		if err := uc.repo.SaveStoryState(ctx, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
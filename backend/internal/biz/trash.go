@@ -0,0 +1,207 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "backend/api/novel/v1"
+	"backend/internal/conf"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultTrashRetentionDays 未配置时回收站的默认保留天数
+const defaultTrashRetentionDays = 30
+
+var (
+	// ErrTrashItemNotFound 回收站中不存在该条目
+	ErrTrashItemNotFound = errors.NotFound(v1.ErrorReason_TRASH_ITEM_NOT_FOUND.String(), "trash item not found")
+	// ErrTrashParentDeleted 所属项目仍在回收站中
+	ErrTrashParentDeleted = errors.Conflict(v1.ErrorReason_TRASH_PARENT_DELETED.String(), "project of the chapter is in trash, restore the project first")
+)
+
+// TrashRepo 回收站数据仓库接口
+type TrashRepo interface {
+	// ListTrash 列出回收站条目，itemType 为空表示全部类型，scope 为 nil 表示不限项目范围
+	ListTrash(ctx context.Context, itemType string, scope *models.ProjectScope, page, pageSize int) ([]*models.TrashItem, int, error)
+	// GetTrashItemProject 获取回收站条目所属的项目，用于校验权限
+	GetTrashItemProject(ctx context.Context, itemType, itemID string) (*models.NovelProject, error)
+	// RestoreProject 恢复项目，连同随项目一起删除的章节与视频脚本
+	RestoreProject(ctx context.Context, projectID string) (*models.NovelProject, error)
+	// RestoreChapter 恢复单独删除的章节
+	RestoreChapter(ctx context.Context, chapterID string) (*models.Chapter, error)
+	// PurgeProject 彻底删除回收站中的项目及其章节、视频脚本
+	PurgeProject(ctx context.Context, projectID string) error
+	// PurgeChapter 彻底删除回收站中的章节
+	PurgeChapter(ctx context.Context, chapterID string) error
+	// PurgeDeletedBefore 彻底删除指定时间之前进入回收站的条目，返回被删除的项目ID与删除的项目、章节总数
+	PurgeDeletedBefore(ctx context.Context, before time.Time) ([]string, int, error)
+}
+
+// TrashUsecase 回收站业务用例
+type TrashUsecase struct {
+	repo      TrashRepo
+	retention time.Duration
+	access    *ProjectAuthorizer
+	index     *IndexUsecase // 恢复后重新建立知识库索引，为 nil 时不自动索引
+	log       *log.Helper
+}
+
+// NewTrashUsecase 创建回收站业务用例
+func NewTrashUsecase(c *conf.Data, repo TrashRepo, access *ProjectAuthorizer, logger log.Logger) *TrashUsecase {
+	days := int32(defaultTrashRetentionDays)
+	if c.GetTrash().GetRetentionDays() > 0 {
+		days = c.GetTrash().GetRetentionDays()
+	}
+
+	return &TrashUsecase{
+		repo:      repo,
+		retention: time.Duration(days) * 24 * time.Hour,
+		access:    access,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// NewTrashUsecaseWithIndex 创建恢复条目时自动重建知识库索引的回收站业务用例
+func NewTrashUsecaseWithIndex(c *conf.Data, repo TrashRepo, access *ProjectAuthorizer, index *IndexUsecase, logger log.Logger) *TrashUsecase {
+	uc := NewTrashUsecase(c, repo, access, logger)
+	uc.index = index
+	return uc
+}
+
+// Retention 回收站保留时长
+func (uc *TrashUsecase) Retention() time.Duration {
+	return uc.retention
+}
+
+// ListTrash 列出回收站条目并计算到期时间
+func (uc *TrashUsecase) ListTrash(ctx context.Context, itemType string, page, pageSize int) ([]*models.TrashItem, int, error) {
+	uc.log.WithContext(ctx).Infof("Listing trash: type=%s, page=%d, pageSize=%d", itemType, page, pageSize)
+
+	if itemType != "" && itemType != models.TrashItemProject && itemType != models.TrashItemChapter {
+		return nil, 0, fmt.Errorf("invalid trash item type: %s", itemType)
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	// 启用访问控制时只列出调用者可见项目中的条目
+	var scope *models.ProjectScope
+	if uc.access != nil {
+		userID, err := uc.access.RequireUser(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		scope = &models.ProjectScope{UserID: userID}
+	}
+
+	items, total, err := uc.repo.ListTrash(ctx, itemType, scope, page, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	for _, item := range items {
+		item.ExpiresAt = item.DeletedAt.Add(uc.retention)
+	}
+
+	return items, total, nil
+}
+
+// RestoreProject 从回收站恢复项目
+func (uc *TrashUsecase) RestoreProject(ctx context.Context, projectID string) (*models.NovelProject, error) {
+	uc.log.WithContext(ctx).Infof("Restoring project from trash: %s", projectID)
+
+	if err := uc.authorize(ctx, models.TrashItemProject, projectID, models.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
+
+	project, err := uc.repo.RestoreProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	uc.index.ProjectChanged(projectID)
+
+	return project, nil
+}
+
+// RestoreChapter 从回收站恢复章节
+func (uc *TrashUsecase) RestoreChapter(ctx context.Context, chapterID string) (*models.Chapter, error) {
+	uc.log.WithContext(ctx).Infof("Restoring chapter from trash: %s", chapterID)
+
+	if err := uc.authorize(ctx, models.TrashItemChapter, chapterID, models.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
+
+	chapter, err := uc.repo.RestoreChapter(ctx, chapterID)
+	if err != nil {
+		return nil, err
+	}
+	uc.index.ChapterChanged(chapter.ProjectID, chapter.ID)
+
+	return chapter, nil
+}
+
+// PurgeProject 彻底删除回收站中的项目
+func (uc *TrashUsecase) PurgeProject(ctx context.Context, projectID string) error {
+	uc.log.WithContext(ctx).Infof("Purging project from trash: %s", projectID)
+
+	if err := uc.authorize(ctx, models.TrashItemProject, projectID, models.ProjectRoleOwner); err != nil {
+		return err
+	}
+
+	if err := uc.repo.PurgeProject(ctx, projectID); err != nil {
+		return err
+	}
+	// 移入回收站时已清理过索引，这里再次清理以防当时的索引任务失败
+	uc.index.ProjectRemoved(projectID)
+
+	return nil
+}
+
+// PurgeChapter 彻底删除回收站中的章节
+func (uc *TrashUsecase) PurgeChapter(ctx context.Context, chapterID string) error {
+	uc.log.WithContext(ctx).Infof("Purging chapter from trash: %s", chapterID)
+
+	if err := uc.authorize(ctx, models.TrashItemChapter, chapterID, models.ProjectRoleOwner); err != nil {
+		return err
+	}
+
+	return uc.repo.PurgeChapter(ctx, chapterID)
+}
+
+// authorize 按条目所属项目校验调用者权限
+func (uc *TrashUsecase) authorize(ctx context.Context, itemType, itemID, required string) error {
+	if uc.access == nil {
+		return nil
+	}
+
+	project, err := uc.repo.GetTrashItemProject(ctx, itemType, itemID)
+	if err != nil {
+		return err
+	}
+	return uc.access.Authorize(ctx, project, required)
+}
+
+// PurgeExpired 彻底删除超过保留期的回收站条目
+func (uc *TrashUsecase) PurgeExpired(ctx context.Context) (int, error) {
+	before := time.Now().Add(-uc.retention)
+
+	projectIDs, count, err := uc.repo.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired trash: %w", err)
+	}
+	for _, projectID := range projectIDs {
+		uc.index.ProjectRemoved(projectID)
+	}
+	if count > 0 {
+		uc.log.WithContext(ctx).Infof("Purged %d expired trash items deleted before %s", count, before.Format(time.RFC3339))
+	}
+
+	return count, nil
+}
@@ -0,0 +1,313 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	novelv1 "backend/api/novel/v1"
+	v1 "backend/api/user/v1"
+	"backend/internal/pkg/auth"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrUnauthenticated 需要登录
+	ErrUnauthenticated = errors.Unauthorized(v1.ErrorReason_UNAUTHENTICATED.String(), "authentication required")
+	// ErrInvalidToken 访问令牌无效
+	ErrInvalidToken = errors.Unauthorized(v1.ErrorReason_INVALID_TOKEN.String(), "invalid access token")
+	// ErrUsernameTaken 用户名已被占用
+	ErrUsernameTaken = errors.Conflict(v1.ErrorReason_USERNAME_TAKEN.String(), "username already taken")
+	// ErrAccountNotFound 用户不存在
+	ErrAccountNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
+	// ErrInvalidRole 无效的成员角色
+	ErrInvalidRole = errors.BadRequest(v1.ErrorReason_INVALID_ROLE.String(), "role must be editor or viewer")
+	// ErrProjectForbidden 无权访问或修改项目
+	ErrProjectForbidden = errors.Forbidden(novelv1.ErrorReason_PROJECT_FORBIDDEN.String(), "no permission on this project")
+)
+
+// roleRank 角色权限等级，数值越大权限越高
+var roleRank = map[string]int{
+	models.ProjectRoleViewer: 1,
+	models.ProjectRoleEditor: 2,
+	models.ProjectRoleOwner:  3,
+}
+
+// UserRepo 用户与项目成员数据仓库接口
+type UserRepo interface {
+	// 用户管理
+	CreateUser(ctx context.Context, user *models.User, tokenHash string) (*models.User, error)
+	GetUser(ctx context.Context, userID string) (*models.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*models.User, error)
+	GetUserByTokenHash(ctx context.Context, tokenHash string) (*models.User, error)
+
+	// 项目成员
+	GetProjectRole(ctx context.Context, projectID, userID string) (string, error)
+	SaveProjectMember(ctx context.Context, member *models.ProjectMember) (*models.ProjectMember, error)
+	RemoveProjectMember(ctx context.Context, projectID, userID string) error
+	ListProjectMembers(ctx context.Context, projectID string) ([]*models.ProjectMember, error)
+
+	// 按调用者可见范围查询项目：本人所有、参与协作以及未归属的项目
+	ListAccessibleProjects(ctx context.Context, userID string, page, pageSize int) ([]*models.NovelProject, int, error)
+	GetProjectStats(ctx context.Context, userID string, monthStart time.Time) (*models.ProjectStats, error)
+}
+
+// ProjectAuthorizer 项目访问控制
+//
+// 启用访问控制时所有项目操作都要求登录。未归属（OwnerID 为空）的项目是多用户之前创建的数据，
+// 登录用户可以查看与编辑，但删除、彻底删除与共享只允许所有者；其余项目按所有者与协作成员角色校验。
+// nil 表示不启用访问控制。
+type ProjectAuthorizer struct {
+	projects NovelRepo
+	users    UserRepo
+}
+
+// NewProjectAuthorizer 创建项目访问控制
+func NewProjectAuthorizer(projects NovelRepo, users UserRepo) *ProjectAuthorizer {
+	return &ProjectAuthorizer{
+		projects: projects,
+		users:    users,
+	}
+}
+
+// ProjectRole 返回调用者在项目中的角色，无权限或未登录时返回空字符串
+func (a *ProjectAuthorizer) ProjectRole(ctx context.Context, project *models.NovelProject) (string, error) {
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return "", nil
+	}
+	if project.OwnerID == "" {
+		return models.ProjectRoleEditor, nil
+	}
+	if userID == project.OwnerID {
+		return models.ProjectRoleOwner, nil
+	}
+
+	return a.users.GetProjectRole(ctx, project.ID, userID)
+}
+
+// RequireUser 返回调用者的用户ID，启用访问控制时匿名调用返回 ErrUnauthenticated
+func (a *ProjectAuthorizer) RequireUser(ctx context.Context) (string, error) {
+	userID := auth.UserIDFromContext(ctx)
+	if a != nil && userID == "" {
+		return "", ErrUnauthenticated
+	}
+	return userID, nil
+}
+
+// Authorize 校验调用者在项目中至少具备指定角色
+func (a *ProjectAuthorizer) Authorize(ctx context.Context, project *models.NovelProject, required string) error {
+	if a == nil {
+		return nil
+	}
+
+	role, err := a.ProjectRole(ctx, project)
+	if err != nil {
+		return fmt.Errorf("failed to get project role: %w", err)
+	}
+	if roleRank[role] >= roleRank[required] {
+		return nil
+	}
+	if auth.UserIDFromContext(ctx) == "" {
+		return ErrUnauthenticated
+	}
+	return ErrProjectForbidden
+}
+
+// AuthorizeProject 加载项目并校验权限
+func (a *ProjectAuthorizer) AuthorizeProject(ctx context.Context, projectID, required string) error {
+	if a == nil || projectID == "" {
+		return nil
+	}
+
+	project, err := a.projects.GetProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	return a.Authorize(ctx, project, required)
+}
+
+// AuthorizeChapter 按章节所属项目校验权限
+func (a *ProjectAuthorizer) AuthorizeChapter(ctx context.Context, chapterID, required string) error {
+	if a == nil {
+		return nil
+	}
+
+	chapter, err := a.projects.GetChapter(ctx, chapterID)
+	if err != nil {
+		return fmt.Errorf("failed to get chapter: %w", err)
+	}
+	return a.AuthorizeProject(ctx, chapter.ProjectID, required)
+}
+
+// UserUsecase 用户与项目协作业务用例
+type UserUsecase struct {
+	repo   UserRepo
+	access *ProjectAuthorizer
+	log    *log.Helper
+}
+
+// NewUserUsecase 创建用户业务用例
+func NewUserUsecase(repo UserRepo, access *ProjectAuthorizer, logger log.Logger) *UserUsecase {
+	return &UserUsecase{
+		repo:   repo,
+		access: access,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// CreateUser 注册用户，返回用户与访问令牌
+func (uc *UserUsecase) CreateUser(ctx context.Context, username, displayName string) (*models.User, string, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, "", fmt.Errorf("username is required")
+	}
+	uc.log.WithContext(ctx).Infof("Creating user: %s", username)
+
+	if existing, err := uc.repo.GetUserByUsername(ctx, username); err == nil && existing != nil {
+		return nil, "", ErrUsernameTaken
+	}
+
+	token, err := auth.GenerateToken()
+	if err != nil {
+		return nil, "", err
+	}
+	if displayName == "" {
+		displayName = username
+	}
+
+	user, err := uc.repo.CreateUser(ctx, &models.User{
+		ID:          generateUserID(),
+		Username:    username,
+		DisplayName: displayName,
+	}, auth.HashToken(token))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return user, token, nil
+}
+
+// Authenticate 根据访问令牌识别用户
+func (uc *UserUsecase) Authenticate(ctx context.Context, token string) (*models.User, error) {
+	user, err := uc.repo.GetUserByTokenHash(ctx, auth.HashToken(token))
+	if err != nil || user == nil {
+		return nil, ErrInvalidToken
+	}
+	return user, nil
+}
+
+// CurrentUser 获取当前调用者
+func (uc *UserUsecase) CurrentUser(ctx context.Context) (*models.User, error) {
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	return uc.repo.GetUser(ctx, userID)
+}
+
+// ListProjectMembers 列出项目成员，所有者在首位
+func (uc *UserUsecase) ListProjectMembers(ctx context.Context, projectID string) ([]*models.ProjectMember, error) {
+	project, err := uc.access.projects.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	if err := uc.access.Authorize(ctx, project, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	members, err := uc.repo.ListProjectMembers(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project members: %w", err)
+	}
+
+	if project.OwnerID == "" {
+		return members, nil
+	}
+	owner := &models.ProjectMember{
+		ProjectID: projectID,
+		UserID:    project.OwnerID,
+		Role:      models.ProjectRoleOwner,
+		CreatedAt: project.CreatedAt,
+	}
+	if user, err := uc.repo.GetUser(ctx, project.OwnerID); err == nil {
+		owner.Username = user.Username
+	}
+	return append([]*models.ProjectMember{owner}, members...), nil
+}
+
+// AddProjectMember 添加或更新项目成员，仅所有者可操作
+func (uc *UserUsecase) AddProjectMember(ctx context.Context, projectID, userID, username, role string) (*models.ProjectMember, error) {
+	if role != models.ProjectRoleEditor && role != models.ProjectRoleViewer {
+		return nil, ErrInvalidRole
+	}
+
+	project, err := uc.ownedProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *models.User
+	if userID != "" {
+		user, err = uc.repo.GetUser(ctx, userID)
+	} else {
+		user, err = uc.repo.GetUserByUsername(ctx, username)
+	}
+	if err != nil || user == nil {
+		return nil, ErrAccountNotFound
+	}
+	if user.ID == project.OwnerID {
+		return nil, fmt.Errorf("user %s is already the owner of project %s", user.Username, projectID)
+	}
+
+	uc.log.WithContext(ctx).Infof("Adding member %s to project %s as %s", user.ID, projectID, role)
+
+	member, err := uc.repo.SaveProjectMember(ctx, &models.ProjectMember{
+		ProjectID: projectID,
+		UserID:    user.ID,
+		Role:      role,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save project member: %w", err)
+	}
+	member.Username = user.Username
+
+	return member, nil
+}
+
+// RemoveProjectMember 移除项目成员，仅所有者可操作
+func (uc *UserUsecase) RemoveProjectMember(ctx context.Context, projectID, userID string) error {
+	if _, err := uc.ownedProject(ctx, projectID); err != nil {
+		return err
+	}
+
+	uc.log.WithContext(ctx).Infof("Removing member %s from project %s", userID, projectID)
+
+	return uc.repo.RemoveProjectMember(ctx, projectID, userID)
+}
+
+// ownedProject 加载项目并要求调用者为所有者，未归属的项目不支持共享
+func (uc *UserUsecase) ownedProject(ctx context.Context, projectID string) (*models.NovelProject, error) {
+	project, err := uc.access.projects.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	userID := auth.UserIDFromContext(ctx)
+	if userID == "" {
+		return nil, ErrUnauthenticated
+	}
+	if project.OwnerID != userID {
+		return nil, ErrProjectForbidden
+	}
+
+	return project, nil
+}
+
+func generateUserID() string {
+	return fmt.Sprintf("user_%d", time.Now().UnixNano())
+}
@@ -33,6 +33,7 @@ type VideoScriptRepo interface {
 // VideoScriptUseCase 短视频脚本业务用例
 type VideoScriptUseCase struct {
 	repo   VideoScriptRepo
+	access *ProjectAuthorizer
 	logger *log.Helper
 }
 
@@ -44,9 +45,20 @@ func NewVideoScriptUseCase(repo VideoScriptRepo, logger log.Logger) *VideoScript
 	}
 }
 
+// NewVideoScriptUseCaseWithAccess 创建启用项目访问控制的短视频脚本业务用例
+func NewVideoScriptUseCaseWithAccess(repo VideoScriptRepo, access *ProjectAuthorizer, logger log.Logger) *VideoScriptUseCase {
+	uc := NewVideoScriptUseCase(repo, logger)
+	uc.access = access
+	return uc
+}
+
 // SaveVideoScript 保存视频脚本
 func (uc *VideoScriptUseCase) SaveVideoScript(ctx context.Context, script *models.VideoScript) (*models.VideoScript, error) {
 	uc.logger.WithContext(ctx).Infof("Saving video script for project: %s", script.ProjectID)
+
+	if err := uc.access.AuthorizeProject(ctx, script.ProjectID, models.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
 	
 	return uc.repo.SaveVideoScript(ctx, script)
 }
@@ -55,12 +67,25 @@ func (uc *VideoScriptUseCase) SaveVideoScript(ctx context.Context, script *model
 func (uc *VideoScriptUseCase) GetVideoScript(ctx context.Context, id string) (*models.VideoScript, error) {
 	uc.logger.WithContext(ctx).Infof("Getting video script: %s", id)
 	
-	return uc.repo.GetVideoScript(ctx, id)
+	script, err := uc.repo.GetVideoScript(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.AuthorizeProject(ctx, script.ProjectID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
+
+	return script, nil
 }
 
 // UpdateVideoScript 更新视频脚本
 func (uc *VideoScriptUseCase) UpdateVideoScript(ctx context.Context, script *models.VideoScript) (*models.VideoScript, error) {
 	uc.logger.WithContext(ctx).Infof("Updating video script: %s", script.ID)
+
+	if err := uc.authorizeScript(ctx, script.ID, models.ProjectRoleEditor); err != nil {
+		return nil, err
+	}
 	
 	return uc.repo.UpdateVideoScript(ctx, script)
 }
@@ -68,6 +93,10 @@ func (uc *VideoScriptUseCase) UpdateVideoScript(ctx context.Context, script *mod
 // DeleteVideoScript 删除视频脚本
 func (uc *VideoScriptUseCase) DeleteVideoScript(ctx context.Context, id string) error {
 	uc.logger.WithContext(ctx).Infof("Deleting video script: %s", id)
+
+	if err := uc.authorizeScript(ctx, id, models.ProjectRoleEditor); err != nil {
+		return err
+	}
 	
 	return uc.repo.DeleteVideoScript(ctx, id)
 }
@@ -75,6 +104,10 @@ func (uc *VideoScriptUseCase) DeleteVideoScript(ctx context.Context, id string)
 // ListVideoScripts 获取视频脚本列表
 func (uc *VideoScriptUseCase) ListVideoScripts(ctx context.Context, projectID string, page, pageSize int) ([]*models.VideoScript, int, error) {
 	uc.logger.WithContext(ctx).Infof("Listing video scripts for project: %s", projectID)
+
+	if err := uc.access.AuthorizeProject(ctx, projectID, models.ProjectRoleViewer); err != nil {
+		return nil, 0, err
+	}
 	
 	return uc.repo.ListVideoScripts(ctx, projectID, page, pageSize)
 }
@@ -82,10 +115,27 @@ func (uc *VideoScriptUseCase) ListVideoScripts(ctx context.Context, projectID st
 // ListVideoScriptsByChapter 根据章节获取视频脚本列表
 func (uc *VideoScriptUseCase) ListVideoScriptsByChapter(ctx context.Context, chapterID string) ([]*models.VideoScript, error) {
 	uc.logger.WithContext(ctx).Infof("Listing video scripts for chapter: %s", chapterID)
+
+	if err := uc.access.AuthorizeChapter(ctx, chapterID, models.ProjectRoleViewer); err != nil {
+		return nil, err
+	}
 	
 	return uc.repo.ListVideoScriptsByChapter(ctx, chapterID)
 }
 
+// authorizeScript 按视频脚本所属项目校验权限
+func (uc *VideoScriptUseCase) authorizeScript(ctx context.Context, id, required string) error {
+	if uc.access == nil {
+		return nil
+	}
+
+	script, err := uc.repo.GetVideoScript(ctx, id)
+	if err != nil {
+		return err
+	}
+	return uc.access.AuthorizeProject(ctx, script.ProjectID, required)
+}
+
 // ValidateVideoScript 验证视频脚本
 func (uc *VideoScriptUseCase) ValidateVideoScript(ctx context.Context, script *models.VideoScript) error {
 	uc.logger.WithContext(ctx).Infof("Validating video script: %s", script.ID)
@@ -0,0 +1,59 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+
+	"backend/internal/pkg/cache"
+)
+
+// 读缓存键
+const (
+	projectCachePrefix  = "novel:project:"
+	chaptersCachePrefix = "novel:chapters:"
+)
+
+// projectCacheKey 项目缓存键
+func projectCacheKey(projectID string) string {
+	return projectCachePrefix + projectID
+}
+
+// chaptersCacheKey 项目章节列表缓存键
+func chaptersCacheKey(projectID string) string {
+	return chaptersCachePrefix + projectID
+}
+
+// loadCache 读取缓存并反序列化，未命中或出错时返回 false 由调用方回源
+func (r *novelRepo) loadCache(ctx context.Context, key string, out interface{}) bool {
+	data, err := r.data.cache.Get(ctx, key)
+	if err != nil {
+		if err != cache.ErrCacheMiss {
+			r.log.WithContext(ctx).Warnf("Failed to read cache %s: %v", key, err)
+		}
+		return false
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		r.log.WithContext(ctx).Warnf("Failed to decode cache %s: %v", key, err)
+		return false
+	}
+	return true
+}
+
+// storeCache 序列化并写入缓存，失败只记录日志
+func (r *novelRepo) storeCache(ctx context.Context, key string, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("Failed to encode cache %s: %v", key, err)
+		return
+	}
+	if err := r.data.cache.Set(ctx, key, data, r.data.cacheTTL); err != nil {
+		r.log.WithContext(ctx).Warnf("Failed to write cache %s: %v", key, err)
+	}
+}
+
+// invalidateCache 写操作提交后删除相关缓存
+func (r *novelRepo) invalidateCache(ctx context.Context, keys ...string) {
+	if err := r.data.cache.Delete(ctx, keys...); err != nil {
+		r.log.WithContext(ctx).Warnf("Failed to invalidate cache %v: %v", keys, err)
+	}
+}
@@ -3,8 +3,10 @@ package data
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"backend/internal/conf"
+	"backend/internal/pkg/cache"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
@@ -14,11 +16,17 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewVideoScriptRepo, NewNovelRepo)
+var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewVideoScriptRepo, NewNovelRepo, NewSearchRepo, NewTrashRepo, NewUserRepo, NewGenerationRepo, NewIndexStateRepo, NewKnowledgeIndexer, NewKnowledgeRepo, NewStoryStateRepo, NewQualityReportRepo, NewPlotThreadRepo)
+
+// defaultCacheTTL 未配置时读缓存的默认过期时间
+const defaultCacheTTL = 5 * time.Minute
 
 // Data .
 type Data struct {
-	db *gorm.DB
+	db       *gorm.DB
+	search   *searchIndex
+	cache    cache.Store
+	cacheTTL time.Duration
 }
 
 // NewData .
@@ -47,16 +55,39 @@ func NewData(c *conf.Data, l log.Logger) (*Data, func(), error) {
 		return nil, nil, err
 	}
 
+	// 初始化全文检索索引
+	search := newSearchIndex(db, helper)
+	if err := search.backfill(db); err != nil {
+		helper.Errorf("failed to backfill search index: %v", err)
+		return nil, nil, err
+	}
+
 	helper.Info("database connected successfully")
 
+	// 初始化缓存、锁与发布订阅，未启用 Redis 时使用进程内存实现
+	store, err := cache.NewStore(c.Redis)
+	if err != nil {
+		helper.Errorf("failed to create cache store: %v", err)
+		return nil, nil, err
+	}
+	if c.GetRedis().GetEnabled() {
+		helper.Infof("redis connected: %s", c.Redis.Addr)
+	}
+
+	cacheTTL := defaultCacheTTL
+	if c.GetRedis().GetCacheTtl() != nil {
+		cacheTTL = c.Redis.CacheTtl.AsDuration()
+	}
+
 	cleanup := func() {
 		helper.Info("closing the data resources")
+		store.Close()
 		if sqlDB, err := db.DB(); err == nil {
 			sqlDB.Close()
 		}
 	}
 
-	return &Data{db: db}, cleanup, nil
+	return &Data{db: db, search: search, cache: store, cacheTTL: cacheTTL}, cleanup, nil
 }
 
 // autoMigrate 自动迁移数据库表结构
@@ -66,6 +97,13 @@ func autoMigrate(db *gorm.DB) error {
 		&NovelProject{},
 		&Chapter{},
 		&VideoScript{},
+		&SearchDocument{},
+		&User{},
+		&ProjectMember{},
+		&IndexRecord{},
+		&StoryStateRecord{},
+		&QualityReportRecord{},
+		&PlotThreadRecord{},
 	); err != nil {
 		return err
 	}
@@ -98,5 +136,11 @@ func createIndexes(db *gorm.DB) error {
 		return err
 	}
 
+	// 创建 SearchDocument 索引
+	searchDocument := &SearchDocument{}
+	if err := searchDocument.CreateIndexes(db); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,44 @@
+package data
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"backend/internal/pkg/cache"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testLogger 丢弃测试中的日志输出
+var testLogger = log.NewStdLogger(io.Discard)
+
+// newTestData 基于临时 SQLite 文件创建数据层，缓存使用进程内存实现
+func newTestData(t *testing.T) *Data {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "novel.db")), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if err := autoMigrate(db); err != nil {
+		t.Fatalf("Failed to migrate database: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	return &Data{
+		db:       db,
+		search:   newSearchIndex(db, log.NewHelper(testLogger)),
+		cache:    cache.NewMemoryStore(),
+		cacheTTL: defaultCacheTTL,
+	}
+}
@@ -0,0 +1,112 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/cache"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 生成锁与进度频道前缀
+const (
+	generationLockPrefix    = "novel:generate:lock:"
+	generationChannelPrefix = "novel:generate:progress:"
+)
+
+// generationRepo 生成任务协调仓库实现
+type generationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewGenerationRepo 创建生成任务协调仓库
+func NewGenerationRepo(data *Data, logger log.Logger) biz.GenerationRepo {
+	return &generationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// LockProject 获取项目生成锁
+func (r *generationRepo) LockProject(ctx context.Context, projectID string, ttl time.Duration) (biz.GenerationLock, error) {
+	lock, err := r.data.cache.TryLock(ctx, generationLockPrefix+projectID, ttl)
+	if err != nil {
+		if err == cache.ErrLockHeld {
+			return nil, biz.ErrProjectGenerating
+		}
+		return nil, fmt.Errorf("failed to acquire generation lock: %w", err)
+	}
+	return &generationLock{lock: lock}, nil
+}
+
+// PublishProgress 广播生成进度
+func (r *generationRepo) PublishProgress(ctx context.Context, progress *models.GenerationProgress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal generation progress: %w", err)
+	}
+	if err := r.data.cache.Publish(ctx, generationChannelPrefix+progress.ProjectID, data); err != nil {
+		return fmt.Errorf("failed to publish generation progress: %w", err)
+	}
+	return nil
+}
+
+// SubscribeProgress 订阅项目生成进度
+func (r *generationRepo) SubscribeProgress(ctx context.Context, projectID string) (<-chan *models.GenerationProgress, error) {
+	messages, err := r.data.cache.Subscribe(ctx, generationChannelPrefix+projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe generation progress: %w", err)
+	}
+
+	out := make(chan *models.GenerationProgress)
+	go func() {
+		defer close(out)
+		for data := range messages {
+			var progress models.GenerationProgress
+			if err := json.Unmarshal(data, &progress); err != nil {
+				r.log.Warnf("Failed to decode generation progress: %v", err)
+				continue
+			}
+			select {
+			case out <- &progress:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// generationLock 将缓存锁的错误转换为业务错误
+type generationLock struct {
+	lock cache.Lock
+}
+
+// Refresh 续期生成锁
+func (l *generationLock) Refresh(ctx context.Context, ttl time.Duration) error {
+	if err := l.lock.Refresh(ctx, ttl); err != nil {
+		if err == cache.ErrLockLost {
+			return biz.ErrGenerationLockLost
+		}
+		return err
+	}
+	return nil
+}
+
+// Unlock 释放生成锁
+func (l *generationLock) Unlock(ctx context.Context) error {
+	if err := l.lock.Unlock(ctx); err != nil {
+		if err == cache.ErrLockLost {
+			return biz.ErrGenerationLockLost
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+	"backend/internal/pkg/vector"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+// indexStateRepo 知识库索引记录仓库实现
+type indexStateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewIndexStateRepo 创建知识库索引记录仓库
+func NewIndexStateRepo(data *Data, logger log.Logger) biz.IndexStateRepo {
+	return &indexStateRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// ListIndexStates 列出项目的索引记录
+func (r *indexStateRepo) ListIndexStates(ctx context.Context, projectID string) ([]*models.IndexState, error) {
+	var records []IndexRecord
+	if err := r.data.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list index records: %w", err)
+	}
+
+	states := make([]*models.IndexState, len(records))
+	for i, record := range records {
+		states[i] = &models.IndexState{
+			ProjectID:   record.ProjectID,
+			ItemType:    record.ItemType,
+			ItemID:      record.ItemID,
+			ContentHash: record.ContentHash,
+			IndexedAt:   record.IndexedAt,
+			Error:       record.Error,
+		}
+	}
+	return states, nil
+}
+
+// SaveIndexState 写入或覆盖条目的索引记录
+func (r *indexStateRepo) SaveIndexState(ctx context.Context, state *models.IndexState) error {
+	record := &IndexRecord{
+		ItemType:    state.ItemType,
+		ItemID:      state.ItemID,
+		ProjectID:   state.ProjectID,
+		ContentHash: state.ContentHash,
+		Error:       state.Error,
+		IndexedAt:   state.IndexedAt,
+	}
+	if err := r.data.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(record).Error; err != nil {
+		return fmt.Errorf("failed to save index record: %w", err)
+	}
+	return nil
+}
+
+// DeleteIndexState 删除条目的索引记录
+func (r *indexStateRepo) DeleteIndexState(ctx context.Context, itemType, itemID string) error {
+	if err := r.data.db.WithContext(ctx).Where("item_type = ? AND item_id = ?", itemType, itemID).
+		Delete(&IndexRecord{}).Error; err != nil {
+		return fmt.Errorf("failed to delete index record: %w", err)
+	}
+	return nil
+}
+
+// DeleteProjectIndexStates 删除项目的全部索引记录
+func (r *indexStateRepo) DeleteProjectIndexStates(ctx context.Context, projectID string) error {
+	if err := r.data.db.WithContext(ctx).Where("project_id = ?", projectID).Delete(&IndexRecord{}).Error; err != nil {
+		return fmt.Errorf("failed to delete index records: %w", err)
+	}
+	return nil
+}
+
+// knowledgeIndexer 基于 RAG 向量库的知识库索引实现
+type knowledgeIndexer struct {
+	rag *vector.RAGService
+}
+
+// NewKnowledgeIndexer 创建知识库索引
+func NewKnowledgeIndexer(rag *vector.RAGService) biz.KnowledgeIndexer {
+	return &knowledgeIndexer{rag: rag}
+}
+
+// IndexWorldView 写入或替换世界观设定
+func (k *knowledgeIndexer) IndexWorldView(ctx context.Context, worldView *models.WorldView) error {
+	return k.rag.AddWorldView(ctx, worldView)
+}
+
+// IndexCharacter 写入或替换人物卡
+func (k *knowledgeIndexer) IndexCharacter(ctx context.Context, character *models.Character) error {
+	return k.rag.AddCharacter(ctx, character)
+}
+
+// IndexChapter 写入或替换章节分块
+func (k *knowledgeIndexer) IndexChapter(ctx context.Context, chapter *models.Chapter, characters []*models.Character) error {
+	return k.rag.AddChapter(ctx, chapter, characters...)
+}
+
+// RemoveItem 删除单个条目的索引
+func (k *knowledgeIndexer) RemoveItem(ctx context.Context, itemType, itemID string) error {
+	switch itemType {
+	case models.IndexItemChapter:
+		return k.rag.DeleteChapter(ctx, itemID)
+	case models.IndexItemWorldView:
+		return k.rag.DeleteWorldView(ctx, itemID)
+	}
+	return k.rag.DeleteDocuments(ctx, []string{itemID})
+}
+
+// RemoveProject 删除项目全部索引
+func (k *knowledgeIndexer) RemoveProject(ctx context.Context, projectID string) error {
+	return k.rag.DeleteProject(ctx, projectID)
+}
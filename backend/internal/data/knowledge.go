@@ -0,0 +1,101 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+	"backend/internal/pkg/vector"
+)
+
+// knowledgeRepo 基于 RAG 向量库的项目知识库检索实现
+type knowledgeRepo struct {
+	rag *vector.RAGService
+}
+
+// NewKnowledgeRepo 创建项目知识库检索仓库
+func NewKnowledgeRepo(rag *vector.RAGService) biz.KnowledgeRepo {
+	return &knowledgeRepo{rag: rag}
+}
+
+// SearchKnowledge 混合检索单一内容类型的文档
+func (r *knowledgeRepo) SearchKnowledge(ctx context.Context, projectID, question, contentType string, topK int) ([]*models.KnowledgePassage, error) {
+	results, err := r.rag.SearchRelevantContext(ctx, question, projectID, contentType, topK)
+	if err != nil {
+		return nil, err
+	}
+
+	passages := make([]*models.KnowledgePassage, len(results))
+	for i, result := range results {
+		passages[i] = knowledgePassage(contentType, result)
+	}
+	return passages, nil
+}
+
+// KnowledgeStats 统计各内容类型已索引的文档数
+func (r *knowledgeRepo) KnowledgeStats(ctx context.Context, projectID string) (map[string]int, error) {
+	all, err := r.rag.GetStats(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := map[string]int{
+		models.KnowledgeWorldView: all[models.KnowledgeWorldView],
+		models.KnowledgeCharacter: all[models.KnowledgeCharacter],
+		models.KnowledgeChapter:   all[models.KnowledgeChapter],
+	}
+	return stats, nil
+}
+
+// knowledgePassage 将检索结果转换为知识库片段，章节片段带上章节与原文位置
+func knowledgePassage(contentType string, result *vector.SearchResult) *models.KnowledgePassage {
+	doc := result.Document
+	passage := &models.KnowledgePassage{
+		DocumentID:  doc.ID,
+		ContentType: contentType,
+		Content:     strings.TrimSpace(doc.Content),
+		Score:       result.Score,
+	}
+
+	switch contentType {
+	case models.KnowledgeCharacter:
+		passage.Title = metadataString(doc.Metadata, "name")
+	case models.KnowledgeChapter:
+		passage.Title = metadataString(doc.Metadata, "title")
+		passage.ChapterID = metadataString(doc.Metadata, "chapter_id")
+		passage.ChapterIndex = metadataInt(doc.Metadata, "index")
+		passage.StartOffset = metadataInt(doc.Metadata, "start_offset")
+		passage.EndOffset = metadataInt(doc.Metadata, "end_offset")
+		// 未分块写入的整章文档没有 chapter_id
+		if passage.ChapterID == "" {
+			passage.ChapterID = doc.ID
+		}
+	default:
+		passage.Title = metadataString(doc.Metadata, "title")
+	}
+	return passage
+}
+
+// metadataString 读取字符串类型的元数据
+func metadataString(metadata map[string]interface{}, key string) string {
+	s, _ := metadata[key].(string)
+	return s
+}
+
+// metadataInt 读取数值类型的元数据，兼容持久化后反序列化得到的浮点数
+func metadataInt(metadata map[string]interface{}, key string) int {
+	switch v := metadata[key].(type) {
+	case int:
+		return v
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case float32:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return 0
+}
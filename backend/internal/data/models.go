@@ -13,6 +13,8 @@ type NovelProject struct {
 	Description string    `gorm:"type:text" json:"description"`
 	Genre       string    `gorm:"size:100" json:"genre"`
 	Status      string    `gorm:"size:50;default:'draft'" json:"status"`
+	Version     int64     `gorm:"not null;default:1" json:"version"` // 乐观锁版本号
+	OwnerID     string    `gorm:"size:255;index" json:"owner_id"`    // 所有者用户ID
 	
 	// 项目属性
 	TargetAudience string `gorm:"size:100" json:"target_audience"` // 目标读者
@@ -51,6 +53,7 @@ type Chapter struct {
 	Summary   string    `gorm:"type:text" json:"summary"`
 	Order     int       `gorm:"not null;default:0" json:"order"`
 	Status    string    `gorm:"size:50;default:'draft'" json:"status"`
+	Version   int64     `gorm:"not null;default:1" json:"version"` // 乐观锁版本号
 	
 	// 章节配置
 	Config    string `gorm:"type:text" json:"config"`
@@ -100,6 +103,40 @@ func (VideoScript) TableName() string {
 	return "video_scripts"
 }
 
+// User 用户数据库模型
+type User struct {
+	ID          string `gorm:"primaryKey;size:255" json:"id"`
+	Username    string `gorm:"size:100;not null;uniqueIndex" json:"username"`
+	DisplayName string `gorm:"size:255" json:"display_name"`
+	TokenHash   string `gorm:"size:64;not null;uniqueIndex" json:"-"` // 访问令牌的SHA-256摘要
+
+	// 时间戳
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName 指定表名
+func (User) TableName() string {
+	return "users"
+}
+
+// ProjectMember 项目协作成员数据库模型
+type ProjectMember struct {
+	ProjectID string `gorm:"primaryKey;size:255" json:"project_id"`
+	UserID    string `gorm:"primaryKey;size:255;index" json:"user_id"`
+	Role      string `gorm:"size:20;not null" json:"role"`
+
+	// 时间戳
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (ProjectMember) TableName() string {
+	return "project_members"
+}
+
 // CreateIndexes 创建复合索引
 func (np *NovelProject) CreateIndexes(db *gorm.DB) error {
 	// 检查并创建复合索引：状态+创建时间
@@ -164,4 +201,69 @@ func (vs *VideoScript) CreateIndexes(db *gorm.DB) error {
 	}
 	
 	return nil
-}
\ No newline at end of file
+}
+
+// IndexRecord 知识库索引记录数据库模型
+type IndexRecord struct {
+	ItemType    string    `gorm:"primaryKey;size:20" json:"item_type"` // worldview/character/chapter
+	ItemID      string    `gorm:"primaryKey;size:255" json:"item_id"`
+	ProjectID   string    `gorm:"size:255;not null;index" json:"project_id"`
+	ContentHash string    `gorm:"size:64" json:"content_hash"` // 索引时的内容摘要，失败时为空
+	Error       string    `gorm:"type:text" json:"error"`
+	IndexedAt   time.Time `json:"indexed_at"`
+}
+
+// TableName 指定表名
+func (IndexRecord) TableName() string {
+	return "index_records"
+}
+
+// StoryStateRecord 章节结束时的故事状态数据库模型，每章一条，内容包含此前各章累积的状态
+type StoryStateRecord struct {
+	ProjectID    string    `gorm:"primaryKey;size:255" json:"project_id"`
+	ChapterIndex int       `gorm:"primaryKey" json:"chapter_index"`
+	ChapterID    string    `gorm:"size:255" json:"chapter_id"`
+	State        string    `gorm:"type:text" json:"state"` // models.StoryState 的 JSON
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (StoryStateRecord) TableName() string {
+	return "story_states"
+}
+
+// QualityReportRecord 章节质量与一致性检查报告数据库模型，每次检查追加一条
+type QualityReportRecord struct {
+	ID               string    `gorm:"primaryKey;size:64" json:"id"`
+	ProjectID        string    `gorm:"size:255;not null;index:idx_quality_reports_project_created,priority:1" json:"project_id"`
+	ChapterID        string    `gorm:"size:255;not null;index" json:"chapter_id"`
+	ChapterIndex     int       `json:"chapter_index"`
+	ChapterVersion   int64     `json:"chapter_version"`
+	Kind             string    `gorm:"size:20" json:"kind"` // quality/consistency
+	CheckType        string    `gorm:"size:20" json:"check_type"`
+	Polished         bool      `json:"polished"`
+	OverallScore     float64   `json:"overall_score"`
+	IssueCount       int       `json:"issue_count"`
+	IssuesBySeverity string    `gorm:"type:text" json:"issues_by_severity"` // map[string]int 的 JSON
+	Details          string    `gorm:"type:text" json:"details"`
+	CreatedAt        time.Time `gorm:"index:idx_quality_reports_project_created,priority:2" json:"created_at"`
+}
+
+// TableName 指定表名
+func (QualityReportRecord) TableName() string {
+	return "quality_reports"
+}
+
+// PlotThreadRecord 情节线索数据库模型，每条线索一条
+type PlotThreadRecord struct {
+	ProjectID string    `gorm:"primaryKey;size:255" json:"project_id"`
+	ID        string    `gorm:"primaryKey;size:255" json:"id"`
+	Status    string    `gorm:"size:20" json:"status"`   // open/resolved
+	Thread    string    `gorm:"type:text" json:"thread"` // models.PlotThread 的 JSON
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (PlotThreadRecord) TableName() string {
+	return "plot_threads"
+}
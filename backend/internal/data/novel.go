@@ -37,6 +37,8 @@ func (r *novelRepo) modelToEntity(dbProject *NovelProject) (*models.NovelProject
 		TargetAudience: dbProject.TargetAudience,
 		Tone:           dbProject.Tone,
 		Status:         dbProject.Status,
+		Version:        dbProject.Version,
+		OwnerID:        dbProject.OwnerID,
 		CreatedAt:      dbProject.CreatedAt,
 		UpdatedAt:      dbProject.UpdatedAt,
 	}
@@ -86,6 +88,7 @@ func (r *novelRepo) entityToModel(project *models.NovelProject) (*NovelProject,
 		TargetAudience: project.TargetAudience,
 		Tone:           project.Tone,
 		Status:         project.Status,
+		OwnerID:        project.OwnerID,
 		CreatedAt:      project.CreatedAt,
 		UpdatedAt:      project.UpdatedAt,
 	}
@@ -132,6 +135,7 @@ func (r *novelRepo) chapterModelToEntity(dbChapter *Chapter) (*models.Chapter, e
 		PolishedContent: dbChapter.Content,
 		Summary:         dbChapter.Summary,
 		Status:          dbChapter.Status,
+		Version:         dbChapter.Version,
 		CreatedAt:       dbChapter.CreatedAt,
 		UpdatedAt:       dbChapter.UpdatedAt,
 	}, nil
@@ -166,8 +170,16 @@ func (r *novelRepo) CreateProject(ctx context.Context, project *models.NovelProj
 	dbProject.CreatedAt = now
 	dbProject.UpdatedAt = now
 
-	if err := r.data.db.WithContext(ctx).Create(dbProject).Error; err != nil {
-		return nil, fmt.Errorf("failed to create project: %w", err)
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dbProject).Error; err != nil {
+			return fmt.Errorf("failed to create project: %w", err)
+		}
+
+		// 同步全文检索索引
+		return r.data.search.indexProject(tx, project)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return r.modelToEntity(dbProject)
@@ -185,26 +197,61 @@ func (r *novelRepo) UpdateProject(ctx context.Context, project *models.NovelProj
 	// 更新时间戳
 	dbProject.UpdatedAt = time.Now()
 
-	// 使用 Select 明确指定要更新的字段，包括 outline
-	if err := r.data.db.WithContext(ctx).Model(&NovelProject{}).Where("id = ?", project.ID).
-		Select("title", "description", "genre", "target_audience", "tone", "themes", "world_view", "characters", "outline", "config", "updated_at").
-		Updates(dbProject).Error; err != nil {
-		return nil, fmt.Errorf("failed to update project: %w", err)
-	}
+	var updated *models.NovelProject
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 版本号大于0时按版本号做条件更新，防止覆盖他人的修改
+		query := tx.Model(&NovelProject{}).Where("id = ?", project.ID)
+		if project.Version > 0 {
+			query = query.Where("version = ?", project.Version)
+		}
+
+		// 使用 Select 明确指定要更新的字段，包括 outline
+		result := query.
+			Select("title", "description", "genre", "target_audience", "tone", "themes", "world_view", "characters", "outline", "config", "updated_at").
+			Updates(dbProject)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update project: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return r.versionMismatch(tx, &NovelProject{}, "project", project.ID)
+		}
+
+		if err := bumpVersion(tx, &NovelProject{}, project.ID); err != nil {
+			return fmt.Errorf("failed to update project version: %w", err)
+		}
+
+		// 重新从数据库获取更新后的数据
+		var updatedProject NovelProject
+		if err := tx.Where("id = ?", project.ID).First(&updatedProject).Error; err != nil {
+			return fmt.Errorf("failed to get updated project: %w", err)
+		}
+
+		entity, err := r.modelToEntity(&updatedProject)
+		if err != nil {
+			return err
+		}
+		updated = entity
 
-	// 重新从数据库获取更新后的数据
-	var updatedProject NovelProject
-	if err := r.data.db.WithContext(ctx).Where("id = ?", project.ID).First(&updatedProject).Error; err != nil {
-		return nil, fmt.Errorf("failed to get updated project: %w", err)
+		// 同步全文检索索引
+		return r.data.search.indexProject(tx, updated)
+	})
+	if err != nil {
+		return nil, err
 	}
+	r.invalidateCache(ctx, projectCacheKey(project.ID))
 
-	return r.modelToEntity(&updatedProject)
+	return updated, nil
 }
 
 // GetProject 获取项目
 func (r *novelRepo) GetProject(ctx context.Context, projectID string) (*models.NovelProject, error) {
 	r.log.WithContext(ctx).Infof("Getting project: %s", projectID)
 
+	var cached models.NovelProject
+	if r.loadCache(ctx, projectCacheKey(projectID), &cached) {
+		return &cached, nil
+	}
+
 	var dbProject NovelProject
 	if err := r.data.db.WithContext(ctx).Where("id = ?", projectID).First(&dbProject).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -213,7 +260,13 @@ func (r *novelRepo) GetProject(ctx context.Context, projectID string) (*models.N
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
-	return r.modelToEntity(&dbProject)
+	project, err := r.modelToEntity(&dbProject)
+	if err != nil {
+		return nil, err
+	}
+	r.storeCache(ctx, projectCacheKey(projectID), project)
+
+	return project, nil
 }
 
 // ListProjects 列出项目
@@ -252,19 +305,37 @@ func (r *novelRepo) ListProjects(ctx context.Context, page, pageSize int) ([]*mo
 func (r *novelRepo) DeleteProject(ctx context.Context, projectID string) error {
 	r.log.WithContext(ctx).Infof("Deleting project: %s", projectID)
 
-	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	// 章节与视频脚本使用与项目相同的删除时间，恢复项目时据此整体还原
+	deletedAt := time.Now()
+
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 删除项目相关的视频脚本
+		if err := tx.Model(&VideoScript{}).Where("project_id = ?", projectID).
+			Update("deleted_at", deletedAt).Error; err != nil {
+			return fmt.Errorf("failed to delete video scripts: %w", err)
+		}
+
 		// 删除项目相关的章节
-		if err := tx.Where("project_id = ?", projectID).Delete(&Chapter{}).Error; err != nil {
+		if err := tx.Model(&Chapter{}).Where("project_id = ?", projectID).
+			Update("deleted_at", deletedAt).Error; err != nil {
 			return fmt.Errorf("failed to delete chapters: %w", err)
 		}
 
 		// 删除项目
-		if err := tx.Where("id = ?", projectID).Delete(&NovelProject{}).Error; err != nil {
+		if err := tx.Model(&NovelProject{}).Where("id = ?", projectID).
+			Update("deleted_at", deletedAt).Error; err != nil {
 			return fmt.Errorf("failed to delete project: %w", err)
 		}
 
-		return nil
+		// 清理全文检索索引
+		return r.data.search.removeProject(tx, projectID)
 	})
+	if err != nil {
+		return err
+	}
+	r.invalidateCache(ctx, projectCacheKey(projectID), chaptersCacheKey(projectID))
+
+	return nil
 }
 
 // SaveChapter 保存章节
@@ -283,9 +354,18 @@ func (r *novelRepo) SaveChapter(ctx context.Context, chapter *models.Chapter) (*
 	}
 	dbChapter.UpdatedAt = now
 
-	if err := r.data.db.WithContext(ctx).Create(dbChapter).Error; err != nil {
-		return nil, fmt.Errorf("failed to save chapter: %w", err)
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dbChapter).Error; err != nil {
+			return fmt.Errorf("failed to save chapter: %w", err)
+		}
+
+		// 同步全文检索索引
+		return r.data.search.indexChapter(tx, dbChapter.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
+	r.invalidateCache(ctx, chaptersCacheKey(dbChapter.ProjectID))
 
 	return r.chapterModelToEntity(dbChapter)
 }
@@ -302,11 +382,40 @@ func (r *novelRepo) UpdateChapter(ctx context.Context, chapter *models.Chapter)
 	// 更新时间戳
 	dbChapter.UpdatedAt = time.Now()
 
-	if err := r.data.db.WithContext(ctx).Where("id = ?", chapter.ID).Updates(dbChapter).Error; err != nil {
-		return nil, fmt.Errorf("failed to update chapter: %w", err)
+	var updatedChapter Chapter
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 版本号大于0时按版本号做条件更新，防止覆盖他人的修改
+		query := tx.Model(&Chapter{}).Where("id = ?", chapter.ID)
+		if chapter.Version > 0 {
+			query = query.Where("version = ?", chapter.Version)
+		}
+
+		result := query.Updates(dbChapter)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update chapter: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return r.versionMismatch(tx, &Chapter{}, "chapter", chapter.ID)
+		}
+
+		if err := bumpVersion(tx, &Chapter{}, chapter.ID); err != nil {
+			return fmt.Errorf("failed to update chapter version: %w", err)
+		}
+
+		// 重新从数据库获取更新后的数据
+		if err := tx.Where("id = ?", chapter.ID).First(&updatedChapter).Error; err != nil {
+			return fmt.Errorf("failed to get updated chapter: %w", err)
+		}
+
+		// 同步全文检索索引
+		return r.data.search.indexChapter(tx, chapter.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
+	r.invalidateCache(ctx, chaptersCacheKey(updatedChapter.ProjectID))
 
-	return r.chapterModelToEntity(dbChapter)
+	return r.chapterModelToEntity(&updatedChapter)
 }
 
 // GetChapter 获取章节
@@ -328,6 +437,11 @@ func (r *novelRepo) GetChapter(ctx context.Context, chapterID string) (*models.C
 func (r *novelRepo) ListChapters(ctx context.Context, projectID string) ([]*models.Chapter, error) {
 	r.log.WithContext(ctx).Infof("Listing chapters for project: %s", projectID)
 
+	var cached []*models.Chapter
+	if r.loadCache(ctx, chaptersCacheKey(projectID), &cached) {
+		return cached, nil
+	}
+
 	var dbChapters []Chapter
 	if err := r.data.db.WithContext(ctx).Where("project_id = ?", projectID).Order("`order` ASC").Find(&dbChapters).Error; err != nil {
 		return nil, fmt.Errorf("failed to list chapters: %w", err)
@@ -343,6 +457,7 @@ func (r *novelRepo) ListChapters(ctx context.Context, projectID string) ([]*mode
 		}
 		chapters = append(chapters, chapter)
 	}
+	r.storeCache(ctx, chaptersCacheKey(projectID), chapters)
 
 	return chapters, nil
 }
@@ -351,9 +466,44 @@ func (r *novelRepo) ListChapters(ctx context.Context, projectID string) ([]*mode
 func (r *novelRepo) DeleteChapter(ctx context.Context, chapterID string) error {
 	r.log.WithContext(ctx).Infof("Deleting chapter: %s", chapterID)
 
-	if err := r.data.db.WithContext(ctx).Where("id = ?", chapterID).Delete(&Chapter{}).Error; err != nil {
-		return fmt.Errorf("failed to delete chapter: %w", err)
+	var projectIDs []string
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Chapter{}).Where("id = ?", chapterID).Pluck("project_id", &projectIDs).Error; err != nil {
+			return fmt.Errorf("failed to get chapter project: %w", err)
+		}
+		if err := tx.Where("id = ?", chapterID).Delete(&Chapter{}).Error; err != nil {
+			return fmt.Errorf("failed to delete chapter: %w", err)
+		}
+
+		// 清理全文检索索引
+		return r.data.search.removeChapter(tx, chapterID)
+	})
+	if err != nil {
+		return err
+	}
+	for _, projectID := range projectIDs {
+		r.invalidateCache(ctx, chaptersCacheKey(projectID))
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// bumpVersion 递增记录的版本号
+func bumpVersion(tx *gorm.DB, model interface{}, id string) error {
+	return tx.Model(model).Where("id = ?", id).
+		UpdateColumn("version", gorm.Expr("version + ?", 1)).Error
+}
+
+// versionMismatch 条件更新未命中任何行时，区分记录不存在与版本冲突
+func (r *novelRepo) versionMismatch(tx *gorm.DB, model interface{}, kind, id string) error {
+	var current struct {
+		Version int64
+	}
+	if err := tx.Model(model).Select("version").Where("id = ?", id).Take(&current).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return fmt.Errorf("%s with ID %s not found", kind, id)
+		}
+		return fmt.Errorf("failed to get %s version: %w", kind, err)
+	}
+	return biz.NewVersionConflictError(kind, id, current.Version)
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// plotThreadRepo 情节线索仓库实现
+type plotThreadRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPlotThreadRepo 创建情节线索仓库
+func NewPlotThreadRepo(data *Data, logger log.Logger) biz.PlotThreadRepo {
+	return &plotThreadRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SavePlotThreads 以 threads 替换项目的全部线索
+func (r *plotThreadRepo) SavePlotThreads(ctx context.Context, projectID string, threads []*models.PlotThread) error {
+	records := make([]*PlotThreadRecord, 0, len(threads))
+	for _, thread := range threads {
+		content, err := json.Marshal(thread)
+		if err != nil {
+			return fmt.Errorf("failed to marshal plot thread: %w", err)
+		}
+		records = append(records, &PlotThreadRecord{
+			ProjectID: projectID,
+			ID:        thread.ID,
+			Status:    thread.Status,
+			Thread:    string(content),
+			UpdatedAt: thread.UpdatedAt,
+		})
+	}
+
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("project_id = ?", projectID).Delete(&PlotThreadRecord{}).Error; err != nil {
+			return fmt.Errorf("failed to save plot threads: %w", err)
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if err := tx.Create(&records).Error; err != nil {
+			return fmt.Errorf("failed to save plot threads: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListPlotThreads 列出项目的全部线索，按埋下或计划回收的章节排序
+func (r *plotThreadRepo) ListPlotThreads(ctx context.Context, projectID string) ([]*models.PlotThread, error) {
+	var records []PlotThreadRecord
+	if err := r.data.db.WithContext(ctx).Where("project_id = ?", projectID).Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list plot threads: %w", err)
+	}
+
+	threads := make([]*models.PlotThread, 0, len(records))
+	for _, record := range records {
+		var thread models.PlotThread
+		if err := json.Unmarshal([]byte(record.Thread), &thread); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal plot thread: %w", err)
+		}
+		threads = append(threads, &thread)
+	}
+	sort.SliceStable(threads, func(i, j int) bool {
+		if a, b := firstThreadChapter(threads[i]), firstThreadChapter(threads[j]); a != b {
+			return a < b
+		}
+		return threads[i].ID < threads[j].ID
+	})
+	return threads, nil
+}
+
+// firstThreadChapter 线索最早埋下的章节，未埋下时为计划回收的章节
+func firstThreadChapter(thread *models.PlotThread) int {
+	if len(thread.PlantedChapters) > 0 {
+		return thread.PlantedChapters[0]
+	}
+	return thread.PayoffChapter
+}
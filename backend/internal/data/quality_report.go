@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// qualityReportRepo 质量报告仓库实现
+type qualityReportRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewQualityReportRepo 创建质量报告仓库
+func NewQualityReportRepo(data *Data, logger log.Logger) biz.QualityReportRepo {
+	return &qualityReportRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SaveQualityReports 追加保存检查报告
+func (r *qualityReportRepo) SaveQualityReports(ctx context.Context, reports ...*models.QualityReport) error {
+	if len(reports) == 0 {
+		return nil
+	}
+
+	records := make([]*QualityReportRecord, 0, len(reports))
+	for _, report := range reports {
+		severity, err := json.Marshal(report.IssuesBySeverity)
+		if err != nil {
+			return fmt.Errorf("failed to marshal issue counts: %w", err)
+		}
+		records = append(records, &QualityReportRecord{
+			ID:               report.ID,
+			ProjectID:        report.ProjectID,
+			ChapterID:        report.ChapterID,
+			ChapterIndex:     report.ChapterIndex,
+			ChapterVersion:   report.ChapterVersion,
+			Kind:             report.Kind,
+			CheckType:        report.CheckType,
+			Polished:         report.Polished,
+			OverallScore:     report.OverallScore,
+			IssueCount:       report.IssueCount,
+			IssuesBySeverity: string(severity),
+			Details:          report.Details,
+			CreatedAt:        report.CreatedAt,
+		})
+	}
+	if err := r.data.db.WithContext(ctx).Create(&records).Error; err != nil {
+		return fmt.Errorf("failed to save quality reports: %w", err)
+	}
+	return nil
+}
+
+// ListQualityReports 按检查时间倒序列出报告
+func (r *qualityReportRepo) ListQualityReports(ctx context.Context, query *models.QualityReportQuery) ([]*models.QualityReport, int, error) {
+	db := r.data.db.WithContext(ctx).Model(&QualityReportRecord{}).Where("project_id = ?", query.ProjectID)
+	if query.ChapterID != "" {
+		db = db.Where("chapter_id = ?", query.ChapterID)
+	}
+	if query.Kind != "" {
+		db = db.Where("kind = ?", query.Kind)
+	}
+	if !query.Since.IsZero() {
+		db = db.Where("created_at >= ?", query.Since)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count quality reports: %w", err)
+	}
+
+	db = db.Order("created_at DESC").Order("id DESC")
+	if query.PageSize > 0 {
+		db = db.Offset((query.Page - 1) * query.PageSize).Limit(query.PageSize)
+	}
+	var records []QualityReportRecord
+	if err := db.Find(&records).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list quality reports: %w", err)
+	}
+
+	reports := make([]*models.QualityReport, 0, len(records))
+	for _, record := range records {
+		report := &models.QualityReport{
+			ID:             record.ID,
+			ProjectID:      record.ProjectID,
+			ChapterID:      record.ChapterID,
+			ChapterIndex:   record.ChapterIndex,
+			ChapterVersion: record.ChapterVersion,
+			Kind:           record.Kind,
+			CheckType:      record.CheckType,
+			Polished:       record.Polished,
+			OverallScore:   record.OverallScore,
+			IssueCount:     record.IssueCount,
+			Details:        record.Details,
+			CreatedAt:      record.CreatedAt,
+		}
+		if record.IssuesBySeverity != "" {
+			if err := json.Unmarshal([]byte(record.IssuesBySeverity), &report.IssuesBySeverity); err != nil {
+				return nil, 0, fmt.Errorf("failed to unmarshal issue counts: %w", err)
+			}
+		}
+		reports = append(reports, report)
+	}
+	return reports, int(total), nil
+}
@@ -0,0 +1,440 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+	"backend/internal/pkg/textutil"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// searchFTSTable FTS5 虚拟表名
+const searchFTSTable = "search_documents_fts"
+
+// SearchDocument 全文检索文档数据库模型
+type SearchDocument struct {
+	ID           string    `gorm:"primaryKey;size:255" json:"id"`
+	ProjectID    string    `gorm:"size:255;not null;index" json:"project_id"`
+	DocType      string    `gorm:"size:50;not null" json:"doc_type"` // chapter/summary/character/outline
+	RefID        string    `gorm:"size:255" json:"ref_id"`
+	ChapterIndex int       `gorm:"default:0" json:"chapter_index"`
+	Status       string    `gorm:"size:50" json:"status"`
+	Title        string    `gorm:"size:500" json:"title"`
+	Content      string    `gorm:"type:text" json:"content"`
+	Tokens       string    `gorm:"type:text" json:"tokens"` // 以空格分隔的词元，首尾带空格便于匹配
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (SearchDocument) TableName() string {
+	return "search_documents"
+}
+
+// CreateIndexes 创建索引
+func (sd *SearchDocument) CreateIndexes(db *gorm.DB) error {
+	var count int64
+	db.Raw("SELECT COUNT(*) FROM sqlite_master WHERE type='index' AND name='idx_search_documents_project_type'").Scan(&count)
+	if count == 0 {
+		if err := db.Exec("CREATE INDEX idx_search_documents_project_type ON search_documents(project_id, doc_type, chapter_index)").Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchIndex 全文检索索引
+// 优先使用 SQLite FTS5（mattn/go-sqlite3 需以 sqlite_fts5 构建标签编译），
+// 不可用时退化为基于词元列的 LIKE 匹配，可在任意驱动上运行。
+type searchIndex struct {
+	ftsEnabled bool
+}
+
+// newSearchIndex 初始化检索索引，探测 FTS5 是否可用
+func newSearchIndex(db *gorm.DB, helper *log.Helper) *searchIndex {
+	idx := &searchIndex{}
+	err := db.Exec(fmt.Sprintf("CREATE VIRTUAL TABLE IF NOT EXISTS %s USING fts5(doc_id UNINDEXED, tokens)", searchFTSTable)).Error
+	if err != nil {
+		helper.Warnf("FTS5 not available, falling back to token matching: %v", err)
+		return idx
+	}
+	idx.ftsEnabled = true
+	return idx
+}
+
+// backfill 在索引为空时为已有数据建立索引，并补齐缺失的 FTS 记录
+func (s *searchIndex) backfill(db *gorm.DB) error {
+	var docCount int64
+	if err := db.Model(&SearchDocument{}).Count(&docCount).Error; err != nil {
+		return fmt.Errorf("failed to count search documents: %w", err)
+	}
+
+	if docCount == 0 {
+		var projects []NovelProject
+		if err := db.Find(&projects).Error; err != nil {
+			return fmt.Errorf("failed to load projects: %w", err)
+		}
+		for i := range projects {
+			project, err := (&novelRepo{}).modelToEntity(&projects[i])
+			if err != nil {
+				continue
+			}
+			if err := s.indexProject(db, project); err != nil {
+				return err
+			}
+		}
+
+		var chapterIDs []string
+		if err := db.Model(&Chapter{}).Pluck("id", &chapterIDs).Error; err != nil {
+			return fmt.Errorf("failed to load chapters: %w", err)
+		}
+		for _, id := range chapterIDs {
+			if err := s.indexChapter(db, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if s.ftsEnabled {
+		var ftsCount int64
+		if err := db.Raw(fmt.Sprintf("SELECT COUNT(*) FROM %s", searchFTSTable)).Scan(&ftsCount).Error; err != nil {
+			return fmt.Errorf("failed to count fts documents: %w", err)
+		}
+		if ftsCount != docCount {
+			if err := db.Exec(fmt.Sprintf("DELETE FROM %s", searchFTSTable)).Error; err != nil {
+				return fmt.Errorf("failed to reset fts documents: %w", err)
+			}
+			if err := db.Exec(fmt.Sprintf("INSERT INTO %s(doc_id, tokens) SELECT id, tokens FROM search_documents", searchFTSTable)).Error; err != nil {
+				return fmt.Errorf("failed to rebuild fts documents: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// indexProject 重建项目的人物卡与大纲文档
+func (s *searchIndex) indexProject(tx *gorm.DB, project *models.NovelProject) error {
+	if s == nil || project == nil {
+		return nil
+	}
+
+	if err := s.removeDocuments(tx, "project_id = ? AND doc_type IN ?", project.ID,
+		[]string{models.SearchDocCharacter, models.SearchDocOutline}); err != nil {
+		return err
+	}
+
+	docs := make([]*SearchDocument, 0)
+	for i, char := range project.Characters {
+		if char == nil || char.Name == "" {
+			continue
+		}
+		refID := char.ID
+		if refID == "" {
+			refID = strconv.Itoa(i)
+		}
+		content := joinNonEmpty("\n", char.Name, char.Role, char.Appearance, char.Background,
+			char.Motivation, char.SpeechTone, strings.Join(char.Flaws, "；"), strings.Join(char.Secrets, "；"))
+		docs = append(docs, newSearchDocument(project.ID, models.SearchDocCharacter, refID, 0, project.Status, char.Name, content))
+	}
+
+	if project.Outline != nil {
+		for _, item := range project.Outline.Chapters {
+			if item == nil {
+				continue
+			}
+			content := joinNonEmpty("\n", item.Summary, item.Goal, item.TwistHint, strings.Join(item.ImportantItems, "；"))
+			docs = append(docs, newSearchDocument(project.ID, models.SearchDocOutline, strconv.Itoa(item.Index),
+				item.Index, project.Status, item.Title, content))
+		}
+	}
+
+	return s.insertDocuments(tx, docs)
+}
+
+// indexChapter 重建章节正文与摘要文档，以数据库中的最新记录为准
+func (s *searchIndex) indexChapter(tx *gorm.DB, chapterID string) error {
+	if s == nil {
+		return nil
+	}
+
+	var chapter Chapter
+	if err := tx.Where("id = ?", chapterID).First(&chapter).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return s.removeChapter(tx, chapterID)
+		}
+		return fmt.Errorf("failed to load chapter for indexing: %w", err)
+	}
+
+	if err := s.removeChapter(tx, chapterID); err != nil {
+		return err
+	}
+
+	docs := make([]*SearchDocument, 0, 2)
+	if chapter.Content != "" {
+		docs = append(docs, newSearchDocument(chapter.ProjectID, models.SearchDocChapter, chapter.ID,
+			chapter.Order, chapter.Status, chapter.Title, chapter.Content))
+	}
+	if chapter.Summary != "" {
+		docs = append(docs, newSearchDocument(chapter.ProjectID, models.SearchDocSummary, chapter.ID,
+			chapter.Order, chapter.Status, chapter.Title, chapter.Summary))
+	}
+
+	return s.insertDocuments(tx, docs)
+}
+
+// removeChapter 删除章节相关文档
+func (s *searchIndex) removeChapter(tx *gorm.DB, chapterID string) error {
+	if s == nil {
+		return nil
+	}
+	return s.removeDocuments(tx, "ref_id = ? AND doc_type IN ?", chapterID,
+		[]string{models.SearchDocChapter, models.SearchDocSummary})
+}
+
+// removeProject 删除项目的全部文档
+func (s *searchIndex) removeProject(tx *gorm.DB, projectID string) error {
+	if s == nil {
+		return nil
+	}
+	return s.removeDocuments(tx, "project_id = ?", projectID)
+}
+
+// removeDocuments 按条件删除文档及其 FTS 记录
+func (s *searchIndex) removeDocuments(tx *gorm.DB, query string, args ...interface{}) error {
+	if s.ftsEnabled {
+		var ids []string
+		if err := tx.Model(&SearchDocument{}).Where(query, args...).Pluck("id", &ids).Error; err != nil {
+			return fmt.Errorf("failed to find search documents: %w", err)
+		}
+		if len(ids) > 0 {
+			if err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE doc_id IN ?", searchFTSTable), ids).Error; err != nil {
+				return fmt.Errorf("failed to delete fts documents: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Where(query, args...).Delete(&SearchDocument{}).Error; err != nil {
+		return fmt.Errorf("failed to delete search documents: %w", err)
+	}
+	return nil
+}
+
+// insertDocuments 写入文档及其 FTS 记录
+func (s *searchIndex) insertDocuments(tx *gorm.DB, docs []*SearchDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	if err := tx.Create(docs).Error; err != nil {
+		return fmt.Errorf("failed to insert search documents: %w", err)
+	}
+
+	if s.ftsEnabled {
+		for _, doc := range docs {
+			if err := tx.Exec(fmt.Sprintf("INSERT INTO %s(doc_id, tokens) VALUES (?, ?)", searchFTSTable), doc.ID, doc.Tokens).Error; err != nil {
+				return fmt.Errorf("failed to insert fts document: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// search 执行检索
+func (s *searchIndex) search(tx *gorm.DB, query *models.SearchQuery) ([]*models.SearchHit, int, error) {
+	tokens := textutil.QueryTokens(query.Query)
+	if len(tokens) == 0 {
+		return []*models.SearchHit{}, 0, nil
+	}
+
+	prefix := ""
+	if s.ftsEnabled {
+		prefix = "d."
+	}
+	conditions, args := searchFilters(query, prefix)
+	if query.Scope != nil {
+		conditions = append(conditions, prefix+"project_id IN (?)")
+		args = append(args, accessibleProjectIDs(tx, query.Scope))
+	}
+
+	if s.ftsEnabled {
+		return s.searchFTS(tx, tokens, conditions, args, query)
+	}
+	return s.searchFallback(tx, tokens, conditions, args, query)
+}
+
+// searchFTS 使用 FTS5 与 bm25 排序检索
+func (s *searchIndex) searchFTS(tx *gorm.DB, tokens, conditions []string, args []interface{}, query *models.SearchQuery) ([]*models.SearchHit, int, error) {
+	quoted := make([]string, len(tokens))
+	for i, token := range tokens {
+		quoted[i] = `"` + strings.ReplaceAll(token, `"`, `""`) + `"`
+	}
+
+	where := append([]string{searchFTSTable + " MATCH ?"}, conditions...)
+	whereArgs := append([]interface{}{strings.Join(quoted, " ")}, args...)
+	from := fmt.Sprintf("FROM %s f JOIN search_documents d ON d.id = f.doc_id WHERE %s", searchFTSTable, strings.Join(where, " AND "))
+
+	var total int64
+	if err := tx.Raw("SELECT COUNT(*) "+from, whereArgs...).Scan(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count search results: %w", err)
+	}
+
+	type row struct {
+		SearchDocument
+		Rank float64
+	}
+	var rows []row
+	offset := (query.Page - 1) * query.PageSize
+	sql := fmt.Sprintf("SELECT d.*, bm25(%s) AS rank %s ORDER BY rank LIMIT ? OFFSET ?", searchFTSTable, from)
+	if err := tx.Raw(sql, append(whereArgs, query.PageSize, offset)...).Scan(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to search documents: %w", err)
+	}
+
+	hits := make([]*models.SearchHit, len(rows))
+	for i := range rows {
+		// bm25 越小越相关，取负值使评分越大越相关
+		hits[i] = rows[i].SearchDocument.toHit(-rows[i].Rank)
+	}
+	return hits, int(total), nil
+}
+
+// searchFallback 基于词元列的匹配检索，按词频评分，计数、排序与分页均在数据库中完成
+func (s *searchIndex) searchFallback(tx *gorm.DB, tokens, conditions []string, args []interface{}, query *models.SearchQuery) ([]*models.SearchHit, int, error) {
+	where := make([]string, 0, len(conditions)+len(tokens))
+	whereArgs := make([]interface{}, 0, len(args)+len(tokens))
+	where = append(where, conditions...)
+	whereArgs = append(whereArgs, args...)
+	// 词元仅由字母、数字和表意文字组成，无需转义通配符
+	for _, token := range tokens {
+		where = append(where, "tokens LIKE ?")
+		whereArgs = append(whereArgs, "% "+token+" %")
+	}
+	whereSQL := strings.Join(where, " AND ")
+
+	var total int64
+	if err := tx.Model(&SearchDocument{}).Where(whereSQL, whereArgs...).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count search results: %w", err)
+	}
+
+	// 词频 = (原长度 - 去掉词元后的长度) / 词元长度，再按文档长度归一化，避免长章节天然占优
+	terms := make([]string, len(tokens))
+	scoreArgs := make([]interface{}, 0, len(tokens)*2)
+	for i, token := range tokens {
+		pattern := " " + token + " "
+		terms[i] = "(LENGTH(tokens) - LENGTH(REPLACE(tokens, ?, ''))) * 1.0 / ?"
+		scoreArgs = append(scoreArgs, pattern, utf8.RuneCountInString(pattern))
+	}
+	score := fmt.Sprintf("(%s) / (1 + LENGTH(tokens) / 1000.0)", strings.Join(terms, " + "))
+
+	type row struct {
+		SearchDocument
+		Score float64
+	}
+	var rows []row
+	offset := (query.Page - 1) * query.PageSize
+	if err := tx.Model(&SearchDocument{}).Select("*, "+score+" AS score", scoreArgs...).
+		Where(whereSQL, whereArgs...).Order("score DESC").Order("id ASC").
+		Offset(offset).Limit(query.PageSize).Scan(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to search documents: %w", err)
+	}
+
+	hits := make([]*models.SearchHit, len(rows))
+	for i := range rows {
+		hits[i] = rows[i].SearchDocument.toHit(rows[i].Score)
+	}
+	return hits, int(total), nil
+}
+
+// searchFilters 构造过滤条件，每个条件恰好对应一个参数
+func searchFilters(query *models.SearchQuery, prefix string) ([]string, []interface{}) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	if query.ProjectID != "" {
+		conditions = append(conditions, prefix+"project_id = ?")
+		args = append(args, query.ProjectID)
+	}
+	if query.Status != "" {
+		conditions = append(conditions, prefix+"status = ?")
+		args = append(args, query.Status)
+	}
+	if query.ChapterFrom > 0 {
+		conditions = append(conditions, prefix+"chapter_index >= ?")
+		args = append(args, query.ChapterFrom)
+	}
+	if query.ChapterTo > 0 {
+		conditions = append(conditions, prefix+"chapter_index <= ?")
+		args = append(args, query.ChapterTo)
+	}
+	if len(query.DocTypes) > 0 {
+		conditions = append(conditions, prefix+"doc_type IN ?")
+		args = append(args, query.DocTypes)
+	}
+	return conditions, args
+}
+
+// toHit 转换为检索结果
+func (sd *SearchDocument) toHit(score float64) *models.SearchHit {
+	return &models.SearchHit{
+		DocType:      sd.DocType,
+		ProjectID:    sd.ProjectID,
+		RefID:        sd.RefID,
+		ChapterIndex: sd.ChapterIndex,
+		Title:        sd.Title,
+		Status:       sd.Status,
+		Content:      sd.Content,
+		Score:        score,
+	}
+}
+
+// newSearchDocument 构造检索文档
+func newSearchDocument(projectID, docType, refID string, chapterIndex int, status, title, content string) *SearchDocument {
+	tokens := textutil.IndexTokens(title + "\n" + content)
+	return &SearchDocument{
+		ID:           fmt.Sprintf("%s:%s:%s", projectID, docType, refID),
+		ProjectID:    projectID,
+		DocType:      docType,
+		RefID:        refID,
+		ChapterIndex: chapterIndex,
+		Status:       status,
+		Title:        title,
+		Content:      content,
+		Tokens:       " " + strings.Join(tokens, " ") + " ",
+		UpdatedAt:    time.Now(),
+	}
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if strings.TrimSpace(part) != "" {
+			result = append(result, part)
+		}
+	}
+	return strings.Join(result, sep)
+}
+
+// searchRepo 全文检索仓库实现
+type searchRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSearchRepo 创建全文检索仓库
+func NewSearchRepo(data *Data, logger log.Logger) biz.SearchRepo {
+	return &searchRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SearchContent 全文检索
+func (r *searchRepo) SearchContent(ctx context.Context, query *models.SearchQuery) ([]*models.SearchHit, int, error) {
+	return r.data.search.search(r.data.db.WithContext(ctx), query)
+}
@@ -0,0 +1,215 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"backend/internal/pkg/models"
+
+	"gorm.io/gorm"
+)
+
+func TestSearchIndex_FallbackPagesInDatabase(t *testing.T) {
+	d := newTestData(t)
+	d.search.ftsEnabled = false
+
+	// 超过旧实现单次扫描上限的文档数，最相关的文档最后写入
+	const docCount = 1205
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		docs := make([]*SearchDocument, 0, docCount)
+		for i := 1; i <= docCount; i++ {
+			content := "林晚拿起玉佩。"
+			if i == docCount {
+				content = strings.Repeat("玉佩", 5)
+			}
+			docs = append(docs, newSearchDocument("p1", models.SearchDocChapter, fmt.Sprintf("c%04d", i), i, "draft", "章节", content))
+		}
+		return d.search.insertDocuments(tx, docs)
+	})
+	if err != nil {
+		t.Fatalf("Failed to insert documents: %v", err)
+	}
+
+	hits, total, err := d.search.search(d.db, &models.SearchQuery{Query: "玉佩", Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if total != docCount {
+		t.Fatalf("Expected total %d, got %d", docCount, total)
+	}
+	if len(hits) != 10 {
+		t.Fatalf("Expected 10 hits, got %d", len(hits))
+	}
+	if hits[0].RefID != fmt.Sprintf("c%04d", docCount) {
+		t.Fatalf("Expected the most relevant document first, got %s", hits[0].RefID)
+	}
+	for i := 1; i < len(hits); i++ {
+		if hits[i].Score > hits[i-1].Score {
+			t.Fatalf("Hits are not ordered by score at %d", i)
+		}
+	}
+
+	last, _, err := d.search.search(d.db, &models.SearchQuery{Query: "玉佩", Page: 121, PageSize: 10})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if len(last) != 5 {
+		t.Fatalf("Expected 5 hits on the last page, got %d", len(last))
+	}
+}
+
+func TestSearchIndex_FallbackFilters(t *testing.T) {
+	d := newTestData(t)
+	d.search.ftsEnabled = false
+
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		return d.search.insertDocuments(tx, []*SearchDocument{
+			newSearchDocument("p1", models.SearchDocChapter, "c1", 1, "draft", "第一章", "青云宗山门"),
+			newSearchDocument("p1", models.SearchDocChapter, "c5", 5, "completed", "第五章", "青云宗大殿"),
+			newSearchDocument("p1", models.SearchDocSummary, "c5", 5, "completed", "第五章", "青云宗之变"),
+			newSearchDocument("p2", models.SearchDocChapter, "c9", 1, "draft", "第一章", "青云宗旧址"),
+		})
+	})
+	if err != nil {
+		t.Fatalf("Failed to insert documents: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query *models.SearchQuery
+		want  int
+	}{
+		{name: "全部项目", query: &models.SearchQuery{Query: "青云宗"}, want: 4},
+		{name: "限定项目", query: &models.SearchQuery{Query: "青云宗", ProjectID: "p1"}, want: 3},
+		{name: "限定章节范围", query: &models.SearchQuery{Query: "青云宗", ProjectID: "p1", ChapterFrom: 2, ChapterTo: 5}, want: 2},
+		{name: "限定文档类型", query: &models.SearchQuery{Query: "青云宗", DocTypes: []string{models.SearchDocSummary}}, want: 1},
+		{name: "限定状态", query: &models.SearchQuery{Query: "青云宗", Status: "draft"}, want: 2},
+		{name: "所有词元都需命中", query: &models.SearchQuery{Query: "青云宗 大殿"}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.query.Page, tt.query.PageSize = 1, 20
+			hits, total, err := d.search.search(d.db, tt.query)
+			if err != nil {
+				t.Fatalf("Search failed: %v", err)
+			}
+			if total != tt.want || len(hits) != tt.want {
+				t.Fatalf("Expected %d hits, got total=%d hits=%d", tt.want, total, len(hits))
+			}
+		})
+	}
+}
+
+func TestNovelRepo_MaintainsSearchIndex(t *testing.T) {
+	d := newTestData(t)
+	repo := NewNovelRepo(d, testLogger)
+	search := NewSearchRepo(d, testLogger)
+	ctx := context.Background()
+
+	count := func(query string) int {
+		t.Helper()
+		_, total, err := search.SearchContent(ctx, &models.SearchQuery{Query: query, ProjectID: "p1", Page: 1, PageSize: 20})
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		return total
+	}
+
+	if _, err := repo.CreateProject(ctx, &models.NovelProject{ID: "p1", Title: "项目"}); err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	chapter, err := repo.SaveChapter(ctx, &models.Chapter{ID: "c1", ProjectID: "p1", Index: 1, Title: "第一章", RawContent: "林晚拾到一枚玉佩", Summary: "拾到玉佩"})
+	if err != nil {
+		t.Fatalf("Failed to save chapter: %v", err)
+	}
+	if got := count("玉佩"); got != 2 {
+		t.Fatalf("Expected chapter and summary to be indexed, got %d hits", got)
+	}
+
+	chapter.RawContent = "林晚拔出长剑"
+	chapter.Summary = "拔剑"
+	if _, err := repo.UpdateChapter(ctx, chapter); err != nil {
+		t.Fatalf("Failed to update chapter: %v", err)
+	}
+	if got := count("玉佩"); got != 0 {
+		t.Fatalf("Expected old content to be removed from the index, got %d hits", got)
+	}
+	if got := count("长剑"); got != 1 {
+		t.Fatalf("Expected updated content to be indexed, got %d hits", got)
+	}
+
+	if err := repo.DeleteChapter(ctx, "c1"); err != nil {
+		t.Fatalf("Failed to delete chapter: %v", err)
+	}
+	if got := count("长剑"); got != 0 {
+		t.Fatalf("Expected deleted chapter to be removed from the index, got %d hits", got)
+	}
+}
+
+func TestSearchIndex_Scope(t *testing.T) {
+	for _, fts := range []bool{true, false} {
+		t.Run(fmt.Sprintf("fts=%v", fts), func(t *testing.T) {
+			d := newTestData(t)
+			if fts && !d.search.ftsEnabled {
+				t.Skip("FTS5 is not available")
+			}
+			d.search.ftsEnabled = fts
+
+			// p1 归属 u1，p2 归属 u2 且 u1 为协作成员，p3 未归属，p4 只属于 u2
+			for _, p := range []NovelProject{
+				{ID: "p1", OwnerID: "u1"},
+				{ID: "p2", OwnerID: "u2"},
+				{ID: "p3"},
+				{ID: "p4", OwnerID: "u2"},
+			} {
+				if err := d.db.Create(&p).Error; err != nil {
+					t.Fatalf("Failed to create project: %v", err)
+				}
+			}
+			if err := d.db.Create(&ProjectMember{ProjectID: "p2", UserID: "u1", Role: models.ProjectRoleViewer}).Error; err != nil {
+				t.Fatalf("Failed to add member: %v", err)
+			}
+			err := d.db.Transaction(func(tx *gorm.DB) error {
+				docs := make([]*SearchDocument, 0, 4)
+				for _, id := range []string{"p1", "p2", "p3", "p4"} {
+					docs = append(docs, newSearchDocument(id, models.SearchDocChapter, id+"-c1", 1, "draft", "第一章", "青云宗山门"))
+				}
+				return d.search.insertDocuments(tx, docs)
+			})
+			if err != nil {
+				t.Fatalf("Failed to insert documents: %v", err)
+			}
+
+			tests := []struct {
+				name  string
+				scope *models.ProjectScope
+				want  []string
+			}{
+				{name: "不限范围", want: []string{"p1", "p2", "p3", "p4"}},
+				{name: "所有者与协作成员", scope: &models.ProjectScope{UserID: "u1"}, want: []string{"p1", "p2", "p3"}},
+				{name: "匿名调用者不可检索任何项目", scope: &models.ProjectScope{}, want: []string{}},
+			}
+			for _, tt := range tests {
+				hits, total, err := d.search.search(d.db, &models.SearchQuery{Query: "青云宗", Scope: tt.scope, Page: 1, PageSize: 20})
+				if err != nil {
+					t.Fatalf("%s: search failed: %v", tt.name, err)
+				}
+				got := make(map[string]bool, len(hits))
+				for _, hit := range hits {
+					got[hit.ProjectID] = true
+				}
+				if total != len(tt.want) || len(got) != len(tt.want) {
+					t.Fatalf("%s: expected projects %v, got total=%d hits=%v", tt.name, tt.want, total, got)
+				}
+				for _, id := range tt.want {
+					if !got[id] {
+						t.Fatalf("%s: expected a hit in project %s, got %v", tt.name, id, got)
+					}
+				}
+			}
+		})
+	}
+}
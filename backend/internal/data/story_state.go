@@ -0,0 +1,85 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+// storyStateRepo 故事状态仓库实现
+type storyStateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewStoryStateRepo 创建故事状态仓库
+func NewStoryStateRepo(data *Data, logger log.Logger) biz.StoryStateRepo {
+	return &storyStateRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// SaveStoryState 写入或覆盖章节的故事状态
+func (r *storyStateRepo) SaveStoryState(ctx context.Context, state *models.StoryState) error {
+	content, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to marshal story state: %w", err)
+	}
+
+	record := &StoryStateRecord{
+		ProjectID:    state.ProjectID,
+		ChapterIndex: state.ChapterIndex,
+		ChapterID:    state.ChapterID,
+		State:        string(content),
+		UpdatedAt:    state.UpdatedAt,
+	}
+	if err := r.data.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(record).Error; err != nil {
+		return fmt.Errorf("failed to save story state: %w", err)
+	}
+	return nil
+}
+
+// GetStoryState 获取章节索引不大于 chapterIndex 的最近一份状态
+func (r *storyStateRepo) GetStoryState(ctx context.Context, projectID string, chapterIndex int) (*models.StoryState, error) {
+	var records []StoryStateRecord
+	if err := r.data.db.WithContext(ctx).
+		Where("project_id = ? AND chapter_index <= ?", projectID, chapterIndex).
+		Order("chapter_index DESC").Limit(1).Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to get story state: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	var state models.StoryState
+	if err := json.Unmarshal([]byte(records[0].State), &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal story state: %w", err)
+	}
+	return &state, nil
+}
+
+// ListStoryStates 按章节索引升序列出项目的全部故事状态
+func (r *storyStateRepo) ListStoryStates(ctx context.Context, projectID string) ([]*models.StoryState, error) {
+	var records []StoryStateRecord
+	if err := r.data.db.WithContext(ctx).Where("project_id = ?", projectID).
+		Order("chapter_index ASC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list story states: %w", err)
+	}
+
+	states := make([]*models.StoryState, 0, len(records))
+	for _, record := range records {
+		var state models.StoryState
+		if err := json.Unmarshal([]byte(record.State), &state); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal story state: %w", err)
+		}
+		states = append(states, &state)
+	}
+	return states, nil
+}
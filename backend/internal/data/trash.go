@@ -0,0 +1,361 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// trashRepo 回收站数据仓库实现
+//
+// 删除项目时，章节与视频脚本与项目共用同一个删除时间；恢复项目时只还原
+// 删除时间不早于项目删除时间的子记录，此前单独删除的章节仍留在回收站中。
+type trashRepo struct {
+	data  *Data
+	novel *novelRepo
+	log   *log.Helper
+}
+
+// NewTrashRepo 创建回收站数据仓库
+func NewTrashRepo(data *Data, logger log.Logger) biz.TrashRepo {
+	helper := log.NewHelper(logger)
+	return &trashRepo{
+		data:  data,
+		novel: &novelRepo{data: data, log: helper},
+		log:   helper,
+	}
+}
+
+// trashRow 回收站列表查询的一行，项目与章节合并为同一结构
+type trashRow struct {
+	Type         string
+	ID           string
+	ProjectID    string
+	Title        string
+	ChapterIndex int
+	DeletedAt    time.Time
+}
+
+// ListTrash 列出回收站条目，按删除时间倒序
+// 项目与章节分别查询后在数据库中合并，计数、排序与分页均在数据库中完成
+func (r *trashRepo) ListTrash(ctx context.Context, itemType string, scope *models.ProjectScope, page, pageSize int) ([]*models.TrashItem, int, error) {
+	db := r.data.db.WithContext(ctx)
+
+	var parts []interface{}
+	if itemType == "" || itemType == models.TrashItemProject {
+		query := db.Unscoped().Model(&NovelProject{}).
+			Select("? AS type, id, id AS project_id, title, 0 AS chapter_index, deleted_at", models.TrashItemProject).
+			Where("deleted_at IS NOT NULL")
+		if scope != nil {
+			query = query.Where("id IN (?)", accessibleProjectIDs(db, scope))
+		}
+		parts = append(parts, query)
+	}
+
+	if itemType == "" || itemType == models.TrashItemChapter {
+		// 随项目一起删除的章节归属于项目条目，不单独列出
+		deletedProjects := db.Unscoped().Model(&NovelProject{}).Select("id").Where("deleted_at IS NOT NULL")
+
+		query := db.Unscoped().Model(&Chapter{}).
+			Select("? AS type, id, project_id, title, `order` AS chapter_index, deleted_at", models.TrashItemChapter).
+			Where("deleted_at IS NOT NULL").
+			Where("project_id NOT IN (?)", deletedProjects)
+		if scope != nil {
+			query = query.Where("project_id IN (?)", accessibleProjectIDs(db, scope))
+		}
+		parts = append(parts, query)
+	}
+
+	if len(parts) == 0 {
+		return []*models.TrashItem{}, 0, nil
+	}
+	selects := make([]string, len(parts))
+	for i := range parts {
+		selects[i] = fmt.Sprintf("SELECT * FROM (?) AS t%d", i)
+	}
+	from := "FROM (" + strings.Join(selects, " UNION ALL ") + ")"
+
+	var total int64
+	if err := db.Raw("SELECT COUNT(*) "+from, parts...).Scan(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count trash items: %w", err)
+	}
+
+	var rows []trashRow
+	offset := (page - 1) * pageSize
+	// 删除时间相同时项目排在章节之前，再按ID排序使分页稳定
+	sql := "SELECT * " + from + " ORDER BY deleted_at DESC, type DESC, id LIMIT ? OFFSET ?"
+	if err := db.Raw(sql, append(parts, pageSize, offset)...).Scan(&rows).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list trash items: %w", err)
+	}
+
+	items := make([]*models.TrashItem, len(rows))
+	for i, row := range rows {
+		items[i] = &models.TrashItem{
+			Type:         row.Type,
+			ID:           row.ID,
+			ProjectID:    row.ProjectID,
+			Title:        row.Title,
+			ChapterIndex: row.ChapterIndex,
+			DeletedAt:    row.DeletedAt,
+		}
+	}
+	return items, int(total), nil
+}
+
+// GetTrashItemProject 获取回收站条目所属的项目，项目本身可能也在回收站中
+func (r *trashRepo) GetTrashItemProject(ctx context.Context, itemType, itemID string) (*models.NovelProject, error) {
+	db := r.data.db.WithContext(ctx)
+
+	projectID := itemID
+	if itemType == models.TrashItemChapter {
+		var dbChapter Chapter
+		if err := db.Unscoped().Select("id", "project_id").
+			Where("id = ? AND deleted_at IS NOT NULL", itemID).First(&dbChapter).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, biz.ErrTrashItemNotFound
+			}
+			return nil, fmt.Errorf("failed to get deleted chapter: %w", err)
+		}
+		projectID = dbChapter.ProjectID
+	}
+
+	query := db.Unscoped().Where("id = ?", projectID)
+	if itemType == models.TrashItemProject {
+		query = query.Where("deleted_at IS NOT NULL")
+	}
+	var dbProject NovelProject
+	if err := query.First(&dbProject).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrTrashItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	return r.novel.modelToEntity(&dbProject)
+}
+
+// RestoreProject 恢复项目及随其删除的章节、视频脚本
+func (r *trashRepo) RestoreProject(ctx context.Context, projectID string) (*models.NovelProject, error) {
+	r.log.WithContext(ctx).Infof("Restoring project: %s", projectID)
+
+	var restored *models.NovelProject
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbProject NovelProject
+		if err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", projectID).First(&dbProject).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrTrashItemNotFound
+			}
+			return fmt.Errorf("failed to get deleted project: %w", err)
+		}
+		deletedAt := dbProject.DeletedAt.Time
+
+		if err := tx.Unscoped().Model(&Chapter{}).
+			Where("project_id = ? AND deleted_at >= ?", projectID, deletedAt).
+			Update("deleted_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to restore chapters: %w", err)
+		}
+		if err := tx.Unscoped().Model(&VideoScript{}).
+			Where("project_id = ? AND deleted_at >= ?", projectID, deletedAt).
+			Update("deleted_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to restore video scripts: %w", err)
+		}
+		if err := tx.Unscoped().Model(&NovelProject{}).Where("id = ?", projectID).
+			Update("deleted_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to restore project: %w", err)
+		}
+
+		if err := tx.Where("id = ?", projectID).First(&dbProject).Error; err != nil {
+			return fmt.Errorf("failed to get restored project: %w", err)
+		}
+		entity, err := r.novel.modelToEntity(&dbProject)
+		if err != nil {
+			return err
+		}
+		restored = entity
+
+		// 重建全文检索索引
+		if err := r.data.search.indexProject(tx, restored); err != nil {
+			return err
+		}
+		var chapterIDs []string
+		if err := tx.Model(&Chapter{}).Where("project_id = ?", projectID).Pluck("id", &chapterIDs).Error; err != nil {
+			return fmt.Errorf("failed to list restored chapters: %w", err)
+		}
+		for _, id := range chapterIDs {
+			if err := r.data.search.indexChapter(tx, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.novel.invalidateCache(ctx, projectCacheKey(projectID), chaptersCacheKey(projectID))
+
+	return restored, nil
+}
+
+// RestoreChapter 恢复单独删除的章节
+func (r *trashRepo) RestoreChapter(ctx context.Context, chapterID string) (*models.Chapter, error) {
+	r.log.WithContext(ctx).Infof("Restoring chapter: %s", chapterID)
+
+	var dbChapter Chapter
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", chapterID).First(&dbChapter).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return biz.ErrTrashItemNotFound
+			}
+			return fmt.Errorf("failed to get deleted chapter: %w", err)
+		}
+
+		// 所属项目在回收站中时，章节需随项目一起恢复
+		var activeProjects int64
+		if err := tx.Model(&NovelProject{}).Where("id = ?", dbChapter.ProjectID).Count(&activeProjects).Error; err != nil {
+			return fmt.Errorf("failed to check project: %w", err)
+		}
+		if activeProjects == 0 {
+			return biz.ErrTrashParentDeleted
+		}
+
+		if err := tx.Unscoped().Model(&Chapter{}).Where("id = ?", chapterID).
+			Update("deleted_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to restore chapter: %w", err)
+		}
+		if err := tx.Where("id = ?", chapterID).First(&dbChapter).Error; err != nil {
+			return fmt.Errorf("failed to get restored chapter: %w", err)
+		}
+
+		// 重建全文检索索引
+		return r.data.search.indexChapter(tx, chapterID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.novel.invalidateCache(ctx, chaptersCacheKey(dbChapter.ProjectID))
+
+	return r.novel.chapterModelToEntity(&dbChapter)
+}
+
+// PurgeProject 彻底删除回收站中的项目
+func (r *trashRepo) PurgeProject(ctx context.Context, projectID string) error {
+	r.log.WithContext(ctx).Infof("Purging project: %s", projectID)
+
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Unscoped().Model(&NovelProject{}).
+			Where("id = ? AND deleted_at IS NOT NULL", projectID).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check deleted project: %w", err)
+		}
+		if count == 0 {
+			return biz.ErrTrashItemNotFound
+		}
+
+		return r.purgeProject(tx, projectID)
+	})
+}
+
+// PurgeChapter 彻底删除回收站中的章节
+func (r *trashRepo) PurgeChapter(ctx context.Context, chapterID string) error {
+	r.log.WithContext(ctx).Infof("Purging chapter: %s", chapterID)
+
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", chapterID).Delete(&Chapter{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to purge chapter: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return biz.ErrTrashItemNotFound
+		}
+
+		if err := tx.Where("chapter_id = ?", chapterID).Delete(&QualityReportRecord{}).Error; err != nil {
+			return fmt.Errorf("failed to purge quality reports: %w", err)
+		}
+		if err := tx.Where("item_type = ? AND item_id = ?", models.IndexItemChapter, chapterID).Delete(&IndexRecord{}).Error; err != nil {
+			return fmt.Errorf("failed to purge index records: %w", err)
+		}
+		return nil
+	})
+}
+
+// PurgeDeletedBefore 彻底删除指定时间之前进入回收站的条目
+func (r *trashRepo) PurgeDeletedBefore(ctx context.Context, before time.Time) ([]string, int, error) {
+	purged := 0
+	var projectIDs []string
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&NovelProject{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+			Pluck("id", &projectIDs).Error; err != nil {
+			return fmt.Errorf("failed to list expired projects: %w", err)
+		}
+		for _, id := range projectIDs {
+			if err := r.purgeProject(tx, id); err != nil {
+				return err
+			}
+		}
+		purged += len(projectIDs)
+
+		expiredChapters := tx.Unscoped().Model(&Chapter{}).Select("id").
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before)
+		if err := tx.Where("chapter_id IN (?)", expiredChapters).Delete(&QualityReportRecord{}).Error; err != nil {
+			return fmt.Errorf("failed to purge expired quality reports: %w", err)
+		}
+		if err := tx.Where("item_type = ? AND item_id IN (?)", models.IndexItemChapter, expiredChapters).Delete(&IndexRecord{}).Error; err != nil {
+			return fmt.Errorf("failed to purge expired index records: %w", err)
+		}
+
+		result := tx.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Delete(&Chapter{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to purge expired chapters: %w", result.Error)
+		}
+		purged += int(result.RowsAffected)
+
+		if err := tx.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+			Delete(&VideoScript{}).Error; err != nil {
+			return fmt.Errorf("failed to purge expired video scripts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return projectIDs, purged, nil
+}
+
+// purgeProject 物理删除项目及其全部章节、视频脚本、成员与索引记录
+func (r *trashRepo) purgeProject(tx *gorm.DB, projectID string) error {
+	if err := tx.Unscoped().Where("project_id = ?", projectID).Delete(&VideoScript{}).Error; err != nil {
+		return fmt.Errorf("failed to purge video scripts: %w", err)
+	}
+	if err := tx.Unscoped().Where("project_id = ?", projectID).Delete(&Chapter{}).Error; err != nil {
+		return fmt.Errorf("failed to purge chapters: %w", err)
+	}
+	if err := tx.Where("project_id = ?", projectID).Delete(&StoryStateRecord{}).Error; err != nil {
+		return fmt.Errorf("failed to purge story states: %w", err)
+	}
+	if err := tx.Where("project_id = ?", projectID).Delete(&QualityReportRecord{}).Error; err != nil {
+		return fmt.Errorf("failed to purge quality reports: %w", err)
+	}
+	if err := tx.Where("project_id = ?", projectID).Delete(&PlotThreadRecord{}).Error; err != nil {
+		return fmt.Errorf("failed to purge plot threads: %w", err)
+	}
+	if err := tx.Where("project_id = ?", projectID).Delete(&ProjectMember{}).Error; err != nil {
+		return fmt.Errorf("failed to purge project members: %w", err)
+	}
+	if err := tx.Where("project_id = ?", projectID).Delete(&IndexRecord{}).Error; err != nil {
+		return fmt.Errorf("failed to purge index records: %w", err)
+	}
+	if err := tx.Unscoped().Where("id = ?", projectID).Delete(&NovelProject{}).Error; err != nil {
+		return fmt.Errorf("failed to purge project: %w", err)
+	}
+
+	return r.data.search.removeProject(tx, projectID)
+}
@@ -0,0 +1,341 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+)
+
+// newTrashTestProject 创建带章节的项目
+func newTrashTestProject(t *testing.T, repo biz.NovelRepo, projectID string, chapterIDs ...string) {
+	t.Helper()
+	ctx := context.Background()
+
+	if _, err := repo.CreateProject(ctx, &models.NovelProject{ID: projectID, Title: "项目" + projectID, Status: "draft"}); err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	for i, id := range chapterIDs {
+		if _, err := repo.SaveChapter(ctx, &models.Chapter{ID: id, ProjectID: projectID, Index: i + 1, Title: "章节" + id, RawContent: "林晚推开木门。"}); err != nil {
+			t.Fatalf("Failed to save chapter: %v", err)
+		}
+	}
+}
+
+// backdateDeletion 把已删除记录的删除时间改到指定时间
+func backdateDeletion(t *testing.T, d *Data, model interface{}, column, id string, at time.Time) {
+	t.Helper()
+	if err := d.db.Unscoped().Model(model).Where(column+" = ?", id).Update("deleted_at", at).Error; err != nil {
+		t.Fatalf("Failed to backdate deletion: %v", err)
+	}
+}
+
+func TestTrashRepo_RestoreProject(t *testing.T) {
+	d := newTestData(t)
+	novel := NewNovelRepo(d, testLogger)
+	trash := NewTrashRepo(d, testLogger)
+	ctx := context.Background()
+
+	newTrashTestProject(t, novel, "p1", "c1", "c2")
+
+	// 章节 c1 在项目之前被单独删除
+	if err := novel.DeleteChapter(ctx, "c1"); err != nil {
+		t.Fatalf("Failed to delete chapter: %v", err)
+	}
+	backdateDeletion(t, d, &Chapter{}, "id", "c1", time.Now().Add(-time.Hour))
+	if err := novel.DeleteProject(ctx, "p1"); err != nil {
+		t.Fatalf("Failed to delete project: %v", err)
+	}
+
+	items, total, err := trash.ListTrash(ctx, "", nil, 1, 20)
+	if err != nil {
+		t.Fatalf("Failed to list trash: %v", err)
+	}
+	if total != 1 || items[0].Type != models.TrashItemProject {
+		t.Fatalf("Expected only the project in trash, got %d items", total)
+	}
+
+	restored, err := trash.RestoreProject(ctx, "p1")
+	if err != nil {
+		t.Fatalf("Failed to restore project: %v", err)
+	}
+	if restored.ID != "p1" {
+		t.Fatalf("Expected project p1, got %s", restored.ID)
+	}
+
+	chapters, err := novel.ListChapters(ctx, "p1")
+	if err != nil {
+		t.Fatalf("Failed to list chapters: %v", err)
+	}
+	if len(chapters) != 1 || chapters[0].ID != "c2" {
+		t.Fatalf("Expected only chapter c2 to be restored, got %d chapters", len(chapters))
+	}
+
+	// 单独删除的章节仍留在回收站中
+	items, _, err = trash.ListTrash(ctx, models.TrashItemChapter, nil, 1, 20)
+	if err != nil {
+		t.Fatalf("Failed to list trash: %v", err)
+	}
+	if len(items) != 1 || items[0].ID != "c1" {
+		t.Fatalf("Expected chapter c1 to remain in trash, got %d items", len(items))
+	}
+
+	if _, err := trash.RestoreProject(ctx, "p1"); err != biz.ErrTrashItemNotFound {
+		t.Fatalf("Expected ErrTrashItemNotFound for an active project, got %v", err)
+	}
+}
+
+func TestTrashRepo_PurgeProject(t *testing.T) {
+	d := newTestData(t)
+	novel := NewNovelRepo(d, testLogger)
+	trash := NewTrashRepo(d, testLogger)
+	ctx := context.Background()
+
+	newTrashTestProject(t, novel, "p1", "c1", "c2")
+	if err := d.db.Create(&ProjectMember{ProjectID: "p1", UserID: "u1", Role: models.ProjectRoleEditor}).Error; err != nil {
+		t.Fatalf("Failed to add member: %v", err)
+	}
+	if err := d.db.Create(&IndexRecord{ItemType: models.IndexItemChapter, ItemID: "c1", ProjectID: "p1"}).Error; err != nil {
+		t.Fatalf("Failed to add index record: %v", err)
+	}
+
+	if err := trash.PurgeProject(ctx, "p1"); err != biz.ErrTrashItemNotFound {
+		t.Fatalf("Expected ErrTrashItemNotFound for an active project, got %v", err)
+	}
+
+	if err := novel.DeleteProject(ctx, "p1"); err != nil {
+		t.Fatalf("Failed to delete project: %v", err)
+	}
+	if err := trash.PurgeProject(ctx, "p1"); err != nil {
+		t.Fatalf("Failed to purge project: %v", err)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		model interface{}
+	}{
+		{"projects", &NovelProject{}},
+		{"chapters", &Chapter{}},
+		{"members", &ProjectMember{}},
+		{"index records", &IndexRecord{}},
+		{"search documents", &SearchDocument{}},
+	} {
+		var count int64
+		if err := d.db.Unscoped().Model(tt.model).Count(&count).Error; err != nil {
+			t.Fatalf("Failed to count %s: %v", tt.name, err)
+		}
+		if count != 0 {
+			t.Errorf("Expected %s to be purged, %d left", tt.name, count)
+		}
+	}
+}
+
+func TestTrashRepo_PurgeDeletedBefore(t *testing.T) {
+	d := newTestData(t)
+	novel := NewNovelRepo(d, testLogger)
+	trash := NewTrashRepo(d, testLogger)
+	ctx := context.Background()
+
+	newTrashTestProject(t, novel, "old", "old-c1")
+	newTrashTestProject(t, novel, "recent", "recent-c1")
+	newTrashTestProject(t, novel, "active", "active-c1", "active-c2")
+
+	for _, id := range []string{"old", "recent"} {
+		if err := novel.DeleteProject(ctx, id); err != nil {
+			t.Fatalf("Failed to delete project: %v", err)
+		}
+	}
+	for _, id := range []string{"active-c1", "active-c2"} {
+		if err := novel.DeleteChapter(ctx, id); err != nil {
+			t.Fatalf("Failed to delete chapter: %v", err)
+		}
+	}
+
+	cutoff := time.Now().Add(-30 * 24 * time.Hour)
+	expired := cutoff.Add(-time.Hour)
+	backdateDeletion(t, d, &NovelProject{}, "id", "old", expired)
+	backdateDeletion(t, d, &Chapter{}, "project_id", "old", expired)
+	backdateDeletion(t, d, &Chapter{}, "id", "active-c1", expired)
+	// 恰好在截止时间删除的章节尚未过期
+	backdateDeletion(t, d, &Chapter{}, "id", "active-c2", cutoff)
+
+	projectIDs, count, err := trash.PurgeDeletedBefore(ctx, cutoff)
+	if err != nil {
+		t.Fatalf("Failed to purge expired items: %v", err)
+	}
+	if len(projectIDs) != 1 || projectIDs[0] != "old" {
+		t.Fatalf("Expected only project old to be purged, got %v", projectIDs)
+	}
+	if count != 2 {
+		t.Fatalf("Expected 2 purged items, got %d", count)
+	}
+
+	items, _, err := trash.ListTrash(ctx, "", nil, 1, 20)
+	if err != nil {
+		t.Fatalf("Failed to list trash: %v", err)
+	}
+	remaining := make(map[string]bool, len(items))
+	for _, item := range items {
+		remaining[item.ID] = true
+	}
+	if len(items) != 2 || !remaining["recent"] || !remaining["active-c2"] {
+		t.Fatalf("Unexpected items left in trash: %v", remaining)
+	}
+
+	var chapters int64
+	if err := d.db.Unscoped().Model(&Chapter{}).Where("project_id = ?", "old").Count(&chapters).Error; err != nil {
+		t.Fatalf("Failed to count chapters: %v", err)
+	}
+	if chapters != 0 {
+		t.Fatalf("Expected chapters of the purged project to be removed, %d left", chapters)
+	}
+}
+
+func TestTrashRepo_ListTrashScope(t *testing.T) {
+	d := newTestData(t)
+	novel := NewNovelRepo(d, testLogger)
+	trash := NewTrashRepo(d, testLogger)
+	ctx := context.Background()
+
+	for _, p := range []*models.NovelProject{
+		{ID: "mine", OwnerID: "u1"},
+		{ID: "shared", OwnerID: "u2"},
+		{ID: "other", OwnerID: "u2"},
+	} {
+		p.Title = "项目" + p.ID
+		if _, err := novel.CreateProject(ctx, p); err != nil {
+			t.Fatalf("Failed to create project: %v", err)
+		}
+		if _, err := novel.SaveChapter(ctx, &models.Chapter{ID: p.ID + "-c1", ProjectID: p.ID, Index: 1, Title: "第一章"}); err != nil {
+			t.Fatalf("Failed to save chapter: %v", err)
+		}
+	}
+	if err := d.db.Create(&ProjectMember{ProjectID: "shared", UserID: "u1", Role: models.ProjectRoleEditor}).Error; err != nil {
+		t.Fatalf("Failed to add member: %v", err)
+	}
+
+	if err := novel.DeleteProject(ctx, "mine"); err != nil {
+		t.Fatalf("Failed to delete project: %v", err)
+	}
+	if err := novel.DeleteProject(ctx, "other"); err != nil {
+		t.Fatalf("Failed to delete project: %v", err)
+	}
+	if err := novel.DeleteChapter(ctx, "shared-c1"); err != nil {
+		t.Fatalf("Failed to delete chapter: %v", err)
+	}
+
+	items, total, err := trash.ListTrash(ctx, "", &models.ProjectScope{UserID: "u1"}, 1, 20)
+	if err != nil {
+		t.Fatalf("Failed to list trash: %v", err)
+	}
+	got := make(map[string]bool, len(items))
+	for _, item := range items {
+		got[item.ID] = true
+	}
+	if total != 2 || !got["mine"] || !got["shared-c1"] {
+		t.Fatalf("Expected only items of accessible projects, got %v", got)
+	}
+
+	// 回收站中的项目仍按所有者与成员校验
+	project, err := trash.GetTrashItemProject(ctx, models.TrashItemProject, "other")
+	if err != nil {
+		t.Fatalf("Failed to get trash item project: %v", err)
+	}
+	if project.OwnerID != "u2" {
+		t.Fatalf("Expected owner u2, got %s", project.OwnerID)
+	}
+	project, err = trash.GetTrashItemProject(ctx, models.TrashItemChapter, "shared-c1")
+	if err != nil {
+		t.Fatalf("Failed to get trash item project: %v", err)
+	}
+	if project.ID != "shared" {
+		t.Fatalf("Expected project shared, got %s", project.ID)
+	}
+	if _, err := trash.GetTrashItemProject(ctx, models.TrashItemProject, "shared"); err != biz.ErrTrashItemNotFound {
+		t.Fatalf("Expected ErrTrashItemNotFound for an active project, got %v", err)
+	}
+}
+
+func TestTrashRepo_ListTrashPagination(t *testing.T) {
+	d := newTestData(t)
+	novel := NewNovelRepo(d, testLogger)
+	trash := NewTrashRepo(d, testLogger)
+	ctx := context.Background()
+
+	newTrashTestProject(t, novel, "p1", "p1-c1", "p1-c2")
+	newTrashTestProject(t, novel, "p2", "p2-c1", "p2-c2", "p2-c3")
+
+	// 删除顺序（由新到旧）：p2-c3、p1、p2-c1、p2-c2；p1 的章节随项目删除，不单独列出
+	base := time.Now().Add(-time.Hour)
+	deletions := []struct {
+		model  interface{}
+		id     string
+		minute int
+	}{
+		{&Chapter{}, "p2-c2", 1},
+		{&Chapter{}, "p2-c1", 2},
+		{&NovelProject{}, "p1", 3},
+		{&Chapter{}, "p2-c3", 4},
+	}
+	for _, deletion := range deletions {
+		var err error
+		if _, ok := deletion.model.(*NovelProject); ok {
+			err = novel.DeleteProject(ctx, deletion.id)
+		} else {
+			err = novel.DeleteChapter(ctx, deletion.id)
+		}
+		if err != nil {
+			t.Fatalf("Failed to delete %s: %v", deletion.id, err)
+		}
+		backdateDeletion(t, d, deletion.model, "id", deletion.id, base.Add(time.Duration(deletion.minute)*time.Minute))
+	}
+
+	tests := []struct {
+		name      string
+		itemType  string
+		page      int
+		pageSize  int
+		wantIDs   []string
+		wantTotal int
+	}{
+		{name: "第一页", page: 1, pageSize: 3, wantIDs: []string{"p2-c3", "p1", "p2-c1"}, wantTotal: 4},
+		{name: "第二页", page: 2, pageSize: 3, wantIDs: []string{"p2-c2"}, wantTotal: 4},
+		{name: "超出范围", page: 3, pageSize: 3, wantIDs: []string{}, wantTotal: 4},
+		{name: "只列出项目", itemType: models.TrashItemProject, page: 1, pageSize: 10, wantIDs: []string{"p1"}, wantTotal: 1},
+		{name: "只列出章节", itemType: models.TrashItemChapter, page: 1, pageSize: 2, wantIDs: []string{"p2-c3", "p2-c1"}, wantTotal: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, total, err := trash.ListTrash(ctx, tt.itemType, nil, tt.page, tt.pageSize)
+			if err != nil {
+				t.Fatalf("Failed to list trash: %v", err)
+			}
+			ids := make([]string, 0, len(items))
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+			if total != tt.wantTotal || strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Fatalf("Expected %v (total %d), got %v (total %d)", tt.wantIDs, tt.wantTotal, ids, total)
+			}
+		})
+	}
+
+	items, _, err := trash.ListTrash(ctx, "", nil, 1, 10)
+	if err != nil {
+		t.Fatalf("Failed to list trash: %v", err)
+	}
+	chapter, project := items[0], items[1]
+	if chapter.Type != models.TrashItemChapter || chapter.ProjectID != "p2" || chapter.ChapterIndex != 3 || chapter.Title != "章节p2-c3" {
+		t.Fatalf("Unexpected chapter item: %+v", chapter)
+	}
+	if project.Type != models.TrashItemProject || project.ProjectID != "p1" || project.Title != "项目p1" {
+		t.Fatalf("Unexpected project item: %+v", project)
+	}
+	if want := base.Add(4 * time.Minute); !chapter.DeletedAt.Equal(want) {
+		t.Fatalf("Expected deleted at %v, got %v", want, chapter.DeletedAt)
+	}
+}
@@ -0,0 +1,259 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userRepo 用户与项目成员数据仓库实现
+type userRepo struct {
+	data  *Data
+	novel *novelRepo
+	log   *log.Helper
+}
+
+// NewUserRepo 创建用户数据仓库
+func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
+	helper := log.NewHelper(logger)
+	return &userRepo{
+		data:  data,
+		novel: &novelRepo{data: data, log: helper},
+		log:   helper,
+	}
+}
+
+// userModelToEntity 将用户数据库模型转换为业务实体
+func userModelToEntity(dbUser *User) *models.User {
+	return &models.User{
+		ID:          dbUser.ID,
+		Username:    dbUser.Username,
+		DisplayName: dbUser.DisplayName,
+		CreatedAt:   dbUser.CreatedAt,
+		UpdatedAt:   dbUser.UpdatedAt,
+	}
+}
+
+// CreateUser 创建用户
+func (r *userRepo) CreateUser(ctx context.Context, user *models.User, tokenHash string) (*models.User, error) {
+	r.log.WithContext(ctx).Infof("Creating user: %s", user.Username)
+
+	now := time.Now()
+	dbUser := &User{
+		ID:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		TokenHash:   tokenHash,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := r.data.db.WithContext(ctx).Create(dbUser).Error; err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return userModelToEntity(dbUser), nil
+}
+
+// GetUser 获取用户
+func (r *userRepo) GetUser(ctx context.Context, userID string) (*models.User, error) {
+	return r.findUser(ctx, "id = ?", userID)
+}
+
+// GetUserByUsername 根据用户名获取用户
+func (r *userRepo) GetUserByUsername(ctx context.Context, username string) (*models.User, error) {
+	return r.findUser(ctx, "username = ?", username)
+}
+
+// GetUserByTokenHash 根据访问令牌摘要获取用户
+func (r *userRepo) GetUserByTokenHash(ctx context.Context, tokenHash string) (*models.User, error) {
+	return r.findUser(ctx, "token_hash = ?", tokenHash)
+}
+
+func (r *userRepo) findUser(ctx context.Context, query string, arg interface{}) (*models.User, error) {
+	var dbUser User
+	if err := r.data.db.WithContext(ctx).Where(query, arg).First(&dbUser).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, biz.ErrAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return userModelToEntity(&dbUser), nil
+}
+
+// GetProjectRole 获取协作成员角色，非成员返回空字符串
+func (r *userRepo) GetProjectRole(ctx context.Context, projectID, userID string) (string, error) {
+	var member ProjectMember
+	err := r.data.db.WithContext(ctx).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Take(&member).Error
+	if err == gorm.ErrRecordNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get project member: %w", err)
+	}
+
+	return member.Role, nil
+}
+
+// SaveProjectMember 添加成员，已存在时更新角色
+func (r *userRepo) SaveProjectMember(ctx context.Context, member *models.ProjectMember) (*models.ProjectMember, error) {
+	now := time.Now()
+	dbMember := &ProjectMember{
+		ProjectID: member.ProjectID,
+		UserID:    member.UserID,
+		Role:      member.Role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+	}).Create(dbMember).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to save project member: %w", err)
+	}
+
+	return &models.ProjectMember{
+		ProjectID: dbMember.ProjectID,
+		UserID:    dbMember.UserID,
+		Role:      dbMember.Role,
+		CreatedAt: dbMember.CreatedAt,
+	}, nil
+}
+
+// RemoveProjectMember 移除成员
+func (r *userRepo) RemoveProjectMember(ctx context.Context, projectID, userID string) error {
+	if err := r.data.db.WithContext(ctx).
+		Where("project_id = ? AND user_id = ?", projectID, userID).
+		Delete(&ProjectMember{}).Error; err != nil {
+		return fmt.Errorf("failed to remove project member: %w", err)
+	}
+
+	return nil
+}
+
+// ListProjectMembers 列出协作成员（不含所有者）
+func (r *userRepo) ListProjectMembers(ctx context.Context, projectID string) ([]*models.ProjectMember, error) {
+	var rows []struct {
+		ProjectMember
+		Username string
+	}
+	if err := r.data.db.WithContext(ctx).Model(&ProjectMember{}).
+		Select("project_members.*, users.username").
+		Joins("LEFT JOIN users ON users.id = project_members.user_id").
+		Where("project_members.project_id = ?", projectID).
+		Order("project_members.created_at ASC").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list project members: %w", err)
+	}
+
+	members := make([]*models.ProjectMember, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, &models.ProjectMember{
+			ProjectID: row.ProjectID,
+			UserID:    row.UserID,
+			Username:  row.Username,
+			Role:      row.Role,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+
+	return members, nil
+}
+
+// accessibleProjects 登录用户可见的项目范围：本人所有、参与协作以及未归属的项目
+func (r *userRepo) accessibleProjects(db *gorm.DB, userID string) *gorm.DB {
+	return scopeAccessibleProjects(db, r.data.db, userID)
+}
+
+// scopeAccessibleProjects 为项目查询追加可见范围条件，base 用于构造成员子查询，匿名调用者不可见任何项目
+func scopeAccessibleProjects(db, base *gorm.DB, userID string) *gorm.DB {
+	if userID == "" {
+		return db.Where("1 = 0")
+	}
+
+	members := base.Model(&ProjectMember{}).Select("project_id").Where("user_id = ?", userID)
+	return db.Where("owner_id = '' OR owner_id = ? OR id IN (?)", userID, members)
+}
+
+// accessibleProjectIDs 范围内项目ID的子查询，包含回收站中的项目
+func accessibleProjectIDs(db *gorm.DB, scope *models.ProjectScope) *gorm.DB {
+	base := db.Session(&gorm.Session{NewDB: true})
+	return scopeAccessibleProjects(base.Unscoped().Model(&NovelProject{}), base, scope.UserID).Select("id")
+}
+
+// ListAccessibleProjects 分页列出调用者可见的项目
+func (r *userRepo) ListAccessibleProjects(ctx context.Context, userID string, page, pageSize int) ([]*models.NovelProject, int, error) {
+	r.log.WithContext(ctx).Infof("Listing accessible projects: user=%s, page=%d, pageSize=%d", userID, page, pageSize)
+
+	db := r.data.db.WithContext(ctx)
+
+	var total int64
+	if err := r.accessibleProjects(db.Model(&NovelProject{}), userID).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count projects: %w", err)
+	}
+
+	var dbProjects []NovelProject
+	offset := (page - 1) * pageSize
+	if err := r.accessibleProjects(db, userID).Order("updated_at DESC").
+		Offset(offset).Limit(pageSize).Find(&dbProjects).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	projects := make([]*models.NovelProject, 0, len(dbProjects))
+	for _, dbProject := range dbProjects {
+		project, err := r.novel.modelToEntity(&dbProject)
+		if err != nil {
+			r.log.Errorf("failed to convert project %s: %v", dbProject.ID, err)
+			continue
+		}
+		projects = append(projects, project)
+	}
+
+	return projects, int(total), nil
+}
+
+// GetProjectStats 在数据库中聚合调用者可见项目的统计信息
+func (r *userRepo) GetProjectStats(ctx context.Context, userID string, monthStart time.Time) (*models.ProjectStats, error) {
+	db := r.data.db.WithContext(ctx)
+
+	var projectStats struct {
+		Total     int
+		Completed int
+	}
+	if err := r.accessibleProjects(db.Model(&NovelProject{}), userID).
+		Select("COUNT(*) AS total, COALESCE(SUM(CASE WHEN status = 'completed' THEN 1 ELSE 0 END), 0) AS completed").
+		Scan(&projectStats).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate projects: %w", err)
+	}
+
+	// 章节只存储正文，字数按字符数统计
+	var wordStats struct {
+		Total   int64
+		Monthly int64
+	}
+	projectIDs := r.accessibleProjects(db.Model(&NovelProject{}), userID).Select("id")
+	if err := db.Model(&Chapter{}).
+		Select("COALESCE(SUM(LENGTH(content)), 0) AS total, COALESCE(SUM(CASE WHEN created_at >= ? THEN LENGTH(content) ELSE 0 END), 0) AS monthly", monthStart).
+		Where("project_id IN (?)", projectIDs).
+		Scan(&wordStats).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate chapters: %w", err)
+	}
+
+	return &models.ProjectStats{
+		TotalProjects:     projectStats.Total,
+		CompletedProjects: projectStats.Completed,
+		TotalWords:        wordStats.Total,
+		MonthlyWords:      wordStats.Monthly,
+	}, nil
+}
@@ -0,0 +1,16 @@
+package auth
+
+import "context"
+
+type userKey struct{}
+
+// NewContext 返回携带调用者用户ID的上下文
+func NewContext(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userKey{}, userID)
+}
+
+// UserIDFromContext 获取调用者用户ID，匿名调用返回空字符串
+func UserIDFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(userKey{}).(string)
+	return userID
+}
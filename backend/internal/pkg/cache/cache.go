@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/internal/conf"
+)
+
+// 错误定义
+var (
+	ErrCacheMiss  = errors.New("cache: key not found")
+	ErrLockHeld   = errors.New("cache: lock is held by another owner")
+	ErrLockLost   = errors.New("cache: lock is no longer held")
+	ErrStoreClose = errors.New("cache: store is closed")
+)
+
+// Cache 键值缓存接口
+type Cache interface {
+	// Get 读取缓存，不存在时返回 ErrCacheMiss
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set 写入缓存，ttl 小于等于0时不过期
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete 删除缓存，不存在的键会被忽略
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Locker 分布式锁接口
+type Locker interface {
+	// TryLock 尝试获取锁，锁已被占用时返回 ErrLockHeld
+	TryLock(ctx context.Context, key string, ttl time.Duration) (Lock, error)
+}
+
+// Lock 已获取的锁，持有者需在租约到期前续期
+type Lock interface {
+	// Refresh 续期锁，锁已过期或被他人获取时返回 ErrLockLost
+	Refresh(ctx context.Context, ttl time.Duration) error
+	// Unlock 释放锁，只会释放自己持有的锁
+	Unlock(ctx context.Context) error
+}
+
+// PubSub 发布订阅接口
+type PubSub interface {
+	// Publish 向频道发布消息
+	Publish(ctx context.Context, channel string, message []byte) error
+	// Subscribe 订阅频道，ctx 结束后返回的通道会被关闭
+	Subscribe(ctx context.Context, channel string) (<-chan []byte, error)
+}
+
+// Store 缓存、锁与发布订阅的组合
+type Store interface {
+	Cache
+	Locker
+	PubSub
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// NewStore 根据配置创建存储，未启用 Redis 时使用内存实现
+func NewStore(c *conf.Data_Redis) (Store, error) {
+	if c == nil || !c.Enabled {
+		return NewMemoryStore(), nil
+	}
+
+	store := NewRedisStore(&RedisOptions{
+		Network:      c.Network,
+		Addr:         c.Addr,
+		Password:     c.Password,
+		DB:           int(c.Db),
+		ReadTimeout:  c.ReadTimeout.AsDuration(),
+		WriteTimeout: c.WriteTimeout.AsDuration(),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := store.Ping(ctx); err != nil {
+		store.Close()
+		return nil, fmt.Errorf("failed to connect redis %s: %w", c.Addr, err)
+	}
+	return store, nil
+}
+
+// newLockToken 生成锁持有者标识
+func newLockToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
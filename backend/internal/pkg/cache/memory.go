@@ -0,0 +1,241 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// subscriberBuffer 每个订阅者的消息缓冲区大小，缓冲区满时丢弃新消息
+const subscriberBuffer = 64
+
+// memoryEntry 内存缓存条目
+type memoryEntry struct {
+	value    []byte
+	expireAt time.Time
+}
+
+// expired 判断条目是否已过期
+func (e *memoryEntry) expired(now time.Time) bool {
+	return !e.expireAt.IsZero() && !now.Before(e.expireAt)
+}
+
+// MemoryStore 进程内存储实现，仅在单实例内生效，适用于默认部署与测试
+type MemoryStore struct {
+	mu          sync.Mutex
+	entries     map[string]*memoryEntry
+	subscribers map[string]map[*memorySubscriber]struct{}
+	closed      bool
+}
+
+// memorySubscriber 内存订阅者
+type memorySubscriber struct {
+	ch   chan []byte
+	once sync.Once
+}
+
+// close 关闭订阅通道
+func (s *memorySubscriber) close() {
+	s.once.Do(func() { close(s.ch) })
+}
+
+// NewMemoryStore 创建内存存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries:     make(map[string]*memoryEntry),
+		subscribers: make(map[string]map[*memorySubscriber]struct{}),
+	}
+}
+
+// Get 读取缓存
+func (m *MemoryStore) Get(ctx context.Context, key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.load(key)
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	value := make([]byte, len(entry.value))
+	copy(value, entry.value)
+	return value, nil
+}
+
+// Set 写入缓存
+func (m *MemoryStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return ErrStoreClose
+	}
+	m.entries[key] = newMemoryEntry(value, ttl)
+	return nil
+}
+
+// Delete 删除缓存
+func (m *MemoryStore) Delete(ctx context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range keys {
+		delete(m.entries, key)
+	}
+	return nil
+}
+
+// TryLock 尝试获取锁
+func (m *MemoryStore) TryLock(ctx context.Context, key string, ttl time.Duration) (Lock, error) {
+	token, err := newLockToken()
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, ErrStoreClose
+	}
+	if _, ok := m.load(key); ok {
+		return nil, ErrLockHeld
+	}
+	m.entries[key] = newMemoryEntry([]byte(token), ttl)
+	return &memoryLock{store: m, key: key, token: token}, nil
+}
+
+// Publish 发布消息
+func (m *MemoryStore) Publish(ctx context.Context, channel string, message []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return ErrStoreClose
+	}
+	for sub := range m.subscribers[channel] {
+		msg := make([]byte, len(message))
+		copy(msg, message)
+		select {
+		case sub.ch <- msg:
+		default:
+			// 订阅者消费过慢时丢弃消息，避免阻塞发布方
+		}
+	}
+	return nil
+}
+
+// Subscribe 订阅频道
+func (m *MemoryStore) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil, ErrStoreClose
+	}
+	sub := &memorySubscriber{ch: make(chan []byte, subscriberBuffer)}
+	if m.subscribers[channel] == nil {
+		m.subscribers[channel] = make(map[*memorySubscriber]struct{})
+	}
+	m.subscribers[channel][sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if subs, ok := m.subscribers[channel]; ok {
+			delete(subs, sub)
+			if len(subs) == 0 {
+				delete(m.subscribers, channel)
+			}
+		}
+		sub.close()
+	}()
+
+	return sub.ch, nil
+}
+
+// Ping 健康检查
+func (m *MemoryStore) Ping(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return ErrStoreClose
+	}
+	return nil
+}
+
+// Close 关闭存储并结束全部订阅
+func (m *MemoryStore) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	for channel, subs := range m.subscribers {
+		for sub := range subs {
+			sub.close()
+		}
+		delete(m.subscribers, channel)
+	}
+	m.entries = make(map[string]*memoryEntry)
+	return nil
+}
+
+// load 读取未过期的条目，调用方需持有锁
+func (m *MemoryStore) load(key string) (*memoryEntry, bool) {
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if entry.expired(time.Now()) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry, true
+}
+
+// newMemoryEntry 创建缓存条目
+func newMemoryEntry(value []byte, ttl time.Duration) *memoryEntry {
+	entry := &memoryEntry{value: make([]byte, len(value))}
+	copy(entry.value, value)
+	if ttl > 0 {
+		entry.expireAt = time.Now().Add(ttl)
+	}
+	return entry
+}
+
+// memoryLock 内存锁
+type memoryLock struct {
+	store *MemoryStore
+	key   string
+	token string
+}
+
+// Refresh 续期锁
+func (l *memoryLock) Refresh(ctx context.Context, ttl time.Duration) error {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	entry, ok := l.store.load(l.key)
+	if !ok || string(entry.value) != l.token {
+		return ErrLockLost
+	}
+	l.store.entries[l.key] = newMemoryEntry(entry.value, ttl)
+	return nil
+}
+
+// Unlock 释放锁
+func (l *memoryLock) Unlock(ctx context.Context) error {
+	l.store.mu.Lock()
+	defer l.store.mu.Unlock()
+
+	entry, ok := l.store.load(l.key)
+	if !ok || string(entry.value) != l.token {
+		return ErrLockLost
+	}
+	delete(l.store.entries, l.key)
+	return nil
+}
@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_GetSetDelete(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	if _, err := store.Get(ctx, "missing"); err != ErrCacheMiss {
+		t.Fatalf("Expected ErrCacheMiss, got %v", err)
+	}
+
+	if err := store.Set(ctx, "key", []byte("value"), 0); err != nil {
+		t.Fatalf("Failed to set: %v", err)
+	}
+	value, err := store.Get(ctx, "key")
+	if err != nil {
+		t.Fatalf("Failed to get: %v", err)
+	}
+	if string(value) != "value" {
+		t.Fatalf("Expected value, got %s", value)
+	}
+
+	if err := store.Delete(ctx, "key", "missing"); err != nil {
+		t.Fatalf("Failed to delete: %v", err)
+	}
+	if _, err := store.Get(ctx, "key"); err != ErrCacheMiss {
+		t.Fatalf("Expected ErrCacheMiss after delete, got %v", err)
+	}
+}
+
+func TestMemoryStore_Expiration(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	if err := store.Set(ctx, "key", []byte("value"), 20*time.Millisecond); err != nil {
+		t.Fatalf("Failed to set: %v", err)
+	}
+	time.Sleep(40 * time.Millisecond)
+
+	if _, err := store.Get(ctx, "key"); err != ErrCacheMiss {
+		t.Fatalf("Expected expired key to be missing, got %v", err)
+	}
+}
+
+func TestMemoryStore_Lock(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	lock, err := store.TryLock(ctx, "lock", time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to acquire lock: %v", err)
+	}
+
+	// 锁被占用时再次获取应失败
+	if _, err := store.TryLock(ctx, "lock", time.Minute); err != ErrLockHeld {
+		t.Fatalf("Expected ErrLockHeld, got %v", err)
+	}
+
+	if err := lock.Refresh(ctx, time.Minute); err != nil {
+		t.Fatalf("Failed to refresh lock: %v", err)
+	}
+	if err := lock.Unlock(ctx); err != nil {
+		t.Fatalf("Failed to unlock: %v", err)
+	}
+
+	// 释放后可以重新获取，旧锁不能释放新持有者的锁
+	second, err := store.TryLock(ctx, "lock", time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to reacquire lock: %v", err)
+	}
+	if err := lock.Unlock(ctx); err != ErrLockLost {
+		t.Fatalf("Expected ErrLockLost for stale lock, got %v", err)
+	}
+	if err := second.Unlock(ctx); err != nil {
+		t.Fatalf("Failed to unlock second owner: %v", err)
+	}
+}
+
+func TestMemoryStore_LockExpiration(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	lock, err := store.TryLock(ctx, "lock", 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to acquire lock: %v", err)
+	}
+	time.Sleep(40 * time.Millisecond)
+
+	if _, err := store.TryLock(ctx, "lock", time.Minute); err != nil {
+		t.Fatalf("Expected expired lock to be acquirable, got %v", err)
+	}
+	if err := lock.Refresh(ctx, time.Minute); err != ErrLockLost {
+		t.Fatalf("Expected ErrLockLost, got %v", err)
+	}
+}
+
+func TestMemoryStore_PubSub(t *testing.T) {
+	store := NewMemoryStore()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	first, err := store.Subscribe(ctx, "channel")
+	if err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	second, err := store.Subscribe(ctx, "channel")
+	if err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+
+	if err := store.Publish(ctx, "channel", []byte("hello")); err != nil {
+		t.Fatalf("Failed to publish: %v", err)
+	}
+	if err := store.Publish(ctx, "other", []byte("ignored")); err != nil {
+		t.Fatalf("Failed to publish: %v", err)
+	}
+
+	for _, ch := range []<-chan []byte{first, second} {
+		select {
+		case msg := <-ch:
+			if string(msg) != "hello" {
+				t.Fatalf("Expected hello, got %s", msg)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for message")
+		}
+	}
+
+	// 取消后订阅通道应被关闭
+	cancel()
+	select {
+	case _, ok := <-first:
+		if ok {
+			t.Fatal("Expected subscription channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for subscription to close")
+	}
+}
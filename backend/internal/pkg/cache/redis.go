@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 锁操作脚本，只允许持有者续期或释放
+var (
+	unlockScript  = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) else return 0 end`)
+	refreshScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("pexpire", KEYS[1], ARGV[2]) else return 0 end`)
+)
+
+// RedisOptions Redis 连接选项
+type RedisOptions struct {
+	Network      string
+	Addr         string
+	Password     string
+	DB           int
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+// RedisStore 基于 Redis 的存储实现，多个实例共享缓存、锁与消息
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore 创建 Redis 存储，连接在首次使用时建立
+func NewRedisStore(opts *RedisOptions) *RedisStore {
+	return &RedisStore{
+		client: redis.NewClient(&redis.Options{
+			Network:      opts.Network,
+			Addr:         opts.Addr,
+			Password:     opts.Password,
+			DB:           opts.DB,
+			ReadTimeout:  opts.ReadTimeout,
+			WriteTimeout: opts.WriteTimeout,
+		}),
+	}
+}
+
+// Get 读取缓存
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := s.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, ErrCacheMiss
+	}
+	return value, s.wrap(err)
+}
+
+// Set 写入缓存
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl < 0 {
+		ttl = 0
+	}
+	return s.wrap(s.client.Set(ctx, key, value, ttl).Err())
+}
+
+// Delete 删除缓存
+func (s *RedisStore) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return s.wrap(s.client.Del(ctx, keys...).Err())
+}
+
+// TryLock 尝试获取锁
+func (s *RedisStore) TryLock(ctx context.Context, key string, ttl time.Duration) (Lock, error) {
+	token, err := newLockToken()
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, s.wrap(err)
+	}
+	if !ok {
+		return nil, ErrLockHeld
+	}
+	return &redisLock{store: s, key: key, token: token}, nil
+}
+
+// Publish 发布消息
+func (s *RedisStore) Publish(ctx context.Context, channel string, message []byte) error {
+	return s.wrap(s.client.Publish(ctx, channel, message).Err())
+}
+
+// Subscribe 订阅频道，每个订阅独占一个连接
+func (s *RedisStore) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	sub := s.client.Subscribe(ctx, channel)
+	// 等待订阅确认，确保返回后发布的消息不会丢失
+	if _, err := sub.Receive(ctx); err != nil {
+		sub.Close()
+		return nil, s.wrap(err)
+	}
+
+	out := make(chan []byte, subscriberBuffer)
+	messages := sub.Channel(redis.WithChannelSize(subscriberBuffer))
+
+	go func() {
+		defer close(out)
+		defer sub.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				select {
+				case out <- []byte(msg.Payload):
+				default:
+					// 订阅者消费过慢时丢弃消息，避免阻塞读取
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Ping 健康检查
+func (s *RedisStore) Ping(ctx context.Context) error {
+	return s.wrap(s.client.Ping(ctx).Err())
+}
+
+// Close 关闭连接池
+func (s *RedisStore) Close() error {
+	err := s.client.Close()
+	if errors.Is(err, redis.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// wrap 将客户端已关闭的错误转换为 ErrStoreClose
+func (s *RedisStore) wrap(err error) error {
+	if errors.Is(err, redis.ErrClosed) {
+		return ErrStoreClose
+	}
+	return err
+}
+
+// redisLock Redis 锁
+type redisLock struct {
+	store *RedisStore
+	key   string
+	token string
+}
+
+// Refresh 续期锁
+func (l *redisLock) Refresh(ctx context.Context, ttl time.Duration) error {
+	n, err := refreshScript.Run(ctx, l.store.client, []string{l.key}, l.token, ttl.Milliseconds()).Int64()
+	if err != nil {
+		return l.store.wrap(err)
+	}
+	if n == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// Unlock 释放锁
+func (l *redisLock) Unlock(ctx context.Context) error {
+	n, err := unlockScript.Run(ctx, l.store.client, []string{l.key}, l.token).Int64()
+	if err != nil {
+		return l.store.wrap(err)
+	}
+	if n == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
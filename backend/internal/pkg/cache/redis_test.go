@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+// newTestRedisStore 连接 REDIS_ADDR 指定的 Redis，未设置时跳过测试
+func newTestRedisStore(t *testing.T) *RedisStore {
+	t.Helper()
+
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		t.Skip("REDIS_ADDR is not set")
+	}
+	store := NewRedisStore(&RedisOptions{Addr: addr})
+	t.Cleanup(func() { store.Close() })
+
+	if err := store.Ping(context.Background()); err != nil {
+		t.Fatalf("Failed to ping redis: %v", err)
+	}
+	return store
+}
+
+func TestRedisStore_GetSetDelete(t *testing.T) {
+	store := newTestRedisStore(t)
+	ctx := context.Background()
+	key := "cache-test:" + t.Name()
+
+	if err := store.Set(ctx, key, []byte("value"), time.Minute); err != nil {
+		t.Fatalf("Failed to set: %v", err)
+	}
+	value, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Failed to get: %v", err)
+	}
+	if string(value) != "value" {
+		t.Fatalf("Expected value, got %s", value)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Failed to delete: %v", err)
+	}
+	if _, err := store.Get(ctx, key); err != ErrCacheMiss {
+		t.Fatalf("Expected ErrCacheMiss after delete, got %v", err)
+	}
+}
+
+func TestRedisStore_Lock(t *testing.T) {
+	store := newTestRedisStore(t)
+	ctx := context.Background()
+	key := "cache-test:" + t.Name()
+	store.Delete(ctx, key)
+
+	lock, err := store.TryLock(ctx, key, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to acquire lock: %v", err)
+	}
+	if _, err := store.TryLock(ctx, key, time.Minute); err != ErrLockHeld {
+		t.Fatalf("Expected ErrLockHeld, got %v", err)
+	}
+	if err := lock.Refresh(ctx, time.Minute); err != nil {
+		t.Fatalf("Failed to refresh lock: %v", err)
+	}
+	if err := lock.Unlock(ctx); err != nil {
+		t.Fatalf("Failed to unlock: %v", err)
+	}
+
+	// 锁释放后续期与释放都应报告锁已丢失
+	if err := lock.Refresh(ctx, time.Minute); err != ErrLockLost {
+		t.Fatalf("Expected ErrLockLost, got %v", err)
+	}
+	if err := lock.Unlock(ctx); err != ErrLockLost {
+		t.Fatalf("Expected ErrLockLost, got %v", err)
+	}
+}
+
+func TestRedisStore_PubSub(t *testing.T) {
+	store := newTestRedisStore(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	channel := "cache-test:" + t.Name()
+
+	messages, err := store.Subscribe(ctx, channel)
+	if err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	if err := store.Publish(ctx, channel, []byte("hello")); err != nil {
+		t.Fatalf("Failed to publish: %v", err)
+	}
+
+	select {
+	case msg := <-messages:
+		if string(msg) != "hello" {
+			t.Fatalf("Expected hello, got %s", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for message")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-messages:
+		if ok {
+			t.Fatal("Expected subscription channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for subscription to close")
+	}
+}
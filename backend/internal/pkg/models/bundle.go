@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// ProjectBundleSchemaVersion 当前项目数据包的结构版本
+// v2 增加故事状态、质量报告与情节线索，v1 数据包仍可导入。
+const ProjectBundleSchemaVersion = 2
+
+// ProjectBundleFormat 项目数据包格式标识
+const ProjectBundleFormat = "auto-novel.project-bundle"
+
+// ProjectBundle 项目数据包，用于项目的整体导出、备份与跨环境迁移
+type ProjectBundle struct {
+	Format        string         `json:"format"`         // 格式标识
+	SchemaVersion int            `json:"schema_version"` // 结构版本
+	ExportedAt    time.Time      `json:"exported_at"`    // 导出时间
+	Project       *NovelProject  `json:"project"`        // 项目（含世界观、人物卡、大纲）
+	Chapters      []*Chapter     `json:"chapters"`       // 章节（含原始稿与润色稿）
+	VideoScripts  []*VideoScript `json:"video_scripts"`  // 视频脚本
+
+	StoryStates    []*StoryState    `json:"story_states,omitempty"`    // 每章结束时的故事状态（v2）
+	QualityReports []*QualityReport `json:"quality_reports,omitempty"` // 质量与一致性检查历史（v2）
+	PlotThreads    []*PlotThread    `json:"plot_threads,omitempty"`    // 情节线索（v2）
+}
+
+// ProjectBundleImportResult 项目数据包导入结果
+type ProjectBundleImportResult struct {
+	Project          *NovelProject     `json:"project"`            // 导入后的项目
+	ChapterCount     int               `json:"chapter_count"`      // 导入章节数
+	VideoScriptCount int               `json:"video_script_count"` // 导入视频脚本数
+	StoryStateCount  int               `json:"story_state_count"`  // 导入故事状态数
+	ReportCount      int               `json:"report_count"`       // 导入质量报告数
+	PlotThreadCount  int               `json:"plot_thread_count"`  // 导入情节线索数
+	IDMapping        map[string]string `json:"id_mapping"`         // 原ID到新ID的映射
+}
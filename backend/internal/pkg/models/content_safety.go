@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+// 内容安全风险类别
+const (
+	SafetyViolence = "violence" // 暴力血腥
+	SafetySexual   = "sexual"   // 色情低俗
+	SafetyPolitics = "politics" // 政治敏感
+	SafetyMinors   = "minors"   // 涉及未成年人的风险内容
+)
+
+// 内容安全风险等级
+const (
+	SafetyLevelNone   = "none"
+	SafetyLevelLow    = "low"
+	SafetyLevelMedium = "medium"
+	SafetyLevelHigh   = "high"
+)
+
+// 内容分级，由低到高
+const (
+	ContentRatingGeneral    = "general"    // 全年龄
+	ContentRatingTeen       = "teen"       // 青少年及以上
+	ContentRatingMature     = "mature"     // 仅限成年读者
+	ContentRatingRestricted = "restricted" // 不宜发布
+)
+
+// 内容安全审核对象
+const (
+	SafetyTargetChapter = "chapter" // 章节
+	SafetyTargetScene   = "scene"   // 视频脚本分镜
+)
+
+// SafetyHit 一处命中的风险内容
+type SafetyHit struct {
+	Category string `json:"category"` // 风险类别
+	Severity string `json:"severity"` // low/medium/high
+	Term     string `json:"term"`     // 命中的关键词或正则，模型判定时为空
+	Quote    string `json:"quote"`    // 命中的原文
+	Start    int    `json:"start"`    // 原文在审核文本中的起始字符偏移（含）
+	End      int    `json:"end"`      // 原文在审核文本中的结束字符偏移（不含）
+	Source   string `json:"source"`   // dictionary/llm
+}
+
+// SafetyLabel 审核对象在某一风险类别上的结论
+type SafetyLabel struct {
+	Category string `json:"category"`  // 风险类别
+	Level    string `json:"level"`     // none/low/medium/high
+	HitCount int    `json:"hit_count"` // 词库命中次数
+	Reason   string `json:"reason"`    // 模型给出的判定理由
+}
+
+// SafetyReview 单个章节或分镜的审核结果
+type SafetyReview struct {
+	TargetType   string         `json:"target_type"`   // chapter/scene
+	TargetID     string         `json:"target_id"`     // 章节ID或视频脚本ID
+	ChapterIndex int            `json:"chapter_index"` // 章节索引
+	SceneIndex   int            `json:"scene_index"`   // 分镜序号，审核章节时为 0
+	Rating       string         `json:"rating"`        // 内容分级
+	Labels       []*SafetyLabel `json:"labels"`        // 各风险类别的结论，按类别固定顺序排列
+	Hits         []*SafetyHit   `json:"hits"`          // 命中的风险内容
+	Blocked      bool           `json:"blocked"`       // 分级达到阻止阈值
+}
+
+// SafetyReport 一次内容安全审核的结果
+type SafetyReport struct {
+	ProjectID   string          `json:"project_id"`   // 项目ID
+	Rating      string          `json:"rating"`       // 所有审核对象中最高的分级
+	BlockRating string          `json:"block_rating"` // 阻止导出与发布的分级阈值，为空表示不阻止
+	Blocked     bool            `json:"blocked"`      // 是否有审核对象达到阻止阈值
+	UsedLLM     bool            `json:"used_llm"`     // 是否调用了模型分类
+	Reviews     []*SafetyReview `json:"reviews"`      // 各章节或分镜的审核结果
+	CheckedAt   time.Time       `json:"checked_at"`   // 审核时间
+}
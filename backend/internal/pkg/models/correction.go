@@ -0,0 +1,41 @@
+package models
+
+import "backend/internal/pkg/textutil"
+
+// TextSpan 问题在章节正文中对应的原文片段，偏移按字符（rune）计
+type TextSpan struct {
+	ChapterID string `json:"chapter_id"` // 章节ID
+	Start     int    `json:"start"`      // 起始偏移（含）
+	End       int    `json:"end"`        // 结束偏移（不含）
+	Quote     string `json:"quote"`      // 引用的原文
+	Verified  bool   `json:"verified"`   // 引文已在正文中找到；为 false 时偏移不可用，仅保留模型给出的引文
+}
+
+// Correction 对正文中一段原文的修正
+type Correction struct {
+	ChapterID       string `json:"chapter_id"`       // 章节ID
+	Start           int    `json:"start"`            // 原文起始偏移（含）
+	End             int    `json:"end"`              // 原文结束偏移（不含）
+	Original        string `json:"original"`         // 期望的原文，与偏移处的内容不一致时按原文重新定位
+	Replacement     string `json:"replacement"`      // 替换后的文本
+	ExpectedVersion int64  `json:"expected_version"` // 期望的章节版本号，大于0时版本不一致将返回冲突
+}
+
+// ChapterContent 返回章节的正文，已润色时为润色后的内容
+func ChapterContent(chapter *Chapter) string {
+	if chapter.PolishedContent != "" {
+		return chapter.PolishedContent
+	}
+	return chapter.RawContent
+}
+
+// LocateSpan 在章节正文中定位引文，找到时 Quote 替换为正文中的原样文字，找不到时返回未校验的片段
+func LocateSpan(chapter *Chapter, quote string, hint int) *TextSpan {
+	span := &TextSpan{ChapterID: chapter.ID, Quote: quote}
+	content := ChapterContent(chapter)
+	if start, end, ok := textutil.LocateQuote(content, quote, hint); ok {
+		span.Start, span.End, span.Verified = start, end, true
+		span.Quote = string([]rune(content)[start:end])
+	}
+	return span
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// 生成进度状态
+const (
+	GenerationStatusGenerating = "generating"
+	GenerationStatusCompleted  = "completed"
+	GenerationStatusError      = "error"
+)
+
+// GenerationProgress 项目生成进度事件，通过发布订阅广播给所有实例
+type GenerationProgress struct {
+	ProjectID string    `json:"project_id"`      // 项目ID
+	Status    string    `json:"status"`          // generating/completed/error
+	Stage     string    `json:"stage"`           // 当前阶段
+	Progress  float64   `json:"progress"`        // 0.0-1.0
+	Message   string    `json:"message"`         // 进度描述
+	Error     string    `json:"error,omitempty"` // 错误信息
+	Issues    []string  `json:"issues,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// 知识库索引条目类型
+const (
+	IndexItemWorldView = "worldview" // 世界观设定
+	IndexItemCharacter = "character" // 人物卡
+	IndexItemChapter   = "chapter"   // 章节
+)
+
+// 知识库索引条目状态
+const (
+	IndexStateIndexed = "indexed" // 索引与当前内容一致
+	IndexStateStale   = "stale"   // 内容在索引后发生了变化
+	IndexStateMissing = "missing" // 尚未建立索引
+	IndexStateFailed  = "failed"  // 上次索引失败
+)
+
+// IndexState 条目最近一次写入知识库索引的记录
+type IndexState struct {
+	ProjectID   string    `json:"project_id"`      // 项目ID
+	ItemType    string    `json:"item_type"`       // worldview/character/chapter
+	ItemID      string    `json:"item_id"`         // 条目ID
+	ContentHash string    `json:"content_hash"`    // 索引时的内容摘要，失败时为空
+	IndexedAt   time.Time `json:"indexed_at"`      // 索引时间
+	Error       string    `json:"error,omitempty"` // 失败原因
+}
+
+// IndexItemStatus 条目的索引新鲜度
+type IndexItemStatus struct {
+	ItemType  string    `json:"item_type"`       // 条目类型
+	ItemID    string    `json:"item_id"`         // 条目ID
+	Title     string    `json:"title"`           // 人物姓名、章节标题等
+	State     string    `json:"state"`           // indexed/stale/missing/failed
+	IndexedAt time.Time `json:"indexed_at"`      // 最近索引时间
+	Error     string    `json:"error,omitempty"` // 失败原因
+}
+
+// IndexStatus 项目知识库索引的新鲜度
+type IndexStatus struct {
+	ProjectID     string             `json:"project_id"`      // 项目ID
+	Fresh         bool               `json:"fresh"`           // 全部条目已索引且没有待处理任务
+	Total         int                `json:"total"`           // 条目总数
+	Indexed       int                `json:"indexed"`         // 已是最新的条目数
+	Stale         int                `json:"stale"`           // 过期的条目数
+	Missing       int                `json:"missing"`         // 未索引的条目数
+	Failed        int                `json:"failed"`          // 索引失败的条目数
+	Pending       int                `json:"pending"`         // 排队中的索引任务数
+	LastIndexedAt time.Time          `json:"last_indexed_at"` // 最近一次索引时间
+	Items         []*IndexItemStatus `json:"items"`           // 各条目状态
+}
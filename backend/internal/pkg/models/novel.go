@@ -14,6 +14,8 @@ type NovelProject struct {
 	Tone           string       `json:"tone"`                 // 调性：温情/冷峻/快节奏/慢热
 	Themes         []string     `json:"themes"`               // 主题
 	Status         string       `json:"status"`               // 状态：draft/generating/completed
+	OwnerID        string       `json:"owner_id,omitempty"`   // 所有者用户ID，为空表示未归属（所有人可访问）
+	Version        int64        `json:"version"`              // 版本号，用于乐观并发控制
 	CreatedAt      time.Time    `json:"created_at"`           // 创建时间
 	UpdatedAt      time.Time    `json:"updated_at"`           // 更新时间
 	WorldView      *WorldView   `json:"world_view,omitempty"` // 世界观设定
@@ -80,28 +82,31 @@ type Chapter struct {
 	PolishedContent string    `json:"polished_content"` // 润色后内容
 	Summary         string    `json:"summary"`          // 章节摘要
 	WordCount       int       `json:"word_count"`       // 章节字数
-	Status          string    `json:"status"`           // draft/polished/completed
+	Status          string    `json:"status"`           // draft/polished/completed/needs_review
+	Version         int64     `json:"version"`          // 版本号，用于乐观并发控制
 	CreatedAt       time.Time `json:"created_at"`       // 创建时间
 	UpdatedAt       time.Time `json:"updated_at"`       // 更新时间
 }
 
 // GenerationContext 生成上下文
 type GenerationContext struct {
-	ProjectID       string           `json:"project_id"`       // 项目ID
-	WorldView       *WorldView       `json:"world_view"`       // 世界观设定
-	Characters      []*Character     `json:"characters"`       // 人物卡
-	PreviousSummary string           `json:"previous_summary"` // 前情摘要
-	ChapterGoal     string           `json:"chapter_goal"`     // 本章目标
-	StyleExamples   []string         `json:"style_examples"`   // 风格示例
-	Timeline        []*TimelineEvent `json:"timeline"`         // 时间线
-	Props           []*PropItem      `json:"props"`            // 道具
+	ProjectID       string            `json:"project_id"`       // 项目ID
+	WorldView       *WorldView        `json:"world_view"`       // 世界观设定
+	Characters      []*Character      `json:"characters"`       // 人物卡
+	PreviousSummary string            `json:"previous_summary"` // 前情摘要
+	ChapterGoal     string            `json:"chapter_goal"`     // 本章目标
+	StyleExamples   []string          `json:"style_examples"`   // 风格示例
+	Timeline        []*TimelineEvent  `json:"timeline"`         // 时间线
+	Props           []*PropItem       `json:"props"`            // 道具
+	CharacterStates []*CharacterState `json:"character_states"` // 人物当前所在与状态
 }
 
 // TimelineEvent 时间线事件
 type TimelineEvent struct {
-	Timestamp   string `json:"timestamp"`   // 时间戳
-	Event       string `json:"event"`       // 事件名称
-	Description string `json:"description"` // 事件描述
+	Timestamp    string `json:"timestamp"`     // 时间戳
+	Event        string `json:"event"`         // 事件名称
+	Description  string `json:"description"`   // 事件描述
+	ChapterIndex int    `json:"chapter_index"` // 发生的章节，0表示未知
 }
 
 // PropItem 道具物品
@@ -109,16 +114,25 @@ type PropItem struct {
 	Name        string `json:"name"`        // 道具名称
 	Description string `json:"description"` // 道具描述
 	Location    string `json:"location"`    // 当前位置
+	Holder      string `json:"holder"`      // 当前持有人，无人持有时为空
 }
 
 // CritiqueResult 质量审查结果
 type CritiqueResult struct {
-	LogicalIssues   []string `json:"logical_issues"`   // 逻辑矛盾
-	CharacterIssues []string `json:"character_issues"` // 人物不一致
-	PacingIssues    []string `json:"pacing_issues"`    // 节奏问题
-	Improvements    []string `json:"improvements"`     // 改进建议
-	FixedExample    string   `json:"fixed_example"`    // 修订示例
-	OverallScore    int      `json:"overall_score"`    // 总体评分 1-10
+	LogicalIssues   []string            `json:"logical_issues"`   // 逻辑矛盾
+	CharacterIssues []string            `json:"character_issues"` // 人物不一致
+	PacingIssues    []string            `json:"pacing_issues"`    // 节奏问题
+	Improvements    []string            `json:"improvements"`     // 改进建议
+	FixedExample    string              `json:"fixed_example"`    // 修订示例
+	OverallScore    int                 `json:"overall_score"`    // 总体评分 1-10
+	Evidence        []*CritiqueEvidence `json:"evidence"`         // 问题的原文依据
+}
+
+// CritiqueEvidence 审查问题及其在正文中的原文依据
+type CritiqueEvidence struct {
+	Category string    `json:"category"` // logical/character/pacing
+	Issue    string    `json:"issue"`    // 对应的问题
+	Span     *TextSpan `json:"span"`     // 原文位置，引文无法在正文中找到时 Verified 为 false
 }
 
 // ExportOptions 导出选项
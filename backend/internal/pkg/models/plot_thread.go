@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// 情节线索类型
+const (
+	PlotThreadMystery = "mystery" // 悬念、谜团
+	PlotThreadPromise = "promise" // 承诺、约定、誓言
+	PlotThreadClue    = "clue"    // 埋下的线索、关键道具
+	PlotThreadTwist   = "twist"   // 大纲计划的转折，需要在此前埋下伏笔
+)
+
+// 情节线索状态
+const (
+	PlotThreadOpen     = "open"     // 尚未回收
+	PlotThreadResolved = "resolved" // 已回收
+)
+
+// 情节线索来源
+const (
+	PlotThreadFromOutline = "outline" // 由大纲的转折点与关键道具生成
+	PlotThreadFromChapter = "chapter" // 从章节正文中提取
+)
+
+// 情节线索问题类型
+const (
+	PlotThreadDangling   = "dangling"   // 伏笔未回收
+	PlotThreadUnprepared = "unprepared" // 回收或转折缺少铺垫
+)
+
+// PlotThread 情节线索，记录伏笔的埋设、提及与回收
+type PlotThread struct {
+	ID              string    `json:"id"`               // 线索ID
+	ProjectID       string    `json:"project_id"`       // 项目ID
+	Kind            string    `json:"kind"`             // mystery/promise/clue/twist
+	Title           string    `json:"title"`            // 线索名称
+	Description     string    `json:"description"`      // 线索内容
+	Source          string    `json:"source"`           // outline/chapter
+	PlantedChapters []int     `json:"planted_chapters"` // 埋下或提及这条线索的章节索引，升序
+	PayoffChapter   int       `json:"payoff_chapter"`   // 大纲计划回收的章节索引，0 表示未计划
+	ResolvedChapter int       `json:"resolved_chapter"` // 实际回收的章节索引，0 表示未回收
+	Resolution      string    `json:"resolution"`       // 回收方式
+	Status          string    `json:"status"`           // open/resolved
+	UpdatedAt       time.Time `json:"updated_at"`       // 更新时间
+}
+
+// PayoffPoint 线索回收的章节：已回收时为实际章节，否则为大纲计划的章节，都没有时为 0
+func (t *PlotThread) PayoffPoint() int {
+	if t.ResolvedChapter > 0 {
+		return t.ResolvedChapter
+	}
+	return t.PayoffChapter
+}
+
+// PreparedBefore 判断线索是否在第 chapterIndex 章之前埋下过
+func (t *PlotThread) PreparedBefore(chapterIndex int) bool {
+	return len(t.PlantedChapters) > 0 && t.PlantedChapters[0] < chapterIndex
+}
+
+// PlotThreadIssue 情节线索问题
+type PlotThreadIssue struct {
+	ThreadID     string `json:"thread_id"`     // 线索ID
+	Type         string `json:"type"`          // dangling/unprepared
+	Title        string `json:"title"`         // 线索名称
+	ChapterIndex int    `json:"chapter_index"` // 相关章节：未回收为计划回收或埋下的章节，缺少铺垫为回收的章节
+	Description  string `json:"description"`   // 问题描述
+	Suggestion   string `json:"suggestion"`    // 修改建议
+}
+
+// PlotThreadReport 情节线索检查报告
+type PlotThreadReport struct {
+	ProjectID     string             `json:"project_id"`     // 项目ID
+	NextChapter   int                `json:"next_chapter"`   // 即将生成的章节索引
+	TotalChapters int                `json:"total_chapters"` // 大纲章节数
+	OpenThreads   []*PlotThread      `json:"open_threads"`   // 尚未回收的线索
+	Issues        []*PlotThreadIssue `json:"issues"`         // 未回收与缺少铺垫的问题
+}
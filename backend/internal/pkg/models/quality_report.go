@@ -0,0 +1,94 @@
+package models
+
+import "time"
+
+// 质量报告类型
+const (
+	QualityReportQuality     = "quality"      // 章节质量检测
+	QualityReportConsistency = "consistency"  // 一致性检查
+	QualityReportGate        = "quality_gate" // 生成时的质量门禁检查
+)
+
+// QualityReport 章节某一版本的质量或一致性检查报告
+type QualityReport struct {
+	ID               string         `json:"id"`                 // 报告ID
+	ProjectID        string         `json:"project_id"`         // 项目ID
+	ChapterID        string         `json:"chapter_id"`         // 章节ID
+	ChapterIndex     int            `json:"chapter_index"`      // 章节索引
+	ChapterVersion   int64          `json:"chapter_version"`    // 检查时章节的版本号
+	Kind             string         `json:"kind"`               // quality/consistency/quality_gate
+	CheckType        string         `json:"check_type"`         // 检查类型，如 proofread/critique/all/rules
+	Polished         bool           `json:"polished"`           // 检查的是否为润色后的正文
+	OverallScore     float64        `json:"overall_score"`      // 总分 0-1
+	IssueCount       int            `json:"issue_count"`        // 问题数
+	IssuesBySeverity map[string]int `json:"issues_by_severity"` // 按严重程度统计问题数
+	Details          string         `json:"details"`            // 完整检查结果（JSON）
+	CreatedAt        time.Time      `json:"created_at"`         // 检查时间
+}
+
+// QualityReportQuery 质量报告查询条件
+type QualityReportQuery struct {
+	ProjectID string    // 项目ID
+	ChapterID string    // 章节ID，为空表示项目内全部章节
+	Kind      string    // 报告类型，为空表示全部
+	Since     time.Time // 只返回此时间之后的报告，零值表示不限
+	Page      int       // 页码，从1开始
+	PageSize  int       // 每页数量，0表示不分页
+}
+
+// QualityTrendPoint 某一天的项目质量
+type QualityTrendPoint struct {
+	Date         string  `json:"date"`          // 日期（YYYY-MM-DD）
+	AverageScore float64 `json:"average_score"` // 当天报告的平均分
+	ReportCount  int     `json:"report_count"`  // 当天的报告数
+}
+
+// ChapterQualityTrend 单个章节的质量变化
+type ChapterQualityTrend struct {
+	ChapterID         string  `json:"chapter_id"`          // 章节ID
+	ChapterIndex      int     `json:"chapter_index"`       // 章节索引
+	LatestScore       float64 `json:"latest_score"`        // 最近一次检查的分数
+	BeforePolishScore float64 `json:"before_polish_score"` // 润色前最近一次检查的分数
+	AfterPolishScore  float64 `json:"after_polish_score"`  // 润色后最近一次检查的分数
+	HasPolishCompare  bool    `json:"has_polish_compare"`  // 润色前后均有报告时为 true
+	ReportCount       int     `json:"report_count"`        // 报告数
+}
+
+// QualityTrends 项目质量趋势
+type QualityTrends struct {
+	ProjectID           string                 `json:"project_id"`            // 项目ID
+	Kind                string                 `json:"kind"`                  // 报告类型
+	Points              []*QualityTrendPoint   `json:"points"`                // 按日期排列的平均分
+	Chapters            []*ChapterQualityTrend `json:"chapters"`              // 按章节索引排列的章节质量
+	AverageBefore       float64                `json:"average_before"`        // 有润色前后对比的章节润色前的平均分
+	AverageAfter        float64                `json:"average_after"`         // 同一批章节润色后的平均分
+	PolishComparedCount int                    `json:"polish_compared_count"` // 有润色前后对比的章节数
+}
+
+const (
+	// ChapterStatusPolished 已润色的章节状态，质量报告据此区分润色前后
+	ChapterStatusPolished = "polished"
+	// ChapterStatusNeedsReview 质量门禁未通过、等待人工审核的章节状态
+	ChapterStatusNeedsReview = "needs_review"
+)
+
+// QualityGateAttempt 质量门禁的一次评审
+type QualityGateAttempt struct {
+	Attempt   int             `json:"attempt"`            // 第几次评审，从1开始
+	Score     float64         `json:"score"`              // 评审分数 0-1
+	Passed    bool            `json:"passed"`             // 是否达到阈值
+	Critique  *CritiqueResult `json:"critique,omitempty"` // 评审结果
+	Feedback  string          `json:"feedback,omitempty"` // 未通过时交给改写的意见
+	Error     string          `json:"error,omitempty"`    // 评审或改写失败的原因
+	CheckedAt time.Time       `json:"checked_at"`         // 评审时间
+}
+
+// QualityGateResult 章节的质量门禁结果，达不到阈值且改写次数用尽时需人工审核
+type QualityGateResult struct {
+	ChapterID    string                `json:"chapter_id"`    // 章节ID
+	ChapterIndex int                   `json:"chapter_index"` // 章节索引
+	Threshold    float64               `json:"threshold"`     // 通过阈值
+	Passed       bool                  `json:"passed"`        // 最终是否通过
+	NeedsReview  bool                  `json:"needs_review"`  // 是否需要人工审核
+	Attempts     []*QualityGateAttempt `json:"attempts"`      // 每次评审的记录
+}
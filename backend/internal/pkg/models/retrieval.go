@@ -0,0 +1,55 @@
+package models
+
+// 检索片段的用途
+const (
+	RetrievalCharacterScene = "character_scene" // 人物最近一次出场
+	RetrievalItemMention    = "item_mention"    // 关键道具最近一次出现
+	RetrievalWorldRule      = "world_rule"      // 适用的世界规则
+)
+
+// RetrievedChunk 生成时引用的知识库片段
+type RetrievedChunk struct {
+	DocumentID   string   `json:"document_id"`   // 向量库文档ID
+	Kind         string   `json:"kind"`          // character_scene/item_mention/world_rule
+	Subjects     []string `json:"subjects"`      // 触发检索的人物、道具或规则
+	ChapterID    string   `json:"chapter_id"`    // 来源章节ID，世界规则为空
+	ChapterIndex int      `json:"chapter_index"` // 来源章节索引，世界规则为0
+	ChapterTitle string   `json:"chapter_title"` // 来源章节标题
+	Content      string   `json:"content"`       // 片段内容
+	Tokens       int      `json:"tokens"`        // 估算的 token 数
+	Score        float32  `json:"score"`         // 检索分数，按出场顺序取得的片段为0
+}
+
+// 知识库内容类型
+const (
+	KnowledgeWorldView = "worldview" // 世界观设定与规则
+	KnowledgeCharacter = "character" // 人物卡
+	KnowledgeChapter   = "chapter"   // 章节片段
+)
+
+// KnowledgeQuery 项目知识库语义检索条件
+type KnowledgeQuery struct {
+	ProjectID    string   `json:"project_id"`    // 项目ID
+	Question     string   `json:"question"`      // 自然语言问题
+	ContentTypes []string `json:"content_types"` // 限定内容类型，为空表示全部
+	TopK         int      `json:"top_k"`         // 返回的片段数
+}
+
+// KnowledgePassage 知识库检索命中的片段
+type KnowledgePassage struct {
+	DocumentID   string  `json:"document_id"`   // 向量库文档ID
+	ContentType  string  `json:"content_type"`  // worldview/character/chapter
+	Title        string  `json:"title"`         // 章节标题、人物姓名或世界观标题
+	Content      string  `json:"content"`       // 片段内容
+	Score        float32 `json:"score"`         // 相关度评分，越大越相关
+	ChapterID    string  `json:"chapter_id"`    // 来源章节ID，非章节片段为空
+	ChapterIndex int     `json:"chapter_index"` // 来源章节索引
+	StartOffset  int     `json:"start_offset"`  // 片段在章节正文中的起始位置（字符）
+	EndOffset    int     `json:"end_offset"`    // 片段在章节正文中的结束位置（字符）
+}
+
+// KnowledgeSearchResult 项目知识库检索结果
+type KnowledgeSearchResult struct {
+	Passages []*KnowledgePassage `json:"passages"` // 按相关度降序排列的片段
+	Stats    map[string]int      `json:"stats"`    // 各内容类型已索引的文档数
+}
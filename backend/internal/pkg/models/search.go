@@ -0,0 +1,36 @@
+package models
+
+// 全文检索文档类型
+const (
+	SearchDocChapter   = "chapter"   // 章节正文
+	SearchDocSummary   = "summary"   // 章节摘要
+	SearchDocCharacter = "character" // 人物卡
+	SearchDocOutline   = "outline"   // 章节大纲条目
+)
+
+// SearchQuery 全文检索查询条件
+type SearchQuery struct {
+	Query       string   `json:"query"`        // 检索词
+	ProjectID   string   `json:"project_id"`   // 限定项目，为空表示全部项目
+	Status      string   `json:"status"`       // 限定状态（章节状态或项目状态）
+	ChapterFrom int      `json:"chapter_from"` // 章节范围起始（含），0表示不限
+	ChapterTo   int      `json:"chapter_to"`   // 章节范围结束（含），0表示不限
+	DocTypes    []string `json:"doc_types"`    // 限定文档类型，为空表示全部
+	Page        int      `json:"page"`         // 页码，从1开始
+	PageSize    int      `json:"page_size"`    // 每页数量
+
+	Scope *ProjectScope `json:"-"` // 限定调用者可访问的项目，为 nil 表示不限
+}
+
+// SearchHit 全文检索命中结果
+type SearchHit struct {
+	DocType      string  `json:"doc_type"`      // 文档类型
+	ProjectID    string  `json:"project_id"`    // 项目ID
+	RefID        string  `json:"ref_id"`        // 关联对象ID（章节ID/人物ID/大纲序号）
+	ChapterIndex int     `json:"chapter_index"` // 章节序号，人物卡为0
+	Title        string  `json:"title"`         // 标题
+	Status       string  `json:"status"`        // 状态
+	Content      string  `json:"content"`       // 被检索的原文
+	Snippet      string  `json:"snippet"`       // 高亮片段
+	Score        float64 `json:"score"`         // 相关度评分，越大越相关
+}
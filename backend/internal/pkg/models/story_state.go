@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// CharacterState 人物在某一章结束时的状态
+type CharacterState struct {
+	Name      string `json:"name"`      // 人物姓名
+	Location  string `json:"location"`  // 所在地点
+	Condition string `json:"condition"` // 伤势与身体状况
+	Status    string `json:"status"`    // 处境，如被囚禁、已死亡、与某人同行
+}
+
+// StoryState 某一章结束时的故事状态，包含此前各章累积的结果
+type StoryState struct {
+	ProjectID    string            `json:"project_id"`    // 项目ID
+	ChapterID    string            `json:"chapter_id"`    // 章节ID，整本生成时可能为空
+	ChapterIndex int               `json:"chapter_index"` // 章节索引
+	Characters   []*CharacterState `json:"characters"`    // 人物所在与状态
+	Locations    []string          `json:"locations"`     // 已出现的地点
+	Props        []*PropItem       `json:"props"`         // 道具的持有人与位置
+	Timeline     []*TimelineEvent  `json:"timeline"`      // 按发生顺序排列的事件
+	UpdatedAt    time.Time         `json:"updated_at"`    // 更新时间
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// 回收站条目类型
+const (
+	TrashItemProject = "project" // 项目（恢复时连带章节与视频脚本）
+	TrashItemChapter = "chapter" // 单独删除的章节
+)
+
+// TrashItem 回收站条目
+type TrashItem struct {
+	Type         string    `json:"type"`          // 条目类型：project/chapter
+	ID           string    `json:"id"`            // 项目ID或章节ID
+	ProjectID    string    `json:"project_id"`    // 所属项目ID
+	Title        string    `json:"title"`         // 标题
+	ChapterIndex int       `json:"chapter_index"` // 章节序号，项目为0
+	DeletedAt    time.Time `json:"deleted_at"`    // 删除时间
+	ExpiresAt    time.Time `json:"expires_at"`    // 到期后将被彻底删除
+}
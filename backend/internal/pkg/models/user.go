@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// 项目成员角色
+const (
+	ProjectRoleOwner  = "owner"  // 所有者：全部权限，可管理成员与删除项目
+	ProjectRoleEditor = "editor" // 编辑者：可修改项目、章节与视频脚本
+	ProjectRoleViewer = "viewer" // 查看者：只读
+)
+
+// User 用户
+type User struct {
+	ID          string    `json:"id"`           // 用户ID
+	Username    string    `json:"username"`     // 用户名，全局唯一
+	DisplayName string    `json:"display_name"` // 显示名称
+	CreatedAt   time.Time `json:"created_at"`   // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`   // 更新时间
+}
+
+// ProjectMember 项目协作成员
+type ProjectMember struct {
+	ProjectID string    `json:"project_id"` // 项目ID
+	UserID    string    `json:"user_id"`    // 用户ID
+	Username  string    `json:"username"`   // 用户名
+	Role      string    `json:"role"`       // 角色：owner/editor/viewer
+	CreatedAt time.Time `json:"created_at"` // 加入时间
+}
+
+// ProjectScope 调用者可访问的项目范围：本人所有、参与协作以及未归属的项目
+type ProjectScope struct {
+	UserID string // 调用者用户ID，为空时只包含未归属的项目
+}
+
+// ProjectStats 项目统计
+type ProjectStats struct {
+	TotalProjects     int   `json:"total_projects"`     // 项目总数
+	CompletedProjects int   `json:"completed_projects"` // 已完成项目数
+	TotalWords        int64 `json:"total_words"`        // 总字数
+	MonthlyWords      int64 `json:"monthly_words"`      // 本月新增章节字数
+}
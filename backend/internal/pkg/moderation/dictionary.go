@@ -0,0 +1,218 @@
+// Package moderation 基于关键词与正则词库的内容安全审核，不依赖模型，结果可复现
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"backend/internal/pkg/models"
+)
+
+// escalateHits 同一类别命中达到该次数时风险等级上调一级
+const escalateHits = 5
+
+// categories 风险类别的固定顺序
+var categories = []string{models.SafetyViolence, models.SafetySexual, models.SafetyPolitics, models.SafetyMinors}
+
+var levelRanks = map[string]int{
+	models.SafetyLevelNone:   0,
+	models.SafetyLevelLow:    1,
+	models.SafetyLevelMedium: 2,
+	models.SafetyLevelHigh:   3,
+}
+
+var ratingRanks = map[string]int{
+	models.ContentRatingGeneral:    0,
+	models.ContentRatingTeen:       1,
+	models.ContentRatingMature:     2,
+	models.ContentRatingRestricted: 3,
+}
+
+// Rule 一组同类别、同等级的词条
+type Rule struct {
+	Category string   // 风险类别
+	Severity string   // low/medium/high，为空时为 medium
+	Keywords []string // 关键词，按原文匹配
+	Patterns []string // 正则表达式（RE2语法）
+}
+
+type keywordEntry struct {
+	category string
+	severity string
+	term     string
+}
+
+type patternEntry struct {
+	category string
+	severity string
+	source   string
+	re       *regexp.Regexp
+}
+
+// Dictionary 编译后的审核词库，可并发使用
+type Dictionary struct {
+	keywords []keywordEntry
+	patterns []patternEntry
+}
+
+// NewDictionary 编译词库。类别、等级或正则无效的词条会被跳过并汇总在 error 中，
+// 返回的词库始终可用。
+func NewDictionary(rules []Rule) (*Dictionary, error) {
+	d := &Dictionary{}
+	var errs []error
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		category := strings.TrimSpace(rule.Category)
+		if !ValidCategory(category) {
+			errs = append(errs, fmt.Errorf("unknown safety category %q", rule.Category))
+			continue
+		}
+		severity := strings.TrimSpace(rule.Severity)
+		if severity == "" {
+			severity = models.SafetyLevelMedium
+		}
+		if rank, ok := levelRanks[severity]; !ok || rank == 0 {
+			errs = append(errs, fmt.Errorf("invalid severity %q for category %s", rule.Severity, category))
+			continue
+		}
+		for _, keyword := range rule.Keywords {
+			keyword = strings.TrimSpace(keyword)
+			key := category + "\x00k\x00" + keyword
+			if keyword == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			d.keywords = append(d.keywords, keywordEntry{category: category, severity: severity, term: keyword})
+		}
+		for _, pattern := range rule.Patterns {
+			pattern = strings.TrimSpace(pattern)
+			key := category + "\x00p\x00" + pattern
+			if pattern == "" || seen[key] {
+				continue
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("invalid pattern %q for category %s: %w", pattern, category, err))
+				continue
+			}
+			seen[key] = true
+			d.patterns = append(d.patterns, patternEntry{category: category, severity: severity, source: pattern, re: re})
+		}
+	}
+	return d, errors.Join(errs...)
+}
+
+// Size 词库中的词条数
+func (d *Dictionary) Size() int {
+	return len(d.keywords) + len(d.patterns)
+}
+
+// Scan 扫描正文，返回按位置排序的命中结果，偏移按字符（rune）计
+func (d *Dictionary) Scan(text string) []*models.SafetyHit {
+	if text == "" {
+		return nil
+	}
+	var hits []*models.SafetyHit
+	for _, entry := range d.keywords {
+		for offset := 0; ; {
+			i := strings.Index(text[offset:], entry.term)
+			if i < 0 {
+				break
+			}
+			begin := offset + i
+			end := begin + len(entry.term)
+			hits = append(hits, newHit(text, entry.category, entry.severity, entry.term, begin, end))
+			offset = end
+		}
+	}
+	for _, entry := range d.patterns {
+		for _, loc := range entry.re.FindAllStringIndex(text, -1) {
+			if loc[1] == loc[0] {
+				continue
+			}
+			hits = append(hits, newHit(text, entry.category, entry.severity, entry.source, loc[0], loc[1]))
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Start != hits[j].Start {
+			return hits[i].Start < hits[j].Start
+		}
+		return hits[i].End < hits[j].End
+	})
+	return hits
+}
+
+// newHit 按字节区间构造命中结果，偏移换算为字符
+func newHit(text, category, severity, term string, begin, end int) *models.SafetyHit {
+	start := utf8.RuneCountInString(text[:begin])
+	return &models.SafetyHit{
+		Category: category,
+		Severity: severity,
+		Term:     term,
+		Quote:    text[begin:end],
+		Start:    start,
+		End:      start + utf8.RuneCountInString(text[begin:end]),
+		Source:   "dictionary",
+	}
+}
+
+// Categories 全部风险类别，按固定顺序
+func Categories() []string {
+	return append([]string(nil), categories...)
+}
+
+// ValidCategory 判断风险类别是否有效
+func ValidCategory(category string) bool {
+	for _, c := range categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidRating 判断内容分级是否有效
+func ValidRating(rating string) bool {
+	_, ok := ratingRanks[rating]
+	return ok
+}
+
+// LevelRank 风险等级的序号，无效等级视为 none
+func LevelRank(level string) int {
+	return levelRanks[level]
+}
+
+// MaxLevel 返回较高的风险等级
+func MaxLevel(a, b string) string {
+	if levelRanks[b] > levelRanks[a] {
+		return b
+	}
+	if _, ok := levelRanks[a]; !ok {
+		return models.SafetyLevelNone
+	}
+	return a
+}
+
+// MaxRating 返回较高的内容分级
+func MaxRating(a, b string) string {
+	if ratingRanks[b] > ratingRanks[a] {
+		return b
+	}
+	if _, ok := ratingRanks[a]; !ok {
+		return models.ContentRatingGeneral
+	}
+	return a
+}
+
+// RatingAtLeast 判断 rating 是否达到阈值，阈值为空或无效时始终为 false
+func RatingAtLeast(rating, threshold string) bool {
+	thresholdRank, ok := ratingRanks[threshold]
+	if !ok {
+		return false
+	}
+	return ratingRanks[rating] >= thresholdRank
+}
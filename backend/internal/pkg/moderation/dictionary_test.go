@@ -0,0 +1,164 @@
+package moderation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"backend/internal/pkg/models"
+)
+
+// hitSummaries 命中的类别、等级、原文与字符区间，便于整体比较
+func hitSummaries(hits []*models.SafetyHit) []string {
+	summaries := make([]string, 0, len(hits))
+	for _, hit := range hits {
+		summaries = append(summaries, fmt.Sprintf("%s/%s %s [%d,%d)", hit.Category, hit.Severity, hit.Quote, hit.Start, hit.End))
+	}
+	return summaries
+}
+
+func TestNewDictionary(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    []Rule
+		wantSize int
+		wantErrs []string
+	}{
+		{
+			name: "等级为空时按 medium，重复与空白词条只收录一次",
+			rules: []Rule{
+				{Category: models.SafetyViolence, Keywords: []string{"斩首", " 斩首 ", ""}, Patterns: []string{`砍(下|断)`, `砍(下|断)`}},
+			},
+			wantSize: 2,
+		},
+		{
+			name: "无效的类别、等级与正则被跳过",
+			rules: []Rule{
+				{Category: "gambling", Keywords: []string{"赌博"}},
+				{Category: models.SafetySexual, Severity: "none", Keywords: []string{"床戏"}},
+				{Category: models.SafetyPolitics, Severity: "severe", Keywords: []string{"政变"}},
+				{Category: models.SafetyMinors, Patterns: []string{`(未成年`, `幼女`}},
+			},
+			wantSize: 1,
+			wantErrs: []string{
+				`unknown safety category "gambling"`,
+				`invalid severity "none" for category sexual`,
+				`invalid severity "severe" for category politics`,
+				`invalid pattern "(未成年" for category minors`,
+			},
+		},
+		{
+			name:     "内置词库全部有效",
+			rules:    DefaultRules(),
+			wantSize: 45,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dictionary, err := NewDictionary(tt.rules)
+			if dictionary == nil {
+				t.Fatalf("Expected a usable dictionary even with errors")
+			}
+			if dictionary.Size() != tt.wantSize {
+				t.Fatalf("Expected %d entries, got %d", tt.wantSize, dictionary.Size())
+			}
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected errors %v, got nil", tt.wantErrs)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Fatalf("Expected error to contain %q, got %v", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestDictionary_Scan(t *testing.T) {
+	dictionary, err := NewDictionary(DefaultRules())
+	if err != nil {
+		t.Fatalf("NewDictionary failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "空文本", text: "", want: []string{}},
+		{name: "没有命中", text: "林晚推开山门，雨下了三天。", want: []string{}},
+		{
+			name: "关键词重复出现，偏移按字符计",
+			text: "刑场上斩首示众。又一次斩首。",
+			want: []string{"violence/medium 斩首 [3,5)", "violence/medium 斩首 [11,13)"},
+		},
+		{
+			name: "正则命中",
+			text: "他一刀砍下了他的头颅。",
+			want: []string{"violence/medium 砍下了他的头颅 [3,10)"},
+		},
+		{
+			name: "多个类别按位置排序",
+			text: "邪教头目下令碎尸。",
+			want: []string{"politics/low 邪教 [0,2)", "violence/high 碎尸 [6,8)"},
+		},
+		{
+			name: "未成年人规则限定在同一句内",
+			text: "初中生躲在墙角抽烟。初中生。隔壁有人抽烟。",
+			want: []string{"minors/low 初中生躲在墙角抽烟 [0,9)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits := dictionary.Scan(tt.text)
+			if got := hitSummaries(hits); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Expected %v, got %v", tt.want, got)
+			}
+			for _, hit := range hits {
+				if hit.Source != "dictionary" || string([]rune(tt.text)[hit.Start:hit.End]) != hit.Quote {
+					t.Fatalf("Expected dictionary hit to locate its quote, got %+v", hit)
+				}
+			}
+		})
+	}
+}
+
+func TestMaxLevelAndRating(t *testing.T) {
+	if got := MaxLevel(models.SafetyLevelLow, models.SafetyLevelHigh); got != models.SafetyLevelHigh {
+		t.Fatalf("Expected high, got %s", got)
+	}
+	if got := MaxLevel("unknown", models.SafetyLevelNone); got != models.SafetyLevelNone {
+		t.Fatalf("Expected invalid level to count as none, got %s", got)
+	}
+	if got := MaxRating(models.ContentRatingMature, models.ContentRatingTeen); got != models.ContentRatingMature {
+		t.Fatalf("Expected mature, got %s", got)
+	}
+	if got := MaxRating("", models.ContentRatingGeneral); got != models.ContentRatingGeneral {
+		t.Fatalf("Expected invalid rating to count as general, got %s", got)
+	}
+
+	tests := []struct {
+		rating    string
+		threshold string
+		want      bool
+	}{
+		{rating: models.ContentRatingMature, threshold: models.ContentRatingMature, want: true},
+		{rating: models.ContentRatingRestricted, threshold: models.ContentRatingTeen, want: true},
+		{rating: models.ContentRatingTeen, threshold: models.ContentRatingMature, want: false},
+		{rating: models.ContentRatingRestricted, threshold: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := RatingAtLeast(tt.rating, tt.threshold); got != tt.want {
+			t.Fatalf("RatingAtLeast(%q, %q) = %v, want %v", tt.rating, tt.threshold, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package moderation
+
+import "backend/internal/pkg/models"
+
+// Labels 汇总命中结果，按固定顺序返回每个风险类别的结论。
+// 等级取命中词条的最高等级，命中次数达到 escalateHits 时上调一级。
+func Labels(hits []*models.SafetyHit) []*models.SafetyLabel {
+	byCategory := make(map[string]*models.SafetyLabel, len(categories))
+	labels := make([]*models.SafetyLabel, 0, len(categories))
+	for _, category := range categories {
+		label := &models.SafetyLabel{Category: category, Level: models.SafetyLevelNone}
+		byCategory[category] = label
+		labels = append(labels, label)
+	}
+	for _, hit := range hits {
+		label, ok := byCategory[hit.Category]
+		if !ok {
+			continue
+		}
+		label.Level = MaxLevel(label.Level, hit.Severity)
+		if hit.Source != "llm" {
+			label.HitCount++
+		}
+	}
+	for _, label := range labels {
+		if label.HitCount >= escalateHits {
+			label.Level = escalate(label.Level)
+		}
+	}
+	return labels
+}
+
+// escalate 风险等级上调一级
+func escalate(level string) string {
+	switch level {
+	case models.SafetyLevelLow:
+		return models.SafetyLevelMedium
+	case models.SafetyLevelMedium:
+		return models.SafetyLevelHigh
+	default:
+		return level
+	}
+}
+
+// Rate 根据各类别的结论给出内容分级：
+// 任一类别为 high，或政治、未成年人类别达到 medium，为 restricted；
+// 暴力、色情类别为 medium 时为 mature；任一类别为 low 时为 teen；否则为 general。
+func Rate(labels []*models.SafetyLabel) string {
+	rating := models.ContentRatingGeneral
+	for _, label := range labels {
+		switch {
+		case label.Level == models.SafetyLevelHigh:
+			return models.ContentRatingRestricted
+		case label.Level == models.SafetyLevelMedium &&
+			(label.Category == models.SafetyPolitics || label.Category == models.SafetyMinors):
+			return models.ContentRatingRestricted
+		case label.Level == models.SafetyLevelMedium:
+			rating = MaxRating(rating, models.ContentRatingMature)
+		case label.Level == models.SafetyLevelLow:
+			rating = MaxRating(rating, models.ContentRatingTeen)
+		}
+	}
+	return rating
+}
@@ -0,0 +1,120 @@
+package moderation
+
+import (
+	"testing"
+
+	"backend/internal/pkg/models"
+)
+
+// levelsOf 各类别的等级，便于输出
+func levelsOf(labels []*models.SafetyLabel) map[string]string {
+	levels := make(map[string]string, len(labels))
+	for _, label := range labels {
+		levels[label.Category] = label.Level
+	}
+	return levels
+}
+
+// repeatHits 构造 n 个同类别、同等级的命中
+func repeatHits(category, severity, source string, n int) []*models.SafetyHit {
+	hits := make([]*models.SafetyHit, 0, n)
+	for i := 0; i < n; i++ {
+		hits = append(hits, &models.SafetyHit{Category: category, Severity: severity, Source: source})
+	}
+	return hits
+}
+
+func TestLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		hits      []*models.SafetyHit
+		category  string
+		wantLevel string
+		wantCount int
+	}{
+		{name: "没有命中", category: models.SafetyViolence, wantLevel: models.SafetyLevelNone},
+		{
+			name:      "取命中词条的最高等级",
+			hits:      append(repeatHits(models.SafetySexual, models.SafetyLevelLow, "dictionary", 2), repeatHits(models.SafetySexual, models.SafetyLevelMedium, "dictionary", 1)...),
+			category:  models.SafetySexual,
+			wantLevel: models.SafetyLevelMedium,
+			wantCount: 3,
+		},
+		{
+			name:      "命中次数达到阈值上调一级",
+			hits:      repeatHits(models.SafetyViolence, models.SafetyLevelLow, "dictionary", escalateHits),
+			category:  models.SafetyViolence,
+			wantLevel: models.SafetyLevelMedium,
+			wantCount: escalateHits,
+		},
+		{
+			name:      "high 不再上调",
+			hits:      repeatHits(models.SafetyViolence, models.SafetyLevelHigh, "dictionary", escalateHits),
+			category:  models.SafetyViolence,
+			wantLevel: models.SafetyLevelHigh,
+			wantCount: escalateHits,
+		},
+		{
+			name:      "模型引文不计入命中次数",
+			hits:      repeatHits(models.SafetyViolence, models.SafetyLevelLow, "llm", escalateHits),
+			category:  models.SafetyViolence,
+			wantLevel: models.SafetyLevelLow,
+		},
+		{
+			name:      "未知类别被忽略",
+			hits:      repeatHits("gambling", models.SafetyLevelHigh, "dictionary", 1),
+			category:  models.SafetyViolence,
+			wantLevel: models.SafetyLevelNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels := Labels(tt.hits)
+			if len(labels) != len(Categories()) {
+				t.Fatalf("Expected a label for every category, got %d", len(labels))
+			}
+			for i, category := range Categories() {
+				if labels[i].Category != category {
+					t.Fatalf("Expected label %d to be %s, got %s", i, category, labels[i].Category)
+				}
+				if category != tt.category {
+					continue
+				}
+				if labels[i].Level != tt.wantLevel || labels[i].HitCount != tt.wantCount {
+					t.Fatalf("Expected %s level=%s count=%d, got level=%s count=%d", category, tt.wantLevel, tt.wantCount, labels[i].Level, labels[i].HitCount)
+				}
+			}
+		})
+	}
+}
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		name   string
+		levels map[string]string
+		want   string
+	}{
+		{name: "没有风险", want: models.ContentRatingGeneral},
+		{name: "轻微风险", levels: map[string]string{models.SafetyViolence: models.SafetyLevelLow}, want: models.ContentRatingTeen},
+		{name: "暴力明显", levels: map[string]string{models.SafetyViolence: models.SafetyLevelMedium, models.SafetySexual: models.SafetyLevelLow}, want: models.ContentRatingMature},
+		{name: "色情明显", levels: map[string]string{models.SafetySexual: models.SafetyLevelMedium}, want: models.ContentRatingMature},
+		{name: "政治明显即受限", levels: map[string]string{models.SafetyPolitics: models.SafetyLevelMedium}, want: models.ContentRatingRestricted},
+		{name: "未成年人明显即受限", levels: map[string]string{models.SafetyMinors: models.SafetyLevelMedium}, want: models.ContentRatingRestricted},
+		{name: "任一类别严重即受限", levels: map[string]string{models.SafetyViolence: models.SafetyLevelHigh}, want: models.ContentRatingRestricted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels := Labels(nil)
+			for _, label := range labels {
+				if level, ok := tt.levels[label.Category]; ok {
+					label.Level = level
+				}
+			}
+			if got := Rate(labels); got != tt.want {
+				t.Fatalf("Expected %s, got %s (levels %v)", tt.want, got, levelsOf(labels))
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package moderation
+
+import "backend/internal/pkg/models"
+
+// DefaultRules 内置词库，覆盖网文与短视频平台常见的审核红线。
+// 只收录指向明确的词条，题材性的泛用词（如“杀”“血”）交给模型分类判断轻重。
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Category: models.SafetyViolence,
+			Severity: models.SafetyLevelLow,
+			Keywords: []string{"鲜血淋漓", "血流如注", "拳打脚踢", "严刑拷打"},
+		},
+		{
+			Category: models.SafetyViolence,
+			Severity: models.SafetyLevelMedium,
+			Keywords: []string{"血肉模糊", "开膛破肚", "断肢", "斩首", "割喉", "剥皮", "挖眼"},
+			Patterns: []string{`(砍|剁|切)(下|断)了?(他|她|它)?的?(头颅|手臂|双腿|手指)`},
+		},
+		{
+			Category: models.SafetyViolence,
+			Severity: models.SafetyLevelHigh,
+			Keywords: []string{"碎尸", "肢解", "虐杀", "凌迟", "分尸", "食人"},
+		},
+		{
+			Category: models.SafetySexual,
+			Severity: models.SafetyLevelLow,
+			Keywords: []string{"酥胸", "春宵", "宽衣解带", "香艳", "调情"},
+		},
+		{
+			Category: models.SafetySexual,
+			Severity: models.SafetyLevelMedium,
+			Keywords: []string{"赤身裸体", "一丝不挂", "床戏", "云雨", "欢好"},
+		},
+		{
+			Category: models.SafetySexual,
+			Severity: models.SafetyLevelHigh,
+			Keywords: []string{"强奸", "性交", "做爱", "淫荡", "卖淫", "嫖娼"},
+		},
+		{
+			Category: models.SafetyPolitics,
+			Severity: models.SafetyLevelLow,
+			Keywords: []string{"政变", "邪教"},
+		},
+		{
+			Category: models.SafetyPolitics,
+			Severity: models.SafetyLevelHigh,
+			Keywords: []string{"颠覆政权", "分裂国家", "推翻政府", "恐怖袭击"},
+		},
+		{
+			Category: models.SafetyMinors,
+			Severity: models.SafetyLevelLow,
+			Patterns: []string{`(未成年|小学生|初中生|中学生)[^。！？\n]{0,8}(抽烟|吸烟|酗酒|喝酒|逃课|打架)`},
+		},
+		{
+			Category: models.SafetyMinors,
+			Severity: models.SafetyLevelHigh,
+			Keywords: []string{"幼女", "娈童", "恋童"},
+			Patterns: []string{`(未成年|小学生|初中生|萝莉|女童|男童)[^。！？\n]{0,10}(性侵|性行为|性关系|裸照|裸体|猥亵|诱奸)`},
+		},
+	}
+}
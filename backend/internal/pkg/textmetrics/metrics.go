@@ -0,0 +1,345 @@
+// Package textmetrics 计算中文正文的客观指标，不依赖模型，任何章节都可以直接计算
+package textmetrics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// 句长分布区间，按句子字数划分
+const (
+	SentenceShort    = "1-10"
+	SentenceMedium   = "11-30"
+	SentenceLong     = "31-60"
+	SentenceVeryLong = "61+"
+)
+
+// 评分阈值
+const (
+	ngramSize            = 4   // 重复片段的字数
+	minRepeat            = 3   // 片段或套话出现多少次视为重复
+	maxRepeatedNGrams    = 10  // 最多列出的重复片段数
+	maxAvgSentenceLength = 40  // 平均句长上限
+	minAvgSentenceLength = 6   // 平均句长下限
+	maxVeryLongRatio     = 0.1 // 超长句占比上限
+	maxAvgParagraph      = 300 // 平均段落字数上限
+	maxAdverbDensity     = 20  // 每千字副词数上限
+)
+
+// PhraseCount 短语及其出现次数
+type PhraseCount struct {
+	Phrase string `json:"phrase"` // 短语
+	Count  int    `json:"count"`  // 出现次数
+}
+
+// Metrics 正文的客观指标，字数按字符（rune）计，不含空白
+type Metrics struct {
+	CharCount              int            `json:"char_count"`               // 正文字数
+	SentenceCount          int            `json:"sentence_count"`           // 句子数
+	AvgSentenceLength      float64        `json:"avg_sentence_length"`      // 平均句长
+	SentenceLengthStdDev   float64        `json:"sentence_length_std_dev"`  // 句长标准差
+	SentenceLengths        map[string]int `json:"sentence_lengths"`         // 句长分布，键为句长区间
+	ParagraphCount         int            `json:"paragraph_count"`          // 段落数
+	AvgParagraphLength     float64        `json:"avg_paragraph_length"`     // 平均段落字数
+	MaxParagraphLength     int            `json:"max_paragraph_length"`     // 最长段落字数
+	DialogueRatio          float64        `json:"dialogue_ratio"`           // 引号内对话字数占正文的比例，其余为叙述
+	RepeatedNGrams         []*PhraseCount `json:"repeated_ngrams"`          // 反复出现的片段
+	OverusedPhrases        []*PhraseCount `json:"overused_phrases"`         // 使用过多的套话
+	AdverbCount            int            `json:"adverb_count"`             // 副词数
+	AdverbDensity          float64        `json:"adverb_density"`           // 每千字副词数
+	PunctuationCount       int            `json:"punctuation_count"`        // 标点数
+	HalfWidthPunctuation   int            `json:"half_width_punctuation"`   // 中文语境中误用的半角标点数
+	PunctuationMisuseRatio float64        `json:"punctuation_misuse_ratio"` // 误用的半角标点占标点的比例
+	Score                  float64        `json:"score"`                    // 综合指标得分 0-1
+}
+
+// stockPhrases 网文常见套话
+var stockPhrases = []string{
+	"嘴角勾起", "倒吸一口凉气", "深吸一口气", "眼中闪过", "心中一动", "微微一笑", "不由得",
+	"不禁", "忍不住", "下一刻", "就在这时", "与此同时", "一丝", "淡淡的", "缓缓",
+}
+
+// adverbs 常见副词；另外“轻轻地”这类叠字加“地”的状语也计为副词
+var adverbs = []string{
+	"非常", "十分", "极其", "特别", "格外", "尤其", "相当", "稍微", "略微", "几乎", "简直",
+	"居然", "竟然", "突然", "忽然", "猛然", "渐渐", "慢慢", "默默", "静静", "轻轻", "缓缓",
+	"悄悄", "偷偷", "狠狠", "紧紧", "立刻", "马上", "顿时", "一下子", "猛地", "终于", "仍然",
+}
+
+// halfWidthPunct 中文正文中应使用全角形式的半角标点
+const halfWidthPunct = ",.!?;:()"
+
+// Analyze 计算正文的客观指标
+func Analyze(text string) *Metrics {
+	m := &Metrics{SentenceLengths: map[string]int{
+		SentenceShort:    0,
+		SentenceMedium:   0,
+		SentenceLong:     0,
+		SentenceVeryLong: 0,
+	}}
+
+	var sentences []int
+	dialogue := 0
+	for _, line := range strings.Split(text, "\n") {
+		paragraph := []rune(strings.TrimSpace(line))
+		length := 0
+		current := 0
+		inQuote := false
+		for i, r := range paragraph {
+			if unicode.IsSpace(r) {
+				continue
+			}
+			length++
+			switch {
+			case r == '“' || r == '「' || r == '『':
+				inQuote = true
+				continue
+			case r == '”' || r == '」' || r == '』':
+				inQuote = false
+				continue
+			case r == '"':
+				inQuote = !inQuote
+				continue
+			}
+			if inQuote {
+				dialogue++
+			}
+			if unicode.IsPunct(r) {
+				m.PunctuationCount++
+				if strings.ContainsRune(halfWidthPunct, r) && nearHan(paragraph, i) {
+					m.HalfWidthPunctuation++
+				}
+			}
+			if isTerminator(r) || (r == '.' && nearHan(paragraph, i)) {
+				if current > 0 {
+					sentences = append(sentences, current)
+				}
+				current = 0
+				continue
+			}
+			current++
+		}
+		if current > 0 {
+			sentences = append(sentences, current)
+		}
+		if length == 0 {
+			continue
+		}
+		m.CharCount += length
+		m.ParagraphCount++
+		if length > m.MaxParagraphLength {
+			m.MaxParagraphLength = length
+		}
+	}
+	if m.CharCount == 0 {
+		return m
+	}
+
+	m.SentenceCount = len(sentences)
+	total := 0
+	for _, length := range sentences {
+		total += length
+		m.SentenceLengths[sentenceBucket(length)]++
+	}
+	if m.SentenceCount > 0 {
+		m.AvgSentenceLength = float64(total) / float64(m.SentenceCount)
+		variance := 0.0
+		for _, length := range sentences {
+			variance += math.Pow(float64(length)-m.AvgSentenceLength, 2)
+		}
+		m.SentenceLengthStdDev = math.Sqrt(variance / float64(m.SentenceCount))
+	}
+	m.AvgParagraphLength = float64(m.CharCount) / float64(m.ParagraphCount)
+	m.DialogueRatio = float64(dialogue) / float64(m.CharCount)
+	if m.PunctuationCount > 0 {
+		m.PunctuationMisuseRatio = float64(m.HalfWidthPunctuation) / float64(m.PunctuationCount)
+	}
+
+	m.RepeatedNGrams = repeatedNGrams(text)
+	for _, phrase := range stockPhrases {
+		if count := strings.Count(text, phrase); count >= minRepeat {
+			m.OverusedPhrases = append(m.OverusedPhrases, &PhraseCount{Phrase: phrase, Count: count})
+		}
+	}
+	sortPhrases(m.OverusedPhrases)
+	m.AdverbCount = countAdverbs(text)
+	m.AdverbDensity = float64(m.AdverbCount) * 1000 / float64(m.CharCount)
+
+	m.Score = m.score()
+	return m
+}
+
+// score 按各项指标偏离常见范围的程度扣分；对话占比因题材而异，不参与评分
+func (m *Metrics) score() float64 {
+	score := 1.0
+	if m.SentenceCount > 0 {
+		if m.AvgSentenceLength > maxAvgSentenceLength || m.AvgSentenceLength < minAvgSentenceLength {
+			score -= 0.1
+		}
+		if float64(m.SentenceLengths[SentenceVeryLong])/float64(m.SentenceCount) > maxVeryLongRatio {
+			score -= 0.1
+		}
+	}
+	if m.AvgParagraphLength > maxAvgParagraph {
+		score -= 0.1
+	}
+	score -= math.Min(0.1, 0.02*float64(len(m.RepeatedNGrams)))
+	score -= math.Min(0.15, 0.03*float64(len(m.OverusedPhrases)))
+	if m.AdverbDensity > maxAdverbDensity {
+		score -= 0.1
+	}
+	score -= math.Min(0.2, m.PunctuationMisuseRatio)
+	return math.Max(0, score)
+}
+
+// Suggestions 根据超出范围的指标给出修改建议
+func (m *Metrics) Suggestions() []string {
+	var suggestions []string
+	if m.SentenceCount > 0 && m.AvgSentenceLength > maxAvgSentenceLength {
+		suggestions = append(suggestions, fmt.Sprintf("平均句长%.0f字，句子偏长，建议拆分长句", m.AvgSentenceLength))
+	}
+	if m.SentenceCount > 0 && m.AvgSentenceLength < minAvgSentenceLength {
+		suggestions = append(suggestions, fmt.Sprintf("平均句长%.0f字，句子过碎，建议合并短句", m.AvgSentenceLength))
+	}
+	if m.AvgParagraphLength > maxAvgParagraph {
+		suggestions = append(suggestions, fmt.Sprintf("平均段落%.0f字，段落过长，建议分段", m.AvgParagraphLength))
+	}
+	if len(m.RepeatedNGrams) > 0 {
+		suggestions = append(suggestions, fmt.Sprintf("「%s」等片段反复出现%d次，建议变换表达", m.RepeatedNGrams[0].Phrase, m.RepeatedNGrams[0].Count))
+	}
+	if len(m.OverusedPhrases) > 0 {
+		suggestions = append(suggestions, fmt.Sprintf("套话「%s」出现%d次，建议删减", m.OverusedPhrases[0].Phrase, m.OverusedPhrases[0].Count))
+	}
+	if m.AdverbDensity > maxAdverbDensity {
+		suggestions = append(suggestions, fmt.Sprintf("每千字副词%.0f个，建议用动作和细节代替副词", m.AdverbDensity))
+	}
+	if m.HalfWidthPunctuation > 0 {
+		suggestions = append(suggestions, fmt.Sprintf("有%d处半角标点，中文正文应使用全角标点", m.HalfWidthPunctuation))
+	}
+	return suggestions
+}
+
+// isTerminator 判断是否为句末标点
+func isTerminator(r rune) bool {
+	switch r {
+	case '。', '！', '？', '!', '?', '…':
+		return true
+	}
+	return false
+}
+
+// nearHan 判断标点前后的字符是否为汉字
+func nearHan(runes []rune, i int) bool {
+	return (i > 0 && unicode.Is(unicode.Han, runes[i-1])) ||
+		(i+1 < len(runes) && unicode.Is(unicode.Han, runes[i+1]))
+}
+
+// sentenceBucket 返回句长所在的区间
+func sentenceBucket(length int) string {
+	switch {
+	case length <= 10:
+		return SentenceShort
+	case length <= 30:
+		return SentenceMedium
+	case length <= 60:
+		return SentenceLong
+	default:
+		return SentenceVeryLong
+	}
+}
+
+// repeatedNGrams 统计连续汉字中反复出现的片段
+// 次数相同且首尾重叠的片段来自同一处更长的重复，合并为一条
+func repeatedNGrams(text string) []*PhraseCount {
+	counts := make(map[string]int)
+	var run []rune
+	flush := func() {
+		for i := 0; i+ngramSize <= len(run); i++ {
+			counts[string(run[i:i+ngramSize])]++
+		}
+		run = run[:0]
+	}
+	for _, r := range text {
+		if unicode.Is(unicode.Han, r) {
+			run = append(run, r)
+			continue
+		}
+		flush()
+	}
+	flush()
+
+	var candidates []*PhraseCount
+	for phrase, count := range counts {
+		if count >= minRepeat {
+			candidates = append(candidates, &PhraseCount{Phrase: phrase, Count: count})
+		}
+	}
+	sortPhrases(candidates)
+
+	var selected []*PhraseCount
+	for _, candidate := range candidates {
+		merged := false
+		for _, kept := range selected {
+			if kept.Count != candidate.Count {
+				continue
+			}
+			if phrase, ok := mergeOverlap(kept.Phrase, candidate.Phrase); ok && strings.Count(text, phrase) == kept.Count {
+				kept.Phrase = phrase
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			selected = append(selected, candidate)
+		}
+	}
+	sortPhrases(selected)
+	if len(selected) > maxRepeatedNGrams {
+		selected = selected[:maxRepeatedNGrams]
+	}
+	return selected
+}
+
+// mergeOverlap 将首尾重叠的两个片段拼接为一个，不重叠或互相包含时返回 false
+func mergeOverlap(a, b string) (string, bool) {
+	ra, rb := []rune(a), []rune(b)
+	for overlap := min(len(ra), len(rb)) - 1; overlap > 0; overlap-- {
+		if string(ra[len(ra)-overlap:]) == string(rb[:overlap]) {
+			return a + string(rb[overlap:]), true
+		}
+		if string(rb[len(rb)-overlap:]) == string(ra[:overlap]) {
+			return b + string(ra[overlap:]), true
+		}
+	}
+	return "", false
+}
+
+// countAdverbs 统计副词数
+func countAdverbs(text string) int {
+	known := make(map[string]bool, len(adverbs))
+	count := 0
+	for _, adverb := range adverbs {
+		known[adverb] = true
+		count += strings.Count(text, adverb)
+	}
+	runes := []rune(text)
+	for i := 0; i+2 < len(runes); i++ {
+		if runes[i] == runes[i+1] && runes[i+2] == '地' && unicode.Is(unicode.Han, runes[i]) && !known[string(runes[i:i+2])] {
+			count++
+		}
+	}
+	return count
+}
+
+// sortPhrases 按出现次数降序排列，次数相同时按短语排序
+func sortPhrases(phrases []*PhraseCount) {
+	sort.Slice(phrases, func(i, j int) bool {
+		if phrases[i].Count != phrases[j].Count {
+			return phrases[i].Count > phrases[j].Count
+		}
+		return phrases[i].Phrase < phrases[j].Phrase
+	})
+}
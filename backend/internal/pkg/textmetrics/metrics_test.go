@@ -0,0 +1,174 @@
+package textmetrics
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name              string
+		text              string
+		wantChars         int
+		wantSentences     int
+		wantParagraphs    int
+		wantPunctuation   int
+		wantHalfWidth     int
+		wantAvgSentence   float64
+		wantDialogueRatio float64
+		wantScore         float64
+		wantBuckets       map[string]int
+	}{
+		{
+			name:        "空文本",
+			text:        "",
+			wantBuckets: map[string]int{SentenceShort: 0, SentenceMedium: 0, SentenceLong: 0, SentenceVeryLong: 0},
+		},
+		{
+			name:        "只有空白",
+			text:        "  \n\t\n",
+			wantBuckets: map[string]int{SentenceShort: 0, SentenceMedium: 0, SentenceLong: 0, SentenceVeryLong: 0},
+		},
+		{
+			name:            "只有标点不构成句子",
+			text:            "。！？…",
+			wantChars:       4,
+			wantParagraphs:  1,
+			wantPunctuation: 4,
+			wantScore:       1,
+			wantBuckets:     map[string]int{SentenceShort: 0, SentenceMedium: 0, SentenceLong: 0, SentenceVeryLong: 0},
+		},
+		{
+			name:              "对话与叙述",
+			text:              "“走吧。”他说。",
+			wantChars:         8,
+			wantSentences:     2,
+			wantParagraphs:    1,
+			wantPunctuation:   2,
+			wantAvgSentence:   2,
+			wantDialogueRatio: 0.375,
+			wantScore:         0.9,
+			wantBuckets:       map[string]int{SentenceShort: 2, SentenceMedium: 0, SentenceLong: 0, SentenceVeryLong: 0},
+		},
+		{
+			name:            "中英混排时汉字旁的半角标点计为误用",
+			text:            "他用iPhone拍照,很好.",
+			wantChars:       14,
+			wantSentences:   1,
+			wantParagraphs:  1,
+			wantPunctuation: 2,
+			wantHalfWidth:   2,
+			wantAvgSentence: 13,
+			wantScore:       0.8,
+			wantBuckets:     map[string]int{SentenceShort: 0, SentenceMedium: 1, SentenceLong: 0, SentenceVeryLong: 0},
+		},
+		{
+			name:            "英文之间的小数点不断句",
+			text:            "版本v1.2发布。\n\n新功能上线。",
+			wantChars:       15,
+			wantSentences:   2,
+			wantParagraphs:  2,
+			wantPunctuation: 3,
+			wantAvgSentence: 6.5,
+			wantScore:       1,
+			wantBuckets:     map[string]int{SentenceShort: 2, SentenceMedium: 0, SentenceLong: 0, SentenceVeryLong: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Analyze(tt.text)
+			if m.CharCount != tt.wantChars || m.SentenceCount != tt.wantSentences || m.ParagraphCount != tt.wantParagraphs {
+				t.Fatalf("Expected chars=%d sentences=%d paragraphs=%d, got chars=%d sentences=%d paragraphs=%d",
+					tt.wantChars, tt.wantSentences, tt.wantParagraphs, m.CharCount, m.SentenceCount, m.ParagraphCount)
+			}
+			if m.PunctuationCount != tt.wantPunctuation || m.HalfWidthPunctuation != tt.wantHalfWidth {
+				t.Fatalf("Expected punctuation=%d half_width=%d, got punctuation=%d half_width=%d",
+					tt.wantPunctuation, tt.wantHalfWidth, m.PunctuationCount, m.HalfWidthPunctuation)
+			}
+			if m.AvgSentenceLength != tt.wantAvgSentence {
+				t.Fatalf("Expected avg sentence length %.2f, got %.2f", tt.wantAvgSentence, m.AvgSentenceLength)
+			}
+			if m.DialogueRatio != tt.wantDialogueRatio {
+				t.Fatalf("Expected dialogue ratio %.3f, got %.3f", tt.wantDialogueRatio, m.DialogueRatio)
+			}
+			if math.Abs(m.Score-tt.wantScore) > 1e-9 {
+				t.Fatalf("Expected score %.2f, got %.2f", tt.wantScore, m.Score)
+			}
+			if !reflect.DeepEqual(m.SentenceLengths, tt.wantBuckets) {
+				t.Fatalf("Expected sentence lengths %v, got %v", tt.wantBuckets, m.SentenceLengths)
+			}
+		})
+	}
+}
+
+func TestAnalyze_Repetition(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		wantRepeated []PhraseCount
+		wantOverused []PhraseCount
+		wantAdverbs  int
+	}{
+		{
+			name:         "重叠片段合并为整句",
+			text:         "青云宗的弟子。青云宗的弟子。青云宗的弟子。",
+			wantRepeated: []PhraseCount{{Phrase: "青云宗的弟子", Count: 3}},
+		},
+		{
+			name:         "出现两次不算重复",
+			text:         "青云宗的弟子。青云宗的弟子。",
+			wantRepeated: []PhraseCount{},
+		},
+		{
+			name:         "套话与副词",
+			text:         "他缓缓抬头，嘴角勾起。她缓缓转身，嘴角勾起。风缓缓吹过，嘴角勾起。",
+			wantRepeated: []PhraseCount{{Phrase: "嘴角勾起", Count: 3}},
+			wantOverused: []PhraseCount{{Phrase: "嘴角勾起", Count: 3}, {Phrase: "缓缓", Count: 3}},
+			wantAdverbs:  3,
+		},
+		{
+			name:         "叠字加地的状语不重复计数",
+			text:         "他轻轻地推门，沉沉地睡去。",
+			wantRepeated: []PhraseCount{},
+			wantAdverbs:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Analyze(tt.text)
+			if got := phraseCounts(m.RepeatedNGrams); !reflect.DeepEqual(got, tt.wantRepeated) {
+				t.Fatalf("Expected repeated n-grams %v, got %v", tt.wantRepeated, got)
+			}
+			if got := phraseCounts(m.OverusedPhrases); len(tt.wantOverused) > 0 && !reflect.DeepEqual(got, tt.wantOverused) {
+				t.Fatalf("Expected overused phrases %v, got %v", tt.wantOverused, got)
+			}
+			if m.AdverbCount != tt.wantAdverbs {
+				t.Fatalf("Expected %d adverbs, got %d", tt.wantAdverbs, m.AdverbCount)
+			}
+		})
+	}
+}
+
+func TestMetrics_Suggestions(t *testing.T) {
+	if got := Analyze("").Suggestions(); len(got) != 0 {
+		t.Fatalf("Expected no suggestions for empty text, got %v", got)
+	}
+
+	got := Analyze("他用iPhone拍照,很好.").Suggestions()
+	want := []string{"有2处半角标点，中文正文应使用全角标点"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+}
+
+// phraseCounts 展开短语统计，便于整体比较
+func phraseCounts(phrases []*PhraseCount) []PhraseCount {
+	counts := make([]PhraseCount, 0, len(phrases))
+	for _, phrase := range phrases {
+		counts = append(counts, *phrase)
+	}
+	return counts
+}
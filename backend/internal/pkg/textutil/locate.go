@@ -0,0 +1,77 @@
+package textutil
+
+import (
+	"unicode"
+)
+
+// LocateQuote 在正文中查找引文，返回按字符（rune）计的 [start, end)
+// 先逐字匹配；找不到时忽略空白与标点的差异再匹配，结果仍对应正文中的原始位置。
+// 引文出现多次时取起点离 hint 最近的一处，hint<0 时取第一处。
+func LocateQuote(text, quote string, hint int) (int, int, bool) {
+	textRunes := []rune(text)
+	quoteRunes := []rune(quote)
+	if len(quoteRunes) == 0 {
+		return 0, 0, false
+	}
+
+	if start, ok := nearestMatch(textRunes, quoteRunes, hint); ok {
+		return start, start + len(quoteRunes), true
+	}
+
+	// 去掉空白与标点后匹配，positions 记录每个保留字符在正文中的位置
+	normText, positions := stripForMatch(textRunes)
+	normQuote, _ := stripForMatch(quoteRunes)
+	if len(normQuote) == 0 {
+		return 0, 0, false
+	}
+	normHint := -1
+	if hint >= 0 {
+		normHint = len(positions)
+		for i, position := range positions {
+			if position >= hint {
+				normHint = i
+				break
+			}
+		}
+	}
+	start, ok := nearestMatch(normText, normQuote, normHint)
+	if !ok {
+		return 0, 0, false
+	}
+	return positions[start], positions[start+len(normQuote)-1] + 1, true
+}
+
+// nearestMatch 返回离 hint 最近的匹配起点
+func nearestMatch(text, pattern []rune, hint int) (int, bool) {
+	best, bestDistance := -1, 0
+	for i := 0; i+len(pattern) <= len(text); i++ {
+		if !runesEqual(text[i:i+len(pattern)], pattern) {
+			continue
+		}
+		if hint < 0 {
+			return i, true
+		}
+		distance := i - hint
+		if distance < 0 {
+			distance = -distance
+		}
+		if best < 0 || distance < bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+	return best, best >= 0
+}
+
+// stripForMatch 去掉空白与标点，返回剩余字符及其原始位置
+func stripForMatch(runes []rune) ([]rune, []int) {
+	stripped := make([]rune, 0, len(runes))
+	positions := make([]int, 0, len(runes))
+	for i, r := range runes {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		stripped = append(stripped, r)
+		positions = append(positions, i)
+	}
+	return stripped, positions
+}
@@ -0,0 +1,115 @@
+package textutil
+
+import (
+	"sort"
+	"strings"
+)
+
+// Snippet 截取文本中命中检索词的片段，并用 pre/post 包裹命中部分
+// terms 为原始检索词；若原词未出现，则退化为按词元（bigram）匹配。
+// radius 为命中位置前后保留的字符数。
+func Snippet(text string, terms []string, radius int, pre, post string) string {
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(lower) != len(runes) {
+		// 大小写转换改变了长度时放弃忽略大小写
+		lower = runes
+	}
+
+	spans := findSpans(lower, terms)
+	if len(spans) == 0 {
+		tokens := make([]string, 0)
+		for _, term := range terms {
+			tokens = append(tokens, Tokenize(term)...)
+		}
+		spans = findSpans(lower, UniqueTokens(tokens))
+	}
+
+	if len(spans) == 0 {
+		if len(runes) > radius*2 {
+			return string(runes[:radius*2]) + "…"
+		}
+		return text
+	}
+
+	// 以第一个命中为中心截取窗口
+	start := spans[0][0] - radius
+	if start < 0 {
+		start = 0
+	}
+	end := spans[0][1] + radius
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, span := range spans {
+		if span[1] <= pos || span[0] >= end {
+			continue
+		}
+		s, e := span[0], span[1]
+		if s < pos {
+			s = pos
+		}
+		if e > end {
+			e = end
+		}
+		b.WriteString(string(runes[pos:s]))
+		b.WriteString(pre)
+		b.WriteString(string(runes[s:e]))
+		b.WriteString(post)
+		pos = e
+	}
+	b.WriteString(string(runes[pos:end]))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// findSpans 查找所有检索词的出现位置（按字符偏移），合并重叠区间
+func findSpans(text []rune, terms []string) [][2]int {
+	spans := make([][2]int, 0)
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(strings.TrimSpace(term)))
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(text); i++ {
+			if runesEqual(text[i:i+len(needle)], needle) {
+				spans = append(spans, [2]int{i, i + len(needle)})
+			}
+		}
+	}
+	if len(spans) == 0 {
+		return spans
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	merged := [][2]int{spans[0]}
+	for _, span := range spans[1:] {
+		last := &merged[len(merged)-1]
+		if span[0] <= last[1] {
+			if span[1] > last[1] {
+				last[1] = span[1]
+			}
+			continue
+		}
+		merged = append(merged, span)
+	}
+	return merged
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package textutil
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSnippet(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		terms  []string
+		radius int
+		want   string
+	}{
+		{
+			name:   "原词命中时高亮原词",
+			text:   "林晚握紧了玉佩。",
+			terms:  []string{"玉佩"},
+			radius: 40,
+			want:   "林晚握紧了<mark>玉佩</mark>。",
+		},
+		{
+			name:   "忽略大小写",
+			text:   "The Sword is here",
+			terms:  []string{"sword"},
+			radius: 40,
+			want:   "The <mark>Sword</mark> is here",
+		},
+		{
+			name:   "原词未出现时按二元组匹配",
+			text:   "她握紧玉石与佩剑",
+			terms:  []string{"握紧玉佩"},
+			radius: 40,
+			want:   "她<mark>握紧玉</mark>石与佩剑",
+		},
+		{
+			name:   "长文本截取命中窗口并加省略号",
+			text:   strings.Repeat("山", 30) + "林晚握紧了玉佩" + strings.Repeat("水", 30),
+			terms:  []string{"玉佩"},
+			radius: 4,
+			want:   "…晚握紧了<mark>玉佩</mark>水水水水…",
+		},
+		{
+			name:   "没有命中时截取开头",
+			text:   strings.Repeat("山", 20),
+			terms:  []string{"玉佩"},
+			radius: 4,
+			want:   strings.Repeat("山", 8) + "…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Snippet(tt.text, tt.terms, tt.radius, "<mark>", "</mark>"); got != tt.want {
+				t.Fatalf("Snippet = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package textutil
+
+import (
+	"strings"
+	"unicode"
+)
+
+// IsCJK 判断字符是否为中日韩表意文字
+func IsCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
+
+// Tokenize 将文本切分为检索用的词元
+// 中文等连续表意文字按重叠二元组（bigram）切分，单个汉字保留为一元词元；
+// 英文与数字按连续字母数字切分并转为小写；其余字符视为分隔符。
+func Tokenize(text string) []string {
+	tokens := make([]string, 0, len(text)/2)
+	var cjkRun []rune
+	var word []rune
+
+	flushCJK := func() {
+		switch len(cjkRun) {
+		case 0:
+		case 1:
+			tokens = append(tokens, string(cjkRun))
+		default:
+			for i := 0; i+1 < len(cjkRun); i++ {
+				tokens = append(tokens, string(cjkRun[i:i+2]))
+			}
+		}
+		cjkRun = cjkRun[:0]
+	}
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case IsCJK(r):
+			flushWord()
+			cjkRun = append(cjkRun, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushCJK()
+			flushWord()
+		}
+	}
+	flushCJK()
+	flushWord()
+
+	return tokens
+}
+
+// UniqueTokens 返回去重后的词元，保持首次出现的顺序
+func UniqueTokens(tokens []string) []string {
+	seen := make(map[string]struct{}, len(tokens))
+	result := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+		result = append(result, token)
+	}
+	return result
+}
+
+// IndexTokens 返回建立索引用的词元
+// 在 Tokenize 的基础上额外收录单个汉字，使单字检索也能命中。
+func IndexTokens(text string) []string {
+	tokens := Tokenize(text)
+	for _, r := range text {
+		if IsCJK(r) {
+			tokens = append(tokens, string(r))
+		}
+	}
+	return tokens
+}
+
+// QueryTokens 返回检索用的词元
+// 多字中文检索词只使用二元组，避免单字带来的噪声。
+func QueryTokens(query string) []string {
+	return UniqueTokens(Tokenize(query))
+}
@@ -0,0 +1,44 @@
+package textutil
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "中文按重叠二元组切分", text: "青云宗", want: []string{"青云", "云宗"}},
+		{name: "单个汉字保留为一元词元", text: "剑", want: []string{"剑"}},
+		{name: "标点分隔中文片段", text: "林晚，回头", want: []string{"林晚", "回头"}},
+		{name: "英文数字转小写并与中文分开", text: "第3章Alpha计划", want: []string{"第", "3", "章", "alpha", "计划"}},
+		{name: "空文本", text: "  ", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Tokenize(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexTokens_IncludesSingleCharacters(t *testing.T) {
+	tokens := IndexTokens("玉佩")
+	want := []string{"玉佩", "玉", "佩"}
+	if !reflect.DeepEqual(tokens, want) {
+		t.Fatalf("IndexTokens = %v, want %v", tokens, want)
+	}
+}
+
+func TestQueryTokens_Deduplicates(t *testing.T) {
+	tokens := QueryTokens("玉佩 玉佩 Sword sword")
+	want := []string{"玉佩", "sword"}
+	if !reflect.DeepEqual(tokens, want) {
+		t.Fatalf("QueryTokens = %v, want %v", tokens, want)
+	}
+}
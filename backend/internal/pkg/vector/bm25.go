@@ -0,0 +1,114 @@
+package vector
+
+import (
+	"math"
+
+	"backend/internal/pkg/textutil"
+)
+
+// BM25 参数
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// keywordIndex BM25 关键词倒排索引，中文按二元组与单字切分
+type keywordIndex struct {
+	postings    map[string]map[string]int // 词元 -> 文档ID -> 词频
+	terms       map[string][]string       // 文档ID -> 去重后的词元，用于删除
+	lengths     map[string]int            // 文档ID -> 词元数
+	totalLength int
+}
+
+// keywordMatch 关键词检索结果
+type keywordMatch struct {
+	ID    string
+	Score float32
+}
+
+// newKeywordIndex 创建关键词索引
+func newKeywordIndex() *keywordIndex {
+	return &keywordIndex{
+		postings: make(map[string]map[string]int),
+		terms:    make(map[string][]string),
+		lengths:  make(map[string]int),
+	}
+}
+
+// Add 添加或替换文档
+func (k *keywordIndex) Add(id, content string) {
+	k.Remove(id)
+
+	tokens := textutil.IndexTokens(content)
+	for _, token := range tokens {
+		docs, ok := k.postings[token]
+		if !ok {
+			docs = make(map[string]int)
+			k.postings[token] = docs
+		}
+		docs[id]++
+	}
+	k.terms[id] = textutil.UniqueTokens(tokens)
+	k.lengths[id] = len(tokens)
+	k.totalLength += len(tokens)
+}
+
+// Remove 删除文档
+func (k *keywordIndex) Remove(id string) {
+	length, ok := k.lengths[id]
+	if !ok {
+		return
+	}
+	for _, token := range k.terms[id] {
+		docs := k.postings[token]
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(k.postings, token)
+		}
+	}
+	delete(k.terms, id)
+	delete(k.lengths, id)
+	k.totalLength -= length
+}
+
+// Len 返回文档数
+func (k *keywordIndex) Len() int {
+	return len(k.lengths)
+}
+
+// Search 按 BM25 分数返回前 topK 个满足 accept 的文档，topK <= 0 时返回全部命中文档
+func (k *keywordIndex) Search(query string, topK int, accept func(id string) bool) []keywordMatch {
+	if len(k.lengths) == 0 {
+		return nil
+	}
+
+	n := float64(len(k.lengths))
+	avgLength := float64(k.totalLength) / n
+	scores := make(map[string]float64)
+	for _, token := range textutil.QueryTokens(query) {
+		docs := k.postings[token]
+		if len(docs) == 0 {
+			continue
+		}
+		df := float64(len(docs))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for id, tf := range docs {
+			if accept != nil && !accept(id) {
+				continue
+			}
+			freq := float64(tf)
+			norm := bm25K1 * (1 - bm25B + bm25B*float64(k.lengths[id])/avgLength)
+			scores[id] += idf * freq * (bm25K1 + 1) / (freq + norm)
+		}
+	}
+
+	matches := make([]keywordMatch, 0, len(scores))
+	for id, score := range scores {
+		matches = append(matches, keywordMatch{ID: id, Score: float32(score)})
+	}
+	sortKeywordMatches(matches)
+	if topK > 0 && len(matches) > topK {
+		matches = matches[:topK]
+	}
+	return matches
+}
@@ -0,0 +1,252 @@
+package vector
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ChunkerConfig 分块配置，大小均以估算 token 数计
+type ChunkerConfig struct {
+	TargetTokens  int // 每块目标大小
+	MaxTokens     int // 单句超过该大小时强制切分
+	OverlapTokens int // 相邻块之间重叠的大小，为 0 时使用默认值，为负数时不重叠
+}
+
+// DefaultChunkerConfig 默认分块配置
+func DefaultChunkerConfig() ChunkerConfig {
+	return ChunkerConfig{
+		TargetTokens:  400,
+		MaxTokens:     600,
+		OverlapTokens: 60,
+	}
+}
+
+// withDefaults 补全未设置的参数
+func (c ChunkerConfig) withDefaults() ChunkerConfig {
+	defaults := DefaultChunkerConfig()
+	if c.TargetTokens <= 0 {
+		c.TargetTokens = defaults.TargetTokens
+	}
+	if c.MaxTokens < c.TargetTokens {
+		c.MaxTokens = c.TargetTokens * 3 / 2
+	}
+	if c.OverlapTokens == 0 {
+		c.OverlapTokens = defaults.OverlapTokens
+	}
+	if c.OverlapTokens < 0 {
+		c.OverlapTokens = 0
+	}
+	if c.OverlapTokens >= c.TargetTokens {
+		c.OverlapTokens = c.TargetTokens / 4
+	}
+	return c
+}
+
+// Chunk 文本分块
+type Chunk struct {
+	Index   int    `json:"index"`   // 块序号
+	Content string `json:"content"` // 块内容
+	Start   int    `json:"start"`   // 在原文中的起始字符偏移（按 rune 计）
+	End     int    `json:"end"`     // 在原文中的结束字符偏移（不含）
+	Tokens  int    `json:"tokens"`  // 估算 token 数
+}
+
+// Chunker 中文文本分块器，按段落和句子切分并合并为目标大小的块
+type Chunker struct {
+	config ChunkerConfig
+}
+
+// NewChunker 创建分块器
+func NewChunker(config ChunkerConfig) *Chunker {
+	return &Chunker{config: config.withDefaults()}
+}
+
+// segment 句子片段
+type segment struct {
+	start, end   int // rune 偏移
+	tokens       int
+	paragraphEnd bool // 是否为段落最后一句
+}
+
+// Split 将文本切分为块，相邻块之间保留句子级别的重叠
+func (c *Chunker) Split(text string) []*Chunk {
+	runes := []rune(text)
+	segments := c.splitSegments(runes)
+	if len(segments) == 0 {
+		return nil
+	}
+
+	var chunks []*Chunk
+	var current []segment
+	tokens := 0
+	fresh := 0 // 当前块中不属于上一块重叠部分的句子数
+
+	flush := func() {
+		if fresh == 0 {
+			return
+		}
+		start, end := current[0].start, current[len(current)-1].end
+		chunks = append(chunks, &Chunk{
+			Index:   len(chunks),
+			Content: string(runes[start:end]),
+			Start:   start,
+			End:     end,
+			Tokens:  tokens,
+		})
+
+		// 从块尾部取不超过重叠大小的句子作为下一块的开头
+		keep := len(current)
+		overlap := 0
+		for keep > 1 && overlap+current[keep-1].tokens <= c.config.OverlapTokens {
+			keep--
+			overlap += current[keep].tokens
+		}
+		current = append([]segment(nil), current[keep:]...)
+		tokens = overlap
+		fresh = 0
+	}
+
+	for i, seg := range segments {
+		if fresh > 0 && tokens+seg.tokens > c.config.TargetTokens {
+			flush()
+			// 重叠部分加上当前句超过目标大小时放弃重叠
+			if tokens+seg.tokens > c.config.TargetTokens {
+				current, tokens = nil, 0
+			}
+		}
+		current = append(current, seg)
+		tokens += seg.tokens
+		fresh++
+
+		// 接近目标大小时优先在段落边界切分
+		if seg.paragraphEnd && i < len(segments)-1 && tokens >= c.config.TargetTokens*3/4 {
+			flush()
+		}
+	}
+	flush()
+
+	return chunks
+}
+
+// splitSegments 按段落和句末标点切分句子，超长句子按目标大小强制切分
+func (c *Chunker) splitSegments(runes []rune) []segment {
+	var segments []segment
+	add := func(start, end int, paragraphEnd bool) {
+		for start < end && unicode.IsSpace(runes[start]) {
+			start++
+		}
+		for end > start && unicode.IsSpace(runes[end-1]) {
+			end--
+		}
+		if start == end {
+			if paragraphEnd && len(segments) > 0 {
+				segments[len(segments)-1].paragraphEnd = true
+			}
+			return
+		}
+		segments = append(segments, c.splitLong(runes, start, end, paragraphEnd)...)
+	}
+
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '\n' {
+			add(start, i, true)
+			start = i + 1
+			continue
+		}
+		if !isSentenceEnd(r) {
+			continue
+		}
+		// 连续的句末标点与后引号、括号归入同一句
+		for i+1 < len(runes) && (isSentenceEnd(runes[i+1]) || isClosingMark(runes[i+1])) {
+			i++
+		}
+		add(start, i+1, false)
+		start = i + 1
+	}
+	add(start, len(runes), true)
+
+	return segments
+}
+
+// splitLong 将超过最大大小的句子切成不超过目标大小的片段
+func (c *Chunker) splitLong(runes []rune, start, end int, paragraphEnd bool) []segment {
+	total := EstimateTokens(string(runes[start:end]))
+	if total <= c.config.MaxTokens {
+		return []segment{{start: start, end: end, tokens: total, paragraphEnd: paragraphEnd}}
+	}
+
+	var parts []segment
+	pieceStart, tokens := start, 0
+	inWord := false
+	for i := start; i < end; i++ {
+		if runeStartsToken(runes[i], inWord) {
+			if tokens == c.config.TargetTokens {
+				parts = append(parts, segment{start: pieceStart, end: i, tokens: tokens})
+				pieceStart, tokens = i, 0
+			}
+			tokens++
+		}
+		inWord = isWordRune(runes[i])
+	}
+	parts = append(parts, segment{start: pieceStart, end: end, tokens: tokens, paragraphEnd: paragraphEnd})
+
+	return parts
+}
+
+// EstimateTokens 估算文本的 token 数：每个汉字、标点计 1，连续的字母数字计 1
+func EstimateTokens(text string) int {
+	tokens := 0
+	inWord := false
+	for _, r := range text {
+		if runeStartsToken(r, inWord) {
+			tokens++
+		}
+		inWord = isWordRune(r)
+	}
+	return tokens
+}
+
+// MentionedNames 返回文本中出现过的名字，保持传入顺序
+func MentionedNames(text string, names []string) []string {
+	var mentioned []string
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		if strings.Contains(text, name) {
+			mentioned = append(mentioned, name)
+		}
+	}
+	return mentioned
+}
+
+func runeStartsToken(r rune, inWord bool) bool {
+	if unicode.IsSpace(r) {
+		return false
+	}
+	return !(inWord && isWordRune(r))
+}
+
+func isWordRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+func isSentenceEnd(r rune) bool {
+	switch r {
+	case '。', '！', '？', '；', '…', '!', '?', ';':
+		return true
+	}
+	return false
+}
+
+func isClosingMark(r rune) bool {
+	switch r {
+	case '”', '’', '」', '』', '）', '》', '"', '\'', ')':
+		return true
+	}
+	return false
+}
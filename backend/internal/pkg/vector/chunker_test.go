@@ -0,0 +1,104 @@
+package vector
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChunker_Split(t *testing.T) {
+	paragraph := strings.Repeat("林晚推开木门，屋里一片寂静。", 10) + "她低声问：“有人吗？”\n\n"
+	text := strings.Repeat(paragraph, 6)
+
+	chunker := NewChunker(ChunkerConfig{TargetTokens: 120, MaxTokens: 200, OverlapTokens: 20})
+	chunks := chunker.Split(text)
+	if len(chunks) < 4 {
+		t.Fatalf("Expected at least 4 chunks, got %d", len(chunks))
+	}
+
+	runes := []rune(text)
+	for i, chunk := range chunks {
+		if chunk.Index != i {
+			t.Fatalf("Expected chunk index %d, got %d", i, chunk.Index)
+		}
+		// 偏移应能从原文还原块内容
+		if got := string(runes[chunk.Start:chunk.End]); got != chunk.Content {
+			t.Fatalf("Chunk %d offsets do not match content", i)
+		}
+		if chunk.Tokens > 200 {
+			t.Fatalf("Chunk %d exceeds max tokens: %d", i, chunk.Tokens)
+		}
+		// 块应在句子边界结束，后引号归入前一句
+		if !strings.HasSuffix(chunk.Content, "。") && !strings.HasSuffix(chunk.Content, "”") {
+			t.Fatalf("Chunk %d does not end at a sentence boundary: %q", i, chunk.Content)
+		}
+		if i > 0 && chunk.Start >= chunks[i-1].End {
+			t.Fatalf("Expected chunk %d to overlap the previous chunk", i)
+		}
+	}
+	if chunks[len(chunks)-1].End != len([]rune(strings.TrimSpace(text))) {
+		t.Fatal("Last chunk should end at the end of the text")
+	}
+}
+
+func TestChunker_SplitNoOverlap(t *testing.T) {
+	text := strings.Repeat("风从山谷里吹过来。", 50)
+
+	chunks := NewChunker(ChunkerConfig{TargetTokens: 50, OverlapTokens: -1}).Split(text)
+	for i := 1; i < len(chunks); i++ {
+		if chunks[i].Start < chunks[i-1].End {
+			t.Fatalf("Chunk %d overlaps the previous chunk", i)
+		}
+	}
+
+	var joined strings.Builder
+	for _, chunk := range chunks {
+		joined.WriteString(chunk.Content)
+	}
+	if joined.String() != text {
+		t.Fatal("Chunks without overlap should reassemble the original text")
+	}
+}
+
+func TestChunker_SplitLongSentence(t *testing.T) {
+	text := strings.Repeat("长", 1000)
+
+	chunks := NewChunker(ChunkerConfig{TargetTokens: 300, MaxTokens: 400}).Split(text)
+	if len(chunks) != 4 {
+		t.Fatalf("Expected 4 chunks, got %d", len(chunks))
+	}
+	for _, chunk := range chunks {
+		if chunk.Tokens > 300 {
+			t.Fatalf("Chunk exceeds target tokens: %d", chunk.Tokens)
+		}
+	}
+}
+
+func TestChunker_SplitEmpty(t *testing.T) {
+	if chunks := NewChunker(DefaultChunkerConfig()).Split(" \n\n "); len(chunks) != 0 {
+		t.Fatalf("Expected no chunks, got %d", len(chunks))
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"你好，世界。", 6},
+		{"hello world", 2},
+		{"第3章 GPT4", 4},
+	}
+	for _, tt := range tests {
+		if got := EstimateTokens(tt.text); got != tt.want {
+			t.Errorf("EstimateTokens(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestMentionedNames(t *testing.T) {
+	got := MentionedNames("林晚看着沈舟，没有说话。", []string{"沈舟", "顾言", "林晚", "沈舟", ""})
+	if len(got) != 2 || got[0] != "沈舟" || got[1] != "林晚" {
+		t.Fatalf("Unexpected mentioned names: %v", got)
+	}
+}
@@ -2,7 +2,13 @@ package vector
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"backend/internal/pkg/models"
@@ -52,6 +58,12 @@ type SearchOptions struct {
 	Threshold  float32                `json:"threshold"`    // 相似度阈值
 	Filter     map[string]interface{} `json:"filter"`       // 元数据过滤
 	IncludeEmbedding bool             `json:"include_embedding"`
+
+	// 以下选项仅用于 Search
+	Mode           string    `json:"mode"` // 检索方式：text（默认）/keyword/hybrid
+	QueryEmbedding []float32 `json:"-"`    // hybrid 模式的查询向量，为空时只做关键词检索
+	RRFK           int       `json:"rrf_k"` // 倒数排名融合常数，默认60
+	Reranker       Reranker  `json:"-"`    // 可选的重排序阶段
 }
 
 // SearchResult 搜索结果
@@ -78,14 +90,40 @@ type EmbeddingService interface {
 type RAGService struct {
 	vectorClient     VectorClient
 	embeddingService EmbeddingService
+	chunker          *Chunker
+	reranker         Reranker          // 为 nil 时不重排序
+	rrfK             int
+	groundingTokens  int               // 章节生成相关前文的 token 预算
 	collections      map[string]string // 集合名称映射
 }
 
+// RAGOptions RAG 服务选项
+type RAGOptions struct {
+	Chunking        ChunkerConfig // 章节分块配置
+	RRFK            int           // 混合检索的倒数排名融合常数，默认60
+	Reranker        Reranker      // 混合检索后的重排序阶段，为 nil 时不重排序
+	GroundingTokens int           // 章节生成时相关前文的 token 预算，默认1500
+}
+
 // NewRAGService 创建 RAG 服务
 func NewRAGService(vectorClient VectorClient, embeddingService EmbeddingService) *RAGService {
+	return NewRAGServiceWithChunker(vectorClient, embeddingService, DefaultChunkerConfig())
+}
+
+// NewRAGServiceWithChunker 创建使用指定分块配置的 RAG 服务
+func NewRAGServiceWithChunker(vectorClient VectorClient, embeddingService EmbeddingService, chunking ChunkerConfig) *RAGService {
+	return NewRAGServiceWithOptions(vectorClient, embeddingService, RAGOptions{Chunking: chunking})
+}
+
+// NewRAGServiceWithOptions 创建使用指定分块与检索配置的 RAG 服务
+func NewRAGServiceWithOptions(vectorClient VectorClient, embeddingService EmbeddingService, options RAGOptions) *RAGService {
 	return &RAGService{
 		vectorClient:     vectorClient,
 		embeddingService: embeddingService,
+		chunker:          NewChunker(options.Chunking),
+		reranker:         options.Reranker,
+		rrfK:             options.RRFK,
+		groundingTokens:  options.GroundingTokens,
 		collections: map[string]string{
 			"worldview":   "novel_worldview",
 			"character":   "novel_character", 
@@ -99,8 +137,20 @@ func NewRAGService(vectorClient VectorClient, embeddingService EmbeddingService)
 // InitializeCollections 初始化集合
 func (r *RAGService) InitializeCollections(ctx context.Context) error {
 	dimension := r.embeddingService.GetDimension()
-	
+
+	existing, err := r.vectorClient.ListCollections(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list collections: %w", err)
+	}
+	exists := make(map[string]bool, len(existing))
+	for _, name := range existing {
+		exists[name] = true
+	}
+
 	for _, collection := range r.collections {
+		if exists[collection] {
+			continue
+		}
 		err := r.vectorClient.CreateCollection(ctx, collection, dimension)
 		if err != nil {
 			return fmt.Errorf("failed to create collection %s: %w", collection, err)
@@ -121,6 +171,17 @@ func (r *RAGService) AddWorldView(ctx context.Context, worldView *models.WorldVi
 `, worldView.Title, worldView.Synopsis, worldView.Setting,
 		joinStrings(worldView.KeyRules, "；"), joinStrings(worldView.Themes, "；"))
 
+	// 内容未变化时跳过重新嵌入
+	hash := contentHash(worldView.ProjectID, content, joinStrings(worldView.KeyRules, "\n"))
+	if r.unchanged(ctx, worldView.ID, hash) {
+		return nil
+	}
+
+	// 先写入规则，主文档记录的内容哈希代表规则也已写入
+	if err := r.addWorldRules(ctx, worldView); err != nil {
+		return err
+	}
+
 	embedding, err := r.embeddingService.Embed(ctx, content)
 	if err != nil {
 		return fmt.Errorf("failed to generate embedding: %w", err)
@@ -131,9 +192,10 @@ func (r *RAGService) AddWorldView(ctx context.Context, worldView *models.WorldVi
 		Content:   content,
 		Embedding: embedding,
 		Metadata: map[string]interface{}{
-			"type":       "worldview",
-			"project_id": worldView.ProjectID,
-			"title":      worldView.Title,
+			"type":         "worldview",
+			"project_id":   worldView.ProjectID,
+			"title":        worldView.Title,
+			"content_hash": hash,
 		},
 		Collection: r.collections["worldview"],
 		CreatedAt:  time.Now(),
@@ -143,6 +205,86 @@ func (r *RAGService) AddWorldView(ctx context.Context, worldView *models.WorldVi
 	return r.vectorClient.AddDocument(ctx, doc)
 }
 
+// addWorldRules 将核心规则逐条写入向量库，便于生成章节时只检索适用的规则，替换之前写入的全部规则
+func (r *RAGService) addWorldRules(ctx context.Context, worldView *models.WorldView) error {
+	if err := r.deleteWorldRules(ctx, worldView.ID); err != nil {
+		return err
+	}
+
+	var rules []string
+	for _, rule := range worldView.KeyRules {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	embeddings, err := r.embeddingService.EmbedBatch(ctx, rules)
+	if err != nil {
+		return fmt.Errorf("failed to generate embedding: %w", err)
+	}
+	if len(embeddings) != len(rules) {
+		return fmt.Errorf("failed to generate embedding: expected %d embeddings, got %d", len(rules), len(embeddings))
+	}
+
+	now := time.Now()
+	docs := make([]*Document, len(rules))
+	for i, rule := range rules {
+		docs[i] = &Document{
+			ID:        worldRuleID(worldView.ID, i),
+			Content:   rule,
+			Embedding: embeddings[i],
+			Metadata: map[string]interface{}{
+				"type":         models.RetrievalWorldRule,
+				"project_id":   worldView.ProjectID,
+				"worldview_id": worldView.ID,
+				"rule_index":   i,
+			},
+			Collection: r.collections["worldview"],
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+	}
+	return r.vectorClient.BatchAdd(ctx, docs)
+}
+
+// DeleteWorldView 删除世界观文档及其规则
+func (r *RAGService) DeleteWorldView(ctx context.Context, worldViewID string) error {
+	if err := r.vectorClient.BatchDelete(ctx, []string{worldViewID}); err != nil {
+		return fmt.Errorf("failed to delete worldview: %w", err)
+	}
+	return r.deleteWorldRules(ctx, worldViewID)
+}
+
+// deleteWorldRules 删除世界观已写入的规则文档
+func (r *RAGService) deleteWorldRules(ctx context.Context, worldViewID string) error {
+	results, err := r.listDocuments(ctx, r.collections["worldview"], map[string]interface{}{
+		"worldview_id": worldViewID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list world rules: %w", err)
+	}
+	if len(results) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result.Document.ID
+	}
+	if err := r.vectorClient.BatchDelete(ctx, ids); err != nil {
+		return fmt.Errorf("failed to delete world rules: %w", err)
+	}
+	return nil
+}
+
+// worldRuleID 世界规则的文档ID
+func worldRuleID(worldViewID string, ruleIndex int) string {
+	return fmt.Sprintf("%s#rule-%d", worldViewID, ruleIndex)
+}
+
 // AddCharacter 添加人物文档
 func (r *RAGService) AddCharacter(ctx context.Context, character *models.Character) error {
 	content := fmt.Sprintf(`
@@ -159,6 +301,11 @@ func (r *RAGService) AddCharacter(ctx context.Context, character *models.Charact
 		character.Background, character.Motivation, joinStrings(character.Flaws, "；"),
 		character.SpeechTone, joinStrings(character.Secrets, "；"))
 
+	hash := contentHash(character.ProjectID, character.Name, character.Role, content)
+	if r.unchanged(ctx, character.ID, hash) {
+		return nil
+	}
+
 	embedding, err := r.embeddingService.Embed(ctx, content)
 	if err != nil {
 		return fmt.Errorf("failed to generate embedding: %w", err)
@@ -169,10 +316,11 @@ func (r *RAGService) AddCharacter(ctx context.Context, character *models.Charact
 		Content:   content,
 		Embedding: embedding,
 		Metadata: map[string]interface{}{
-			"type":       "character",
-			"project_id": character.ProjectID,
-			"name":       character.Name,
-			"role":       character.Role,
+			"type":         "character",
+			"project_id":   character.ProjectID,
+			"name":         character.Name,
+			"role":         character.Role,
+			"content_hash": hash,
 		},
 		Collection: r.collections["character"],
 		CreatedAt:  time.Now(),
@@ -182,44 +330,164 @@ func (r *RAGService) AddCharacter(ctx context.Context, character *models.Charact
 	return r.vectorClient.AddDocument(ctx, doc)
 }
 
-// AddChapter 添加章节文档
-func (r *RAGService) AddChapter(ctx context.Context, chapter *models.Chapter) error {
+// AddChapter 将章节切分为块后写入向量库，替换该章节之前的全部分块
+// 传入人物卡时，每个块的元数据会记录其中出现的人物；章节内容与人物均未变化时跳过
+func (r *RAGService) AddChapter(ctx context.Context, chapter *models.Chapter, characters ...*models.Character) error {
 	content := chapter.PolishedContent
 	if content == "" {
 		content = chapter.RawContent
 	}
 
-	// 为长章节创建摘要
-	if len(content) > 2000 {
-		content = fmt.Sprintf("标题：%s\n摘要：%s\n内容片段：%s", 
-			chapter.Title, chapter.Summary, content[:2000])
+	names := make([]string, 0, len(characters))
+	for _, character := range characters {
+		names = append(names, character.Name)
 	}
 
-	embedding, err := r.embeddingService.Embed(ctx, content)
+	hash := contentHash(chapter.ProjectID, strconv.Itoa(chapter.Index), chapter.Title, strconv.Itoa(chapter.WordCount),
+		content, joinStrings(names, "\n"), fmt.Sprintf("%+v", r.chunker.config))
+	if r.chapterUnchanged(ctx, chapter.ID, hash) {
+		return nil
+	}
+
+	if err := r.deleteChapterChunks(ctx, chapter.ID); err != nil {
+		return err
+	}
+
+	chunks := r.chunker.Split(content)
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	// 嵌入时带上章节标题，便于区分不同章节的相似片段
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = fmt.Sprintf("第%d章 %s\n%s", chapter.Index, chapter.Title, chunk.Content)
+	}
+	embeddings, err := r.embeddingService.EmbedBatch(ctx, texts)
 	if err != nil {
 		return fmt.Errorf("failed to generate embedding: %w", err)
 	}
+	if len(embeddings) != len(chunks) {
+		return fmt.Errorf("failed to generate embedding: expected %d embeddings, got %d", len(chunks), len(embeddings))
+	}
 
-	doc := &Document{
-		ID:        chapter.ID,
-		Content:   content,
-		Embedding: embedding,
-		Metadata: map[string]interface{}{
-			"type":       "chapter",
-			"project_id": chapter.ProjectID,
-			"index":      chapter.Index,
-			"title":      chapter.Title,
-			"word_count": chapter.WordCount,
-		},
-		Collection: r.collections["chapter"],
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
+	now := time.Now()
+	docs := make([]*Document, len(chunks))
+	for i, chunk := range chunks {
+		mentioned := MentionedNames(chunk.Content, names)
+		if mentioned == nil {
+			mentioned = []string{}
+		}
+		docs[i] = &Document{
+			ID:        chapterChunkID(chapter.ID, chunk.Index),
+			Content:   chunk.Content,
+			Embedding: embeddings[i],
+			Metadata: map[string]interface{}{
+				"type":         "chapter",
+				"project_id":   chapter.ProjectID,
+				"chapter_id":   chapter.ID,
+				"index":        chapter.Index,
+				"title":        chapter.Title,
+				"word_count":   chapter.WordCount,
+				"chunk_index":  chunk.Index,
+				"chunk_count":  len(chunks),
+				"start_offset": chunk.Start,
+				"end_offset":   chunk.End,
+				"tokens":       chunk.Tokens,
+				"characters":   mentioned,
+				"content_hash": hash,
+			},
+			Collection: r.collections["chapter"],
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
 	}
 
-	return r.vectorClient.AddDocument(ctx, doc)
+	return r.vectorClient.BatchAdd(ctx, docs)
+}
+
+// DeleteChapter 删除章节的全部分块
+func (r *RAGService) DeleteChapter(ctx context.Context, chapterID string) error {
+	return r.deleteChapterChunks(ctx, chapterID)
 }
 
-// SearchRelevantContext 搜索相关上下文
+// DeleteDocuments 按文档ID删除世界观、人物等文档
+func (r *RAGService) DeleteDocuments(ctx context.Context, ids []string) error {
+	return r.vectorClient.BatchDelete(ctx, ids)
+}
+
+// deleteChapterChunks 删除章节已有的分块（包括未分块时以章节ID写入的整章文档）
+func (r *RAGService) deleteChapterChunks(ctx context.Context, chapterID string) error {
+	results, err := r.listDocuments(ctx, r.collections["chapter"], map[string]interface{}{
+		"chapter_id": chapterID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list chapter chunks: %w", err)
+	}
+
+	ids := []string{chapterID}
+	for _, result := range results {
+		ids = append(ids, result.Document.ID)
+	}
+	if err := r.vectorClient.BatchDelete(ctx, ids); err != nil {
+		return fmt.Errorf("failed to delete chapter chunks: %w", err)
+	}
+	return nil
+}
+
+// listDocuments 列出集合中满足过滤条件的全部文档
+func (r *RAGService) listDocuments(ctx context.Context, collection string, filter map[string]interface{}) ([]*SearchResult, error) {
+	return r.vectorClient.Search(ctx, "", &SearchOptions{
+		Collection: collection,
+		TopK:       math.MaxInt32,
+		Filter:     filter,
+	})
+}
+
+// unchanged 判断文档是否已按相同内容写入
+func (r *RAGService) unchanged(ctx context.Context, id, hash string) bool {
+	doc, err := r.vectorClient.GetDocument(ctx, id)
+	if err != nil || doc == nil {
+		return false
+	}
+	stored, _ := doc.Metadata["content_hash"].(string)
+	return stored == hash
+}
+
+// chapterUnchanged 判断章节的全部分块是否已按相同内容写入
+func (r *RAGService) chapterUnchanged(ctx context.Context, chapterID, hash string) bool {
+	results, err := r.listDocuments(ctx, r.collections["chapter"], map[string]interface{}{
+		"chapter_id": chapterID,
+	})
+	if err != nil || len(results) == 0 {
+		return false
+	}
+	for _, result := range results {
+		if stored, _ := result.Document.Metadata["content_hash"].(string); stored != hash {
+			return false
+		}
+	}
+	// 分块写入是一次批量操作，数量不全说明上次写入不完整
+	return len(results) == metadataInt(results[0].Document.Metadata, "chunk_count")
+}
+
+// contentHash 计算写入向量库的内容哈希，用于跳过未变化的文档
+func contentHash(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// chapterChunkID 章节分块的文档ID
+func chapterChunkID(chapterID string, chunkIndex int) string {
+	return fmt.Sprintf("%s#%d", chapterID, chunkIndex)
+}
+
+// SearchRelevantContext 搜索相关上下文，融合 BM25 关键词与向量检索结果，配置了重排序时再重排
+// 查询向量生成失败时只使用关键词检索
 func (r *RAGService) SearchRelevantContext(ctx context.Context, query string, projectID string, contextType string, topK int) ([]*SearchResult, error) {
 	collection := r.collections[contextType]
 	if collection == "" {
@@ -229,10 +497,18 @@ func (r *RAGService) SearchRelevantContext(ctx context.Context, query string, pr
 	options := &SearchOptions{
 		Collection: collection,
 		TopK:       topK,
-		Threshold:  0.7, // 相似度阈值
+		Threshold:  0.7, // 向量检索的相似度阈值
 		Filter: map[string]interface{}{
 			"project_id": projectID,
 		},
+		Mode:     SearchModeHybrid,
+		RRFK:     r.rrfK,
+		Reranker: r.reranker,
+	}
+	if strings.TrimSpace(query) != "" {
+		if embedding, err := r.embeddingService.Embed(ctx, query); err == nil {
+			options.QueryEmbedding = embedding
+		}
 	}
 
 	return r.vectorClient.Search(ctx, query, options)
@@ -262,6 +538,7 @@ func (r *RAGService) GetWorldViewContext(ctx context.Context, query string, proj
 		Threshold:  0.7,
 		Filter: map[string]interface{}{
 			"project_id": projectID,
+			"type":       "worldview",
 		},
 	}
 
@@ -305,14 +582,10 @@ func (r *RAGService) BuildContextPrompt(ctx context.Context, projectID string, c
 		}
 	}
 
-	// 获取前面章节上下文
-	prevResults, err := r.GetPreviousChapters(ctx, chapterIndex, projectID, 2)
-	if err == nil && len(prevResults) > 0 {
+	// 获取与当前写作目标最相关的前文片段
+	if chunks := r.searchChapterChunks(ctx, query, projectID, chapterIndex); len(chunks) > 0 {
 		contextParts = append(contextParts, "\n【前情回顾】")
-		for _, result := range prevResults {
-			contextParts = append(contextParts, fmt.Sprintf("第%d章：%s", 
-				result.Document.Metadata["index"], result.Document.Content))
-		}
+		contextParts = append(contextParts, assembleChunks(chunks, contextChunkTokens)...)
 	}
 
 	if len(contextParts) == 0 {
@@ -322,6 +595,119 @@ func (r *RAGService) BuildContextPrompt(ctx context.Context, projectID string, c
 	return fmt.Sprintf("参考上下文：\n%s\n", joinStrings(contextParts, "\n")), nil
 }
 
+// 前文片段检索参数
+const (
+	contextChunkCandidates = 8    // 参与排序的候选片段数
+	contextChunkTokens     = 1200 // 前情回顾的 token 预算
+)
+
+// searchChapterChunks 检索当前章节之前与查询最相关的章节片段；查询为空或嵌入失败时退回最近章节的片段
+func (r *RAGService) searchChapterChunks(ctx context.Context, query string, projectID string, chapterIndex int) []*SearchResult {
+	options := &SearchOptions{
+		Collection: r.collections["chapter"],
+		TopK:       contextChunkCandidates,
+		Threshold:  -1,
+		Filter: map[string]interface{}{
+			"project_id": projectID,
+			"index":      map[string]interface{}{"$lt": chapterIndex},
+		},
+	}
+
+	if strings.TrimSpace(query) != "" {
+		embedding, err := r.embeddingService.Embed(ctx, query)
+		if err == nil {
+			results, err := r.vectorClient.SimilaritySearch(ctx, embedding, options)
+			if err == nil && len(results) > 0 {
+				return results
+			}
+		}
+	}
+
+	// 退回到紧邻的前两章
+	recent, err := r.listDocuments(ctx, options.Collection, options.Filter)
+	if err != nil {
+		return nil
+	}
+	sort.SliceStable(recent, func(i, j int) bool {
+		return chunkOrder(recent[i].Document, recent[j].Document) > 0
+	})
+	chapters := make(map[int]bool)
+	var results []*SearchResult
+	for _, result := range recent {
+		index := metadataInt(result.Document.Metadata, "index")
+		if !chapters[index] && len(chapters) == 2 {
+			break
+		}
+		chapters[index] = true
+		results = append(results, result)
+	}
+	return results
+}
+
+// assembleChunks 按相关度在 token 预算内挑选片段，再按章节和原文顺序拼接，同一章节相邻或重叠的片段合并输出
+func assembleChunks(results []*SearchResult, budget int) []string {
+	var selected []*Document
+	used := 0
+	for _, result := range results {
+		tokens := EstimateTokens(result.Document.Content)
+		if used+tokens > budget && len(selected) > 0 {
+			continue
+		}
+		selected = append(selected, result.Document)
+		used += tokens
+	}
+
+	sort.SliceStable(selected, func(i, j int) bool {
+		return chunkOrder(selected[i], selected[j]) < 0
+	})
+
+	var parts []string
+	for i := 0; i < len(selected); {
+		doc := selected[i]
+		index := metadataInt(doc.Metadata, "index")
+		content := doc.Content
+		end := metadataInt(doc.Metadata, "end_offset")
+
+		// 合并同一章节中首尾相接或重叠的后续片段
+		j := i + 1
+		for ; j < len(selected); j++ {
+			next := selected[j]
+			if metadataInt(next.Metadata, "index") != index {
+				break
+			}
+			start := metadataInt(next.Metadata, "start_offset")
+			if start > end {
+				break
+			}
+			nextRunes := []rune(next.Content)
+			if skip := end - start; skip < len(nextRunes) {
+				content += string(nextRunes[skip:])
+			}
+			if nextEnd := metadataInt(next.Metadata, "end_offset"); nextEnd > end {
+				end = nextEnd
+			}
+		}
+
+		parts = append(parts, fmt.Sprintf("第%d章《%v》片段：%s", index, doc.Metadata["title"], content))
+		i = j
+	}
+	return parts
+}
+
+// chunkOrder 按章节索引和原文偏移比较两个片段
+func chunkOrder(a, b *Document) int {
+	if ai, bi := metadataInt(a.Metadata, "index"), metadataInt(b.Metadata, "index"); ai != bi {
+		return ai - bi
+	}
+	return metadataInt(a.Metadata, "start_offset") - metadataInt(b.Metadata, "start_offset")
+}
+
+// metadataInt 读取数值类型的元数据
+func metadataInt(metadata map[string]interface{}, key string) int {
+	f, _ := toFloat(metadata[key])
+	return int(f)
+}
+
 // UpdateProject 更新项目所有文档
 func (r *RAGService) UpdateProject(ctx context.Context, project *models.NovelProject) error {
 	// 更新世界观
@@ -340,7 +726,7 @@ func (r *RAGService) UpdateProject(ctx context.Context, project *models.NovelPro
 
 	// 更新章节
 	for _, chapter := range project.Chapters {
-		if err := r.AddChapter(ctx, chapter); err != nil {
+		if err := r.AddChapter(ctx, chapter, project.Characters...); err != nil {
 			return fmt.Errorf("failed to update chapter %d: %w", chapter.Index, err)
 		}
 	}
@@ -352,15 +738,9 @@ func (r *RAGService) UpdateProject(ctx context.Context, project *models.NovelPro
 func (r *RAGService) DeleteProject(ctx context.Context, projectID string) error {
 	// 获取项目相关的所有文档ID
 	for _, collection := range r.collections {
-		options := &SearchOptions{
-			Collection: collection,
-			TopK:       1000, // 获取所有相关文档
-			Filter: map[string]interface{}{
-				"project_id": projectID,
-			},
-		}
-
-		results, err := r.vectorClient.Search(ctx, "", options)
+		results, err := r.listDocuments(ctx, collection, map[string]interface{}{
+			"project_id": projectID,
+		})
 		if err != nil {
 			continue // 忽略错误，继续删除其他集合
 		}
@@ -386,15 +766,9 @@ func (r *RAGService) GetStats(ctx context.Context, projectID string) (map[string
 	stats := make(map[string]int)
 
 	for contextType, collection := range r.collections {
-		options := &SearchOptions{
-			Collection: collection,
-			TopK:       1000,
-			Filter: map[string]interface{}{
-				"project_id": projectID,
-			},
-		}
-
-		results, err := r.vectorClient.Search(ctx, "", options)
+		results, err := r.listDocuments(ctx, collection, map[string]interface{}{
+			"project_id": projectID,
+		})
 		if err != nil {
 			stats[contextType] = 0
 		} else {
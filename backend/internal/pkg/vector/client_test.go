@@ -0,0 +1,229 @@
+package vector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"backend/internal/pkg/models"
+)
+
+func newTestRAGService(t *testing.T) *RAGService {
+	t.Helper()
+	service := NewRAGServiceWithChunker(NewMemoryVectorClient(), NewLocalEmbeddingService(64),
+		ChunkerConfig{TargetTokens: 80, OverlapTokens: 10})
+	if err := service.InitializeCollections(context.Background()); err != nil {
+		t.Fatalf("Failed to initialize collections: %v", err)
+	}
+	return service
+}
+
+func newTestChapter(index int, sentence string, repeat int) *models.Chapter {
+	return &models.Chapter{
+		ID:         fmt.Sprintf("chapter-%d", index),
+		ProjectID:  "project-1",
+		Index:      index,
+		Title:      fmt.Sprintf("标题%d", index),
+		RawContent: strings.Repeat(sentence, repeat),
+	}
+}
+
+func TestRAGService_AddChapterChunks(t *testing.T) {
+	ctx := context.Background()
+	service := newTestRAGService(t)
+	characters := []*models.Character{{Name: "林晚"}, {Name: "沈舟"}}
+
+	chapter := newTestChapter(1, "林晚走进院子，看见一地落叶。", 30)
+	chapter.RawContent += "沈舟站在门口。"
+	if err := service.AddChapter(ctx, chapter, characters...); err != nil {
+		t.Fatalf("Failed to add chapter: %v", err)
+	}
+
+	chunks, err := service.listDocuments(ctx, "novel_chapter", map[string]interface{}{"chapter_id": chapter.ID})
+	if err != nil {
+		t.Fatalf("Failed to list chunks: %v", err)
+	}
+	if len(chunks) < 3 {
+		t.Fatalf("Expected chapter to be split into several chunks, got %d", len(chunks))
+	}
+
+	content := []rune(chapter.RawContent)
+	for _, result := range chunks {
+		doc := result.Document
+		start := metadataInt(doc.Metadata, "start_offset")
+		end := metadataInt(doc.Metadata, "end_offset")
+		if string(content[start:end]) != doc.Content {
+			t.Fatalf("Chunk %s offsets do not match content", doc.ID)
+		}
+		if doc.Metadata["index"] != 1 || doc.Metadata["project_id"] != "project-1" {
+			t.Fatalf("Unexpected chunk metadata: %v", doc.Metadata)
+		}
+	}
+
+	// 按出现的人物过滤分块
+	withShen, err := service.listDocuments(ctx, "novel_chapter", map[string]interface{}{
+		"characters": map[string]interface{}{"$contains": "沈舟"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to filter chunks: %v", err)
+	}
+	if len(withShen) != 1 || !strings.Contains(withShen[0].Document.Content, "沈舟") {
+		t.Fatalf("Expected exactly one chunk mentioning 沈舟, got %d", len(withShen))
+	}
+
+	// 重新写入较短的章节时应替换旧分块
+	chapter.RawContent = "林晚关上了门。"
+	if err := service.AddChapter(ctx, chapter, characters...); err != nil {
+		t.Fatalf("Failed to re-add chapter: %v", err)
+	}
+	chunks, err = service.listDocuments(ctx, "novel_chapter", map[string]interface{}{"chapter_id": chapter.ID})
+	if err != nil {
+		t.Fatalf("Failed to list chunks: %v", err)
+	}
+	if len(chunks) != 1 {
+		t.Fatalf("Expected old chunks to be replaced, got %d chunks", len(chunks))
+	}
+}
+
+func TestRAGService_BuildContextPrompt(t *testing.T) {
+	ctx := context.Background()
+	service := newTestRAGService(t)
+
+	for i := 1; i <= 3; i++ {
+		chapter := newTestChapter(i, fmt.Sprintf("第%d章里发生了一些事情。", i), 20)
+		if err := service.AddChapter(ctx, chapter); err != nil {
+			t.Fatalf("Failed to add chapter %d: %v", i, err)
+		}
+	}
+
+	for _, query := range []string{"发生了什么", ""} {
+		prompt, err := service.BuildContextPrompt(ctx, "project-1", 3, query)
+		if err != nil {
+			t.Fatalf("Failed to build context prompt: %v", err)
+		}
+		if !strings.Contains(prompt, "【前情回顾】") {
+			t.Fatalf("Expected previous chapter context, got %q", prompt)
+		}
+		if strings.Contains(prompt, "第3章里") {
+			t.Fatal("Context should not include the current or later chapters")
+		}
+		if EstimateTokens(prompt) > contextChunkTokens+200 {
+			t.Fatalf("Context exceeds token budget: %d", EstimateTokens(prompt))
+		}
+	}
+}
+
+func TestAssembleChunks_MergesOverlap(t *testing.T) {
+	text := []rune("一二三四五六七八九十")
+	chunk := func(start, end int, score float32) *SearchResult {
+		return &SearchResult{
+			Score: score,
+			Document: &Document{
+				Content: string(text[start:end]),
+				Metadata: map[string]interface{}{
+					"index": 1, "title": "开端", "start_offset": start, "end_offset": end,
+				},
+			},
+		}
+	}
+
+	parts := assembleChunks([]*SearchResult{chunk(4, 8, 0.9), chunk(0, 5, 0.8)}, 100)
+	if len(parts) != 1 || parts[0] != "第1章《开端》片段：一二三四五六七八" {
+		t.Fatalf("Unexpected assembled chunks: %v", parts)
+	}
+}
+
+func TestRAGService_RetrieveChapterGrounding(t *testing.T) {
+	ctx := context.Background()
+	service := newTestRAGService(t)
+	characters := []*models.Character{{Name: "林晚"}, {Name: "沈舟"}}
+
+	chapters := []*models.Chapter{
+		newTestChapter(1, "林晚在旧宅的抽屉里找到一枚铜钥匙。", 1),
+		newTestChapter(2, "沈舟独自赶往渡口。", 1),
+		newTestChapter(3, "林晚与沈舟在集市重逢。", 1),
+		newTestChapter(4, "林晚一个人回到了山上。", 1),
+	}
+	for _, chapter := range chapters {
+		if err := service.AddChapter(ctx, chapter, characters...); err != nil {
+			t.Fatalf("Failed to add chapter %d: %v", chapter.Index, err)
+		}
+	}
+	if err := service.AddWorldView(ctx, &models.WorldView{
+		ID:        "worldview-1",
+		ProjectID: "project-1",
+		Title:     "山海",
+		KeyRules:  []string{"铜钥匙只能打开旧宅的门", "渡口每晚子时封闭"},
+	}); err != nil {
+		t.Fatalf("Failed to add worldview: %v", err)
+	}
+
+	outline := &models.ChapterOutline{
+		Index:          4,
+		Title:          "归来",
+		Summary:        "沈舟带着林晚回到旧宅",
+		ImportantItems: []string{"铜钥匙"},
+	}
+	grounding, err := service.RetrieveChapterGrounding(ctx, &GroundingRequest{
+		ProjectID:  "project-1",
+		Outline:    outline,
+		Characters: characters,
+	})
+	if err != nil {
+		t.Fatalf("Failed to retrieve grounding: %v", err)
+	}
+
+	byKind := make(map[string][]*models.RetrievedChunk)
+	for _, chunk := range grounding.Chunks {
+		byKind[chunk.Kind] = append(byKind[chunk.Kind], chunk)
+	}
+
+	// 两个人物最近一次出场都在第3章的同一片段，合并为一条
+	scenes := byKind[models.RetrievalCharacterScene]
+	if len(scenes) != 1 || scenes[0].ChapterIndex != 3 || len(scenes[0].Subjects) != 2 {
+		t.Fatalf("Expected merged character scene from chapter 3, got %+v", scenes)
+	}
+	items := byKind[models.RetrievalItemMention]
+	if len(items) != 1 || items[0].ChapterIndex != 1 || items[0].Subjects[0] != "铜钥匙" {
+		t.Fatalf("Expected item mention from chapter 1, got %+v", items)
+	}
+	rules := byKind[models.RetrievalWorldRule]
+	if len(rules) == 0 || rules[0].Content != "铜钥匙只能打开旧宅的门" {
+		t.Fatalf("Expected the key rule to rank first, got %+v", rules)
+	}
+	if !strings.Contains(grounding.Prompt, "第3章《标题3》（涉及：林晚、沈舟）") {
+		t.Fatalf("Unexpected grounding prompt: %q", grounding.Prompt)
+	}
+	if strings.Contains(grounding.Prompt, "回到了山上") {
+		t.Fatal("Grounding should not include the current or later chapters")
+	}
+
+	// 放不下的片段被跳过，更靠后的短片段仍可写入
+	budget := rules[0].Tokens + 1
+	limited, err := service.RetrieveChapterGrounding(ctx, &GroundingRequest{
+		ProjectID:   "project-1",
+		Outline:     outline,
+		Characters:  characters,
+		TokenBudget: budget,
+	})
+	if err != nil {
+		t.Fatalf("Failed to retrieve limited grounding: %v", err)
+	}
+	used := 0
+	for _, chunk := range limited.Chunks {
+		used += chunk.Tokens
+	}
+	if len(limited.Chunks) == 0 || len(limited.Chunks) >= len(grounding.Chunks) || used > budget {
+		t.Fatalf("Expected grounding to be trimmed to %d tokens, got %d chunks using %d", budget, len(limited.Chunks), used)
+	}
+
+	// 删除世界观时同时删除规则
+	if err := service.DeleteWorldView(ctx, "worldview-1"); err != nil {
+		t.Fatalf("Failed to delete worldview: %v", err)
+	}
+	remaining, err := service.listDocuments(ctx, "novel_worldview", map[string]interface{}{"project_id": "project-1"})
+	if err != nil || len(remaining) != 0 {
+		t.Fatalf("Expected worldview and rules to be deleted, got %d (%v)", len(remaining), err)
+	}
+}
@@ -12,7 +12,7 @@ import (
 
 // EmbeddingConfig 嵌入服务配置
 type EmbeddingConfig struct {
-	Provider string `json:"provider"` // openai, azure, deepseek, local
+	Provider string `json:"provider"` // openai, azure, deepseek, local, ngram
 	APIKey   string `json:"api_key"`
 	BaseURL  string `json:"base_url"`
 	Model    string `json:"model"`
@@ -297,7 +297,15 @@ func (f *EmbeddingServiceFactory) CreateEmbeddingService(config *EmbeddingConfig
 			}
 		}
 		return NewLocalEmbeddingService(dimension), nil
-		
+
+	case "ngram":
+		// 离线 n-gram TF-IDF 嵌入，例如 "ngram-512"
+		dimension := 0
+		if strings.HasPrefix(config.Model, "ngram-") {
+			fmt.Sscanf(config.Model, "ngram-%d", &dimension)
+		}
+		return NewNgramEmbeddingService(NgramEmbeddingConfig{Dimension: dimension}), nil
+
 	default:
 		return nil, fmt.Errorf("unsupported embedding provider: %s", config.Provider)
 	}
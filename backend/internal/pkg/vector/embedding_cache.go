@@ -0,0 +1,432 @@
+package vector
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+)
+
+// 嵌入缓存与批处理参数
+const (
+	defaultEmbeddingBatchSize = 64                    // 单次 EmbedBatch 的默认文本数上限
+	embeddingBatchLinger      = 10 * time.Millisecond // 后台队列凑批的最长等待时间
+	embeddingBatchTimeout     = 2 * time.Minute       // 后台批量请求的超时时间
+	embeddingCacheLoadBatch   = 500                   // 单条 SQL 查询的缓存键数量
+	embeddingCacheFile        = "embedding_cache.db"  // 默认缓存文件名，与向量库放在同一目录
+)
+
+// EmbeddingCacheStore 嵌入缓存存储，键为模型标识与文本内容的哈希
+type EmbeddingCacheStore interface {
+	// Load 批量读取缓存，只返回命中的键
+	Load(ctx context.Context, keys []string) (map[string][]float32, error)
+	// Save 批量写入缓存，已存在的键会被覆盖
+	Save(ctx context.Context, entries map[string][]float32) error
+	// Close 关闭存储
+	Close() error
+}
+
+// MemoryEmbeddingCacheStore 进程内嵌入缓存，重启后失效
+type MemoryEmbeddingCacheStore struct {
+	mu      sync.RWMutex
+	entries map[string][]float32
+}
+
+// NewMemoryEmbeddingCacheStore 创建进程内嵌入缓存
+func NewMemoryEmbeddingCacheStore() *MemoryEmbeddingCacheStore {
+	return &MemoryEmbeddingCacheStore{entries: make(map[string][]float32)}
+}
+
+// Load 批量读取缓存
+func (m *MemoryEmbeddingCacheStore) Load(ctx context.Context, keys []string) (map[string][]float32, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	found := make(map[string][]float32, len(keys))
+	for _, key := range keys {
+		if embedding, ok := m.entries[key]; ok {
+			found[key] = append([]float32(nil), embedding...)
+		}
+	}
+	return found, nil
+}
+
+// Save 批量写入缓存
+func (m *MemoryEmbeddingCacheStore) Save(ctx context.Context, entries map[string][]float32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, embedding := range entries {
+		m.entries[key] = append([]float32(nil), embedding...)
+	}
+	return nil
+}
+
+// Close 关闭存储
+func (m *MemoryEmbeddingCacheStore) Close() error {
+	return nil
+}
+
+// embeddingCacheEntry 嵌入缓存表，向量以 float32 小端序 BLOB 存储
+type embeddingCacheEntry struct {
+	Key       string `gorm:"primaryKey;type:varchar(64)"`
+	Embedding []byte `gorm:"type:blob"`
+	CreatedAt time.Time
+}
+
+// TableName 表名
+func (embeddingCacheEntry) TableName() string {
+	return "embedding_cache"
+}
+
+// SQLiteEmbeddingCacheStore 持久化到 SQLite 的嵌入缓存
+type SQLiteEmbeddingCacheStore struct {
+	db *gorm.DB
+}
+
+// NewSQLiteEmbeddingCacheStore 打开（或创建）嵌入缓存文件
+func NewSQLiteEmbeddingCacheStore(path string) (*SQLiteEmbeddingCacheStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("embedding cache path is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create embedding cache directory: %w", err)
+	}
+
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedding cache: %w", err)
+	}
+	if err := db.AutoMigrate(&embeddingCacheEntry{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate embedding cache: %w", err)
+	}
+
+	return &SQLiteEmbeddingCacheStore{db: db}, nil
+}
+
+// Load 批量读取缓存
+func (s *SQLiteEmbeddingCacheStore) Load(ctx context.Context, keys []string) (map[string][]float32, error) {
+	found := make(map[string][]float32, len(keys))
+	for start := 0; start < len(keys); start += embeddingCacheLoadBatch {
+		end := start + embeddingCacheLoadBatch
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		var rows []*embeddingCacheEntry
+		if err := s.db.WithContext(ctx).Where("key IN ?", keys[start:end]).Find(&rows).Error; err != nil {
+			return nil, fmt.Errorf("failed to load embedding cache: %w", err)
+		}
+		for _, row := range rows {
+			found[row.Key] = decodeEmbedding(row.Embedding)
+		}
+	}
+	return found, nil
+}
+
+// Save 批量写入缓存
+func (s *SQLiteEmbeddingCacheStore) Save(ctx context.Context, entries map[string][]float32) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rows := make([]*embeddingCacheEntry, 0, len(entries))
+	for key, embedding := range entries {
+		rows = append(rows, &embeddingCacheEntry{
+			Key:       key,
+			Embedding: encodeEmbedding(embedding),
+			CreatedAt: now,
+		})
+	}
+	err := s.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(rows, 100).Error
+	if err != nil {
+		return fmt.Errorf("failed to save embedding cache: %w", err)
+	}
+	return nil
+}
+
+// Close 关闭缓存文件
+func (s *SQLiteEmbeddingCacheStore) Close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// CachedEmbeddingConfig 嵌入缓存配置
+type CachedEmbeddingConfig struct {
+	Namespace string // 模型标识，参与缓存键，更换模型后旧缓存不再命中
+	BatchSize int    // 单次 EmbedBatch 的最大文本数（提供商上限），默认64
+}
+
+// EmbeddingCacheStats 嵌入缓存命中统计
+type EmbeddingCacheStats struct {
+	Hits   int64 // 命中缓存的文本数
+	Misses int64 // 调用底层服务生成的文本数
+}
+
+// embedRequest 排队等待批量嵌入的单条请求
+type embedRequest struct {
+	ctx    context.Context
+	key    string
+	text   string
+	result chan embedResult
+}
+
+// embedResult 单条嵌入请求的结果
+type embedResult struct {
+	embedding []float32
+	err       error
+}
+
+// CachedEmbeddingService 带内容哈希缓存的嵌入服务
+// 相同模型下相同文本只生成一次向量；并发的单条 Embed 请求由后台队列合并为 EmbedBatch 调用，
+// EmbedBatch 只为未命中的文本去重后按提供商上限分批请求。
+type CachedEmbeddingService struct {
+	inner     EmbeddingService
+	store     EmbeddingCacheStore
+	namespace string
+	batchSize int
+
+	queue     chan *embedRequest
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewCachedEmbeddingService 创建带缓存的嵌入服务并启动后台批处理队列，store 为 nil 时使用进程内缓存
+func NewCachedEmbeddingService(inner EmbeddingService, store EmbeddingCacheStore, config CachedEmbeddingConfig) *CachedEmbeddingService {
+	if store == nil {
+		store = NewMemoryEmbeddingCacheStore()
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultEmbeddingBatchSize
+	}
+
+	s := &CachedEmbeddingService{
+		inner:     inner,
+		store:     store,
+		namespace: config.Namespace,
+		batchSize: config.BatchSize,
+		queue:     make(chan *embedRequest),
+		done:      make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.run()
+	return s
+}
+
+// Embed 生成文本嵌入，未命中缓存时进入后台队列与其他请求合并生成
+func (s *CachedEmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	if text == "" {
+		return nil, fmt.Errorf("text cannot be empty")
+	}
+
+	key := s.cacheKey(text)
+	if found, err := s.store.Load(ctx, []string{key}); err == nil {
+		if embedding, ok := found[key]; ok {
+			s.hits.Add(1)
+			return embedding, nil
+		}
+	}
+
+	req := &embedRequest{ctx: ctx, key: key, text: text, result: make(chan embedResult, 1)}
+	select {
+	case s.queue <- req:
+	case <-s.done:
+		// 队列已关闭，直接调用底层服务
+		s.misses.Add(1)
+		return s.inner.Embed(ctx, text)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case res := <-req.result:
+		return res.embedding, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// EmbedBatch 批量生成文本嵌入，只为未命中缓存的文本调用底层服务
+func (s *CachedEmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	keys := make([]string, len(texts))
+	for i, text := range texts {
+		if text == "" {
+			return nil, fmt.Errorf("text %d cannot be empty", i)
+		}
+		keys[i] = s.cacheKey(text)
+	}
+
+	found, err := s.store.Load(ctx, keys)
+	if err != nil {
+		// 缓存不可用时退化为直接生成
+		found = make(map[string][]float32)
+	}
+
+	var missKeys, missTexts []string
+	pending := make(map[string]bool)
+	for i, key := range keys {
+		if _, ok := found[key]; ok || pending[key] {
+			continue
+		}
+		pending[key] = true
+		missKeys = append(missKeys, key)
+		missTexts = append(missTexts, texts[i])
+	}
+
+	for start := 0; start < len(missTexts); start += s.batchSize {
+		end := start + s.batchSize
+		if end > len(missTexts) {
+			end = len(missTexts)
+		}
+		generated, err := s.embedMisses(ctx, missKeys[start:end], missTexts[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for key, embedding := range generated {
+			found[key] = embedding
+		}
+	}
+
+	embeddings := make([][]float32, len(texts))
+	for i, key := range keys {
+		embeddings[i] = found[key]
+	}
+	s.hits.Add(int64(len(texts) - len(missTexts)))
+	return embeddings, nil
+}
+
+// GetDimension 获取嵌入维度
+func (s *CachedEmbeddingService) GetDimension() int {
+	return s.inner.GetDimension()
+}
+
+// Ping 健康检查
+func (s *CachedEmbeddingService) Ping(ctx context.Context) error {
+	return s.inner.Ping(ctx)
+}
+
+// Stats 获取缓存命中统计
+func (s *CachedEmbeddingService) Stats() EmbeddingCacheStats {
+	return EmbeddingCacheStats{
+		Hits:   s.hits.Load(),
+		Misses: s.misses.Load(),
+	}
+}
+
+// Close 停止后台队列并关闭缓存存储，正在处理的批次会先完成
+func (s *CachedEmbeddingService) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		s.wg.Wait()
+		err = s.store.Close()
+	})
+	return err
+}
+
+// run 后台批处理循环：收到第一条请求后在短暂等待内继续收集，凑满批次或超时后统一生成
+func (s *CachedEmbeddingService) run() {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case req := <-s.queue:
+			batch := []*embedRequest{req}
+			timer := time.NewTimer(embeddingBatchLinger)
+		collect:
+			for len(batch) < s.batchSize {
+				select {
+				case req := <-s.queue:
+					batch = append(batch, req)
+				case <-timer.C:
+					break collect
+				}
+			}
+			timer.Stop()
+			s.flush(batch)
+		}
+	}
+}
+
+// flush 为一批排队请求生成嵌入并分发结果，已取消的请求不再参与生成
+func (s *CachedEmbeddingService) flush(batch []*embedRequest) {
+	var keys, texts []string
+	waiting := make(map[string][]*embedRequest)
+	for _, req := range batch {
+		if err := req.ctx.Err(); err != nil {
+			req.result <- embedResult{err: err}
+			continue
+		}
+		if _, ok := waiting[req.key]; !ok {
+			keys = append(keys, req.key)
+			texts = append(texts, req.text)
+		}
+		waiting[req.key] = append(waiting[req.key], req)
+	}
+	if len(keys) == 0 {
+		return
+	}
+
+	// 批次由多个调用方共享，不使用单个请求的上下文
+	ctx, cancel := context.WithTimeout(context.Background(), embeddingBatchTimeout)
+	defer cancel()
+
+	generated, err := s.embedMisses(ctx, keys, texts)
+	for key, reqs := range waiting {
+		for _, req := range reqs {
+			if err != nil {
+				req.result <- embedResult{err: err}
+				continue
+			}
+			req.result <- embedResult{embedding: generated[key]}
+		}
+	}
+}
+
+// embedMisses 调用底层服务生成一批未命中缓存的文本并写入缓存
+func (s *CachedEmbeddingService) embedMisses(ctx context.Context, keys, texts []string) (map[string][]float32, error) {
+	embeddings, err := s.inner.EmbedBatch(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate embedding: %w", err)
+	}
+	if len(embeddings) != len(texts) {
+		return nil, fmt.Errorf("failed to generate embedding: expected %d embeddings, got %d", len(texts), len(embeddings))
+	}
+	s.misses.Add(int64(len(texts)))
+
+	generated := make(map[string][]float32, len(keys))
+	for i, key := range keys {
+		generated[key] = embeddings[i]
+	}
+	// 缓存写入失败只影响下次命中，不影响本次结果
+	_ = s.store.Save(ctx, generated)
+	return generated, nil
+}
+
+// cacheKey 缓存键：模型标识与文本内容的 SHA-256
+func (s *CachedEmbeddingService) cacheKey(text string) string {
+	sum := sha256.Sum256([]byte(s.namespace + "\x00" + text))
+	return hex.EncodeToString(sum[:])
+}
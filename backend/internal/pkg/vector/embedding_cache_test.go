@@ -0,0 +1,235 @@
+package vector
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"backend/internal/pkg/models"
+)
+
+// countingEmbeddingService 记录底层调用次数与批次大小
+type countingEmbeddingService struct {
+	*LocalEmbeddingService
+	mu      sync.Mutex
+	texts   int
+	batches []int
+}
+
+func newCountingEmbeddingService() *countingEmbeddingService {
+	return &countingEmbeddingService{LocalEmbeddingService: NewLocalEmbeddingService(32)}
+}
+
+func (c *countingEmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	c.mu.Lock()
+	c.texts++
+	c.batches = append(c.batches, 1)
+	c.mu.Unlock()
+	return c.LocalEmbeddingService.Embed(ctx, text)
+}
+
+func (c *countingEmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	c.mu.Lock()
+	c.texts += len(texts)
+	c.batches = append(c.batches, len(texts))
+	c.mu.Unlock()
+	return c.LocalEmbeddingService.EmbedBatch(ctx, texts)
+}
+
+func (c *countingEmbeddingService) calls() (int, []int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.texts, append([]int(nil), c.batches...)
+}
+
+func TestCachedEmbeddingService_CacheHit(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingEmbeddingService()
+	service := NewCachedEmbeddingService(inner, nil, CachedEmbeddingConfig{Namespace: "test"})
+	defer service.Close()
+
+	first, err := service.Embed(ctx, "林晚走进院子")
+	if err != nil {
+		t.Fatalf("Failed to embed: %v", err)
+	}
+	second, err := service.Embed(ctx, "林晚走进院子")
+	if err != nil {
+		t.Fatalf("Failed to embed: %v", err)
+	}
+	if len(first) != len(second) || first[0] != second[0] {
+		t.Fatal("Cached embedding should equal the generated one")
+	}
+
+	embeddings, err := service.EmbedBatch(ctx, []string{"林晚走进院子", "沈舟站在门口", "沈舟站在门口"})
+	if err != nil {
+		t.Fatalf("Failed to embed batch: %v", err)
+	}
+	if len(embeddings) != 3 || embeddings[1][0] != embeddings[2][0] {
+		t.Fatalf("Unexpected batch result: %d embeddings", len(embeddings))
+	}
+
+	texts, _ := inner.calls()
+	if texts != 2 {
+		t.Fatalf("Expected only 2 distinct texts to reach the provider, got %d", texts)
+	}
+	if stats := service.Stats(); stats.Hits != 3 || stats.Misses != 2 {
+		t.Fatalf("Unexpected cache stats: %+v", stats)
+	}
+}
+
+func TestCachedEmbeddingService_Namespace(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryEmbeddingCacheStore()
+	inner := newCountingEmbeddingService()
+
+	a := NewCachedEmbeddingService(inner, store, CachedEmbeddingConfig{Namespace: "model-a"})
+	defer a.Close()
+	b := NewCachedEmbeddingService(inner, store, CachedEmbeddingConfig{Namespace: "model-b"})
+	defer b.Close()
+
+	if _, err := a.Embed(ctx, "玉佩"); err != nil {
+		t.Fatalf("Failed to embed: %v", err)
+	}
+	if _, err := b.Embed(ctx, "玉佩"); err != nil {
+		t.Fatalf("Failed to embed: %v", err)
+	}
+	if texts, _ := inner.calls(); texts != 2 {
+		t.Fatalf("Different models should not share cached embeddings, got %d provider calls", texts)
+	}
+}
+
+func TestCachedEmbeddingService_PersistsAcrossRestart(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "embedding_cache.db")
+
+	store, err := NewSQLiteEmbeddingCacheStore(path)
+	if err != nil {
+		t.Fatalf("Failed to open cache: %v", err)
+	}
+	inner := newCountingEmbeddingService()
+	service := NewCachedEmbeddingService(inner, store, CachedEmbeddingConfig{Namespace: "test"})
+	want, err := service.EmbedBatch(ctx, []string{"第一段", "第二段"})
+	if err != nil {
+		t.Fatalf("Failed to embed batch: %v", err)
+	}
+	if err := service.Close(); err != nil {
+		t.Fatalf("Failed to close service: %v", err)
+	}
+
+	store, err = NewSQLiteEmbeddingCacheStore(path)
+	if err != nil {
+		t.Fatalf("Failed to reopen cache: %v", err)
+	}
+	inner = newCountingEmbeddingService()
+	service = NewCachedEmbeddingService(inner, store, CachedEmbeddingConfig{Namespace: "test"})
+	defer service.Close()
+
+	got, err := service.EmbedBatch(ctx, []string{"第一段", "第二段"})
+	if err != nil {
+		t.Fatalf("Failed to embed batch: %v", err)
+	}
+	if texts, _ := inner.calls(); texts != 0 {
+		t.Fatalf("Expected cached embeddings after restart, provider was called for %d texts", texts)
+	}
+	for i := range want {
+		if len(got[i]) != len(want[i]) || got[i][3] != want[i][3] {
+			t.Fatalf("Embedding %d changed after restart", i)
+		}
+	}
+}
+
+func TestCachedEmbeddingService_Batching(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingEmbeddingService()
+	service := NewCachedEmbeddingService(inner, nil, CachedEmbeddingConfig{BatchSize: 4})
+	defer service.Close()
+
+	texts := make([]string, 10)
+	for i := range texts {
+		texts[i] = fmt.Sprintf("片段%d", i)
+	}
+	if _, err := service.EmbedBatch(ctx, texts); err != nil {
+		t.Fatalf("Failed to embed batch: %v", err)
+	}
+	if _, batches := inner.calls(); fmt.Sprint(batches) != "[4 4 2]" {
+		t.Fatalf("Expected batches split by provider limit, got %v", batches)
+	}
+
+	// 并发的单条请求由后台队列合并
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := service.Embed(ctx, fmt.Sprintf("并发%d", i)); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("Failed to embed: %v", err)
+	}
+
+	texts2, batches := inner.calls()
+	if texts2 != 18 {
+		t.Fatalf("Expected 18 texts to reach the provider, got %d", texts2)
+	}
+	queued := batches[3:]
+	for _, size := range queued {
+		if size > 4 {
+			t.Fatalf("Queued batch exceeds provider limit: %v", batches)
+		}
+	}
+	if len(queued) >= 8 {
+		t.Fatalf("Expected concurrent requests to be grouped, got batches %v", queued)
+	}
+}
+
+func TestRAGService_UpdateProjectSkipsUnchanged(t *testing.T) {
+	ctx := context.Background()
+	inner := newCountingEmbeddingService()
+	service := NewRAGServiceWithChunker(NewMemoryVectorClient(), inner, ChunkerConfig{TargetTokens: 80, OverlapTokens: 10})
+	if err := service.InitializeCollections(ctx); err != nil {
+		t.Fatalf("Failed to initialize collections: %v", err)
+	}
+
+	characters := []*models.Character{{ID: "c1", ProjectID: "project-1", Name: "林晚"}}
+	project := &models.NovelProject{
+		WorldView:  &models.WorldView{ID: "w1", ProjectID: "project-1", Title: "山城", KeyRules: []string{"夜里不能出城"}},
+		Characters: characters,
+		Chapters: []*models.Chapter{
+			newTestChapter(1, "林晚走进院子，看见一地落叶。", 30),
+			newTestChapter(2, "沈舟站在门口。", 20),
+		},
+	}
+	if err := service.UpdateProject(ctx, project); err != nil {
+		t.Fatalf("Failed to update project: %v", err)
+	}
+	first, _ := inner.calls()
+
+	if err := service.UpdateProject(ctx, project); err != nil {
+		t.Fatalf("Failed to update project: %v", err)
+	}
+	if again, _ := inner.calls(); again != first {
+		t.Fatalf("Unchanged documents should not be re-embedded: %d texts before, %d after", first, again)
+	}
+
+	project.Chapters[1].RawContent += "雨停了。"
+	if err := service.UpdateProject(ctx, project); err != nil {
+		t.Fatalf("Failed to update project: %v", err)
+	}
+	changed, _ := inner.calls()
+	if changed == first {
+		t.Fatal("Changed chapter should be re-embedded")
+	}
+
+	chunks, err := service.listDocuments(ctx, "novel_chapter", map[string]interface{}{"chapter_id": "chapter-1"})
+	if err != nil || len(chunks) == 0 {
+		t.Fatalf("Unchanged chapter chunks should be kept: %v", err)
+	}
+}
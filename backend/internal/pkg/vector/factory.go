@@ -3,10 +3,23 @@ package vector
 import (
 	"context"
 	"fmt"
+	"io"
+	"path/filepath"
+	"time"
 
 	"backend/internal/conf"
+	"backend/internal/pkg/llm"
 )
 
+// 向量库后端
+const (
+	BackendMemory = "memory"
+	BackendSQLite = "sqlite"
+)
+
+// defaultSnapshotInterval 内存向量库默认快照间隔
+const defaultSnapshotInterval = 5 * time.Minute
+
 // VectorServiceFactory 向量服务工厂
 type VectorServiceFactory struct {
 	config   *conf.Data_Vector
@@ -21,10 +34,55 @@ func NewVectorServiceFactory(config *conf.Data_Vector, aiConfig *conf.AI) *Vecto
 	}
 }
 
-// CreateVectorClient 创建向量数据库客户端
+// CreateVectorClient 根据配置创建向量数据库客户端，默认使用内存实现
 func (f *VectorServiceFactory) CreateVectorClient() (VectorClient, error) {
-	// 优先使用内存实现，避免 Milvus 依赖问题
-	return NewMemoryVectorClient(), nil
+	hnsw, err := f.hnswConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	switch f.config.GetBackend() {
+	case "", BackendMemory:
+		client := NewMemoryVectorClient()
+		if hnsw != nil {
+			client = NewMemoryVectorClientWithHNSW(*hnsw)
+		}
+		if f.config.GetSnapshotPath() != "" {
+			interval := defaultSnapshotInterval
+			if f.config.GetSnapshotInterval() != nil {
+				interval = f.config.SnapshotInterval.AsDuration()
+			}
+			if err := client.EnableSnapshots(f.config.SnapshotPath, interval); err != nil {
+				return nil, fmt.Errorf("failed to enable vector snapshots: %w", err)
+			}
+		}
+		return client, nil
+	case BackendSQLite:
+		if hnsw != nil {
+			return NewSQLiteVectorClientWithHNSW(f.config.GetPath(), *hnsw)
+		}
+		return NewSQLiteVectorClient(f.config.GetPath())
+	default:
+		return nil, fmt.Errorf("unsupported vector backend: %s", f.config.GetBackend())
+	}
+}
+
+// hnswConfig 解析索引配置，使用暴力检索时返回 nil
+func (f *VectorServiceFactory) hnswConfig() (*HNSWConfig, error) {
+	index := f.config.GetIndex()
+	switch index.GetType() {
+	case "", IndexFlat:
+		return nil, nil
+	case IndexHNSW:
+		config := HNSWConfig{
+			M:              int(index.GetM()),
+			EfConstruction: int(index.GetEfConstruction()),
+			EfSearch:       int(index.GetEfSearch()),
+		}.withDefaults()
+		return &config, nil
+	default:
+		return nil, fmt.Errorf("unsupported vector index type: %s", index.GetType())
+	}
 }
 
 // CreateEmbeddingService 创建嵌入服务
@@ -33,6 +91,17 @@ func (f *VectorServiceFactory) CreateEmbeddingService() (EmbeddingService, error
 		return nil, fmt.Errorf("embedding configuration is required")
 	}
 
+	factory := &EmbeddingServiceFactory{}
+
+	// 离线嵌入不需要引用模型配置
+	switch provider := f.config.Embedding.Provider; provider {
+	case "ngram", "local":
+		return factory.CreateEmbeddingService(&EmbeddingConfig{
+			Provider: provider,
+			Model:    f.config.Embedding.Model,
+		})
+	}
+
 	// 获取引用的模型配置
 	modelRef := f.config.Embedding.ModelRef
 	if modelRef == "" {
@@ -56,13 +125,20 @@ func (f *VectorServiceFactory) CreateEmbeddingService() (EmbeddingService, error
 		Model:    f.config.Embedding.Model, // 使用embedding专用的模型名称
 		Timeout:  int(modelConfig.Timeout.AsDuration().Seconds()),
 	}
+	if f.config.Embedding.Provider != "" {
+		embeddingConfig.Provider = f.config.Embedding.Provider
+	}
 
-	factory := &EmbeddingServiceFactory{}
 	return factory.CreateEmbeddingService(embeddingConfig)
 }
 
 // CreateRAGService 创建 RAG 服务
 func (f *VectorServiceFactory) CreateRAGService() (*RAGService, error) {
+	return f.CreateRAGServiceWithLLM(nil)
+}
+
+// CreateRAGServiceWithLLM 创建 RAG 服务，llmClient 仅在使用 LLM 重排序时需要
+func (f *VectorServiceFactory) CreateRAGServiceWithLLM(llmClient llm.LLMClient) (*RAGService, error) {
 	vectorClient, err := f.CreateVectorClient()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create vector client: %w", err)
@@ -70,10 +146,90 @@ func (f *VectorServiceFactory) CreateRAGService() (*RAGService, error) {
 
 	embeddingService, err := f.CreateEmbeddingService()
 	if err != nil {
+		vectorClient.Close()
 		return nil, fmt.Errorf("failed to create embedding service: %w", err)
 	}
+	embeddingService, err = f.CreateEmbeddingCache(embeddingService)
+	if err != nil {
+		vectorClient.Close()
+		return nil, err
+	}
 
-	return NewRAGService(vectorClient, embeddingService), nil
+	reranker, err := f.CreateReranker(llmClient)
+	if err != nil {
+		return nil, err
+	}
+
+	chunking := f.config.GetChunking()
+	return NewRAGServiceWithOptions(vectorClient, embeddingService, RAGOptions{
+		Chunking: ChunkerConfig{
+			TargetTokens:  int(chunking.GetTargetTokens()),
+			MaxTokens:     int(chunking.GetMaxTokens()),
+			OverlapTokens: int(chunking.GetOverlapTokens()),
+		},
+		RRFK:            int(f.config.GetRetrieval().GetRrfK()),
+		Reranker:        reranker,
+		GroundingTokens: int(f.config.GetRetrieval().GetGroundingTokens()),
+	}), nil
+}
+
+// CreateEmbeddingCache 为远程嵌入服务加上内容哈希缓存与批处理队列，离线嵌入计算开销低于读缓存，直接返回
+// 缓存默认持久化在向量库同目录的 embedding_cache.db；内存后端未配置快照时只缓存在进程内
+func (f *VectorServiceFactory) CreateEmbeddingCache(embeddingService EmbeddingService) (EmbeddingService, error) {
+	remote, ok := embeddingService.(*OpenAIEmbeddingService)
+	if !ok {
+		return embeddingService, nil
+	}
+
+	var store EmbeddingCacheStore
+	if path := f.embeddingCachePath(); path != "" {
+		sqliteStore, err := NewSQLiteEmbeddingCacheStore(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create embedding cache: %w", err)
+		}
+		store = sqliteStore
+	}
+
+	return NewCachedEmbeddingService(embeddingService, store, CachedEmbeddingConfig{
+		Namespace: fmt.Sprintf("%s/%s/%d", remote.config.Provider, remote.config.Model, remote.GetDimension()),
+		BatchSize: int(f.config.GetEmbedding().GetBatchSize()),
+	}), nil
+}
+
+// embeddingCachePath 嵌入缓存文件路径，为空表示只缓存在进程内
+func (f *VectorServiceFactory) embeddingCachePath() string {
+	if path := f.config.GetEmbedding().GetCachePath(); path != "" {
+		return path
+	}
+
+	var storePath string
+	switch f.config.GetBackend() {
+	case BackendSQLite:
+		storePath = f.config.GetPath()
+	case "", BackendMemory:
+		storePath = f.config.GetSnapshotPath()
+	}
+	if storePath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(storePath), embeddingCacheFile)
+}
+
+// CreateReranker 根据配置创建重排序器，未配置时返回 nil
+func (f *VectorServiceFactory) CreateReranker(llmClient llm.LLMClient) (Reranker, error) {
+	switch f.config.GetRetrieval().GetReranker() {
+	case "", RerankerNone:
+		return nil, nil
+	case RerankerLocal:
+		return NewLocalReranker(), nil
+	case RerankerLLM:
+		if llmClient == nil {
+			return nil, fmt.Errorf("llm reranker requires an llm client")
+		}
+		return NewLLMReranker(llmClient), nil
+	default:
+		return nil, fmt.Errorf("unsupported reranker: %s", f.config.GetRetrieval().GetReranker())
+	}
 }
 
 // InitializeServices 初始化所有向量服务
@@ -103,10 +259,20 @@ func (f *VectorServiceFactory) InitializeServices(ctx context.Context) (*RAGServ
 	return ragService, nil
 }
 
-// CloseServices 关闭所有服务
+// CloseServices 关闭所有服务，嵌入缓存的后台队列会先处理完当前批次
 func (f *VectorServiceFactory) CloseServices(ragService *RAGService) error {
-	if ragService != nil && ragService.vectorClient != nil {
-		return ragService.vectorClient.Close()
+	if ragService == nil {
+		return nil
+	}
+
+	var err error
+	if ragService.vectorClient != nil {
+		err = ragService.vectorClient.Close()
+	}
+	if closer, ok := ragService.embeddingService.(io.Closer); ok {
+		if closeErr := closer.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
 	}
-	return nil
+	return err
 }
\ No newline at end of file
@@ -2,6 +2,7 @@ package vector
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"backend/internal/conf"
@@ -189,4 +190,56 @@ func TestVectorServiceFactory_MissingEmbeddingConfig(t *testing.T) {
 	if err == nil {
 		t.Fatal("Expected error for missing embedding config")
 	}
-}
\ No newline at end of file
+}
+
+func TestVectorServiceFactory_CreateSQLiteVectorClient(t *testing.T) {
+	config := &conf.Data_Vector{
+		Backend: BackendSQLite,
+		Path:    filepath.Join(t.TempDir(), "vectors.db"),
+	}
+
+	factory := NewVectorServiceFactory(config, nil)
+	client, err := factory.CreateVectorClient()
+	if err != nil {
+		t.Fatalf("Failed to create vector client: %v", err)
+	}
+	defer client.Close()
+
+	if _, ok := client.(*SQLiteVectorClient); !ok {
+		t.Fatal("Expected SQLiteVectorClient")
+	}
+
+	// 未知后端应返回错误
+	config.Backend = "milvus"
+	if _, err := factory.CreateVectorClient(); err == nil {
+		t.Fatal("Expected error for unsupported backend")
+	}
+}
+
+func TestVectorServiceFactory_CreateReranker(t *testing.T) {
+	config := &conf.Data_Vector{}
+	factory := NewVectorServiceFactory(config, nil)
+
+	reranker, err := factory.CreateReranker(nil)
+	if err != nil || reranker != nil {
+		t.Fatalf("Expected no reranker by default, got %v, %v", reranker, err)
+	}
+
+	config.Retrieval = &conf.Data_Vector_Retrieval{Reranker: RerankerLocal}
+	if reranker, err := factory.CreateReranker(nil); err != nil {
+		t.Fatalf("Failed to create local reranker: %v", err)
+	} else if _, ok := reranker.(*LocalReranker); !ok {
+		t.Fatal("Expected LocalReranker")
+	}
+
+	// LLM 重排序需要 LLM 客户端
+	config.Retrieval.Reranker = RerankerLLM
+	if _, err := factory.CreateReranker(nil); err == nil {
+		t.Fatal("Expected error for llm reranker without client")
+	}
+
+	config.Retrieval.Reranker = "cohere"
+	if _, err := factory.CreateReranker(nil); err == nil {
+		t.Fatal("Expected error for unsupported reranker")
+	}
+}
@@ -0,0 +1,134 @@
+package vector
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// matchMetadata 检查元数据是否满足过滤条件
+// 过滤值可以是直接比较的值，也可以是 {"$lt": n} 形式的比较运算（支持 $lt/$lte/$gt/$gte/$ne，以及匹配数组元素的 $contains）
+func matchMetadata(metadata map[string]interface{}, filter map[string]interface{}) bool {
+	for key, expected := range filter {
+		actual, exists := metadata[key]
+		if !exists {
+			return false
+		}
+
+		if ops, ok := expected.(map[string]interface{}); ok {
+			if !matchOperators(actual, ops) {
+				return false
+			}
+			continue
+		}
+
+		if !valuesEqual(actual, expected) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchOperators 检查比较运算条件
+func matchOperators(actual interface{}, ops map[string]interface{}) bool {
+	for op, operand := range ops {
+		if op == "$ne" {
+			if valuesEqual(actual, operand) {
+				return false
+			}
+			continue
+		}
+		if op == "$contains" {
+			if !containsValue(actual, operand) {
+				return false
+			}
+			continue
+		}
+
+		a, ok1 := toFloat(actual)
+		b, ok2 := toFloat(operand)
+		if !ok1 || !ok2 {
+			return false
+		}
+
+		switch op {
+		case "$lt":
+			if !(a < b) {
+				return false
+			}
+		case "$lte":
+			if !(a <= b) {
+				return false
+			}
+		case "$gt":
+			if !(a > b) {
+				return false
+			}
+		case "$gte":
+			if !(a >= b) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsValue 检查数组类型的元数据是否包含指定值
+func containsValue(actual interface{}, operand interface{}) bool {
+	switch values := actual.(type) {
+	case []string:
+		for _, v := range values {
+			if valuesEqual(v, operand) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, v := range values {
+			if valuesEqual(v, operand) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// valuesEqual 比较两个元数据值，数值类型按数值比较（元数据经过 JSON 持久化后整数会变为浮点数）
+func valuesEqual(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			return fa == fb
+		}
+		return false
+	}
+
+	switch a.(type) {
+	case string, bool, nil:
+		return a == b
+	default:
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	}
+}
+
+// toFloat 将数值类型转换为 float64
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,193 @@
+package vector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"backend/internal/pkg/models"
+)
+
+// 章节生成检索参数
+const (
+	defaultGroundingTokens  = 1500 // 相关前文的默认 token 预算
+	groundingRuleCandidates = 3    // 检索的世界规则数
+	groundingRuleThreshold  = 0.5  // 世界规则向量检索的相似度阈值
+)
+
+// GroundingRequest 章节生成的检索请求
+type GroundingRequest struct {
+	ProjectID   string
+	Outline     *models.ChapterOutline
+	Characters  []*models.Character // 项目人物，用于识别大纲中出现的人物
+	TokenBudget int                 // 片段总 token 预算，<=0 时使用服务配置
+}
+
+// Grounding 章节生成的检索结果
+type Grounding struct {
+	Prompt string                   // 拼接好的提示词段落，没有可用片段时为空
+	Chunks []*models.RetrievedChunk // 实际写入提示词的片段
+}
+
+// RetrieveChapterGrounding 为即将生成的章节检索前文依据：
+// 大纲中出现的每个人物最近一次出场的片段、每个关键道具最近一次出现的片段，以及与本章大纲相关的世界规则。
+// 同一片段被多个人物或道具命中时只写入一次并合并涉及对象，按人物、道具、规则的优先级在 token 预算内取舍。
+func (r *RAGService) RetrieveChapterGrounding(ctx context.Context, req *GroundingRequest) (*Grounding, error) {
+	if req == nil || req.Outline == nil {
+		return &Grounding{}, nil
+	}
+	budget := req.TokenBudget
+	if budget <= 0 {
+		budget = r.groundingTokens
+	}
+	if budget <= 0 {
+		budget = defaultGroundingTokens
+	}
+
+	chunks, err := r.listDocuments(ctx, r.collections["chapter"], map[string]interface{}{
+		"project_id": req.ProjectID,
+		"index":      map[string]interface{}{"$lt": req.Outline.Index},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chapter chunks: %w", err)
+	}
+	// 从最近的片段往前找
+	sort.SliceStable(chunks, func(i, j int) bool {
+		return chunkOrder(chunks[i].Document, chunks[j].Document) > 0
+	})
+
+	var candidates []*models.RetrievedChunk
+	byID := make(map[string]*models.RetrievedChunk)
+	add := func(kind, subject string, result *SearchResult) {
+		if existing, ok := byID[result.Document.ID]; ok {
+			existing.Subjects = append(existing.Subjects, subject)
+			return
+		}
+		chunk := retrievedChunk(kind, subject, result)
+		byID[chunk.DocumentID] = chunk
+		candidates = append(candidates, chunk)
+	}
+
+	outlineText := joinStrings([]string{req.Outline.Title, req.Outline.Summary, req.Outline.Goal, req.Outline.TwistHint}, "\n")
+	names := make([]string, 0, len(req.Characters))
+	for _, character := range req.Characters {
+		if character != nil {
+			names = append(names, character.Name)
+		}
+	}
+	for _, name := range MentionedNames(outlineText, names) {
+		for _, result := range chunks {
+			if strings.Contains(result.Document.Content, name) {
+				add(models.RetrievalCharacterScene, name, result)
+				break
+			}
+		}
+	}
+
+	for _, item := range req.Outline.ImportantItems {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		for _, result := range chunks {
+			if strings.Contains(result.Document.Content, item) {
+				add(models.RetrievalItemMention, item, result)
+				break
+			}
+		}
+	}
+
+	query := joinStrings(append([]string{outlineText}, req.Outline.ImportantItems...), "\n")
+	rules, err := r.searchWorldRules(ctx, req.ProjectID, query)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range rules {
+		add(models.RetrievalWorldRule, result.Document.Content, result)
+	}
+
+	// 在预算内按优先级取舍，放不下的片段跳过，让更短的片段仍有机会写入
+	var selected []*models.RetrievedChunk
+	used := 0
+	for _, chunk := range candidates {
+		if used+chunk.Tokens > budget {
+			continue
+		}
+		selected = append(selected, chunk)
+		used += chunk.Tokens
+	}
+
+	return &Grounding{
+		Prompt: formatGrounding(selected),
+		Chunks: selected,
+	}, nil
+}
+
+// searchWorldRules 检索与查询相关的世界规则
+func (r *RAGService) searchWorldRules(ctx context.Context, projectID, query string) ([]*SearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, nil
+	}
+
+	options := &SearchOptions{
+		Collection: r.collections["worldview"],
+		TopK:       groundingRuleCandidates,
+		Threshold:  groundingRuleThreshold,
+		Filter: map[string]interface{}{
+			"project_id": projectID,
+			"type":       models.RetrievalWorldRule,
+		},
+		Mode:     SearchModeHybrid,
+		RRFK:     r.rrfK,
+		Reranker: r.reranker,
+	}
+	if embedding, err := r.embeddingService.Embed(ctx, query); err == nil {
+		options.QueryEmbedding = embedding
+	}
+
+	results, err := r.vectorClient.Search(ctx, query, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search world rules: %w", err)
+	}
+	return results, nil
+}
+
+// retrievedChunk 将检索结果转换为生成依据片段
+func retrievedChunk(kind, subject string, result *SearchResult) *models.RetrievedChunk {
+	doc := result.Document
+	chunk := &models.RetrievedChunk{
+		DocumentID: doc.ID,
+		Kind:       kind,
+		Subjects:   []string{subject},
+		Content:    doc.Content,
+		Tokens:     EstimateTokens(doc.Content),
+		Score:      result.Score,
+	}
+	if kind != models.RetrievalWorldRule {
+		chunk.ChapterID, _ = doc.Metadata["chapter_id"].(string)
+		chunk.ChapterIndex = metadataInt(doc.Metadata, "index")
+		chunk.ChapterTitle, _ = doc.Metadata["title"].(string)
+		// 按出场顺序选取的片段没有检索分数
+		chunk.Score = 0
+	}
+	return chunk
+}
+
+// formatGrounding 拼接相关前文段落
+func formatGrounding(chunks []*models.RetrievedChunk) string {
+	if len(chunks) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, chunk := range chunks {
+		if chunk.Kind == models.RetrievalWorldRule {
+			fmt.Fprintf(&b, "- 世界规则：%s\n", chunk.Content)
+			continue
+		}
+		fmt.Fprintf(&b, "- 第%d章《%s》（涉及：%s）：%s\n", chunk.ChapterIndex, chunk.ChapterTitle,
+			joinStrings(chunk.Subjects, "、"), chunk.Content)
+	}
+	return b.String()
+}
@@ -0,0 +1,420 @@
+package vector
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// 索引类型
+const (
+	IndexFlat = "flat" // 暴力检索
+	IndexHNSW = "hnsw" // 分层可导航小世界图近似检索
+)
+
+// HNSWConfig HNSW 索引参数
+type HNSWConfig struct {
+	M              int   // 每层每个节点的最大连接数，第0层为 2*M
+	EfConstruction int   // 插入时的候选集大小，越大图质量越高、构建越慢
+	EfSearch       int   // 查询时的候选集大小，越大召回率越高、查询越慢
+	Seed           int64 // 层级随机数种子，固定种子使构建结果可复现
+}
+
+// DefaultHNSWConfig 默认 HNSW 参数
+func DefaultHNSWConfig() HNSWConfig {
+	return HNSWConfig{
+		M:              16,
+		EfConstruction: 200,
+		EfSearch:       64,
+		Seed:           42,
+	}
+}
+
+// withDefaults 用默认值补全未设置的参数
+func (c HNSWConfig) withDefaults() HNSWConfig {
+	def := DefaultHNSWConfig()
+	if c.M <= 1 {
+		c.M = def.M
+	}
+	if c.EfConstruction <= 0 {
+		c.EfConstruction = def.EfConstruction
+	}
+	if c.EfSearch <= 0 {
+		c.EfSearch = def.EfSearch
+	}
+	if c.Seed == 0 {
+		c.Seed = def.Seed
+	}
+	return c
+}
+
+// HNSWIndex HNSW 近似最近邻索引，使用余弦距离；非并发安全，由调用方加锁
+type HNSWIndex struct {
+	config    HNSWConfig
+	levelMult float64
+	rng       *rand.Rand
+	nodes     map[string]*hnswNode
+	entry     *hnswNode
+	maxLevel  int
+}
+
+// hnswNode 图节点
+type hnswNode struct {
+	id        string
+	vector    []float32 // 归一化后的向量
+	level     int
+	neighbors [][]*hnswNode // 每层的邻居
+}
+
+// hnswMatch 检索结果
+type hnswMatch struct {
+	ID    string
+	Score float32 // 余弦相似度
+}
+
+// NewHNSWIndex 创建 HNSW 索引
+func NewHNSWIndex(config HNSWConfig) *HNSWIndex {
+	config = config.withDefaults()
+	return &HNSWIndex{
+		config:    config,
+		levelMult: 1 / math.Log(float64(config.M)),
+		rng:       rand.New(rand.NewSource(config.Seed)),
+		nodes:     make(map[string]*hnswNode),
+	}
+}
+
+// Len 索引中的向量数量
+func (h *HNSWIndex) Len() int {
+	return len(h.nodes)
+}
+
+// Insert 插入向量，已存在的 ID 会先删除再插入
+func (h *HNSWIndex) Insert(id string, vector []float32) {
+	if _, exists := h.nodes[id]; exists {
+		h.Delete(id)
+	}
+
+	node := &hnswNode{
+		id:     id,
+		vector: normalize(vector),
+		level:  h.randomLevel(),
+	}
+	node.neighbors = make([][]*hnswNode, node.level+1)
+	h.nodes[id] = node
+
+	if h.entry == nil {
+		h.entry = node
+		h.maxLevel = node.level
+		return
+	}
+
+	// 从最高层贪心下降到新节点所在的最高层
+	ep := h.entry
+	epDist := distance(node.vector, ep.vector)
+	for level := h.maxLevel; level > node.level; level-- {
+		ep, epDist = h.greedyClosest(node.vector, ep, epDist, level)
+	}
+
+	// 在新节点所在的每一层建立连接
+	entryPoints := []hnswCandidate{{node: ep, dist: epDist}}
+	for level := minInt(node.level, h.maxLevel); level >= 0; level-- {
+		candidates := h.searchLayer(node.vector, entryPoints, h.config.EfConstruction, level, nil)
+		neighbors := h.selectNeighbors(candidates, h.config.M)
+		node.neighbors[level] = neighbors
+
+		for _, neighbor := range neighbors {
+			neighbor.neighbors[level] = append(neighbor.neighbors[level], node)
+			if len(neighbor.neighbors[level]) > h.maxConnections(level) {
+				h.shrink(neighbor, level)
+			}
+		}
+		entryPoints = candidates
+	}
+
+	if node.level > h.maxLevel {
+		h.entry = node
+		h.maxLevel = node.level
+	}
+}
+
+// Delete 删除向量，并为失去连接的节点重新选择邻居
+func (h *HNSWIndex) Delete(id string) {
+	node, exists := h.nodes[id]
+	if !exists {
+		return
+	}
+	delete(h.nodes, id)
+
+	// 连接不一定是双向的，需要扫描全部节点移除指向被删节点的边
+	for _, other := range h.nodes {
+		for level := 0; level <= minInt(other.level, node.level); level++ {
+			if !removeNeighbor(other, level, node) {
+				continue
+			}
+			// 用被删节点的邻居补充连接，保持图的连通性
+			pool := make([]hnswCandidate, 0, len(other.neighbors[level])+len(node.neighbors[level]))
+			seen := map[*hnswNode]bool{other: true}
+			for _, n := range append(append([]*hnswNode{}, other.neighbors[level]...), node.neighbors[level]...) {
+				if n == node || seen[n] {
+					continue
+				}
+				seen[n] = true
+				pool = append(pool, hnswCandidate{node: n, dist: distance(other.vector, n.vector)})
+			}
+			sortCandidates(pool)
+			other.neighbors[level] = h.selectNeighbors(pool, h.maxConnections(level))
+		}
+	}
+
+	if h.entry == node {
+		h.entry = nil
+		h.maxLevel = 0
+		for _, other := range h.nodes {
+			if h.entry == nil || other.level > h.maxLevel {
+				h.entry = other
+				h.maxLevel = other.level
+			}
+		}
+	}
+}
+
+// Search 检索与查询向量最相似的 k 个向量，accept 不为 nil 时只返回被接受的 ID
+// 未被接受的节点仍参与图遍历，过滤条件越严格遍历越多，最坏情况下退化为全量扫描
+func (h *HNSWIndex) Search(query []float32, k, ef int, accept func(id string) bool) []hnswMatch {
+	if h.entry == nil || k <= 0 {
+		return nil
+	}
+	if ef < k {
+		ef = k
+	}
+
+	q := normalize(query)
+	ep := h.entry
+	epDist := distance(q, ep.vector)
+	for level := h.maxLevel; level > 0; level-- {
+		ep, epDist = h.greedyClosest(q, ep, epDist, level)
+	}
+
+	candidates := h.searchLayer(q, []hnswCandidate{{node: ep, dist: epDist}}, ef, 0, accept)
+	if len(candidates) > k {
+		candidates = candidates[:k]
+	}
+
+	matches := make([]hnswMatch, len(candidates))
+	for i, c := range candidates {
+		matches[i] = hnswMatch{ID: c.node.id, Score: 1 - c.dist}
+	}
+	return matches
+}
+
+// greedyClosest 在指定层上贪心移动到距离查询最近的节点
+func (h *HNSWIndex) greedyClosest(q []float32, ep *hnswNode, epDist float32, level int) (*hnswNode, float32) {
+	for changed := true; changed; {
+		changed = false
+		for _, n := range ep.neighbors[level] {
+			if d := distance(q, n.vector); d < epDist {
+				ep, epDist = n, d
+				changed = true
+			}
+		}
+	}
+	return ep, epDist
+}
+
+// searchLayer 在指定层上做束搜索，返回按距离升序排列的至多 ef 个节点
+func (h *HNSWIndex) searchLayer(q []float32, entryPoints []hnswCandidate, ef, level int, accept func(id string) bool) []hnswCandidate {
+	visited := make(map[*hnswNode]bool, ef*4)
+	candidates := &minHeap{}
+	results := &maxHeap{}
+
+	for _, ep := range entryPoints {
+		if visited[ep.node] {
+			continue
+		}
+		visited[ep.node] = true
+		heap.Push(candidates, ep)
+		if accept == nil || accept(ep.node.id) {
+			heap.Push(results, ep)
+		}
+	}
+	for results.Len() > ef {
+		heap.Pop(results)
+	}
+
+	for candidates.Len() > 0 {
+		current := heap.Pop(candidates).(hnswCandidate)
+		if results.Len() >= ef && current.dist > (*results)[0].dist {
+			break
+		}
+
+		for _, n := range current.node.neighbors[level] {
+			if visited[n] {
+				continue
+			}
+			visited[n] = true
+
+			d := distance(q, n.vector)
+			if results.Len() < ef || d < (*results)[0].dist {
+				heap.Push(candidates, hnswCandidate{node: n, dist: d})
+				if accept == nil || accept(n.id) {
+					heap.Push(results, hnswCandidate{node: n, dist: d})
+					if results.Len() > ef {
+						heap.Pop(results)
+					}
+				}
+			}
+		}
+	}
+
+	out := make([]hnswCandidate, results.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(results).(hnswCandidate)
+	}
+	return out
+}
+
+// selectNeighbors 启发式选择邻居：优先保留彼此方向分散的候选，不足时用剩余最近候选补齐
+// candidates 需按距离升序排列
+func (h *HNSWIndex) selectNeighbors(candidates []hnswCandidate, m int) []*hnswNode {
+	selected := make([]*hnswNode, 0, m)
+	pruned := make([]*hnswNode, 0, len(candidates))
+
+	for _, c := range candidates {
+		if len(selected) >= m {
+			break
+		}
+		keep := true
+		for _, s := range selected {
+			if distance(c.node.vector, s.vector) < c.dist {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			selected = append(selected, c.node)
+		} else {
+			pruned = append(pruned, c.node)
+		}
+	}
+
+	for _, n := range pruned {
+		if len(selected) >= m {
+			break
+		}
+		selected = append(selected, n)
+	}
+	return selected
+}
+
+// shrink 连接数超出上限时重新选择邻居
+func (h *HNSWIndex) shrink(node *hnswNode, level int) {
+	pool := make([]hnswCandidate, len(node.neighbors[level]))
+	for i, n := range node.neighbors[level] {
+		pool[i] = hnswCandidate{node: n, dist: distance(node.vector, n.vector)}
+	}
+	sortCandidates(pool)
+	node.neighbors[level] = h.selectNeighbors(pool, h.maxConnections(level))
+}
+
+// maxConnections 指定层的最大连接数
+func (h *HNSWIndex) maxConnections(level int) int {
+	if level == 0 {
+		return 2 * h.config.M
+	}
+	return h.config.M
+}
+
+// randomLevel 按指数分布随机生成节点层级
+func (h *HNSWIndex) randomLevel() int {
+	return int(math.Floor(-math.Log(1-h.rng.Float64()) * h.levelMult))
+}
+
+// removeNeighbor 从节点指定层的邻居中移除目标节点
+func removeNeighbor(node *hnswNode, level int, target *hnswNode) bool {
+	neighbors := node.neighbors[level]
+	for i, n := range neighbors {
+		if n == target {
+			node.neighbors[level] = append(neighbors[:i:i], neighbors[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// normalize 归一化向量，零向量保持不变
+func normalize(v []float32) []float32 {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	out := make([]float32, len(v))
+	if norm == 0 {
+		return out
+	}
+	inv := 1 / math.Sqrt(norm)
+	for i, x := range v {
+		out[i] = float32(float64(x) * inv)
+	}
+	return out
+}
+
+// distance 归一化向量间的余弦距离
+func distance(a, b []float32) float32 {
+	if len(a) != len(b) {
+		return 1
+	}
+	var dot float32
+	for i := range a {
+		dot += a[i] * b[i]
+	}
+	return 1 - dot
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// hnswCandidate 带距离的候选节点
+type hnswCandidate struct {
+	node *hnswNode
+	dist float32
+}
+
+// sortCandidates 按距离升序排序
+func sortCandidates(c []hnswCandidate) {
+	sort.Slice(c, func(i, j int) bool { return c[i].dist < c[j].dist })
+}
+
+// minHeap 按距离升序的堆
+type minHeap []hnswCandidate
+
+func (h minHeap) Len() int            { return len(h) }
+func (h minHeap) Less(i, j int) bool  { return h[i].dist < h[j].dist }
+func (h minHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x interface{}) { *h = append(*h, x.(hnswCandidate)) }
+func (h *minHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// maxHeap 按距离降序的堆，堆顶为当前结果中最远的节点
+type maxHeap []hnswCandidate
+
+func (h maxHeap) Len() int            { return len(h) }
+func (h maxHeap) Less(i, j int) bool  { return h[i].dist > h[j].dist }
+func (h maxHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *maxHeap) Push(x interface{}) { *h = append(*h, x.(hnswCandidate)) }
+func (h *maxHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
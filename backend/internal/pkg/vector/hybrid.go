@@ -0,0 +1,127 @@
+package vector
+
+import (
+	"context"
+	"sort"
+)
+
+// 检索方式
+const (
+	SearchModeText    = "text"    // 子串匹配（默认）
+	SearchModeKeyword = "keyword" // BM25 关键词检索
+	SearchModeHybrid  = "hybrid"  // BM25 与向量检索通过倒数排名融合
+)
+
+// 混合检索默认参数
+const (
+	defaultRRFK           = 60 // 倒数排名融合常数
+	minHybridCandidates   = 20 // 每一路检索的最少候选数
+	hybridCandidateFactor = 4  // 每一路检索的候选数相对 TopK 的倍数
+)
+
+// keywordSearcher 支持混合检索的向量库实现
+type keywordSearcher interface {
+	keywordSearch(ctx context.Context, query string, options *SearchOptions, topK int) ([]*SearchResult, error)
+	SimilaritySearch(ctx context.Context, embedding []float32, options *SearchOptions) ([]*SearchResult, error)
+}
+
+// hybridSearch 执行关键词或混合检索：两路结果按倒数排名融合后交给可选的重排序阶段
+// 关键词检索不应用相似度阈值，向量检索仍按 Threshold 过滤；重排序失败时保留融合排序
+func hybridSearch(ctx context.Context, client keywordSearcher, query string, options *SearchOptions) ([]*SearchResult, error) {
+	candidates := options.TopK * hybridCandidateFactor
+	if candidates < minHybridCandidates {
+		candidates = minHybridCandidates
+	}
+
+	keyword, err := client.keywordSearch(ctx, query, options, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	lists := [][]*SearchResult{keyword}
+	if options.Mode == SearchModeHybrid && len(options.QueryEmbedding) > 0 {
+		vectorOptions := *options
+		vectorOptions.TopK = candidates
+		semantic, err := client.SimilaritySearch(ctx, options.QueryEmbedding, &vectorOptions)
+		if err != nil {
+			return nil, err
+		}
+		lists = append(lists, semantic)
+	}
+
+	rrfK := options.RRFK
+	if rrfK <= 0 {
+		rrfK = defaultRRFK
+	}
+	results := reciprocalRankFusion(lists, rrfK)
+	if len(results) > candidates {
+		results = results[:candidates]
+	}
+
+	if options.Reranker != nil && len(results) > 0 {
+		if reranked, err := options.Reranker.Rerank(ctx, query, results); err == nil {
+			results = reranked
+		}
+	}
+
+	if options.TopK > 0 && len(results) > options.TopK {
+		results = results[:options.TopK]
+	}
+	return results, nil
+}
+
+// reciprocalRankFusion 按 Σ 1/(k+rank) 融合多路排序结果，同一文档以首次出现的结果为准
+func reciprocalRankFusion(lists [][]*SearchResult, k int) []*SearchResult {
+	scores := make(map[string]float64)
+	docs := make(map[string]*Document)
+	var order []string
+	for _, list := range lists {
+		for rank, result := range list {
+			id := result.Document.ID
+			if _, seen := docs[id]; !seen {
+				docs[id] = result.Document
+				order = append(order, id)
+			}
+			scores[id] += 1.0 / float64(k+rank+1)
+		}
+	}
+
+	results := make([]*SearchResult, len(order))
+	for i, id := range order {
+		score := float32(scores[id])
+		results[i] = &SearchResult{
+			Document: docs[id],
+			Score:    score,
+			Distance: 1.0 - score,
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results
+}
+
+// sortKeywordMatches 按分数降序排序，分数相同时按ID排序保证结果稳定
+func sortKeywordMatches(matches []keywordMatch) {
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID < matches[j].ID
+	})
+}
+
+// searchResultDocument 返回结果中的文档，不需要向量时返回不含向量的副本
+func searchResultDocument(doc *Document, includeEmbedding bool) *Document {
+	if includeEmbedding || len(doc.Embedding) == 0 {
+		return doc
+	}
+	return &Document{
+		ID:         doc.ID,
+		Content:    doc.Content,
+		Metadata:   doc.Metadata,
+		Collection: doc.Collection,
+		CreatedAt:  doc.CreatedAt,
+		UpdatedAt:  doc.UpdatedAt,
+	}
+}
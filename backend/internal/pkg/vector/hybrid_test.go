@@ -0,0 +1,212 @@
+package vector
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"backend/internal/pkg/llm"
+)
+
+// hybridTestDocuments 向量与关键词各自只能命中一部分目标的测试文档
+func hybridTestDocuments() []*Document {
+	return []*Document{
+		{
+			ID:         "sword",
+			Collection: "test_collection",
+			Content:    "沈舟拔出了青霜剑，剑光映着雪地。",
+			Embedding:  []float32{0.0, 1.0, 0.0},
+			Metadata:   map[string]interface{}{"project_id": "p1"},
+		},
+		{
+			ID:         "snow",
+			Collection: "test_collection",
+			Content:    "大雪封山，林晚在山门外等了一夜。",
+			Embedding:  []float32{1.0, 0.0, 0.0},
+			Metadata:   map[string]interface{}{"project_id": "p1"},
+		},
+		{
+			ID:         "tea",
+			Collection: "test_collection",
+			Content:    "茶楼里说书人讲起了江湖旧事。",
+			Embedding:  []float32{0.0, 0.0, 1.0},
+			Metadata:   map[string]interface{}{"project_id": "p1"},
+		},
+		{
+			ID:         "other",
+			Collection: "test_collection",
+			Content:    "青霜剑被供奉在另一个故事的祠堂里。",
+			Embedding:  []float32{0.0, 1.0, 0.0},
+			Metadata:   map[string]interface{}{"project_id": "p2"},
+		},
+	}
+}
+
+func testHybridSearch(t *testing.T, client VectorClient) {
+	t.Helper()
+	ctx := context.Background()
+	if err := client.CreateCollection(ctx, "test_collection", 3); err != nil {
+		t.Fatalf("Failed to create collection: %v", err)
+	}
+	if err := client.BatchAdd(ctx, hybridTestDocuments()); err != nil {
+		t.Fatalf("Failed to add documents: %v", err)
+	}
+
+	// 关键词检索应命中精确的道具名
+	keyword, err := client.Search(ctx, "青霜剑", &SearchOptions{
+		Collection: "test_collection",
+		TopK:       5,
+		Filter:     map[string]interface{}{"project_id": "p1"},
+		Mode:       SearchModeKeyword,
+	})
+	if err != nil {
+		t.Fatalf("Keyword search failed: %v", err)
+	}
+	if len(keyword) != 1 || keyword[0].Document.ID != "sword" {
+		t.Fatalf("Expected keyword search to return only the sword document, got %v", resultIDs(keyword))
+	}
+
+	// 混合检索融合关键词与向量两路结果
+	hybrid, err := client.Search(ctx, "青霜剑", &SearchOptions{
+		Collection:     "test_collection",
+		TopK:           2,
+		Filter:         map[string]interface{}{"project_id": "p1"},
+		Mode:           SearchModeHybrid,
+		QueryEmbedding: []float32{0.9, 0.1, 0.0},
+	})
+	if err != nil {
+		t.Fatalf("Hybrid search failed: %v", err)
+	}
+	ids := resultIDs(hybrid)
+	if len(ids) != 2 || !containsID(ids, "sword") || !containsID(ids, "snow") {
+		t.Fatalf("Expected hybrid search to return sword and snow, got %v", ids)
+	}
+	if hybrid[0].Score < hybrid[1].Score {
+		t.Fatal("Hybrid results should be sorted by fused score")
+	}
+}
+
+func TestMemoryVectorClient_HybridSearch(t *testing.T) {
+	testHybridSearch(t, NewMemoryVectorClient())
+}
+
+func TestSQLiteVectorClient_HybridSearch(t *testing.T) {
+	client := newTestSQLiteClient(t, filepath.Join(t.TempDir(), "vectors.db"))
+	defer client.Close()
+	testHybridSearch(t, client)
+}
+
+func TestMemoryVectorClient_KeywordIndexUpdates(t *testing.T) {
+	ctx := context.Background()
+	client := NewMemoryVectorClient()
+	if err := client.CreateCollection(ctx, "test_collection", 3); err != nil {
+		t.Fatalf("Failed to create collection: %v", err)
+	}
+	if err := client.BatchAdd(ctx, hybridTestDocuments()); err != nil {
+		t.Fatalf("Failed to add documents: %v", err)
+	}
+
+	options := &SearchOptions{Collection: "test_collection", TopK: 5, Mode: SearchModeKeyword}
+	if err := client.DeleteDocument(ctx, "other"); err != nil {
+		t.Fatalf("Failed to delete document: %v", err)
+	}
+	updated := hybridTestDocuments()[0]
+	updated.Content = "沈舟收起了长剑。"
+	if err := client.UpdateDocument(ctx, updated); err != nil {
+		t.Fatalf("Failed to update document: %v", err)
+	}
+
+	results, err := client.Search(ctx, "青霜剑", options)
+	if err != nil {
+		t.Fatalf("Keyword search failed: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("Expected no matches after update and delete, got %v", resultIDs(results))
+	}
+}
+
+func TestReciprocalRankFusion(t *testing.T) {
+	doc := func(id string) *SearchResult {
+		return &SearchResult{Document: &Document{ID: id}}
+	}
+	fused := reciprocalRankFusion([][]*SearchResult{
+		{doc("a"), doc("b"), doc("c")},
+		{doc("c"), doc("b"), doc("d")},
+	}, 60)
+
+	ids := resultIDs(fused)
+	// b 与 c 在两路中都出现，应排在只出现一次的文档之前
+	if len(ids) != 4 || ids[3] != "d" || !containsID(ids[:2], "b") || !containsID(ids[:2], "c") {
+		t.Fatalf("Unexpected fusion order: %v", ids)
+	}
+}
+
+func TestLocalReranker(t *testing.T) {
+	results := []*SearchResult{
+		{Document: &Document{ID: "partial", Content: "林晚走进茶楼。"}},
+		{Document: &Document{ID: "exact", Content: "林晚在山门外等了一夜。"}},
+		{Document: &Document{ID: "none", Content: "说书人讲起了江湖旧事。"}},
+	}
+
+	reranked, err := NewLocalReranker().Rerank(context.Background(), "林晚在山门外", results)
+	if err != nil {
+		t.Fatalf("Rerank failed: %v", err)
+	}
+	if ids := resultIDs(reranked); ids[0] != "exact" || ids[2] != "none" {
+		t.Fatalf("Unexpected rerank order: %v", ids)
+	}
+}
+
+// scoringLLMClient 按固定分数响应的 LLM 客户端
+type scoringLLMClient struct {
+	llm.LLMClient
+	response map[string]interface{}
+}
+
+func (c *scoringLLMClient) GenerateJSON(ctx context.Context, prompt string, opts *llm.GenerateOptions) (map[string]interface{}, error) {
+	return c.response, nil
+}
+
+func TestLLMReranker(t *testing.T) {
+	client := &scoringLLMClient{response: map[string]interface{}{
+		"scores": []interface{}{
+			map[string]interface{}{"id": float64(1), "score": float64(2)},
+			map[string]interface{}{"id": float64(2), "score": float64(9)},
+		},
+	}}
+	results := []*SearchResult{
+		{Document: &Document{ID: "first"}},
+		{Document: &Document{ID: "second"}},
+		{Document: &Document{ID: "unscored"}},
+	}
+
+	reranked, err := NewLLMReranker(client).Rerank(context.Background(), "查询", results)
+	if err != nil {
+		t.Fatalf("Rerank failed: %v", err)
+	}
+	if ids := resultIDs(reranked); ids[0] != "second" || ids[1] != "first" || ids[2] != "unscored" {
+		t.Fatalf("Unexpected rerank order: %v", ids)
+	}
+
+	client.response = map[string]interface{}{}
+	if _, err := NewLLMReranker(client).Rerank(context.Background(), "查询", results); err == nil {
+		t.Fatal("Expected error when llm returns no scores")
+	}
+}
+
+func resultIDs(results []*SearchResult) []string {
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result.Document.ID
+	}
+	return ids
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -14,6 +14,10 @@ import (
 type MemoryVectorClient struct {
 	mu          sync.RWMutex
 	collections map[string]*MemoryCollection
+	dirty       bool        // 上次快照后是否有修改
+	hnsw        *HNSWConfig // 为 nil 时使用暴力检索
+
+	snapshot *snapshotter
 }
 
 // MemoryCollection 内存集合
@@ -26,7 +30,46 @@ type MemoryCollection struct {
 
 // MemoryIndex 内存索引
 type MemoryIndex struct {
-	vectors map[string][]float32
+	vectors  map[string][]float32
+	ann      *HNSWIndex // 近似最近邻索引，未启用时为 nil
+	keywords *keywordIndex
+}
+
+// newMemoryIndex 创建内存索引
+func newMemoryIndex(hnsw *HNSWConfig) *MemoryIndex {
+	index := &MemoryIndex{
+		vectors:  make(map[string][]float32),
+		keywords: newKeywordIndex(),
+	}
+	if hnsw != nil {
+		index.ann = NewHNSWIndex(*hnsw)
+	}
+	return index
+}
+
+// add 添加或替换向量
+func (i *MemoryIndex) add(id string, vector []float32) {
+	i.vectors[id] = vector
+	if i.ann != nil {
+		i.ann.Insert(id, vector)
+	}
+}
+
+// addText 添加或替换文档的关键词索引
+func (i *MemoryIndex) addText(id string, content string) {
+	i.keywords.Add(id, content)
+}
+
+// remove 删除向量与关键词索引
+func (i *MemoryIndex) remove(id string) {
+	i.keywords.Remove(id)
+	if _, exists := i.vectors[id]; !exists {
+		return
+	}
+	delete(i.vectors, id)
+	if i.ann != nil {
+		i.ann.Delete(id)
+	}
 }
 
 // NewMemoryVectorClient 创建内存向量数据库客户端
@@ -36,6 +79,15 @@ func NewMemoryVectorClient() *MemoryVectorClient {
 	}
 }
 
+// NewMemoryVectorClientWithHNSW 创建使用 HNSW 近似检索的内存向量数据库客户端
+func NewMemoryVectorClientWithHNSW(config HNSWConfig) *MemoryVectorClient {
+	config = config.withDefaults()
+	return &MemoryVectorClient{
+		collections: make(map[string]*MemoryCollection),
+		hnsw:        &config,
+	}
+}
+
 // CreateCollection 创建集合
 func (m *MemoryVectorClient) CreateCollection(ctx context.Context, name string, dimension int) error {
 	m.mu.Lock()
@@ -49,10 +101,9 @@ func (m *MemoryVectorClient) CreateCollection(ctx context.Context, name string,
 		name:      name,
 		dimension: dimension,
 		documents: make(map[string]*Document),
-		index: &MemoryIndex{
-			vectors: make(map[string][]float32),
-		},
+		index:     newMemoryIndex(m.hnsw),
 	}
+	m.dirty = true
 
 	return nil
 }
@@ -63,6 +114,7 @@ func (m *MemoryVectorClient) DeleteCollection(ctx context.Context, name string)
 	defer m.mu.Unlock()
 
 	delete(m.collections, name)
+	m.dirty = true
 	return nil
 }
 
@@ -99,9 +151,11 @@ func (m *MemoryVectorClient) UpdateDocument(ctx context.Context, doc *Document)
 	collection.documents[doc.ID] = doc
 	
 	// 更新索引
+	collection.index.addText(doc.ID, doc.Content)
 	if len(doc.Embedding) > 0 {
-		collection.index.vectors[doc.ID] = doc.Embedding
+		collection.index.add(doc.ID, doc.Embedding)
 	}
+	m.dirty = true
 
 	return nil
 }
@@ -155,10 +209,12 @@ func (m *MemoryVectorClient) BatchAdd(ctx context.Context, docs []*Document) err
 		collection.documents[doc.ID] = doc
 		
 		// 添加到索引
+		collection.index.addText(doc.ID, doc.Content)
 		if len(doc.Embedding) > 0 {
-			collection.index.vectors[doc.ID] = doc.Embedding
+			collection.index.add(doc.ID, doc.Embedding)
 		}
 	}
+	m.dirty = true
 
 	return nil
 }
@@ -175,20 +231,51 @@ func (m *MemoryVectorClient) BatchDelete(ctx context.Context, ids []string) erro
 	for _, id := range ids {
 		for _, collection := range m.collections {
 			delete(collection.documents, id)
-			delete(collection.index.vectors, id)
+			collection.index.remove(id)
 		}
 	}
+	m.dirty = true
 
 	return nil
 }
 
 // Search 搜索文档
 func (m *MemoryVectorClient) Search(ctx context.Context, query string, options *SearchOptions) ([]*SearchResult, error) {
+	switch options.Mode {
+	case SearchModeKeyword, SearchModeHybrid:
+		return hybridSearch(ctx, m, query, options)
+	}
+
 	// 内存实现中，需要外部提供嵌入向量
 	// 这里简化处理，返回基于文本匹配的结果
 	return m.textSearch(ctx, query, options)
 }
 
+// keywordSearch BM25 关键词检索
+func (m *MemoryVectorClient) keywordSearch(ctx context.Context, query string, options *SearchOptions, topK int) ([]*SearchResult, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	collection, exists := m.collections[options.Collection]
+	if !exists {
+		return nil, fmt.Errorf("collection %s not found", options.Collection)
+	}
+
+	matches := collection.index.keywords.Search(query, topK, func(id string) bool {
+		return m.matchFilter(collection.documents[id], options.Filter)
+	})
+
+	results := make([]*SearchResult, len(matches))
+	for i, match := range matches {
+		results[i] = &SearchResult{
+			Document: searchResultDocument(collection.documents[match.ID], options.IncludeEmbedding),
+			Score:    match.Score,
+			Distance: 1.0 - match.Score,
+		}
+	}
+	return results, nil
+}
+
 // SimilaritySearch 相似度搜索
 func (m *MemoryVectorClient) SimilaritySearch(ctx context.Context, embedding []float32, options *SearchOptions) ([]*SearchResult, error) {
 	m.mu.RLock()
@@ -206,21 +293,37 @@ func (m *MemoryVectorClient) SimilaritySearch(ctx context.Context, embedding []f
 	}
 
 	var candidates []scoreDoc
-	for id, vector := range collection.index.vectors {
-		doc := collection.documents[id]
-		
-		// 应用过滤器
-		if !m.matchFilter(doc, options.Filter) {
-			continue
+	if collection.index.ann != nil && options.TopK > 0 {
+		// 近似检索，过滤条件在图遍历时应用
+		ef := m.hnsw.EfSearch
+		matches := collection.index.ann.Search(embedding, options.TopK, ef, func(id string) bool {
+			return m.matchFilter(collection.documents[id], options.Filter)
+		})
+		for _, match := range matches {
+			if match.Score >= options.Threshold {
+				candidates = append(candidates, scoreDoc{
+					doc:   collection.documents[match.ID],
+					score: match.Score,
+				})
+			}
 		}
+	} else {
+		for id, vector := range collection.index.vectors {
+			doc := collection.documents[id]
+
+			// 应用过滤器
+			if !m.matchFilter(doc, options.Filter) {
+				continue
+			}
 
-		// 计算余弦相似度
-		similarity := cosineSimilarity(embedding, vector)
-		if similarity >= options.Threshold {
-			candidates = append(candidates, scoreDoc{
-				doc:   doc,
-				score: similarity,
-			})
+			// 计算余弦相似度
+			similarity := cosineSimilarity(embedding, vector)
+			if similarity >= options.Threshold {
+				candidates = append(candidates, scoreDoc{
+					doc:   doc,
+					score: similarity,
+				})
+			}
 		}
 	}
 
@@ -324,19 +427,7 @@ func (m *MemoryVectorClient) matchFilter(doc *Document, filter map[string]interf
 		return true
 	}
 
-	for key, expectedValue := range filter {
-		actualValue, exists := doc.Metadata[key]
-		if !exists {
-			return false
-		}
-
-		// 简单的值比较
-		if actualValue != expectedValue {
-			return false
-		}
-	}
-
-	return true
+	return matchMetadata(doc.Metadata, filter)
 }
 
 // Ping 健康检查
@@ -344,8 +435,12 @@ func (m *MemoryVectorClient) Ping(ctx context.Context) error {
 	return nil // 内存实现总是可用
 }
 
-// Close 关闭连接
+// Close 关闭连接，启用快照时先写入最终快照
 func (m *MemoryVectorClient) Close() error {
+	if err := m.stopSnapshots(); err != nil {
+		return err
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -2,7 +2,11 @@ package vector
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestMemoryVectorClient_CreateCollection(t *testing.T) {
@@ -216,4 +220,242 @@ func TestMemoryVectorClient_CollectionManagement(t *testing.T) {
 	if len(listed) != len(collections)-1 {
 		t.Fatalf("Expected %d collections after deletion, got %d", len(collections)-1, len(listed))
 	}
-}
\ No newline at end of file
+}
+
+func TestMemoryVectorClient_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "vectors.json")
+
+	client := NewMemoryVectorClient()
+	if err := client.EnableSnapshots(path, 0); err != nil {
+		t.Fatalf("Failed to enable snapshots: %v", err)
+	}
+	if err := client.CreateCollection(ctx, "test_collection", 2); err != nil {
+		t.Fatalf("Failed to create collection: %v", err)
+	}
+	doc := &Document{
+		ID:         "doc1",
+		Collection: "test_collection",
+		Content:    "Persistent document",
+		Embedding:  []float32{0.6, 0.8},
+		Metadata:   map[string]interface{}{"project_id": "p1", "index": 3},
+	}
+	if err := client.AddDocument(ctx, doc); err != nil {
+		t.Fatalf("Failed to add document: %v", err)
+	}
+
+	// 关闭时写入最终快照
+	if err := client.Close(); err != nil {
+		t.Fatalf("Failed to close client: %v", err)
+	}
+
+	restored := NewMemoryVectorClient()
+	if err := restored.LoadSnapshot(path); err != nil {
+		t.Fatalf("Failed to load snapshot: %v", err)
+	}
+
+	results, err := restored.SimilaritySearch(ctx, []float32{0.6, 0.8}, &SearchOptions{
+		Collection: "test_collection",
+		TopK:       1,
+		Filter:     map[string]interface{}{"project_id": "p1", "index": 3},
+	})
+	if err != nil {
+		t.Fatalf("Failed to search restored client: %v", err)
+	}
+	if len(results) != 1 || results[0].Document.ID != "doc1" {
+		t.Fatalf("Expected doc1 after restore, got %v", results)
+	}
+}
+
+// annTestDimension 近似检索测试使用的向量维度
+const annTestDimension = 64
+
+// newClusteredDocuments 生成围绕若干簇中心分布的随机文档，模拟真实文本嵌入的聚类特征
+func newClusteredDocuments(rng *rand.Rand, n, clusters int) []*Document {
+	centers := make([][]float32, clusters)
+	for i := range centers {
+		centers[i] = randomVector(rng, 1.0)
+	}
+
+	docs := make([]*Document, n)
+	for i := range docs {
+		center := centers[rng.Intn(clusters)]
+		noise := randomVector(rng, 0.3)
+		embedding := make([]float32, annTestDimension)
+		for j := range embedding {
+			embedding[j] = center[j] + noise[j]
+		}
+		docs[i] = &Document{
+			ID:         fmt.Sprintf("doc%d", i),
+			Collection: "test_collection",
+			Content:    fmt.Sprintf("document %d", i),
+			Embedding:  embedding,
+			Metadata:   map[string]interface{}{"project_id": fmt.Sprintf("p%d", i%4)},
+		}
+	}
+	return docs
+}
+
+func randomVector(rng *rand.Rand, scale float64) []float32 {
+	v := make([]float32, annTestDimension)
+	for i := range v {
+		v[i] = float32(rng.NormFloat64() * scale)
+	}
+	return v
+}
+
+// newANNBenchmarkClients 构建包含相同文档的暴力检索与 HNSW 客户端
+func newANNBenchmarkClients(tb testing.TB, docs []*Document) (*MemoryVectorClient, *MemoryVectorClient) {
+	ctx := context.Background()
+	flat := NewMemoryVectorClient()
+	hnsw := NewMemoryVectorClientWithHNSW(DefaultHNSWConfig())
+	for _, client := range []*MemoryVectorClient{flat, hnsw} {
+		if err := client.CreateCollection(ctx, "test_collection", annTestDimension); err != nil {
+			tb.Fatalf("Failed to create collection: %v", err)
+		}
+		if err := client.BatchAdd(ctx, docs); err != nil {
+			tb.Fatalf("Failed to add documents: %v", err)
+		}
+	}
+	return flat, hnsw
+}
+
+// measureRecall 计算 HNSW 结果相对暴力检索结果的召回率
+func measureRecall(tb testing.TB, flat, hnsw *MemoryVectorClient, queries [][]float32, options *SearchOptions) float64 {
+	ctx := context.Background()
+	hits, total := 0, 0
+	for _, query := range queries {
+		exact, err := flat.SimilaritySearch(ctx, query, options)
+		if err != nil {
+			tb.Fatalf("Brute-force search failed: %v", err)
+		}
+		approx, err := hnsw.SimilaritySearch(ctx, query, options)
+		if err != nil {
+			tb.Fatalf("HNSW search failed: %v", err)
+		}
+
+		found := make(map[string]bool, len(approx))
+		for _, result := range approx {
+			found[result.Document.ID] = true
+		}
+		for _, result := range exact {
+			if found[result.Document.ID] {
+				hits++
+			}
+		}
+		total += len(exact)
+	}
+	if total == 0 {
+		return 1
+	}
+	return float64(hits) / float64(total)
+}
+
+func TestMemoryVectorClient_HNSWRecall(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	docs := newClusteredDocuments(rng, 2000, 20)
+	flat, hnsw := newANNBenchmarkClients(t, docs)
+
+	queries := make([][]float32, 50)
+	for i := range queries {
+		queries[i] = randomVector(rng, 1.0)
+	}
+
+	options := &SearchOptions{Collection: "test_collection", TopK: 10, Threshold: -1}
+	if recall := measureRecall(t, flat, hnsw, queries, options); recall < 0.95 {
+		t.Fatalf("HNSW recall@10 too low: %.3f", recall)
+	}
+
+	// 带过滤条件时同样应保持召回率
+	options.Filter = map[string]interface{}{"project_id": "p1"}
+	if recall := measureRecall(t, flat, hnsw, queries, options); recall < 0.95 {
+		t.Fatalf("Filtered HNSW recall@10 too low: %.3f", recall)
+	}
+}
+
+func TestMemoryVectorClient_HNSWIncrementalUpdates(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(2))
+	docs := newClusteredDocuments(rng, 500, 10)
+	flat, hnsw := newANNBenchmarkClients(t, docs)
+
+	// 删除一半文档并更新部分文档的向量
+	deleted := make([]string, 0, len(docs)/2)
+	for i := 0; i < len(docs); i += 2 {
+		deleted = append(deleted, docs[i].ID)
+	}
+	for _, client := range []*MemoryVectorClient{flat, hnsw} {
+		if err := client.BatchDelete(ctx, deleted); err != nil {
+			t.Fatalf("Failed to delete documents: %v", err)
+		}
+	}
+	for i := 1; i < 100; i += 2 {
+		updated := *docs[i]
+		updated.Embedding = randomVector(rng, 1.0)
+		for _, client := range []*MemoryVectorClient{flat, hnsw} {
+			doc := updated
+			if err := client.UpdateDocument(ctx, &doc); err != nil {
+				t.Fatalf("Failed to update document: %v", err)
+			}
+		}
+	}
+
+	queries := make([][]float32, 30)
+	for i := range queries {
+		queries[i] = randomVector(rng, 1.0)
+	}
+	options := &SearchOptions{Collection: "test_collection", TopK: 10, Threshold: -1}
+	if recall := measureRecall(t, flat, hnsw, queries, options); recall < 0.95 {
+		t.Fatalf("HNSW recall@10 after updates too low: %.3f", recall)
+	}
+
+	// 已删除的文档不应出现在结果中
+	results, err := hnsw.SimilaritySearch(ctx, docs[0].Embedding, options)
+	if err != nil {
+		t.Fatalf("Failed to search: %v", err)
+	}
+	for _, result := range results {
+		if result.Document.ID == docs[0].ID {
+			t.Fatal("Deleted document returned by HNSW search")
+		}
+	}
+}
+
+// BenchmarkMemoryVectorClient_SimilaritySearch 对比暴力检索与 HNSW 的查询延迟与召回率
+func BenchmarkMemoryVectorClient_SimilaritySearch(b *testing.B) {
+	for _, size := range []int{1000, 10000} {
+		rng := rand.New(rand.NewSource(int64(size)))
+		docs := newClusteredDocuments(rng, size, 50)
+
+		start := time.Now()
+		flat, hnsw := newANNBenchmarkClients(b, docs)
+		b.Logf("built %d documents in %v", size, time.Since(start))
+
+		queries := make([][]float32, 100)
+		for i := range queries {
+			queries[i] = randomVector(rng, 1.0)
+		}
+		options := &SearchOptions{Collection: "test_collection", TopK: 10, Threshold: -1}
+		recall := measureRecall(b, flat, hnsw, queries, options)
+
+		for _, bc := range []struct {
+			name   string
+			client *MemoryVectorClient
+			recall float64
+		}{
+			{"brute_force", flat, 1},
+			{"hnsw", hnsw, recall},
+		} {
+			b.Run(fmt.Sprintf("%s/n=%d", bc.name, size), func(b *testing.B) {
+				ctx := context.Background()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := bc.client.SimilaritySearch(ctx, queries[i%len(queries)], options); err != nil {
+						b.Fatalf("Search failed: %v", err)
+					}
+				}
+				b.ReportMetric(bc.recall, "recall@10")
+			})
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package vector
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"backend/internal/pkg/textutil"
+)
+
+// n-gram 嵌入默认参数
+const (
+	defaultNgramDimension = 512
+	defaultNgramMaxN      = 3
+)
+
+// 未登记特征的先验文档频率，越长的 n-gram 越少见、信息量越大
+var ngramPriorDF = map[int]float64{
+	1: 0.02,
+	2: 0.005,
+	3: 0.001,
+}
+
+// 高频汉字、英文停用词以及全部由高频汉字组成的 n-gram 的先验文档频率，IDF 权重因此明显低于普通特征
+const (
+	commonFeatureDF = 0.5
+	commonNgramDF   = 0.1
+)
+
+// commonHanChars 现代汉语中最常见的功能性汉字
+const commonHanChars = "的一是了不在人有我他这个们中来上大为和到以说时要就出会可也你对能而子那得于着下自之过后里去么她没看好还只把又被让给从向很都与再已吧呢吗啊"
+
+// commonEnglishWords 英文停用词
+var commonEnglishWords = []string{
+	"the", "a", "an", "of", "to", "and", "in", "is", "was", "he", "she", "it", "that", "for",
+	"on", "with", "as", "at", "by", "his", "her", "they", "be", "this", "had", "were", "are",
+	"not", "but", "from", "or", "have", "i", "you",
+}
+
+// NgramEmbeddingConfig n-gram 嵌入配置
+type NgramEmbeddingConfig struct {
+	Dimension int // 向量维度，默认512
+	MaxN      int // 中文字符 n-gram 的最大长度，默认3
+}
+
+// NgramEmbeddingService 离线 n-gram 嵌入服务
+// 中文取连续汉字的 1~MaxN 字 n-gram，英文取小写单词、相邻词对和带边界的字符三元组；
+// 特征经带符号的哈希映射到固定维度，按 (1+ln tf)·idf 加权后做 L2 归一化。
+// idf 来自内置的先验文档频率：常用虚字与停用词权重低，越长的 n-gram 权重越高。
+// 不依赖外部模型或语料统计，同一文本总是得到相同的向量，共享字词越多的文本余弦相似度越高。
+type NgramEmbeddingService struct {
+	dimension int
+	maxN      int
+	common    map[string]bool
+}
+
+// NewNgramEmbeddingService 创建 n-gram 嵌入服务
+func NewNgramEmbeddingService(config NgramEmbeddingConfig) *NgramEmbeddingService {
+	if config.Dimension <= 0 {
+		config.Dimension = defaultNgramDimension
+	}
+	if config.MaxN <= 0 {
+		config.MaxN = defaultNgramMaxN
+	}
+
+	common := make(map[string]bool, len(commonHanChars)+len(commonEnglishWords))
+	for _, r := range commonHanChars {
+		common[string(r)] = true
+	}
+	for _, word := range commonEnglishWords {
+		common["w:"+word] = true
+	}
+
+	return &NgramEmbeddingService{
+		dimension: config.Dimension,
+		maxN:      config.MaxN,
+		common:    common,
+	}
+}
+
+// Embed 生成文本嵌入
+func (s *NgramEmbeddingService) Embed(ctx context.Context, text string) ([]float32, error) {
+	if text == "" {
+		return nil, fmt.Errorf("text cannot be empty")
+	}
+
+	features := s.features(text)
+	embedding := make([]float32, s.dimension)
+	for feature, tf := range features {
+		h := fnv.New64a()
+		h.Write([]byte(feature))
+		sum := h.Sum64()
+
+		weight := (1 + math.Log(float64(tf))) * s.idf(feature)
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		embedding[sum%uint64(s.dimension)] += float32(weight)
+	}
+
+	return normalize(embedding), nil
+}
+
+// EmbedBatch 批量生成文本嵌入
+func (s *NgramEmbeddingService) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(texts))
+	for i, text := range texts {
+		embedding, err := s.Embed(ctx, text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to embed text %d: %w", i, err)
+		}
+		embeddings[i] = embedding
+	}
+	return embeddings, nil
+}
+
+// GetDimension 获取嵌入维度
+func (s *NgramEmbeddingService) GetDimension() int {
+	return s.dimension
+}
+
+// Ping 健康检查
+func (s *NgramEmbeddingService) Ping(ctx context.Context) error {
+	return nil // 本地服务总是可用
+}
+
+// features 提取文本特征及其词频
+func (s *NgramEmbeddingService) features(text string) map[string]int {
+	features := make(map[string]int)
+	var han []rune
+	var words []string
+	var word []rune
+
+	flushHan := func() {
+		for n := 1; n <= s.maxN; n++ {
+			for i := 0; i+n <= len(han); i++ {
+				features[string(han[i:i+n])]++
+			}
+		}
+		han = han[:0]
+	}
+	flushWord := func() {
+		if len(word) == 0 {
+			return
+		}
+		w := strings.ToLower(string(word))
+		features["w:"+w]++
+		if len(words) > 0 {
+			features["b:"+words[len(words)-1]+" "+w]++
+		}
+		words = append(words, w)
+
+		// 带边界的字符三元组，使词形变化（walk/walked）也有相似度
+		bounded := []rune("#" + w + "#")
+		for i := 0; i+3 <= len(bounded); i++ {
+			features["c:"+string(bounded[i:i+3])]++
+		}
+		word = word[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case textutil.IsCJK(r):
+			flushWord()
+			words = words[:0]
+			han = append(han, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushHan()
+			word = append(word, r)
+		case unicode.IsSpace(r):
+			flushHan()
+			flushWord()
+		default:
+			// 标点切断 n-gram 与词对
+			flushHan()
+			flushWord()
+			words = words[:0]
+		}
+	}
+	flushHan()
+	flushWord()
+
+	return features
+}
+
+// idf 按先验文档频率计算特征的逆文档频率
+func (s *NgramEmbeddingService) idf(feature string) float64 {
+	if s.common[feature] {
+		return math.Log(1 + 1/commonFeatureDF)
+	}
+
+	var df float64
+	switch {
+	case strings.HasPrefix(feature, "w:"):
+		df = ngramPriorDF[2]
+	case strings.HasPrefix(feature, "b:"):
+		df = ngramPriorDF[3]
+	case strings.HasPrefix(feature, "c:"):
+		// 字符三元组只用于弥补词形变化，权重低于整词
+		df = ngramPriorDF[1] * 5
+	case s.allCommon(feature):
+		// 由常用字组成的词（我们、他的）同样常见
+		df = commonNgramDF
+	default:
+		n := len([]rune(feature))
+		if n > 3 {
+			n = 3
+		}
+		df = ngramPriorDF[n]
+	}
+	return math.Log(1 + 1/df)
+}
+
+// allCommon 判断汉字 n-gram 是否全部由常用字组成
+func (s *NgramEmbeddingService) allCommon(feature string) bool {
+	for _, r := range feature {
+		if !s.common[string(r)] {
+			return false
+		}
+	}
+	return true
+}
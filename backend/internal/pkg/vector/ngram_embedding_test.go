@@ -0,0 +1,143 @@
+package vector
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"backend/internal/conf"
+)
+
+func cosine(a, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	return dot / (math.Sqrt(na)*math.Sqrt(nb) + 1e-12)
+}
+
+func TestNgramEmbeddingService_Embed(t *testing.T) {
+	ctx := context.Background()
+	service := NewNgramEmbeddingService(NgramEmbeddingConfig{Dimension: 256})
+
+	first, err := service.Embed(ctx, "林晚在雨夜第一次见到了侦探沈舟")
+	if err != nil {
+		t.Fatalf("Failed to embed: %v", err)
+	}
+	second, _ := service.Embed(ctx, "林晚在雨夜第一次见到了侦探沈舟")
+	if len(first) != 256 || cosine(first, second) < 0.9999 {
+		t.Fatal("Embedding should be deterministic with the configured dimension")
+	}
+
+	var norm float64
+	for _, v := range first {
+		norm += float64(v) * float64(v)
+	}
+	if math.Abs(norm-1) > 1e-4 {
+		t.Fatalf("Expected unit vector, got norm %f", norm)
+	}
+
+	if _, err := service.Embed(ctx, ""); err == nil {
+		t.Fatal("Expected error for empty text")
+	}
+}
+
+func TestNgramEmbeddingService_LexicalSimilarity(t *testing.T) {
+	ctx := context.Background()
+	service := NewNgramEmbeddingService(NgramEmbeddingConfig{})
+
+	tests := []struct {
+		name      string
+		query     string
+		related   string
+		unrelated string
+	}{
+		{
+			name:      "中文共享人物与事件",
+			query:     "林晚什么时候第一次见到侦探",
+			related:   "那个雨夜，林晚第一次见到了侦探沈舟。",
+			unrelated: "山门外的集市上，商贩们正忙着收摊。",
+		},
+		{
+			name:      "常用虚字不主导相似度",
+			query:     "我们在他的房间里找到了那块玉佩",
+			related:   "玉佩从沈舟怀里滑落",
+			unrelated: "我们在他的那里坐了一会儿",
+		},
+		{
+			name:      "英文词形变化",
+			query:     "the detective walked into the station",
+			related:   "a detective walking to the police station",
+			unrelated: "the garden was full of roses in spring",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, _ := service.Embed(ctx, tt.query)
+			related, _ := service.Embed(ctx, tt.related)
+			unrelated, _ := service.Embed(ctx, tt.unrelated)
+			if cosine(query, related) <= cosine(query, unrelated) {
+				t.Fatalf("Expected related text to score higher: related=%.3f unrelated=%.3f",
+					cosine(query, related), cosine(query, unrelated))
+			}
+		})
+	}
+}
+
+func TestNgramEmbeddingService_Retrieval(t *testing.T) {
+	ctx := context.Background()
+	service := NewRAGService(NewMemoryVectorClient(), NewNgramEmbeddingService(NgramEmbeddingConfig{}))
+	if err := service.InitializeCollections(ctx); err != nil {
+		t.Fatalf("Failed to initialize collections: %v", err)
+	}
+
+	chapters := []string{
+		"清晨，沈舟在码头清点货物，海风带着咸味。",
+		"那个雨夜，林晚第一次见到了侦探沈舟，他浑身湿透地站在门口。",
+		"集市上人声鼎沸，卖糖人的老汉吆喝个不停。",
+	}
+	for i, content := range chapters {
+		chapter := newTestChapter(i+1, content, 1)
+		if err := service.AddChapter(ctx, chapter); err != nil {
+			t.Fatalf("Failed to add chapter: %v", err)
+		}
+	}
+
+	embedding, _ := service.embeddingService.Embed(ctx, "林晚什么时候第一次见到侦探")
+	results, err := service.vectorClient.SimilaritySearch(ctx, embedding, &SearchOptions{
+		Collection: "novel_chapter",
+		TopK:       1,
+		Threshold:  -1,
+	})
+	if err != nil || len(results) != 1 {
+		t.Fatalf("Failed to search: %v", err)
+	}
+	if metadataInt(results[0].Document.Metadata, "index") != 2 {
+		t.Fatalf("Expected the first meeting chapter, got %q", results[0].Document.Content)
+	}
+}
+
+func TestVectorServiceFactory_CreateNgramEmbeddingService(t *testing.T) {
+	config := &conf.Data_Vector{
+		Embedding: &conf.Data_Vector_Embedding{
+			Provider: "ngram",
+			Model:    "ngram-384",
+		},
+	}
+
+	// 离线嵌入不需要 AI 模型配置
+	service, err := NewVectorServiceFactory(config, nil).CreateEmbeddingService()
+	if err != nil {
+		t.Fatalf("Failed to create embedding service: %v", err)
+	}
+	ngram, ok := service.(*NgramEmbeddingService)
+	if !ok {
+		t.Fatalf("Expected NgramEmbeddingService, got %T", service)
+	}
+	if ngram.GetDimension() != 384 {
+		t.Fatalf("Expected dimension 384, got %d", ngram.GetDimension())
+	}
+}
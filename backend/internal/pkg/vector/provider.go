@@ -1,20 +1,36 @@
 package vector
 
 import (
+	"context"
 	"fmt"
 	"backend/internal/conf"
+	"backend/internal/pkg/llm"
 	"github.com/google/wire"
 )
 
 // ProviderSet is vector providers.
 var ProviderSet = wire.NewSet(NewVectorServiceFactory, NewRAGServiceProvider)
 
-// NewRAGServiceProvider 创建RAG服务提供者（用于依赖注入）
-func NewRAGServiceProvider(config *conf.Data, aiConfig *conf.AI) (*RAGService, error) {
+// NewRAGServiceProvider 创建RAG服务提供者（用于依赖注入），清理时关闭向量库以落盘
+func NewRAGServiceProvider(config *conf.Data, aiConfig *conf.AI, llmClient llm.LLMClient) (*RAGService, func(), error) {
 	if config.Vector == nil {
-		return nil, fmt.Errorf("vector configuration is required")
+		return nil, nil, fmt.Errorf("vector configuration is required")
 	}
 
 	factory := NewVectorServiceFactory(config.Vector, aiConfig)
-	return factory.CreateRAGService()
+	ragService, err := factory.CreateRAGServiceWithLLM(llmClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 持久化后端重启后集合已存在，只创建缺失的集合
+	if err := ragService.InitializeCollections(context.Background()); err != nil {
+		factory.CloseServices(ragService)
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		factory.CloseServices(ragService)
+	}
+	return ragService, cleanup, nil
 }
\ No newline at end of file
@@ -0,0 +1,156 @@
+package vector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"backend/internal/pkg/llm"
+	"backend/internal/pkg/textutil"
+)
+
+// 重排序方式
+const (
+	RerankerNone  = "none"
+	RerankerLocal = "local"
+	RerankerLLM   = "llm"
+)
+
+// Reranker 检索结果重排序接口，返回按新分数降序排列的结果
+type Reranker interface {
+	Rerank(ctx context.Context, query string, results []*SearchResult) ([]*SearchResult, error)
+}
+
+// LocalReranker 本地交叉编码重排序（替身实现）
+// 同时考察查询与文档：查询词元覆盖率衡量相关面，最长连续匹配衡量短语贴合度
+type LocalReranker struct{}
+
+// NewLocalReranker 创建本地重排序器
+func NewLocalReranker() *LocalReranker {
+	return &LocalReranker{}
+}
+
+// Rerank 重排序
+func (r *LocalReranker) Rerank(ctx context.Context, query string, results []*SearchResult) ([]*SearchResult, error) {
+	tokens := textutil.QueryTokens(query)
+	queryRunes := []rune(strings.ToLower(strings.TrimSpace(query)))
+
+	reranked := make([]*SearchResult, len(results))
+	for i, result := range results {
+		content := strings.ToLower(result.Document.Content)
+
+		coverage := float32(0)
+		if len(tokens) > 0 {
+			hits := 0
+			for _, token := range tokens {
+				if strings.Contains(content, token) {
+					hits++
+				}
+			}
+			coverage = float32(hits) / float32(len(tokens))
+		}
+
+		proximity := float32(0)
+		if len(queryRunes) > 0 {
+			proximity = float32(longestCommonSubstring(queryRunes, []rune(content))) / float32(len(queryRunes))
+		}
+
+		score := 0.6*coverage + 0.4*proximity
+		reranked[i] = &SearchResult{
+			Document: result.Document,
+			Score:    score,
+			Distance: 1.0 - score,
+		}
+	}
+
+	// 分数相同时保留原有排序
+	sort.SliceStable(reranked, func(i, j int) bool {
+		return reranked[i].Score > reranked[j].Score
+	})
+	return reranked, nil
+}
+
+// longestCommonSubstring 返回 a 与 b 的最长公共子串长度
+func longestCommonSubstring(a, b []rune) int {
+	longest := 0
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				curr[j] = prev[j-1] + 1
+				if curr[j] > longest {
+					longest = curr[j]
+				}
+			} else {
+				curr[j] = 0
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return longest
+}
+
+// llmRerankSnippetRunes 交给 LLM 打分的片段长度
+const llmRerankSnippetRunes = 300
+
+// LLMReranker 使用 LLM 为候选片段打分的重排序器
+type LLMReranker struct {
+	client llm.LLMClient
+}
+
+// NewLLMReranker 创建 LLM 重排序器
+func NewLLMReranker(client llm.LLMClient) *LLMReranker {
+	return &LLMReranker{client: client}
+}
+
+// Rerank 重排序，LLM 未给出分数的片段记为 0 分
+func (r *LLMReranker) Rerank(ctx context.Context, query string, results []*SearchResult) ([]*SearchResult, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "请评估以下候选片段与查询的相关程度，为每个片段给出 0-10 的分数。\n查询：%s\n\n", query)
+	for i, result := range results {
+		snippet := []rune(result.Document.Content)
+		if len(snippet) > llmRerankSnippetRunes {
+			snippet = snippet[:llmRerankSnippetRunes]
+		}
+		fmt.Fprintf(&b, "[%d] %s\n", i+1, string(snippet))
+	}
+	b.WriteString("\n返回格式：{\"scores\": [{\"id\": 1, \"score\": 8}]}")
+
+	response, err := r.client.GenerateJSON(ctx, b.String(), llm.PreciseOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to rerank with llm: %w", err)
+	}
+
+	scores := make(map[int]float32)
+	items, _ := response["scores"].([]interface{})
+	for _, item := range items {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, ok1 := toFloat(entry["id"])
+		score, ok2 := toFloat(entry["score"])
+		if ok1 && ok2 {
+			scores[int(id)] = float32(score) / 10
+		}
+	}
+	if len(scores) == 0 {
+		return nil, fmt.Errorf("failed to rerank with llm: no scores in response")
+	}
+
+	reranked := make([]*SearchResult, len(results))
+	for i, result := range results {
+		score := scores[i+1]
+		reranked[i] = &SearchResult{
+			Document: result.Document,
+			Score:    score,
+			Distance: 1.0 - score,
+		}
+	}
+	sort.SliceStable(reranked, func(i, j int) bool {
+		return reranked[i].Score > reranked[j].Score
+	})
+	return reranked, nil
+}
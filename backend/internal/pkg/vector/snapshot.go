@@ -0,0 +1,196 @@
+package vector
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// snapshotVersion 快照文件格式版本
+const snapshotVersion = 1
+
+// memorySnapshot 内存向量库快照
+type memorySnapshot struct {
+	Version     int                  `json:"version"`
+	SavedAt     time.Time            `json:"saved_at"`
+	Collections []snapshotCollection `json:"collections"`
+}
+
+// snapshotCollection 快照中的集合
+type snapshotCollection struct {
+	Name      string      `json:"name"`
+	Dimension int         `json:"dimension"`
+	Documents []*Document `json:"documents"`
+}
+
+// snapshotter 定期快照任务
+type snapshotter struct {
+	path string
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+// SaveSnapshot 将全部集合与文档写入快照文件，先写临时文件再重命名，避免写入中断损坏快照
+func (m *MemoryVectorClient) SaveSnapshot(path string) error {
+	m.mu.Lock()
+	snapshot := memorySnapshot{
+		Version:     snapshotVersion,
+		SavedAt:     time.Now(),
+		Collections: make([]snapshotCollection, 0, len(m.collections)),
+	}
+	for _, collection := range m.collections {
+		docs := make([]*Document, 0, len(collection.documents))
+		for _, doc := range collection.documents {
+			docs = append(docs, doc)
+		}
+		snapshot.Collections = append(snapshot.Collections, snapshotCollection{
+			Name:      collection.name,
+			Dimension: collection.dimension,
+			Documents: docs,
+		})
+	}
+	data, err := json.Marshal(&snapshot)
+	if err == nil {
+		m.dirty = false
+	}
+	m.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to marshal vector snapshot: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		m.markDirty()
+		return fmt.Errorf("failed to write vector snapshot: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		m.markDirty()
+		return fmt.Errorf("failed to replace vector snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// LoadSnapshot 从快照文件恢复集合与文档，覆盖当前内容；快照不存在时不做任何操作
+func (m *MemoryVectorClient) LoadSnapshot(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read vector snapshot: %w", err)
+	}
+
+	var snapshot memorySnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("failed to parse vector snapshot: %w", err)
+	}
+	if snapshot.Version != snapshotVersion {
+		return fmt.Errorf("unsupported vector snapshot version: %d", snapshot.Version)
+	}
+
+	collections := make(map[string]*MemoryCollection, len(snapshot.Collections))
+	for _, sc := range snapshot.Collections {
+		collection := &MemoryCollection{
+			name:      sc.Name,
+			dimension: sc.Dimension,
+			documents: make(map[string]*Document, len(sc.Documents)),
+			index:     newMemoryIndex(m.hnsw),
+		}
+		for _, doc := range sc.Documents {
+			if doc == nil {
+				continue
+			}
+			collection.documents[doc.ID] = doc
+			collection.index.addText(doc.ID, doc.Content)
+			if len(doc.Embedding) > 0 {
+				collection.index.add(doc.ID, doc.Embedding)
+			}
+		}
+		collections[sc.Name] = collection
+	}
+
+	m.mu.Lock()
+	m.collections = collections
+	m.dirty = false
+	m.mu.Unlock()
+
+	return nil
+}
+
+// EnableSnapshots 从快照恢复数据，并按间隔定期写入快照；Close 时会写入最终快照
+func (m *MemoryVectorClient) EnableSnapshots(path string, interval time.Duration) error {
+	if err := m.LoadSnapshot(path); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.snapshot != nil {
+		return fmt.Errorf("snapshots already enabled: %s", m.snapshot.path)
+	}
+	s := &snapshotter{path: path, stop: make(chan struct{})}
+	m.snapshot = s
+
+	if interval > 0 {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-s.stop:
+					return
+				case <-ticker.C:
+					if m.isDirty() {
+						// 定期快照失败时保留脏标记，下次继续重试
+						_ = m.SaveSnapshot(path)
+					}
+				}
+			}
+		}()
+	}
+
+	return nil
+}
+
+// stopSnapshots 停止定期快照并写入最终快照
+func (m *MemoryVectorClient) stopSnapshots() error {
+	m.mu.Lock()
+	s := m.snapshot
+	m.snapshot = nil
+	m.mu.Unlock()
+
+	if s == nil {
+		return nil
+	}
+	close(s.stop)
+	s.wg.Wait()
+
+	if m.isDirty() {
+		return m.SaveSnapshot(s.path)
+	}
+	return nil
+}
+
+// isDirty 上次快照后是否有修改
+func (m *MemoryVectorClient) isDirty() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.dirty
+}
+
+// markDirty 标记存在未写入快照的修改
+func (m *MemoryVectorClient) markDirty() {
+	m.mu.Lock()
+	m.dirty = true
+	m.mu.Unlock()
+}
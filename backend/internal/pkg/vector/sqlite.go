@@ -0,0 +1,477 @@
+package vector
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+)
+
+// 作为独立列存储以便在 SQL 中过滤的元数据键
+const (
+	metadataProjectID = "project_id"
+	metadataType      = "type"
+)
+
+// vectorCollection 集合表
+type vectorCollection struct {
+	Name      string `gorm:"primaryKey;type:varchar(128)"`
+	Dimension int    `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+// TableName 表名
+func (vectorCollection) TableName() string {
+	return "vector_collections"
+}
+
+// vectorDocument 文档表，向量以 float32 小端序 BLOB 存储
+type vectorDocument struct {
+	Collection string `gorm:"primaryKey;type:varchar(128)"`
+	ID         string `gorm:"primaryKey;type:varchar(255)"`
+	ProjectID  string `gorm:"index;type:varchar(64)"`
+	DocType    string `gorm:"index;type:varchar(64)"`
+	Content    string `gorm:"type:text"`
+	Embedding  []byte `gorm:"type:blob"`
+	Metadata   string `gorm:"type:text"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName 表名
+func (vectorDocument) TableName() string {
+	return "vector_documents"
+}
+
+// SQLiteVectorClient 基于 SQLite 的持久化向量数据库客户端
+type SQLiteVectorClient struct {
+	db *gorm.DB
+
+	// 近似检索索引，hnsw 为 nil 时使用暴力检索
+	hnsw *HNSWConfig
+	mu   sync.Mutex
+	ann  map[string]*sqliteANN
+}
+
+// NewSQLiteVectorClient 打开（或创建）SQLite 向量库
+func NewSQLiteVectorClient(path string) (*SQLiteVectorClient, error) {
+	if path == "" {
+		return nil, fmt.Errorf("sqlite vector store path is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create vector store directory: %w", err)
+	}
+
+	// 向量 BLOB 体积较大，只记录警告级别的 SQL 日志
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open vector store: %w", err)
+	}
+
+	if err := db.AutoMigrate(&vectorCollection{}, &vectorDocument{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate vector store: %w", err)
+	}
+
+	return &SQLiteVectorClient{db: db}, nil
+}
+
+// CreateCollection 创建集合
+func (s *SQLiteVectorClient) CreateCollection(ctx context.Context, name string, dimension int) error {
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&vectorCollection{
+		Name:      name,
+		Dimension: dimension,
+		CreatedAt: time.Now(),
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to create collection: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("collection %s already exists", name)
+	}
+
+	return nil
+}
+
+// DeleteCollection 删除集合及其全部文档
+func (s *SQLiteVectorClient) DeleteCollection(ctx context.Context, name string) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection = ?", name).Delete(&vectorDocument{}).Error; err != nil {
+			return fmt.Errorf("failed to delete collection documents: %w", err)
+		}
+		if err := tx.Where("name = ?", name).Delete(&vectorCollection{}).Error; err != nil {
+			return fmt.Errorf("failed to delete collection: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.annDrop(name)
+
+	return nil
+}
+
+// ListCollections 列出所有集合
+func (s *SQLiteVectorClient) ListCollections(ctx context.Context) ([]string, error) {
+	var names []string
+	if err := s.db.WithContext(ctx).Model(&vectorCollection{}).Order("name").Pluck("name", &names).Error; err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	return names, nil
+}
+
+// AddDocument 添加文档
+func (s *SQLiteVectorClient) AddDocument(ctx context.Context, doc *Document) error {
+	return s.BatchAdd(ctx, []*Document{doc})
+}
+
+// UpdateDocument 更新文档
+func (s *SQLiteVectorClient) UpdateDocument(ctx context.Context, doc *Document) error {
+	return s.BatchAdd(ctx, []*Document{doc})
+}
+
+// DeleteDocument 删除文档
+func (s *SQLiteVectorClient) DeleteDocument(ctx context.Context, id string) error {
+	return s.BatchDelete(ctx, []string{id})
+}
+
+// GetDocument 获取文档
+func (s *SQLiteVectorClient) GetDocument(ctx context.Context, id string) (*Document, error) {
+	var rows []*vectorDocument
+	if err := s.db.WithContext(ctx).Where("id = ?", id).Limit(1).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("document %s not found", id)
+	}
+
+	return rowToDocument(rows[0], true)
+}
+
+// BatchAdd 批量添加文档，已存在的文档会被覆盖
+func (s *SQLiteVectorClient) BatchAdd(ctx context.Context, docs []*Document) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	rows := make([]*vectorDocument, 0, len(docs))
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dimensions := make(map[string]int)
+		now := time.Now()
+
+		for _, doc := range docs {
+			dimension, ok := dimensions[doc.Collection]
+			if !ok {
+				var collections []vectorCollection
+				if err := tx.Where("name = ?", doc.Collection).Limit(1).Find(&collections).Error; err != nil {
+					return fmt.Errorf("failed to get collection: %w", err)
+				}
+				if len(collections) == 0 {
+					return fmt.Errorf("collection %s not found", doc.Collection)
+				}
+				dimension = collections[0].Dimension
+				dimensions[doc.Collection] = dimension
+			}
+
+			// 验证向量维度
+			if len(doc.Embedding) > 0 && len(doc.Embedding) != dimension {
+				return fmt.Errorf("embedding dimension mismatch: expected %d, got %d", dimension, len(doc.Embedding))
+			}
+
+			// 设置时间戳
+			if doc.CreatedAt.IsZero() {
+				doc.CreatedAt = now
+			}
+			doc.UpdatedAt = now
+
+			row, err := documentToRow(doc)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, row)
+		}
+
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(rows).Error; err != nil {
+			return fmt.Errorf("failed to save documents: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.annApply(rows)
+
+	return nil
+}
+
+// BatchDelete 批量删除文档
+func (s *SQLiteVectorClient) BatchDelete(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := s.db.WithContext(ctx).Where("id IN ?", ids).Delete(&vectorDocument{}).Error; err != nil {
+		return fmt.Errorf("failed to delete documents: %w", err)
+	}
+	s.annRemove(ids)
+	return nil
+}
+
+// Search 搜索文档，与内存实现一致使用文本匹配
+func (s *SQLiteVectorClient) Search(ctx context.Context, query string, options *SearchOptions) ([]*SearchResult, error) {
+	switch options.Mode {
+	case SearchModeKeyword, SearchModeHybrid:
+		return hybridSearch(ctx, s, query, options)
+	}
+
+	rows, err := s.candidates(ctx, options, false)
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.ToLower(query)
+	var results []*SearchResult
+	for _, row := range rows {
+		doc, err := rowToDocument(row, options.IncludeEmbedding)
+		if err != nil {
+			return nil, err
+		}
+		if !matchMetadata(doc.Metadata, options.Filter) {
+			continue
+		}
+
+		content := strings.ToLower(doc.Content)
+		if !strings.Contains(content, query) {
+			continue
+		}
+		// 计算简单的匹配分数
+		score := float32(strings.Count(content, query)) / float32(len(strings.Fields(content)))
+		if score < options.Threshold {
+			continue
+		}
+
+		results = append(results, &SearchResult{
+			Document: doc,
+			Score:    score,
+			Distance: 1.0 - score,
+		})
+	}
+
+	return rankResults(results, options.TopK), nil
+}
+
+// keywordSearch BM25 关键词检索，在 SQL 预过滤后的候选文档上临时建立索引
+func (s *SQLiteVectorClient) keywordSearch(ctx context.Context, query string, options *SearchOptions, topK int) ([]*SearchResult, error) {
+	rows, err := s.candidates(ctx, options, false)
+	if err != nil {
+		return nil, err
+	}
+
+	index := newKeywordIndex()
+	docs := make(map[string]*Document, len(rows))
+	for _, row := range rows {
+		doc, err := rowToDocument(row, options.IncludeEmbedding)
+		if err != nil {
+			return nil, err
+		}
+		if !matchMetadata(doc.Metadata, options.Filter) {
+			continue
+		}
+		docs[doc.ID] = doc
+		index.Add(doc.ID, doc.Content)
+	}
+
+	matches := index.Search(query, topK, nil)
+	results := make([]*SearchResult, len(matches))
+	for i, match := range matches {
+		results[i] = &SearchResult{
+			Document: docs[match.ID],
+			Score:    match.Score,
+			Distance: 1.0 - match.Score,
+		}
+	}
+	return results, nil
+}
+
+// SimilaritySearch 相似度搜索
+func (s *SQLiteVectorClient) SimilaritySearch(ctx context.Context, embedding []float32, options *SearchOptions) ([]*SearchResult, error) {
+	if s.hnsw != nil && options.TopK > 0 {
+		return s.annSearch(ctx, embedding, options)
+	}
+
+	rows, err := s.candidates(ctx, options, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*SearchResult
+	for _, row := range rows {
+		if len(row.Embedding) == 0 {
+			continue
+		}
+		doc, err := rowToDocument(row, true)
+		if err != nil {
+			return nil, err
+		}
+		if !matchMetadata(doc.Metadata, options.Filter) {
+			continue
+		}
+
+		// 计算余弦相似度
+		similarity := cosineSimilarity(embedding, doc.Embedding)
+		if similarity < options.Threshold {
+			continue
+		}
+		if !options.IncludeEmbedding {
+			doc.Embedding = nil
+		}
+
+		results = append(results, &SearchResult{
+			Document: doc,
+			Score:    similarity,
+			Distance: 1.0 - similarity,
+		})
+	}
+
+	return rankResults(results, options.TopK), nil
+}
+
+// candidates 按集合与可下推的元数据列查询候选文档
+func (s *SQLiteVectorClient) candidates(ctx context.Context, options *SearchOptions, withEmbedding bool) ([]*vectorDocument, error) {
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&vectorCollection{}).Where("name = ?", options.Collection).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("collection %s not found", options.Collection)
+	}
+
+	query := s.db.WithContext(ctx).Where("collection = ?", options.Collection)
+	if !withEmbedding && !options.IncludeEmbedding {
+		query = query.Omit("embedding")
+	}
+	if v, ok := options.Filter[metadataProjectID].(string); ok {
+		query = query.Where("project_id = ?", v)
+	}
+	if v, ok := options.Filter[metadataType].(string); ok {
+		query = query.Where("doc_type = ?", v)
+	}
+
+	var rows []*vectorDocument
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to query documents: %w", err)
+	}
+	return rows, nil
+}
+
+// Ping 健康检查
+func (s *SQLiteVectorClient) Ping(ctx context.Context) error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+// Close 关闭连接
+func (s *SQLiteVectorClient) Close() error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// documentToRow 将文档转换为表记录
+func documentToRow(doc *Document) (*vectorDocument, error) {
+	metadata, err := json.Marshal(doc.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	row := &vectorDocument{
+		Collection: doc.Collection,
+		ID:         doc.ID,
+		Content:    doc.Content,
+		Embedding:  encodeEmbedding(doc.Embedding),
+		Metadata:   string(metadata),
+		CreatedAt:  doc.CreatedAt,
+		UpdatedAt:  doc.UpdatedAt,
+	}
+	if v, ok := doc.Metadata[metadataProjectID].(string); ok {
+		row.ProjectID = v
+	}
+	if v, ok := doc.Metadata[metadataType].(string); ok {
+		row.DocType = v
+	}
+	return row, nil
+}
+
+// rowToDocument 将表记录转换为文档
+func rowToDocument(row *vectorDocument, includeEmbedding bool) (*Document, error) {
+	doc := &Document{
+		ID:         row.ID,
+		Content:    row.Content,
+		Collection: row.Collection,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+	if row.Metadata != "" {
+		if err := json.Unmarshal([]byte(row.Metadata), &doc.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal metadata of %s: %w", row.ID, err)
+		}
+	}
+	if includeEmbedding {
+		doc.Embedding = decodeEmbedding(row.Embedding)
+	}
+	return doc, nil
+}
+
+// encodeEmbedding 将向量编码为小端序字节
+func encodeEmbedding(embedding []float32) []byte {
+	if len(embedding) == 0 {
+		return nil
+	}
+	buf := make([]byte, 4*len(embedding))
+	for i, v := range embedding {
+		binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(v))
+	}
+	return buf
+}
+
+// decodeEmbedding 从小端序字节解码向量
+func decodeEmbedding(buf []byte) []float32 {
+	if len(buf) == 0 {
+		return nil
+	}
+	embedding := make([]float32, len(buf)/4)
+	for i := range embedding {
+		embedding[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[i*4:]))
+	}
+	return embedding
+}
+
+// rankResults 按分数降序排列并截取前 topK 个结果
+func rankResults(results []*SearchResult, topK int) []*SearchResult {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if topK > 0 && len(results) > topK {
+		results = results[:topK]
+	}
+	return results
+}
@@ -0,0 +1,181 @@
+package vector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// sqliteANN 单个集合的内存近似检索索引，首次检索时从数据库构建
+type sqliteANN struct {
+	index    *HNSWIndex
+	metadata map[string]map[string]interface{} // 用于遍历时应用过滤条件
+}
+
+// NewSQLiteVectorClientWithHNSW 创建使用 HNSW 近似检索的 SQLite 向量库，索引常驻内存、数据持久化在 SQLite
+func NewSQLiteVectorClientWithHNSW(path string, config HNSWConfig) (*SQLiteVectorClient, error) {
+	client, err := NewSQLiteVectorClient(path)
+	if err != nil {
+		return nil, err
+	}
+	config = config.withDefaults()
+	client.hnsw = &config
+	client.ann = make(map[string]*sqliteANN)
+	return client, nil
+}
+
+// annSearch 使用近似索引检索，返回按相似度降序排列的结果
+func (s *SQLiteVectorClient) annSearch(ctx context.Context, embedding []float32, options *SearchOptions) ([]*SearchResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ann, err := s.loadANN(ctx, options.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := ann.index.Search(embedding, options.TopK, s.hnsw.EfSearch, func(id string) bool {
+		return matchMetadata(ann.metadata[id], options.Filter)
+	})
+
+	ids := make([]string, 0, len(matches))
+	for _, match := range matches {
+		if match.Score >= options.Threshold {
+			ids = append(ids, match.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	query := s.db.WithContext(ctx).Where("collection = ? AND id IN ?", options.Collection, ids)
+	if !options.IncludeEmbedding {
+		query = query.Omit("embedding")
+	}
+	var rows []*vectorDocument
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to query documents: %w", err)
+	}
+	byID := make(map[string]*vectorDocument, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	results := make([]*SearchResult, 0, len(ids))
+	for _, match := range matches {
+		row, ok := byID[match.ID]
+		if !ok {
+			continue
+		}
+		doc, err := rowToDocument(row, options.IncludeEmbedding)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &SearchResult{
+			Document: doc,
+			Score:    match.Score,
+			Distance: 1.0 - match.Score,
+		})
+	}
+
+	return results, nil
+}
+
+// loadANN 获取集合的近似索引，未加载时从数据库构建；调用方需持有 s.mu
+func (s *SQLiteVectorClient) loadANN(ctx context.Context, collection string) (*sqliteANN, error) {
+	if ann, ok := s.ann[collection]; ok {
+		return ann, nil
+	}
+
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&vectorCollection{}).Where("name = ?", collection).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("collection %s not found", collection)
+	}
+
+	var rows []*vectorDocument
+	if err := s.db.WithContext(ctx).Select("id", "embedding", "metadata").
+		Where("collection = ?", collection).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load vectors: %w", err)
+	}
+
+	ann := &sqliteANN{
+		index:    NewHNSWIndex(*s.hnsw),
+		metadata: make(map[string]map[string]interface{}, len(rows)),
+	}
+	for _, row := range rows {
+		if err := ann.add(row); err != nil {
+			return nil, err
+		}
+	}
+	s.ann[collection] = ann
+
+	return ann, nil
+}
+
+// annApply 写入提交后同步已加载的近似索引
+func (s *SQLiteVectorClient) annApply(rows []*vectorDocument) {
+	if s.hnsw == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, row := range rows {
+		ann, ok := s.ann[row.Collection]
+		if !ok {
+			continue
+		}
+		if err := ann.add(row); err != nil {
+			// 元数据无法解析时丢弃整个索引，下次检索时重建
+			delete(s.ann, row.Collection)
+		}
+	}
+}
+
+// annRemove 删除提交后同步已加载的近似索引
+func (s *SQLiteVectorClient) annRemove(ids []string) {
+	if s.hnsw == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ann := range s.ann {
+		for _, id := range ids {
+			ann.index.Delete(id)
+			delete(ann.metadata, id)
+		}
+	}
+}
+
+// annDrop 丢弃集合的近似索引
+func (s *SQLiteVectorClient) annDrop(collection string) {
+	if s.hnsw == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.ann, collection)
+}
+
+// add 添加或替换索引中的文档，没有向量的文档会从索引中移除
+func (a *sqliteANN) add(row *vectorDocument) error {
+	if len(row.Embedding) == 0 {
+		a.index.Delete(row.ID)
+		delete(a.metadata, row.ID)
+		return nil
+	}
+
+	var metadata map[string]interface{}
+	if row.Metadata != "" {
+		if err := json.Unmarshal([]byte(row.Metadata), &metadata); err != nil {
+			return fmt.Errorf("failed to unmarshal metadata of %s: %w", row.ID, err)
+		}
+	}
+	a.index.Insert(row.ID, decodeEmbedding(row.Embedding))
+	a.metadata[row.ID] = metadata
+	return nil
+}
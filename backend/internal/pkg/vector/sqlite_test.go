@@ -0,0 +1,123 @@
+package vector
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func newTestSQLiteClient(t *testing.T, path string) *SQLiteVectorClient {
+	t.Helper()
+	client, err := NewSQLiteVectorClient(path)
+	if err != nil {
+		t.Fatalf("Failed to open sqlite vector client: %v", err)
+	}
+	return client
+}
+
+func TestSQLiteVectorClient_Persistence(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "vectors.db")
+
+	client := newTestSQLiteClient(t, path)
+	if err := client.CreateCollection(ctx, "test_collection", 3); err != nil {
+		t.Fatalf("Failed to create collection: %v", err)
+	}
+	if err := client.CreateCollection(ctx, "test_collection", 3); err == nil {
+		t.Fatal("Expected error when creating duplicate collection")
+	}
+
+	docs := []*Document{
+		{
+			ID:         "doc1",
+			Collection: "test_collection",
+			Content:    "This is about cats",
+			Embedding:  []float32{1.0, 0.0, 0.0},
+			Metadata:   map[string]interface{}{"project_id": "p1", "type": "chapter", "index": 1},
+		},
+		{
+			ID:         "doc2",
+			Collection: "test_collection",
+			Content:    "This is about dogs",
+			Embedding:  []float32{0.0, 1.0, 0.0},
+			Metadata:   map[string]interface{}{"project_id": "p1", "type": "chapter", "index": 2},
+		},
+		{
+			ID:         "doc3",
+			Collection: "test_collection",
+			Content:    "This is about cats too",
+			Embedding:  []float32{0.9, 0.1, 0.0},
+			Metadata:   map[string]interface{}{"project_id": "p2", "type": "chapter", "index": 1},
+		},
+	}
+	if err := client.BatchAdd(ctx, docs); err != nil {
+		t.Fatalf("Failed to batch add documents: %v", err)
+	}
+	if err := client.AddDocument(ctx, &Document{
+		ID:         "bad",
+		Collection: "test_collection",
+		Embedding:  []float32{1.0},
+	}); err == nil {
+		t.Fatal("Expected dimension mismatch error")
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("Failed to close client: %v", err)
+	}
+
+	// 重新打开后数据仍然存在
+	client = newTestSQLiteClient(t, path)
+	defer client.Close()
+
+	retrieved, err := client.GetDocument(ctx, "doc2")
+	if err != nil {
+		t.Fatalf("Failed to get document: %v", err)
+	}
+	if retrieved.Content != "This is about dogs" || len(retrieved.Embedding) != 3 || retrieved.Embedding[1] != 1.0 {
+		t.Fatalf("Retrieved document doesn't match original: %+v", retrieved)
+	}
+
+	results, err := client.SimilaritySearch(ctx, []float32{1.0, 0.0, 0.0}, &SearchOptions{
+		Collection: "test_collection",
+		TopK:       5,
+		Filter:     map[string]interface{}{"project_id": "p1"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to perform similarity search: %v", err)
+	}
+	if len(results) != 2 || results[0].Document.ID != "doc1" {
+		t.Fatalf("Expected doc1 first among project p1 documents, got %v", results)
+	}
+	if results[0].Document.Embedding != nil {
+		t.Fatal("Embedding should be omitted unless requested")
+	}
+
+	results, err = client.Search(ctx, "", &SearchOptions{
+		Collection: "test_collection",
+		TopK:       5,
+		Filter:     map[string]interface{}{"project_id": "p1", "index": map[string]interface{}{"$lt": 2}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to perform search: %v", err)
+	}
+	if len(results) != 1 || results[0].Document.ID != "doc1" {
+		t.Fatalf("Expected only doc1 to match range filter, got %v", results)
+	}
+
+	if err := client.BatchDelete(ctx, []string{"doc1", "doc3"}); err != nil {
+		t.Fatalf("Failed to batch delete documents: %v", err)
+	}
+	if _, err := client.GetDocument(ctx, "doc1"); err == nil {
+		t.Fatal("Document doc1 should have been deleted")
+	}
+
+	if err := client.DeleteCollection(ctx, "test_collection"); err != nil {
+		t.Fatalf("Failed to delete collection: %v", err)
+	}
+	listed, err := client.ListCollections(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list collections: %v", err)
+	}
+	if len(listed) != 0 {
+		t.Fatalf("Expected no collections, got %v", listed)
+	}
+}
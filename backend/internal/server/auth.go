@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+
+	"backend/internal/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// Authenticator 根据访问令牌解析调用者
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (string, error)
+}
+
+// Auth 解析 Authorization: Bearer <token> 并将调用者写入上下文；
+// 未携带令牌的请求按匿名调用处理，只能访问未归属的项目
+func Auth(authenticator Authenticator) middleware.Middleware {
+	return func(next middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if token := auth.ParseBearer(tr.RequestHeader().Get("Authorization")); token != "" {
+					userID, err := authenticator.Authenticate(ctx, token)
+					if err != nil {
+						return nil, err
+					}
+					ctx = auth.NewContext(ctx, userID)
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
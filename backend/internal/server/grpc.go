@@ -3,6 +3,7 @@ package server
 import (
 	v1 "backend/api/helloworld/v1"
 	novelv1 "backend/api/novel/v1"
+	userv1 "backend/api/user/v1"
 	videoscriptv1 "backend/api/video_script/v1"
 	"backend/internal/conf"
 	"backend/internal/service"
@@ -13,10 +14,11 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, videoScript *service.VideoScriptService, novel *service.NovelService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, videoScript *service.VideoScriptService, novel *service.NovelService, user *service.UserService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			Auth(user),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -32,5 +34,6 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, videoScript
 	v1.RegisterGreeterServer(srv, greeter)
 	videoscriptv1.RegisterVideoScriptServiceServer(srv, videoScript)
 	novelv1.RegisterNovelServiceServer(srv, novel)
+	userv1.RegisterUserServiceServer(srv, user)
 	return srv
 }
@@ -5,6 +5,7 @@ import (
 
 	v1 "backend/api/helloworld/v1"
 	novelv1 "backend/api/novel/v1"
+	userv1 "backend/api/user/v1"
 	videoscriptv1 "backend/api/video_script/v1"
 	"backend/internal/conf"
 	"backend/internal/service"
@@ -34,11 +35,12 @@ func CORS() middleware.Middleware {
 }
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, videoScript *service.VideoScriptService, novel *service.NovelService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, videoScript *service.VideoScriptService, novel *service.NovelService, user *service.UserService, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
 			CORS(),
+			Auth(user),
 		),
 	}
 	if c.Http.Network != "" {
@@ -55,5 +57,6 @@ func NewHTTPServer(c *conf.Server, greeter *service.GreeterService, videoScript
 	v1.RegisterGreeterHTTPServer(srv, greeter)
 	videoscriptv1.RegisterVideoScriptServiceHTTPServer(srv, videoScript)
 	novelv1.RegisterNovelServiceHTTPServer(srv, novel)
+	userv1.RegisterUserServiceHTTPServer(srv, user)
 	return srv
 }
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+
+	"backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// IndexWorker 知识库索引后台任务，作为 kratos.Server 随应用启停
+type IndexWorker struct {
+	uc  *biz.IndexUsecase
+	log *log.Helper
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewIndexWorker 创建知识库索引后台任务
+func NewIndexWorker(uc *biz.IndexUsecase, logger log.Logger) *IndexWorker {
+	return &IndexWorker{
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// Start 启动索引任务，持续处理内容变更产生的索引队列
+func (w *IndexWorker) Start(ctx context.Context) error {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+
+	w.log.Info("[Index] worker started")
+
+	go func() {
+		defer close(w.done)
+		w.uc.Run(ctx)
+	}()
+
+	return nil
+}
+
+// Stop 停止索引任务并等待当前任务结束
+func (w *IndexWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+
+	select {
+	case <-w.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	w.log.Info("[Index] worker stopped")
+	return nil
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultTrashPurgeInterval 未配置时回收站过期清理的执行间隔
+const defaultTrashPurgeInterval = time.Hour
+
+// TrashCleaner 回收站过期清理任务，作为 kratos.Server 随应用启停
+type TrashCleaner struct {
+	uc       *biz.TrashUsecase
+	interval time.Duration
+	log      *log.Helper
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewTrashCleaner 创建回收站过期清理任务
+func NewTrashCleaner(c *conf.Data, uc *biz.TrashUsecase, logger log.Logger) *TrashCleaner {
+	interval := defaultTrashPurgeInterval
+	if d := c.GetTrash().GetPurgeInterval(); d != nil && d.AsDuration() > 0 {
+		interval = d.AsDuration()
+	}
+
+	return &TrashCleaner{
+		uc:       uc,
+		interval: interval,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start 启动清理任务，启动时立即执行一次，之后按间隔执行
+func (t *TrashCleaner) Start(ctx context.Context) error {
+	ctx, t.cancel = context.WithCancel(ctx)
+	t.done = make(chan struct{})
+
+	t.log.Infof("[Trash] cleaner started: retention=%s, interval=%s", t.uc.Retention(), t.interval)
+
+	go func() {
+		defer close(t.done)
+
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := t.uc.PurgeExpired(ctx); err != nil {
+				t.log.Errorf("[Trash] purge expired items failed: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop 停止清理任务并等待当前一轮结束
+func (t *TrashCleaner) Stop(ctx context.Context) error {
+	if t.cancel == nil {
+		return nil
+	}
+	t.cancel()
+
+	select {
+	case <-t.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	t.log.Info("[Trash] cleaner stopped")
+	return nil
+}
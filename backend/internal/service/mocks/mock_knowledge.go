@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/biz/knowledge.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "backend/internal/pkg/models"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockKnowledgeRepo is a mock of KnowledgeRepo interface.
+type MockKnowledgeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockKnowledgeRepoMockRecorder
+}
+
+// MockKnowledgeRepoMockRecorder is the mock recorder for MockKnowledgeRepo.
+type MockKnowledgeRepoMockRecorder struct {
+	mock *MockKnowledgeRepo
+}
+
+// NewMockKnowledgeRepo creates a new mock instance.
+func NewMockKnowledgeRepo(ctrl *gomock.Controller) *MockKnowledgeRepo {
+	mock := &MockKnowledgeRepo{ctrl: ctrl}
+	mock.recorder = &MockKnowledgeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKnowledgeRepo) EXPECT() *MockKnowledgeRepoMockRecorder {
+	return m.recorder
+}
+
+// KnowledgeStats mocks base method.
+func (m *MockKnowledgeRepo) KnowledgeStats(ctx context.Context, projectID string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KnowledgeStats", ctx, projectID)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// KnowledgeStats indicates an expected call of KnowledgeStats.
+func (mr *MockKnowledgeRepoMockRecorder) KnowledgeStats(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KnowledgeStats", reflect.TypeOf((*MockKnowledgeRepo)(nil).KnowledgeStats), ctx, projectID)
+}
+
+// SearchKnowledge mocks base method.
+func (m *MockKnowledgeRepo) SearchKnowledge(ctx context.Context, projectID, question, contentType string, topK int) ([]*models.KnowledgePassage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchKnowledge", ctx, projectID, question, contentType, topK)
+	ret0, _ := ret[0].([]*models.KnowledgePassage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchKnowledge indicates an expected call of SearchKnowledge.
+func (mr *MockKnowledgeRepoMockRecorder) SearchKnowledge(ctx, projectID, question, contentType, topK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchKnowledge", reflect.TypeOf((*MockKnowledgeRepo)(nil).SearchKnowledge), ctx, projectID, question, contentType, topK)
+}
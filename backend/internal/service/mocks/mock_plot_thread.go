@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/biz/plot_thread.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "backend/internal/pkg/models"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPlotThreadRepo is a mock of PlotThreadRepo interface.
+type MockPlotThreadRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlotThreadRepoMockRecorder
+}
+
+// MockPlotThreadRepoMockRecorder is the mock recorder for MockPlotThreadRepo.
+type MockPlotThreadRepoMockRecorder struct {
+	mock *MockPlotThreadRepo
+}
+
+// NewMockPlotThreadRepo creates a new mock instance.
+func NewMockPlotThreadRepo(ctrl *gomock.Controller) *MockPlotThreadRepo {
+	mock := &MockPlotThreadRepo{ctrl: ctrl}
+	mock.recorder = &MockPlotThreadRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlotThreadRepo) EXPECT() *MockPlotThreadRepoMockRecorder {
+	return m.recorder
+}
+
+// ListPlotThreads mocks base method.
+func (m *MockPlotThreadRepo) ListPlotThreads(ctx context.Context, projectID string) ([]*models.PlotThread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlotThreads", ctx, projectID)
+	ret0, _ := ret[0].([]*models.PlotThread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlotThreads indicates an expected call of ListPlotThreads.
+func (mr *MockPlotThreadRepoMockRecorder) ListPlotThreads(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlotThreads", reflect.TypeOf((*MockPlotThreadRepo)(nil).ListPlotThreads), ctx, projectID)
+}
+
+// SavePlotThreads mocks base method.
+func (m *MockPlotThreadRepo) SavePlotThreads(ctx context.Context, projectID string, threads []*models.PlotThread) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePlotThreads", ctx, projectID, threads)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePlotThreads indicates an expected call of SavePlotThreads.
+func (mr *MockPlotThreadRepoMockRecorder) SavePlotThreads(ctx, projectID, threads interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePlotThreads", reflect.TypeOf((*MockPlotThreadRepo)(nil).SavePlotThreads), ctx, projectID, threads)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/biz/quality_report.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "backend/internal/pkg/models"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockQualityReportRepo is a mock of QualityReportRepo interface.
+type MockQualityReportRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockQualityReportRepoMockRecorder
+}
+
+// MockQualityReportRepoMockRecorder is the mock recorder for MockQualityReportRepo.
+type MockQualityReportRepoMockRecorder struct {
+	mock *MockQualityReportRepo
+}
+
+// NewMockQualityReportRepo creates a new mock instance.
+func NewMockQualityReportRepo(ctrl *gomock.Controller) *MockQualityReportRepo {
+	mock := &MockQualityReportRepo{ctrl: ctrl}
+	mock.recorder = &MockQualityReportRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQualityReportRepo) EXPECT() *MockQualityReportRepoMockRecorder {
+	return m.recorder
+}
+
+// ListQualityReports mocks base method.
+func (m *MockQualityReportRepo) ListQualityReports(ctx context.Context, query *models.QualityReportQuery) ([]*models.QualityReport, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQualityReports", ctx, query)
+	ret0, _ := ret[0].([]*models.QualityReport)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListQualityReports indicates an expected call of ListQualityReports.
+func (mr *MockQualityReportRepoMockRecorder) ListQualityReports(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQualityReports", reflect.TypeOf((*MockQualityReportRepo)(nil).ListQualityReports), ctx, query)
+}
+
+// SaveQualityReports mocks base method.
+func (m *MockQualityReportRepo) SaveQualityReports(ctx context.Context, reports ...*models.QualityReport) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range reports {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveQualityReports", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveQualityReports indicates an expected call of SaveQualityReports.
+func (mr *MockQualityReportRepoMockRecorder) SaveQualityReports(ctx interface{}, reports ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, reports...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveQualityReports", reflect.TypeOf((*MockQualityReportRepo)(nil).SaveQualityReports), varargs...)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/biz/search.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "backend/internal/pkg/models"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSearchRepo is a mock of SearchRepo interface.
+type MockSearchRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchRepoMockRecorder
+}
+
+// MockSearchRepoMockRecorder is the mock recorder for MockSearchRepo.
+type MockSearchRepoMockRecorder struct {
+	mock *MockSearchRepo
+}
+
+// NewMockSearchRepo creates a new mock instance.
+func NewMockSearchRepo(ctrl *gomock.Controller) *MockSearchRepo {
+	mock := &MockSearchRepo{ctrl: ctrl}
+	mock.recorder = &MockSearchRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchRepo) EXPECT() *MockSearchRepoMockRecorder {
+	return m.recorder
+}
+
+// SearchContent mocks base method.
+func (m *MockSearchRepo) SearchContent(arg0 context.Context, arg1 *models.SearchQuery) ([]*models.SearchHit, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchContent", arg0, arg1)
+	ret0, _ := ret[0].([]*models.SearchHit)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchContent indicates an expected call of SearchContent.
+func (mr *MockSearchRepoMockRecorder) SearchContent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContent", reflect.TypeOf((*MockSearchRepo)(nil).SearchContent), arg0, arg1)
+}
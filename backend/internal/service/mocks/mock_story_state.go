@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/biz/story_state.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "backend/internal/pkg/models"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStoryStateRepo is a mock of StoryStateRepo interface.
+type MockStoryStateRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoryStateRepoMockRecorder
+}
+
+// MockStoryStateRepoMockRecorder is the mock recorder for MockStoryStateRepo.
+type MockStoryStateRepoMockRecorder struct {
+	mock *MockStoryStateRepo
+}
+
+// NewMockStoryStateRepo creates a new mock instance.
+func NewMockStoryStateRepo(ctrl *gomock.Controller) *MockStoryStateRepo {
+	mock := &MockStoryStateRepo{ctrl: ctrl}
+	mock.recorder = &MockStoryStateRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStoryStateRepo) EXPECT() *MockStoryStateRepoMockRecorder {
+	return m.recorder
+}
+
+// GetStoryState mocks base method.
+func (m *MockStoryStateRepo) GetStoryState(ctx context.Context, projectID string, chapterIndex int) (*models.StoryState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoryState", ctx, projectID, chapterIndex)
+	ret0, _ := ret[0].(*models.StoryState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoryState indicates an expected call of GetStoryState.
+func (mr *MockStoryStateRepoMockRecorder) GetStoryState(ctx, projectID, chapterIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoryState", reflect.TypeOf((*MockStoryStateRepo)(nil).GetStoryState), ctx, projectID, chapterIndex)
+}
+
+// ListStoryStates mocks base method.
+func (m *MockStoryStateRepo) ListStoryStates(ctx context.Context, projectID string) ([]*models.StoryState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoryStates", ctx, projectID)
+	ret0, _ := ret[0].([]*models.StoryState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoryStates indicates an expected call of ListStoryStates.
+func (mr *MockStoryStateRepoMockRecorder) ListStoryStates(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoryStates", reflect.TypeOf((*MockStoryStateRepo)(nil).ListStoryStates), ctx, projectID)
+}
+
+// SaveStoryState mocks base method.
+func (m *MockStoryStateRepo) SaveStoryState(ctx context.Context, state *models.StoryState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStoryState", ctx, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStoryState indicates an expected call of SaveStoryState.
+func (mr *MockStoryStateRepoMockRecorder) SaveStoryState(ctx, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStoryState", reflect.TypeOf((*MockStoryStateRepo)(nil).SaveStoryState), ctx, state)
+}
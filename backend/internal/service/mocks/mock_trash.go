@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/biz/trash.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "backend/internal/pkg/models"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTrashRepo is a mock of TrashRepo interface.
+type MockTrashRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashRepoMockRecorder
+}
+
+// MockTrashRepoMockRecorder is the mock recorder for MockTrashRepo.
+type MockTrashRepoMockRecorder struct {
+	mock *MockTrashRepo
+}
+
+// NewMockTrashRepo creates a new mock instance.
+func NewMockTrashRepo(ctrl *gomock.Controller) *MockTrashRepo {
+	mock := &MockTrashRepo{ctrl: ctrl}
+	mock.recorder = &MockTrashRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashRepo) EXPECT() *MockTrashRepoMockRecorder {
+	return m.recorder
+}
+
+// GetTrashItemProject mocks base method.
+func (m *MockTrashRepo) GetTrashItemProject(ctx context.Context, itemType, itemID string) (*models.NovelProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashItemProject", ctx, itemType, itemID)
+	ret0, _ := ret[0].(*models.NovelProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashItemProject indicates an expected call of GetTrashItemProject.
+func (mr *MockTrashRepoMockRecorder) GetTrashItemProject(ctx, itemType, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashItemProject", reflect.TypeOf((*MockTrashRepo)(nil).GetTrashItemProject), ctx, itemType, itemID)
+}
+
+// ListTrash mocks base method.
+func (m *MockTrashRepo) ListTrash(ctx context.Context, itemType string, scope *models.ProjectScope, page, pageSize int) ([]*models.TrashItem, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, itemType, scope, page, pageSize)
+	ret0, _ := ret[0].([]*models.TrashItem)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockTrashRepoMockRecorder) ListTrash(ctx, itemType, scope, page, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockTrashRepo)(nil).ListTrash), ctx, itemType, scope, page, pageSize)
+}
+
+// PurgeChapter mocks base method.
+func (m *MockTrashRepo) PurgeChapter(ctx context.Context, chapterID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeChapter", ctx, chapterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeChapter indicates an expected call of PurgeChapter.
+func (mr *MockTrashRepoMockRecorder) PurgeChapter(ctx, chapterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeChapter", reflect.TypeOf((*MockTrashRepo)(nil).PurgeChapter), ctx, chapterID)
+}
+
+// PurgeDeletedBefore mocks base method.
+func (m *MockTrashRepo) PurgeDeletedBefore(ctx context.Context, before time.Time) ([]string, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockTrashRepoMockRecorder) PurgeDeletedBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockTrashRepo)(nil).PurgeDeletedBefore), ctx, before)
+}
+
+// PurgeProject mocks base method.
+func (m *MockTrashRepo) PurgeProject(ctx context.Context, projectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeProject", ctx, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeProject indicates an expected call of PurgeProject.
+func (mr *MockTrashRepoMockRecorder) PurgeProject(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeProject", reflect.TypeOf((*MockTrashRepo)(nil).PurgeProject), ctx, projectID)
+}
+
+// RestoreChapter mocks base method.
+func (m *MockTrashRepo) RestoreChapter(ctx context.Context, chapterID string) (*models.Chapter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreChapter", ctx, chapterID)
+	ret0, _ := ret[0].(*models.Chapter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreChapter indicates an expected call of RestoreChapter.
+func (mr *MockTrashRepoMockRecorder) RestoreChapter(ctx, chapterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreChapter", reflect.TypeOf((*MockTrashRepo)(nil).RestoreChapter), ctx, chapterID)
+}
+
+// RestoreProject mocks base method.
+func (m *MockTrashRepo) RestoreProject(ctx context.Context, projectID string) (*models.NovelProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProject", ctx, projectID)
+	ret0, _ := ret[0].(*models.NovelProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProject indicates an expected call of RestoreProject.
+func (mr *MockTrashRepoMockRecorder) RestoreProject(ctx, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProject", reflect.TypeOf((*MockTrashRepo)(nil).RestoreProject), ctx, projectID)
+}
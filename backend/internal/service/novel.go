@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -11,13 +12,17 @@ import (
 	"backend/internal/agent/consistency"
 	"backend/internal/agent/orchestrator"
 	"backend/internal/agent/outline"
+	"backend/internal/agent/plotthread"
 	"backend/internal/agent/polish"
 	"backend/internal/agent/quality"
+	"backend/internal/agent/safety"
+	"backend/internal/agent/storystate"
 	"backend/internal/agent/worldbuilding"
 	"backend/internal/biz"
 	"backend/internal/pkg/eino"
 	"backend/internal/pkg/llm"
 	"backend/internal/pkg/models"
+	"backend/internal/pkg/textmetrics"
 	"backend/internal/pkg/vector"
 	"github.com/go-kratos/kratos/v2/log"
 
@@ -29,6 +34,16 @@ type NovelService struct {
 	pb.UnimplementedNovelServiceServer
 
 	uc               *biz.NovelUsecase
+	bundleUc         *biz.ProjectBundleUsecase
+	searchUc         *biz.SearchUsecase
+	trashUc          *biz.TrashUsecase
+	generationUc     *biz.GenerationUsecase
+	indexUc          *biz.IndexUsecase
+	knowledgeUc      *biz.KnowledgeUsecase
+	storyStateUc     *biz.StoryStateUsecase
+	qualityReportUc  *biz.QualityReportUsecase
+	plotThreadUc     *biz.PlotThreadUsecase
+	safetyUc         *biz.SafetyUsecase
 	orchestrator     *orchestrator.OrchestratorAgent
 	worldAgent       *worldbuilding.WorldBuildingAgent
 	charAgent        *character.CharacterAgent
@@ -37,6 +52,9 @@ type NovelService struct {
 	polishAgent      *polish.PolishAgent
 	qualityAgent     *quality.QualityAgent
 	consistencyAgent *consistency.ConsistencyAgent
+	storyStateAgent  *storystate.StoryStateAgent
+	plotThreadAgent  *plotthread.PlotThreadAgent
+	safetyAgent      *safety.SafetyAgent
 	modelSwitcher    *eino.ModelSwitcher
 	log              *log.Helper
 }
@@ -52,6 +70,9 @@ func NewNovelService(uc *biz.NovelUsecase, orchestratorAgent *orchestrator.Orche
 		chapterAgent:     chapterAgent,
 		polishAgent:      polish.NewPolishAgent(llmClient),
 		consistencyAgent: consistency.NewConsistencyAgent(llmClient),
+		storyStateAgent:  storystate.NewStoryStateAgent(llmClient),
+		plotThreadAgent:  plotthread.NewPlotThreadAgent(llmClient),
+		safetyAgent:      safety.NewSafetyAgent(llmClient),
 		modelSwitcher:    modelSwitcher,
 		log:              log.NewHelper(logger),
 	}
@@ -63,10 +84,20 @@ func NewNovelService(uc *biz.NovelUsecase, orchestratorAgent *orchestrator.Orche
 }
 
 // NewNovelServiceWithRAG 创建带RAG功能的小说服务
-func NewNovelServiceWithRAG(uc *biz.NovelUsecase, orchestratorAgent *orchestrator.OrchestratorAgent, chapterAgent *chapter.ChapterAgent,
+func NewNovelServiceWithRAG(uc *biz.NovelUsecase, bundleUc *biz.ProjectBundleUsecase, searchUc *biz.SearchUsecase, trashUc *biz.TrashUsecase, generationUc *biz.GenerationUsecase, indexUc *biz.IndexUsecase, knowledgeUc *biz.KnowledgeUsecase, storyStateUc *biz.StoryStateUsecase, qualityReportUc *biz.QualityReportUsecase, plotThreadUc *biz.PlotThreadUsecase, safetyUc *biz.SafetyUsecase, orchestratorAgent *orchestrator.OrchestratorAgent, chapterAgent *chapter.ChapterAgent,
 	einoClient *eino.EinoLLMClient, ragService *vector.RAGService, llmClient llm.LLMClient, modelSwitcher *eino.ModelSwitcher, logger log.Logger) *NovelService {
 	service := &NovelService{
 		uc:               uc,
+		bundleUc:         bundleUc,
+		searchUc:         searchUc,
+		trashUc:          trashUc,
+		generationUc:     generationUc,
+		indexUc:          indexUc,
+		knowledgeUc:      knowledgeUc,
+		storyStateUc:     storyStateUc,
+		qualityReportUc:  qualityReportUc,
+		plotThreadUc:     plotThreadUc,
+		safetyUc:         safetyUc,
 		orchestrator:     orchestratorAgent,
 		worldAgent:       worldbuilding.NewWorldBuildingAgent(llmClient, logger),
 		charAgent:        character.NewCharacterAgent(llmClient),
@@ -74,6 +105,9 @@ func NewNovelServiceWithRAG(uc *biz.NovelUsecase, orchestratorAgent *orchestrato
 		chapterAgent:     chapterAgent,
 		polishAgent:      polish.NewPolishAgent(llmClient),
 		consistencyAgent: consistency.NewConsistencyAgentWithRAG(llmClient, *einoClient, ragService),
+		storyStateAgent:  storystate.NewStoryStateAgent(llmClient),
+		plotThreadAgent:  plotthread.NewPlotThreadAgent(llmClient),
+		safetyAgent:      safety.NewSafetyAgent(llmClient),
 		modelSwitcher:    modelSwitcher,
 		log:              log.NewHelper(logger),
 	}
@@ -181,6 +215,9 @@ func (s *NovelService) UpdateProject(ctx context.Context, req *pb.UpdateProjectR
 	project.TargetAudience = req.TargetAudience
 	project.Tone = req.Tone
 	project.Themes = req.Themes
+	if req.ExpectedVersion > 0 {
+		project.Version = req.ExpectedVersion
+	}
 
 	// 更新大纲（如果提供）
 	if req.Outline != nil {
@@ -211,11 +248,13 @@ func (s *NovelService) UpdateProject(ctx context.Context, req *pb.UpdateProjectR
 		project.Title, project.Description, project.Genre, project.TargetAudience, project.Tone, project.Themes)
 
 	// 保存更新后的项目
-	_, err = s.uc.UpdateProject(ctx, project)
+	updated, err := s.uc.UpdateProject(ctx, project)
 	if err != nil {
 		s.log.Errorf("Error updating project: %v", err)
 		return nil, err
 	}
+	project.Version = updated.Version
+	project.UpdatedAt = updated.UpdatedAt
 
 	s.log.Infof("Successfully updated project")
 
@@ -351,6 +390,7 @@ func (s *NovelService) GenerateOutline(ctx context.Context, req *pb.GenerateOutl
 	if err != nil {
 		return nil, err
 	}
+	s.refreshPlotThreads(ctx, project)
 
 	return &pb.GenerateOutlineResponse{
 		Outline: convertOutlineToProto(resp.Outline),
@@ -369,6 +409,9 @@ func (s *NovelService) UpdateChapterOutline(ctx context.Context, req *pb.UpdateC
 	if project.Outline == nil {
 		return nil, fmt.Errorf("project outline not found")
 	}
+	if req.ExpectedVersion > 0 {
+		project.Version = req.ExpectedVersion
+	}
 
 	// 查找要更新的章节 - 按章节的Index字段查找，而不是数组索引
 	targetChapterIndex := int(req.ChapterIndex)
@@ -424,6 +467,9 @@ func (s *NovelService) DeleteChapterOutline(ctx context.Context, req *pb.DeleteC
 	if project.Outline == nil {
 		return nil, fmt.Errorf("project outline not found")
 	}
+	if req.ExpectedVersion > 0 {
+		project.Version = req.ExpectedVersion
+	}
 
 	// 查找要删除的章节
 	targetChapterIndex := int(req.ChapterIndex)
@@ -534,6 +580,9 @@ func (s *NovelService) ReorderChapterOutline(ctx context.Context, req *pb.Reorde
 
 		// 更新项目大纲
 		project.Outline.Chapters = newChapters
+		if req.ExpectedVersion > 0 && project.Version != req.ExpectedVersion {
+			return nil, biz.NewVersionConflictError("project", project.ID, project.Version)
+		}
 	} else {
 		// 多个映射的情况，使用更复杂的算法
 		s.log.WithContext(ctx).Infof("Multiple mappings detected, using complex reordering algorithm")
@@ -555,7 +604,7 @@ func (s *NovelService) ReorderChapterOutline(ctx context.Context, req *pb.Reorde
 		}
 
 		// 调用业务层的重排序方法
-		err = s.uc.ReorderChapterOutline(ctx, req.ProjectId, indexMappings)
+		err = s.uc.ReorderChapterOutline(ctx, req.ProjectId, req.ExpectedVersion, indexMappings)
 		if err != nil {
 			s.log.WithContext(ctx).Errorf("Failed to reorder chapters in business layer: %v", err)
 			return nil, err
@@ -598,6 +647,7 @@ func (s *NovelService) GenerateChapter(ctx context.Context, req *pb.GenerateChap
 		TargetWordCount: int(req.TargetWordCount),
 		Options:         convertLLMOptionsFromProto(req.LlmOptions),
 	}
+	previousState := s.applyStoryState(ctx, chapterReq)
 
 	resp, err := s.chapterAgent.GenerateChapter(ctx, chapterReq)
 	if err != nil {
@@ -611,9 +661,12 @@ func (s *NovelService) GenerateChapter(ctx context.Context, req *pb.GenerateChap
 		s.log.WithContext(ctx).Errorf("Failed to save chapter: %v", err)
 		return nil, fmt.Errorf("章节保存失败: %w", err)
 	}
+	s.trackStoryState(ctx, savedChapter, previousState, chapterReq)
+	s.trackPlotThreads(ctx, savedChapter, chapterReq.Options)
 
 	return &pb.GenerateChapterResponse{
-		Chapter: convertChapterToProto(savedChapter),
+		Chapter:   convertChapterToProto(savedChapter),
+		Grounding: convertRetrievedChunksToProto(resp.Grounding),
 	}, nil
 }
 
@@ -650,6 +703,16 @@ func (c *StreamCallback) OnProgress(stage string, progress int) error {
 	})
 }
 
+// OnGrounding 发送生成时引用的前文片段
+func (c *StreamCallback) OnGrounding(chunks []*models.RetrievedChunk) error {
+	return c.stream.Send(&pb.GenerateChapterStreamResponse{
+		Type:      pb.GenerateChapterStreamResponse_METADATA,
+		ChapterId: c.chapterID,
+		Title:     c.title,
+		Grounding: convertRetrievedChunksToProto(chunks),
+	})
+}
+
 // OnComplete 处理完成回调
 func (c *StreamCallback) OnComplete(chapter *models.Chapter) error {
 	return nil // 完成处理在主函数中进行
@@ -685,6 +748,7 @@ func (s *NovelService) GenerateChapterStream(req *pb.GenerateChapterRequest, str
 		TargetWordCount: int(req.TargetWordCount),
 		Options:         convertLLMOptionsFromProto(req.LlmOptions),
 	}
+	s.applyStoryState(ctx, chapterReq)
 
 	// 生成章节ID和标题
 	chapterID := generateChapterID()
@@ -748,6 +812,9 @@ func (s *NovelService) PolishChapter(ctx context.Context, req *pb.PolishChapterR
 	if err != nil {
 		return nil, err
 	}
+	if req.ExpectedVersion > 0 {
+		chapter.Version = req.ExpectedVersion
+	}
 
 	polishReq := &polish.PolishChapterRequest{
 		Chapter: chapter,
@@ -785,21 +852,26 @@ func (s *NovelService) CheckConsistency(ctx context.Context, req *pb.CheckConsis
 		CheckType: req.CheckType,
 		Options:   convertLLMOptionsFromProto(req.LlmOptions),
 	}
+	if s.storyStateUc != nil {
+		// 最新的故事状态为规则检查提供时间线和道具，缺失时规则检查只依据正文
+		state, err := s.storyStateUc.GetStoryState(ctx, project.ID, 0)
+		if err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to load story state for consistency check of %s: %v", project.ID, err)
+		} else if state != nil {
+			consistencyReq.Timeline = state.Timeline
+			consistencyReq.Props = state.Props
+		}
+	}
 
 	resp, err := s.consistencyAgent.CheckConsistency(ctx, consistencyReq)
 	if err != nil {
 		return nil, err
 	}
+	s.recordConsistencyReports(ctx, project, req.CheckType, resp.Issues)
 
 	pbIssues := make([]*pb.ConsistencyIssue, len(resp.Issues))
 	for i, issue := range resp.Issues {
-		pbIssues[i] = &pb.ConsistencyIssue{
-			Type:        issue.Type,
-			Severity:    issue.Severity,
-			Description: issue.Description,
-			Location:    issue.Location,
-			Suggestion:  issue.Suggestion,
-		}
+		pbIssues[i] = convertConsistencyIssueToProto(issue)
 	}
 
 	return &pb.CheckConsistencyResponse{
@@ -813,10 +885,39 @@ func (s *NovelService) CheckConsistency(ctx context.Context, req *pb.CheckConsis
 func (s *NovelService) GenerateNovel(req *pb.GenerateNovelRequest, stream pb.NovelService_GenerateNovelServer) error {
 	ctx := stream.Context()
 
-	project, err := s.uc.GetProject(ctx, req.ProjectId)
+	// 生成结果最终会写回项目，开始前即要求编辑权限
+	project, err := s.uc.GetProjectForEdit(ctx, req.ProjectId)
 	if err != nil {
 		return err
 	}
+	// 记录生成开始时的版本，生成期间项目或章节被他人修改时保存返回版本冲突
+	baseline := biz.NewGenerationBaseline(project)
+
+	// 同一项目同一时间只允许一个生成任务，进度广播给所有订阅者
+	var session *biz.GenerationSession
+	if s.generationUc != nil {
+		session, err = s.generationUc.Begin(ctx, project.ID)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			// 请求可能已被取消，释放锁使用独立的 context
+			endCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			session.End(endCtx)
+		}()
+
+		// 生成锁丢失时会话 context 被取消，后续生成与保存随之中止
+		ctx = orchestrator.WithStatusCallback(session.Context(), func(status *orchestrator.WorkflowStatus) {
+			session.Publish(ctx, &models.GenerationProgress{
+				Status:   models.GenerationStatusGenerating,
+				Stage:    status.Stage,
+				Progress: status.Progress,
+				Message:  status.Message,
+				Error:    status.Error,
+			})
+		})
+	}
 
 	orchestratorReq := &orchestrator.GenerateNovelRequest{
 		Project: project,
@@ -825,37 +926,107 @@ func (s *NovelService) GenerateNovel(req *pb.GenerateNovelRequest, stream pb.Nov
 
 	resp, err := s.orchestrator.GenerateNovel(ctx, orchestratorReq)
 	if err != nil {
-		return err
+		return failGeneration(ctx, session, err)
 	}
 
 	// 保存生成的内容
-	_, err = s.uc.UpdateProject(ctx, resp.Project)
+	_, chapters, err := s.uc.SaveGeneratedNovel(ctx, baseline, resp.Project, resp.Chapters)
 	if err != nil {
-		return err
+		return failGeneration(ctx, session, err)
+	}
+	if s.storyStateUc != nil {
+		if err := s.storyStateUc.SaveStoryStates(ctx, resp.StoryStates...); err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to save story states for %s: %v", project.ID, err)
+		}
+	}
+	s.recordQualityGates(ctx, project.ID, chapters, resp.QualityGates)
+	if s.plotThreadUc != nil {
+		if err := s.plotThreadUc.SaveThreads(ctx, project.ID, resp.PlotThreads); err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to save plot threads for %s: %v", project.ID, err)
+		}
+	}
+
+	if session != nil {
+		session.Publish(ctx, &models.GenerationProgress{
+			Status:   models.GenerationStatusCompleted,
+			Stage:    models.GenerationStatusCompleted,
+			Progress: resp.Progress,
+			Message:  resp.Message,
+			Issues:   resp.Issues,
+		})
 	}
 
-	pbChapters := make([]*pb.Chapter, len(resp.Chapters))
-	for i, chapter := range resp.Chapters {
+	pbChapters := make([]*pb.Chapter, len(chapters))
+	for i, chapter := range chapters {
 		pbChapters[i] = convertChapterToProto(chapter)
 	}
+	pbGates := make([]*pb.QualityGateResult, len(resp.QualityGates))
+	for i, gate := range resp.QualityGates {
+		pbGates[i] = convertQualityGateResultToProto(gate)
+	}
 
 	// 发送响应
 	return stream.Send(&pb.GenerateNovelResponse{
-		Status:       resp.Status,
-		Progress:     resp.Progress,
-		CurrentStage: resp.Message,
-		Message:      resp.Message,
-		Chapters:     pbChapters,
-		Issues:       resp.Issues,
+		Status:           resp.Status,
+		Progress:         resp.Progress,
+		CurrentStage:     resp.Message,
+		Message:          resp.Message,
+		Chapters:         pbChapters,
+		Issues:           resp.Issues,
+		QualityGates:     pbGates,
+		PlotThreads:      convertPlotThreadsToProto(resp.PlotThreads),
+		PlotThreadIssues: convertPlotThreadIssuesToProto(resp.PlotThreadIssues),
 	})
 }
 
+// WatchGenerationProgress 订阅项目生成进度
+func (s *NovelService) WatchGenerationProgress(req *pb.WatchGenerationProgressRequest, stream pb.NovelService_WatchGenerationProgressServer) error {
+	if req.ProjectId == "" {
+		return fmt.Errorf("project_id is required")
+	}
+	if s.generationUc == nil {
+		return fmt.Errorf("generation progress is not available")
+	}
+
+	ctx := stream.Context()
+	progressCh, err := s.generationUc.WatchProgress(ctx, req.ProjectId)
+	if err != nil {
+		return err
+	}
+
+	for progress := range progressCh {
+		if err := stream.Send(convertGenerationProgressToProto(progress)); err != nil {
+			return err
+		}
+		// 生成结束后关闭订阅
+		if progress.Status != models.GenerationStatusGenerating {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+// failGeneration 广播生成失败事件，生成因锁丢失被取消时返回取消原因
+func failGeneration(ctx context.Context, session *biz.GenerationSession, err error) error {
+	if ctx.Err() != nil {
+		err = context.Cause(ctx)
+	}
+	if session != nil {
+		session.Fail(ctx, err)
+	}
+	return err
+}
+
 // ExportNovel 导出小说
 func (s *NovelService) ExportNovel(ctx context.Context, req *pb.ExportNovelRequest) (*pb.ExportNovelResponse, error) {
 	project, err := s.uc.GetProject(ctx, req.ProjectId)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.enforceContentSafety(ctx, project); err != nil {
+		return nil, err
+	}
 
 	options := &models.ExportOptions{
 		IncludeMetadata: req.Options.IncludeMetadata,
@@ -876,6 +1047,235 @@ func (s *NovelService) ExportNovel(ctx context.Context, req *pb.ExportNovelReque
 	}, nil
 }
 
+// ExportProjectBundle 导出项目数据包
+func (s *NovelService) ExportProjectBundle(ctx context.Context, req *pb.ExportProjectBundleRequest) (*pb.ExportProjectBundleResponse, error) {
+	if req.ProjectId == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	if s.bundleUc == nil {
+		return nil, fmt.Errorf("project bundle service not available")
+	}
+
+	bundle, err := s.bundleUc.ExportProjectBundle(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	// 数据包包含全部章节正文，与导出小说一样需要通过内容审核
+	project := *bundle.Project
+	project.Chapters = bundle.Chapters
+	if err := s.enforceContentSafety(ctx, &project); err != nil {
+		return nil, err
+	}
+
+	data, err := s.bundleUc.MarshalProjectBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportProjectBundleResponse{
+		Bundle:           data,
+		FileName:         fmt.Sprintf("%s_bundle_v%d.json", bundle.Project.ID, bundle.SchemaVersion),
+		SchemaVersion:    int32(bundle.SchemaVersion),
+		ChapterCount:     int32(len(bundle.Chapters)),
+		VideoScriptCount: int32(len(bundle.VideoScripts)),
+	}, nil
+}
+
+// ImportProjectBundle 导入项目数据包
+func (s *NovelService) ImportProjectBundle(ctx context.Context, req *pb.ImportProjectBundleRequest) (*pb.ImportProjectBundleResponse, error) {
+	if s.bundleUc == nil {
+		return nil, fmt.Errorf("project bundle service not available")
+	}
+
+	bundle, err := s.bundleUc.ParseProjectBundle(req.Bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.bundleUc.ImportProjectBundle(ctx, bundle, req.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ImportProjectBundleResponse{
+		Project:          convertProjectToProto(result.Project),
+		ChapterCount:     int32(result.ChapterCount),
+		VideoScriptCount: int32(result.VideoScriptCount),
+		IdMapping:        result.IDMapping,
+	}, nil
+}
+
+// SearchContent 全文检索
+func (s *NovelService) SearchContent(ctx context.Context, req *pb.SearchContentRequest) (*pb.SearchContentResponse, error) {
+	if s.searchUc == nil {
+		return nil, fmt.Errorf("search service not available")
+	}
+
+	hits, total, err := s.searchUc.SearchContent(ctx, &models.SearchQuery{
+		Query:       req.Query,
+		ProjectID:   req.ProjectId,
+		Status:      req.Status,
+		ChapterFrom: int(req.ChapterFrom),
+		ChapterTo:   int(req.ChapterTo),
+		DocTypes:    req.DocTypes,
+		Page:        int(req.Page),
+		PageSize:    int(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pbHits := make([]*pb.SearchHit, len(hits))
+	for i, hit := range hits {
+		pbHits[i] = &pb.SearchHit{
+			DocType:      hit.DocType,
+			ProjectId:    hit.ProjectID,
+			RefId:        hit.RefID,
+			ChapterIndex: int32(hit.ChapterIndex),
+			Title:        hit.Title,
+			Snippet:      hit.Snippet,
+			Score:        hit.Score,
+			Status:       hit.Status,
+		}
+	}
+
+	return &pb.SearchContentResponse{
+		Hits:  pbHits,
+		Total: int32(total),
+	}, nil
+}
+
+// DeleteProject 删除项目，项目连同章节与视频脚本移入回收站
+func (s *NovelService) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	if req.ProjectId == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+
+	if _, err := s.uc.GetProject(ctx, req.ProjectId); err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.DeleteProject(ctx, req.ProjectId); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteProjectResponse{Success: true}, nil
+}
+
+// DeleteChapter 删除章节，章节移入回收站
+func (s *NovelService) DeleteChapter(ctx context.Context, req *pb.DeleteChapterRequest) (*pb.DeleteChapterResponse, error) {
+	if req.ChapterId == "" {
+		return nil, fmt.Errorf("chapter_id is required")
+	}
+
+	chapter, err := s.uc.GetChapter(ctx, req.ChapterId)
+	if err != nil {
+		return nil, err
+	}
+	if req.ProjectId != "" && chapter.ProjectID != req.ProjectId {
+		return nil, fmt.Errorf("chapter %s does not belong to project %s", req.ChapterId, req.ProjectId)
+	}
+
+	if err := s.uc.DeleteChapter(ctx, req.ChapterId); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteChapterResponse{Success: true}, nil
+}
+
+// ListTrash 列出回收站
+func (s *NovelService) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	if s.trashUc == nil {
+		return nil, fmt.Errorf("trash service not available")
+	}
+
+	items, total, err := s.trashUc.ListTrash(ctx, req.Type, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	pbItems := make([]*pb.TrashItem, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.TrashItem{
+			Type:         item.Type,
+			Id:           item.ID,
+			ProjectId:    item.ProjectID,
+			Title:        item.Title,
+			ChapterIndex: int32(item.ChapterIndex),
+			DeletedAt:    timestamppb.New(item.DeletedAt),
+			ExpiresAt:    timestamppb.New(item.ExpiresAt),
+		}
+	}
+
+	return &pb.ListTrashResponse{
+		Items: pbItems,
+		Total: int32(total),
+	}, nil
+}
+
+// RestoreProject 从回收站恢复项目
+func (s *NovelService) RestoreProject(ctx context.Context, req *pb.RestoreProjectRequest) (*pb.RestoreProjectResponse, error) {
+	if s.trashUc == nil {
+		return nil, fmt.Errorf("trash service not available")
+	}
+
+	if _, err := s.trashUc.RestoreProject(ctx, req.ProjectId); err != nil {
+		return nil, err
+	}
+
+	// 重新加载项目以带上恢复的章节
+	project, err := s.uc.GetProject(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreProjectResponse{
+		Project: convertProjectToProto(project),
+	}, nil
+}
+
+// RestoreChapter 从回收站恢复章节
+func (s *NovelService) RestoreChapter(ctx context.Context, req *pb.RestoreChapterRequest) (*pb.RestoreChapterResponse, error) {
+	if s.trashUc == nil {
+		return nil, fmt.Errorf("trash service not available")
+	}
+
+	chapter, err := s.trashUc.RestoreChapter(ctx, req.ChapterId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreChapterResponse{
+		Chapter: convertChapterToProto(chapter),
+	}, nil
+}
+
+// PurgeProject 彻底删除回收站中的项目
+func (s *NovelService) PurgeProject(ctx context.Context, req *pb.PurgeProjectRequest) (*pb.PurgeProjectResponse, error) {
+	if s.trashUc == nil {
+		return nil, fmt.Errorf("trash service not available")
+	}
+
+	if err := s.trashUc.PurgeProject(ctx, req.ProjectId); err != nil {
+		return nil, err
+	}
+
+	return &pb.PurgeProjectResponse{Success: true}, nil
+}
+
+// PurgeChapter 彻底删除回收站中的章节
+func (s *NovelService) PurgeChapter(ctx context.Context, req *pb.PurgeChapterRequest) (*pb.PurgeChapterResponse, error) {
+	if s.trashUc == nil {
+		return nil, fmt.Errorf("trash service not available")
+	}
+
+	if err := s.trashUc.PurgeChapter(ctx, req.ChapterId); err != nil {
+		return nil, err
+	}
+
+	return &pb.PurgeChapterResponse{Success: true}, nil
+}
+
 // GenerateVideoScript 生成视频脚本
 func (s *NovelService) GenerateVideoScript(ctx context.Context, req *pb.GenerateVideoScriptRequest) (*pb.GenerateVideoScriptResponse, error) {
 	// 获取章节
@@ -950,56 +1350,9 @@ func (s *NovelService) CheckQuality(ctx context.Context, req *pb.CheckQualityReq
 		return nil, fmt.Errorf("质量检测失败: %w", err)
 	}
 
-	// 转换响应
-	response := &pb.CheckQualityResponse{
-		OverallScore:    result.OverallScore,
-		Recommendations: result.Recommendations,
-	}
-
-	if result.PolishedChapter != nil {
-		response.PolishedChapter = convertChapterToProto(result.PolishedChapter)
-	}
-
-	if result.ProofreadResult != nil {
-		response.ProofreadResult = &pb.ProofreadResult{
-			CorrectedContent: result.ProofreadResult.CorrectedContent,
-			Suggestions:      result.ProofreadResult.Suggestions,
-		}
-
-		for _, issue := range result.ProofreadResult.Issues {
-			response.ProofreadResult.Issues = append(response.ProofreadResult.Issues, &pb.QualityIssue{
-				Type:        issue.Type,
-				Severity:    issue.Severity,
-				Description: issue.Description,
-				Position:    issue.Position,
-				Original:    issue.Original,
-				Corrected:   issue.Corrected,
-			})
-		}
-	}
-
-	if result.CritiqueResult != nil {
-		response.CritiqueResult = &pb.CritiqueResult{
-			LogicalIssues:   result.CritiqueResult.LogicalIssues,
-			CharacterIssues: result.CritiqueResult.CharacterIssues,
-			PacingIssues:    result.CritiqueResult.PacingIssues,
-			Improvements:    result.CritiqueResult.Improvements,
-			FixedExample:    result.CritiqueResult.FixedExample,
-			OverallScore:    int32(result.CritiqueResult.OverallScore),
-		}
-	}
-
-	for _, issue := range result.ConsistencyIssues {
-		response.ConsistencyIssues = append(response.ConsistencyIssues, &pb.ConsistencyIssue{
-			Type:        issue.Type,
-			Severity:    issue.Severity,
-			Description: issue.Description,
-			Location:    issue.Location,
-			Suggestion:  issue.Suggestion,
-		})
-	}
+	s.recordQualityReports(ctx, project.ID, req.CheckType, []*models.Chapter{chapter}, []*quality.QualityCheckResponse{result})
 
-	return response, nil
+	return convertQualityResultToProto(result), nil
 }
 
 // BatchCheckQuality 批量质量检测
@@ -1010,8 +1363,11 @@ func (s *NovelService) BatchCheckQuality(ctx context.Context, req *pb.BatchCheck
 		return nil, fmt.Errorf("获取项目失败: %w", err)
 	}
 
-	// 获取章节信息
+	// 获取章节信息，重复检测未指定章节时比较项目全部章节
 	var chapters []*models.Chapter
+	if len(req.ChapterIds) == 0 && req.CheckType == quality.CheckTypeRepetition {
+		chapters = project.Chapters
+	}
 	for _, chapterID := range req.ChapterIds {
 		chapter, err := s.uc.GetChapter(ctx, chapterID)
 		if err != nil {
@@ -1047,56 +1403,10 @@ func (s *NovelService) BatchCheckQuality(ctx context.Context, req *pb.BatchCheck
 
 	// 转换单个结果
 	for _, res := range result.Results {
-		pbResult := &pb.CheckQualityResponse{
-			OverallScore:    res.OverallScore,
-			Recommendations: res.Recommendations,
-		}
-
-		if res.PolishedChapter != nil {
-			pbResult.PolishedChapter = convertChapterToProto(res.PolishedChapter)
-		}
-
-		if res.ProofreadResult != nil {
-			pbResult.ProofreadResult = &pb.ProofreadResult{
-				CorrectedContent: res.ProofreadResult.CorrectedContent,
-				Suggestions:      res.ProofreadResult.Suggestions,
-			}
-
-			for _, issue := range res.ProofreadResult.Issues {
-				pbResult.ProofreadResult.Issues = append(pbResult.ProofreadResult.Issues, &pb.QualityIssue{
-					Type:        issue.Type,
-					Severity:    issue.Severity,
-					Description: issue.Description,
-					Position:    issue.Position,
-					Original:    issue.Original,
-					Corrected:   issue.Corrected,
-				})
-			}
-		}
-
-		if res.CritiqueResult != nil {
-			pbResult.CritiqueResult = &pb.CritiqueResult{
-				LogicalIssues:   res.CritiqueResult.LogicalIssues,
-				CharacterIssues: res.CritiqueResult.CharacterIssues,
-				PacingIssues:    res.CritiqueResult.PacingIssues,
-				Improvements:    res.CritiqueResult.Improvements,
-				FixedExample:    res.CritiqueResult.FixedExample,
-				OverallScore:    int32(res.CritiqueResult.OverallScore),
-			}
-		}
-
-		for _, issue := range res.ConsistencyIssues {
-			pbResult.ConsistencyIssues = append(pbResult.ConsistencyIssues, &pb.ConsistencyIssue{
-				Type:        issue.Type,
-				Severity:    issue.Severity,
-				Description: issue.Description,
-				Location:    issue.Location,
-				Suggestion:  issue.Suggestion,
-			})
-		}
-
-		response.Results = append(response.Results, pbResult)
+		response.Results = append(response.Results, convertQualityResultToProto(res))
 	}
+	response.Repetitions = convertRepetitionPairsToProto(result.Repetitions)
+	s.recordQualityReports(ctx, project.ID, req.CheckType, chapters, result.Results)
 
 	// 转换汇总信息
 	if result.Summary != nil {
@@ -1123,64 +1433,626 @@ func (s *NovelService) BatchCheckQuality(ctx context.Context, req *pb.BatchCheck
 	return response, nil
 }
 
-// 辅助函数：数据模型转换
-func convertProjectToProto(project *models.NovelProject) *pb.Project {
-	pbProject := &pb.Project{
-		Id:             project.ID,
-		Title:          project.Title,
-		Description:    project.Description,
-		Genre:          project.Genre,
-		TargetAudience: project.TargetAudience,
-		Tone:           project.Tone,
-		Themes:         project.Themes,
-		Status:         project.Status,
-		CreatedAt:      timestamppb.New(project.CreatedAt),
-		UpdatedAt:      timestamppb.New(project.UpdatedAt),
+// GetIndexStatus 获取项目知识库索引状态
+func (s *NovelService) GetIndexStatus(ctx context.Context, req *pb.GetIndexStatusRequest) (*pb.GetIndexStatusResponse, error) {
+	if s.indexUc == nil {
+		return nil, fmt.Errorf("index service not available")
 	}
 
-	if project.WorldView != nil {
-		pbProject.WorldView = convertWorldViewToProto(project.WorldView)
+	status, err := s.indexUc.GetIndexStatus(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(project.Characters) > 0 {
-		pbProject.Characters = make([]*pb.Character, len(project.Characters))
-		for i, char := range project.Characters {
-			pbProject.Characters[i] = convertCharacterToProto(char)
-		}
-	}
+	return &pb.GetIndexStatusResponse{Status: convertIndexStatusToProto(status)}, nil
+}
 
-	if project.Outline != nil {
-		pbProject.Outline = convertOutlineToProto(project.Outline)
+// ReindexProject 重建项目知识库索引
+func (s *NovelService) ReindexProject(ctx context.Context, req *pb.ReindexProjectRequest) (*pb.ReindexProjectResponse, error) {
+	if s.indexUc == nil {
+		return nil, fmt.Errorf("index service not available")
 	}
 
-	if len(project.Chapters) > 0 {
-		pbProject.Chapters = make([]*pb.Chapter, len(project.Chapters))
-		for i, chapter := range project.Chapters {
-			pbProject.Chapters[i] = convertChapterToProto(chapter)
-		}
+	status, err := s.indexUc.ReindexProject(ctx, req.ProjectId, req.Force)
+	if err != nil {
+		return nil, err
 	}
 
-	return pbProject
+	return &pb.ReindexProjectResponse{Status: convertIndexStatusToProto(status)}, nil
 }
 
-func convertWorldViewToProto(worldView *models.WorldView) *pb.WorldView {
-	return &pb.WorldView{
-		Title:        worldView.Title,
-		Synopsis:     worldView.Synopsis,
-		Setting:      worldView.Setting,
-		KeyRules:     worldView.KeyRules,
-		ToneExamples: worldView.ToneExamples,
-		Themes:       worldView.Themes,
+// SearchProjectKnowledge 语义检索项目知识库
+func (s *NovelService) SearchProjectKnowledge(ctx context.Context, req *pb.SearchProjectKnowledgeRequest) (*pb.SearchProjectKnowledgeResponse, error) {
+	if s.knowledgeUc == nil {
+		return nil, fmt.Errorf("knowledge service not available")
 	}
-}
 
-func convertCharacterToProto(character *models.Character) *pb.Character {
-	return &pb.Character{
-		Id:              character.ID,
-		ProjectId:       character.ProjectID,
-		Name:            character.Name,
-		Role:            character.Role,
-		Age:             int32(character.Age),
+	result, err := s.knowledgeUc.SearchProjectKnowledge(ctx, &models.KnowledgeQuery{
+		ProjectID:    req.ProjectId,
+		Question:     req.Question,
+		ContentTypes: req.ContentTypes,
+		TopK:         int(req.TopK),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SearchProjectKnowledgeResponse{
+		Passages: make([]*pb.KnowledgePassage, len(result.Passages)),
+		Stats:    make(map[string]int32, len(result.Stats)),
+	}
+	for i, passage := range result.Passages {
+		resp.Passages[i] = &pb.KnowledgePassage{
+			DocumentId:   passage.DocumentID,
+			ContentType:  passage.ContentType,
+			Title:        passage.Title,
+			Content:      passage.Content,
+			Score:        passage.Score,
+			ChapterId:    passage.ChapterID,
+			ChapterIndex: int32(passage.ChapterIndex),
+			StartOffset:  int32(passage.StartOffset),
+			EndOffset:    int32(passage.EndOffset),
+		}
+	}
+	for contentType, count := range result.Stats {
+		resp.Stats[contentType] = int32(count)
+	}
+	return resp, nil
+}
+
+// GetStoryState 获取章节结束时的故事状态
+func (s *NovelService) GetStoryState(ctx context.Context, req *pb.GetStoryStateRequest) (*pb.GetStoryStateResponse, error) {
+	if s.storyStateUc == nil {
+		return nil, fmt.Errorf("story state service not available")
+	}
+
+	state, err := s.storyStateUc.GetStoryState(ctx, req.ProjectId, int(req.ChapterIndex))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetStoryStateResponse{State: convertStoryStateToProto(state)}, nil
+}
+
+// ApplyCorrection 将修正建议应用到章节正文中对应的原文片段
+func (s *NovelService) ApplyCorrection(ctx context.Context, req *pb.ApplyCorrectionRequest) (*pb.ApplyCorrectionResponse, error) {
+	chapter, span, err := s.uc.ApplyCorrection(ctx, &models.Correction{
+		ChapterID:       req.ChapterId,
+		Start:           int(req.Start),
+		End:             int(req.End),
+		Original:        req.Original,
+		Replacement:     req.Replacement,
+		ExpectedVersion: req.ExpectedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApplyCorrectionResponse{
+		Chapter: convertChapterToProto(chapter),
+		Span:    convertTextSpanToProto(span),
+	}, nil
+}
+
+// ListQualityReports 列出章节的质量与一致性检查历史
+func (s *NovelService) ListQualityReports(ctx context.Context, req *pb.ListQualityReportsRequest) (*pb.ListQualityReportsResponse, error) {
+	if s.qualityReportUc == nil {
+		return nil, fmt.Errorf("quality report service not available")
+	}
+
+	reports, total, err := s.qualityReportUc.ListChapterReports(ctx, req.ProjectId, req.ChapterId, req.Kind, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListQualityReportsResponse{
+		Reports: make([]*pb.QualityReport, len(reports)),
+		Total:   int32(total),
+	}
+	for i, report := range reports {
+		resp.Reports[i] = s.convertQualityReportToProto(ctx, report)
+	}
+	return resp, nil
+}
+
+// GetQualityTrends 获取项目的质量趋势与润色前后的分数对比
+func (s *NovelService) GetQualityTrends(ctx context.Context, req *pb.GetQualityTrendsRequest) (*pb.GetQualityTrendsResponse, error) {
+	if s.qualityReportUc == nil {
+		return nil, fmt.Errorf("quality report service not available")
+	}
+
+	trends, err := s.qualityReportUc.GetQualityTrends(ctx, req.ProjectId, req.Kind, int(req.Days))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetQualityTrendsResponse{
+		Kind:                trends.Kind,
+		AverageBefore:       trends.AverageBefore,
+		AverageAfter:        trends.AverageAfter,
+		PolishComparedCount: int32(trends.PolishComparedCount),
+	}
+	for _, point := range trends.Points {
+		resp.Points = append(resp.Points, &pb.QualityTrendPoint{
+			Date:         point.Date,
+			AverageScore: point.AverageScore,
+			ReportCount:  int32(point.ReportCount),
+		})
+	}
+	for _, chapter := range trends.Chapters {
+		resp.Chapters = append(resp.Chapters, &pb.ChapterQualityTrend{
+			ChapterId:         chapter.ChapterID,
+			ChapterIndex:      int32(chapter.ChapterIndex),
+			LatestScore:       chapter.LatestScore,
+			BeforePolishScore: chapter.BeforePolishScore,
+			AfterPolishScore:  chapter.AfterPolishScore,
+			HasPolishCompare:  chapter.HasPolishCompare,
+			ReportCount:       int32(chapter.ReportCount),
+		})
+	}
+	return resp, nil
+}
+
+// ListPlotThreads 列出项目的情节线索
+func (s *NovelService) ListPlotThreads(ctx context.Context, req *pb.ListPlotThreadsRequest) (*pb.ListPlotThreadsResponse, error) {
+	if s.plotThreadUc == nil {
+		return nil, fmt.Errorf("plot thread service not available")
+	}
+
+	threads, err := s.plotThreadUc.ListThreads(ctx, req.ProjectId, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPlotThreadsResponse{Threads: convertPlotThreadsToProto(threads)}, nil
+}
+
+// CheckPlotThreads 按当前大纲同步大纲线索后，检查即将生成的章节前未回收的伏笔与缺少铺垫的回收
+func (s *NovelService) CheckPlotThreads(ctx context.Context, req *pb.CheckPlotThreadsRequest) (*pb.CheckPlotThreadsResponse, error) {
+	if s.plotThreadUc == nil {
+		return nil, fmt.Errorf("plot thread service not available")
+	}
+
+	project, err := s.uc.GetProject(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	threads, err := s.syncPlotThreads(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	// 未指定时检查已有章节之后的一章，大纲章节数不足时以已有章节数为准
+	total, last := 0, 0
+	if project.Outline != nil {
+		total = len(project.Outline.Chapters)
+	}
+	for _, chapter := range project.Chapters {
+		if chapter.Index > last {
+			last = chapter.Index
+		}
+	}
+	if last > total {
+		total = last
+	}
+	next := int(req.NextChapter)
+	if next <= 0 {
+		next = last + 1
+	}
+
+	report := plotthread.Analyze(project.ID, threads, total, next)
+	return &pb.CheckPlotThreadsResponse{
+		NextChapter:   int32(report.NextChapter),
+		TotalChapters: int32(report.TotalChapters),
+		OpenThreads:   convertPlotThreadsToProto(report.OpenThreads),
+		Issues:        convertPlotThreadIssuesToProto(report.Issues),
+	}, nil
+}
+
+// RefineOutline 根据反馈优化章节大纲，可要求模型为尚未回收的情节线索安排铺垫与回收
+func (s *NovelService) RefineOutline(ctx context.Context, req *pb.RefineOutlineRequest) (*pb.RefineOutlineResponse, error) {
+	project, err := s.uc.GetProject(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	if project.Outline == nil {
+		return nil, fmt.Errorf("project outline not found")
+	}
+
+	var openThreads []*models.PlotThread
+	if req.IncludeOpenThreads {
+		if s.plotThreadUc == nil {
+			return nil, fmt.Errorf("plot thread service not available")
+		}
+		threads, err := s.syncPlotThreads(ctx, project)
+		if err != nil {
+			return nil, err
+		}
+		for _, thread := range threads {
+			if thread.Status != models.PlotThreadResolved {
+				openThreads = append(openThreads, thread)
+			}
+		}
+	}
+
+	resp, err := s.outlineAgent.RefineOutline(ctx, &outline.RefineOutlineRequest{
+		Outline:     project.Outline,
+		Feedback:    req.Feedback,
+		OpenThreads: openThreads,
+		Options:     convertLLMOptionsFromProto(req.LlmOptions),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 保存大纲到项目中
+	project.Outline = resp.Outline
+	if _, err := s.uc.UpdateProject(ctx, project); err != nil {
+		return nil, err
+	}
+	s.refreshPlotThreads(ctx, project)
+
+	return &pb.RefineOutlineResponse{
+		Outline:     convertOutlineToProto(resp.Outline),
+		OpenThreads: convertPlotThreadsToProto(openThreads),
+	}, nil
+}
+
+// ReviewContent 内容安全审核，按章节标注暴力、色情、政治、未成年人风险并给出内容分级
+func (s *NovelService) ReviewContent(ctx context.Context, req *pb.ReviewContentRequest) (*pb.ReviewContentResponse, error) {
+	if s.safetyUc == nil {
+		return nil, fmt.Errorf("content safety service not available")
+	}
+
+	project, err := s.uc.GetProject(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	chapters := project.Chapters
+	if len(req.ChapterIndexes) > 0 {
+		wanted := make(map[int]bool, len(req.ChapterIndexes))
+		for _, index := range req.ChapterIndexes {
+			wanted[int(index)] = true
+		}
+		chapters = make([]*models.Chapter, 0, len(wanted))
+		for _, chapter := range project.Chapters {
+			if wanted[chapter.Index] {
+				chapters = append(chapters, chapter)
+			}
+		}
+	}
+
+	report, err := s.safetyAgent.ReviewChapters(ctx, &safety.ReviewChaptersRequest{
+		ProjectID:  project.ID,
+		Chapters:   chapters,
+		Dictionary: s.safetyUc.Dictionary(),
+		UseLLM:     req.UseLlm,
+		Options:    convertLLMOptionsFromProto(req.LlmOptions),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ChapterIndexes) > 0 {
+		s.safetyUc.ApplyPartial(report)
+	} else {
+		s.safetyUc.Apply(report)
+	}
+
+	return convertSafetyReportToProto(report), nil
+}
+
+// enforceContentSafety 导出前审核全部章节，分级达到阈值时返回 biz.ErrContentBlocked
+// 未配置阈值时跳过审核；按配置调用模型分类，模型不可用时只使用词库
+func (s *NovelService) enforceContentSafety(ctx context.Context, project *models.NovelProject) error {
+	if s.safetyUc == nil || s.safetyUc.BlockRating() == "" {
+		return nil
+	}
+
+	req := &safety.ReviewChaptersRequest{
+		ProjectID:  project.ID,
+		Chapters:   project.Chapters,
+		Dictionary: s.safetyUc.Dictionary(),
+		UseLLM:     s.safetyUc.UseLLM(),
+	}
+	report, err := s.safetyAgent.ReviewChapters(ctx, req)
+	if err != nil && req.UseLLM {
+		s.log.WithContext(ctx).Warnf("Failed to classify project %s, falling back to dictionary: %v", project.ID, err)
+		req.UseLLM = false
+		report, err = s.safetyAgent.ReviewChapters(ctx, req)
+	}
+	if err != nil {
+		return err
+	}
+	if err := s.safetyUc.Enforce(report); err != nil {
+		s.log.WithContext(ctx).Warnf("Blocked exporting project %s: rating=%s", project.ID, report.Rating)
+		return err
+	}
+	return nil
+}
+
+// syncPlotThreads 按项目当前的大纲更新大纲线索并保存，返回项目的全部线索
+func (s *NovelService) syncPlotThreads(ctx context.Context, project *models.NovelProject) ([]*models.PlotThread, error) {
+	existing, err := s.plotThreadUc.ListThreads(ctx, project.ID, "")
+	if err != nil {
+		return nil, err
+	}
+	threads := plotthread.ThreadsFromOutline(project.ID, project.Outline, existing)
+	if err := s.plotThreadUc.SaveThreads(ctx, project.ID, threads); err != nil {
+		return nil, err
+	}
+	return threads, nil
+}
+
+// refreshPlotThreads 大纲变更后同步大纲线索，失败只记录日志
+func (s *NovelService) refreshPlotThreads(ctx context.Context, project *models.NovelProject) {
+	if s.plotThreadUc == nil {
+		return
+	}
+	if _, err := s.syncPlotThreads(ctx, project); err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to sync plot threads for %s: %v", project.ID, err)
+	}
+}
+
+// trackPlotThreads 提取已保存章节中的伏笔埋设与回收并持久化，失败只记录日志
+func (s *NovelService) trackPlotThreads(ctx context.Context, saved *models.Chapter, options *llm.GenerateOptions) {
+	if s.plotThreadUc == nil || s.plotThreadAgent == nil || saved == nil {
+		return
+	}
+
+	threads, err := s.plotThreadUc.ListThreads(ctx, saved.ProjectID, "")
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to load plot threads for %s: %v", saved.ProjectID, err)
+		return
+	}
+	threads, err = s.plotThreadAgent.TrackChapter(ctx, &plotthread.TrackChapterRequest{
+		Chapter: saved,
+		Threads: threads,
+		Options: options,
+	})
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to track plot threads for chapter %s: %v", saved.ID, err)
+		return
+	}
+	if err := s.plotThreadUc.SaveThreads(ctx, saved.ProjectID, threads); err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to save plot threads for chapter %s: %v", saved.ID, err)
+	}
+}
+
+// applyStoryState 将上一章结束时的故事状态写入生成上下文，返回该状态供生成后合并
+// 读取失败只记录日志，章节照常生成
+func (s *NovelService) applyStoryState(ctx context.Context, req *chapter.GenerateChapterRequest) *models.StoryState {
+	if s.storyStateUc == nil || req.ChapterOutline == nil {
+		return nil
+	}
+
+	state, err := s.storyStateUc.StateBefore(ctx, req.ProjectID, req.ChapterOutline.Index)
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to load story state for %s: %v", req.ProjectID, err)
+		return nil
+	}
+	storystate.ApplyToContext(req.Context, state)
+	return state
+}
+
+// trackStoryState 提取已保存章节结束时的故事状态并持久化，失败只记录日志
+func (s *NovelService) trackStoryState(ctx context.Context, saved *models.Chapter, previous *models.StoryState, req *chapter.GenerateChapterRequest) {
+	if s.storyStateUc == nil || s.storyStateAgent == nil || saved == nil {
+		return
+	}
+
+	state, err := s.storyStateAgent.ExtractStoryState(ctx, &storystate.ExtractStoryStateRequest{
+		Chapter:    saved,
+		Previous:   previous,
+		Characters: req.Context.Characters,
+		Options:    req.Options,
+	})
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to extract story state for chapter %s: %v", saved.ID, err)
+		return
+	}
+	if err := s.storyStateUc.SaveStoryStates(ctx, state); err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to save story state for chapter %s: %v", saved.ID, err)
+	}
+}
+
+// recordQualityReports 为每个检测过的章节保存一份质量报告，失败只记录日志
+func (s *NovelService) recordQualityReports(ctx context.Context, projectID, checkType string, chapters []*models.Chapter, results []*quality.QualityCheckResponse) {
+	if s.qualityReportUc == nil {
+		return
+	}
+
+	reports := make([]*models.QualityReport, 0, len(results))
+	for i, result := range results {
+		if result == nil || i >= len(chapters) {
+			continue
+		}
+		severity := make(map[string]int)
+		if result.ProofreadResult != nil {
+			for _, issue := range result.ProofreadResult.Issues {
+				severity[issue.Severity]++
+			}
+		}
+		for _, issue := range result.ConsistencyIssues {
+			severity[issue.Severity]++
+		}
+		details, err := json.Marshal(result)
+		if err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to marshal quality result for chapter %s: %v", chapters[i].ID, err)
+			continue
+		}
+		reports = append(reports, &models.QualityReport{
+			ProjectID:        projectID,
+			ChapterID:        chapters[i].ID,
+			ChapterIndex:     chapters[i].Index,
+			ChapterVersion:   chapters[i].Version,
+			Kind:             models.QualityReportQuality,
+			CheckType:        checkType,
+			Polished:         chapters[i].Status == models.ChapterStatusPolished,
+			OverallScore:     result.OverallScore,
+			IssuesBySeverity: severity,
+			Details:          string(details),
+		})
+	}
+	if err := s.qualityReportUc.RecordReports(ctx, reports...); err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to record quality reports for %s: %v", projectID, err)
+	}
+}
+
+// recordConsistencyReports 按章节拆分一致性问题并为每个章节保存一份报告，失败只记录日志
+// 分数按该章节问题的严重程度扣分计算，无法归属到章节的问题不计入
+func (s *NovelService) recordConsistencyReports(ctx context.Context, project *models.NovelProject, checkType string, issues []consistency.ConsistencyIssue) {
+	if s.qualityReportUc == nil || len(project.Chapters) == 0 {
+		return
+	}
+
+	byChapter := make(map[string][]consistency.ConsistencyIssue)
+	for _, issue := range issues {
+		for _, chapter := range project.Chapters {
+			if issue.Span != nil && issue.Span.ChapterID != "" {
+				if issue.Span.ChapterID != chapter.ID {
+					continue
+				}
+			} else if issue.ChapterIndex != chapter.Index {
+				continue
+			}
+			byChapter[chapter.ID] = append(byChapter[chapter.ID], issue)
+			break
+		}
+	}
+
+	reports := make([]*models.QualityReport, 0, len(project.Chapters))
+	for _, chapter := range project.Chapters {
+		chapterIssues := byChapter[chapter.ID]
+		severity := make(map[string]int)
+		for _, issue := range chapterIssues {
+			severity[issue.Severity]++
+		}
+		details, err := json.Marshal(chapterIssues)
+		if err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to marshal consistency issues for chapter %s: %v", chapter.ID, err)
+			continue
+		}
+		reports = append(reports, &models.QualityReport{
+			ProjectID:        project.ID,
+			ChapterID:        chapter.ID,
+			ChapterIndex:     chapter.Index,
+			ChapterVersion:   chapter.Version,
+			Kind:             models.QualityReportConsistency,
+			CheckType:        checkType,
+			Polished:         chapter.Status == models.ChapterStatusPolished,
+			OverallScore:     consistency.RuleScore(chapterIssues),
+			IssuesBySeverity: severity,
+			Details:          string(details),
+		})
+	}
+	if err := s.qualityReportUc.RecordReports(ctx, reports...); err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to record consistency reports for %s: %v", project.ID, err)
+	}
+}
+
+// recordQualityGates 将质量门禁的每次评审保存为一份报告供审计，失败只记录日志
+func (s *NovelService) recordQualityGates(ctx context.Context, projectID string, chapters []*models.Chapter, gates []*models.QualityGateResult) {
+	if s.qualityReportUc == nil || len(gates) == 0 {
+		return
+	}
+
+	// 生成时章节尚未保存，门禁结果没有章节ID，按序号关联到保存后的章节
+	ids := make(map[int]string, len(chapters))
+	versions := make(map[int]int64, len(chapters))
+	for _, chapter := range chapters {
+		ids[chapter.Index] = chapter.ID
+		versions[chapter.Index] = chapter.Version
+	}
+	var reports []*models.QualityReport
+	for _, gate := range gates {
+		for _, attempt := range gate.Attempts {
+			details, err := json.Marshal(attempt)
+			if err != nil {
+				s.log.WithContext(ctx).Warnf("Failed to marshal quality gate attempt for chapter %d: %v", gate.ChapterIndex, err)
+				continue
+			}
+			issueCount := 0
+			if attempt.Critique != nil {
+				issueCount = len(attempt.Critique.LogicalIssues) + len(attempt.Critique.CharacterIssues) + len(attempt.Critique.PacingIssues)
+			}
+			reports = append(reports, &models.QualityReport{
+				ProjectID:        projectID,
+				ChapterID:        ids[gate.ChapterIndex],
+				ChapterIndex:     gate.ChapterIndex,
+				ChapterVersion:   versions[gate.ChapterIndex],
+				Kind:             models.QualityReportGate,
+				CheckType:        "critique",
+				OverallScore:     attempt.Score,
+				IssueCount:       issueCount,
+				Details:          string(details),
+				CreatedAt:        attempt.CheckedAt,
+			})
+		}
+	}
+	if err := s.qualityReportUc.RecordReports(ctx, reports...); err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to record quality gate reports for %s: %v", projectID, err)
+	}
+}
+
+// 辅助函数：数据模型转换
+func convertProjectToProto(project *models.NovelProject) *pb.Project {
+	pbProject := &pb.Project{
+		Id:             project.ID,
+		Title:          project.Title,
+		Description:    project.Description,
+		Genre:          project.Genre,
+		TargetAudience: project.TargetAudience,
+		Tone:           project.Tone,
+		Themes:         project.Themes,
+		Status:         project.Status,
+		CreatedAt:      timestamppb.New(project.CreatedAt),
+		UpdatedAt:      timestamppb.New(project.UpdatedAt),
+		Version:        project.Version,
+		OwnerId:        project.OwnerID,
+	}
+
+	if project.WorldView != nil {
+		pbProject.WorldView = convertWorldViewToProto(project.WorldView)
+	}
+
+	if len(project.Characters) > 0 {
+		pbProject.Characters = make([]*pb.Character, len(project.Characters))
+		for i, char := range project.Characters {
+			pbProject.Characters[i] = convertCharacterToProto(char)
+		}
+	}
+
+	if project.Outline != nil {
+		pbProject.Outline = convertOutlineToProto(project.Outline)
+	}
+
+	if len(project.Chapters) > 0 {
+		pbProject.Chapters = make([]*pb.Chapter, len(project.Chapters))
+		for i, chapter := range project.Chapters {
+			pbProject.Chapters[i] = convertChapterToProto(chapter)
+		}
+	}
+
+	return pbProject
+}
+
+func convertWorldViewToProto(worldView *models.WorldView) *pb.WorldView {
+	return &pb.WorldView{
+		Title:        worldView.Title,
+		Synopsis:     worldView.Synopsis,
+		Setting:      worldView.Setting,
+		KeyRules:     worldView.KeyRules,
+		ToneExamples: worldView.ToneExamples,
+		Themes:       worldView.Themes,
+	}
+}
+
+func convertCharacterToProto(character *models.Character) *pb.Character {
+	return &pb.Character{
+		Id:              character.ID,
+		ProjectId:       character.ProjectID,
+		Name:            character.Name,
+		Role:            character.Role,
+		Age:             int32(character.Age),
 		Appearance:      character.Appearance,
 		Background:      character.Background,
 		Motivation:      character.Motivation,
@@ -1226,6 +2098,21 @@ func convertChapterToProto(chapter *models.Chapter) *pb.Chapter {
 		Status:     chapter.Status,
 		CreatedAt:  timestamppb.New(chapter.CreatedAt),
 		UpdatedAt:  timestamppb.New(chapter.UpdatedAt),
+		Version:    chapter.Version,
+	}
+}
+
+func convertGenerationProgressToProto(progress *models.GenerationProgress) *pb.GenerateNovelResponse {
+	message := progress.Message
+	if progress.Error != "" {
+		message = fmt.Sprintf("%s: %s", message, progress.Error)
+	}
+	return &pb.GenerateNovelResponse{
+		Status:       progress.Status,
+		Progress:     progress.Progress,
+		CurrentStage: progress.Stage,
+		Message:      message,
+		Issues:       progress.Issues,
 	}
 }
 
@@ -1250,9 +2137,10 @@ func convertContextFromProto(pbContext *pb.GenerationContext) *models.Generation
 		context.Timeline = make([]*models.TimelineEvent, len(pbContext.Timeline))
 		for i, event := range pbContext.Timeline {
 			context.Timeline[i] = &models.TimelineEvent{
-				Timestamp:   event.Timestamp,
-				Event:       event.Event,
-				Description: event.Description,
+				Timestamp:    event.Timestamp,
+				Event:        event.Event,
+				Description:  event.Description,
+				ChapterIndex: int(event.ChapterIndex),
 			}
 		}
 	}
@@ -1264,6 +2152,19 @@ func convertContextFromProto(pbContext *pb.GenerationContext) *models.Generation
 				Name:        prop.Name,
 				Description: prop.Description,
 				Location:    prop.Location,
+				Holder:      prop.Holder,
+			}
+		}
+	}
+
+	if len(pbContext.CharacterStates) > 0 {
+		context.CharacterStates = make([]*models.CharacterState, len(pbContext.CharacterStates))
+		for i, state := range pbContext.CharacterStates {
+			context.CharacterStates[i] = &models.CharacterState{
+				Name:      state.Name,
+				Location:  state.Location,
+				Condition: state.Condition,
+				Status:    state.Status,
 			}
 		}
 	}
@@ -1360,46 +2261,18 @@ func (s *NovelService) ListModels(ctx context.Context, req *pb.ListModelsRequest
 
 // GetStats 获取统计信息
 func (s *NovelService) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
-	// 获取项目统计信息
-	projects, total, err := s.uc.ListProjects(ctx, 1, 1000) // 获取所有项目
+	// 统计在数据库中按调用者可见范围聚合
+	stats, err := s.uc.GetStats(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// 计算统计信息
-	completedCount := 0
-	totalWords := int64(0)
-	monthlyWords := int64(0)
-
-	currentTime := time.Now()
-	currentMonth := currentTime.Month()
-	currentYear := currentTime.Year()
-
-	for _, project := range projects {
-		// 统计已完成项目
-		if project.Status == "completed" {
-			completedCount++
-		}
-
-		// 统计总字数和本月字数
-		if project.Chapters != nil {
-			for _, chapter := range project.Chapters {
-				totalWords += int64(chapter.WordCount)
-
-				// 检查是否为本月创建的章节
-				if chapter.CreatedAt.Month() == currentMonth && chapter.CreatedAt.Year() == currentYear {
-					monthlyWords += int64(chapter.WordCount)
-				}
-			}
-		}
-	}
-
 	return &pb.GetStatsResponse{
 		Stats: &pb.ProjectStats{
-			TotalProjects:     int32(total),
-			CompletedProjects: int32(completedCount),
-			TotalWords:        totalWords,
-			MonthlyWords:      monthlyWords,
+			TotalProjects:     int32(stats.TotalProjects),
+			CompletedProjects: int32(stats.CompletedProjects),
+			TotalWords:        stats.TotalWords,
+			MonthlyWords:      stats.MonthlyWords,
 		},
 	}, nil
 }
@@ -1450,6 +2323,407 @@ func convertGenerateOptionsFromProto(pbOptions *pb.GenerateOptions) *orchestrato
 		WordsPerChapter:  int(pbOptions.WordsPerChapter),
 		PolishEnabled:    pbOptions.PolishEnabled,
 		ConsistencyCheck: pbOptions.ConsistencyCheck,
+		QualityGate:      pbOptions.QualityGate,
+		QualityThreshold: pbOptions.QualityThreshold,
+		MaxRevisions:     int(pbOptions.MaxRevisions),
 		LLMOptions:       convertLLMOptionsFromProto(pbOptions.LlmOptions),
 	}
 }
+
+// convertIndexStatusToProto 将索引状态转换为protobuf，未索引过的时间字段留空
+func convertIndexStatusToProto(status *models.IndexStatus) *pb.IndexStatus {
+	pbStatus := &pb.IndexStatus{
+		ProjectId: status.ProjectID,
+		Fresh:     status.Fresh,
+		Total:     int32(status.Total),
+		Indexed:   int32(status.Indexed),
+		Stale:     int32(status.Stale),
+		Missing:   int32(status.Missing),
+		Failed:    int32(status.Failed),
+		Pending:   int32(status.Pending),
+	}
+	if !status.LastIndexedAt.IsZero() {
+		pbStatus.LastIndexedAt = timestamppb.New(status.LastIndexedAt)
+	}
+
+	for _, item := range status.Items {
+		pbItem := &pb.IndexItemStatus{
+			ItemType: item.ItemType,
+			ItemId:   item.ItemID,
+			Title:    item.Title,
+			State:    item.State,
+			Error:    item.Error,
+		}
+		if !item.IndexedAt.IsZero() {
+			pbItem.IndexedAt = timestamppb.New(item.IndexedAt)
+		}
+		pbStatus.Items = append(pbStatus.Items, pbItem)
+	}
+	return pbStatus
+}
+
+// convertRetrievedChunksToProto 将生成时引用的前文片段转换为protobuf
+func convertRetrievedChunksToProto(chunks []*models.RetrievedChunk) []*pb.RetrievedChunk {
+	pbChunks := make([]*pb.RetrievedChunk, len(chunks))
+	for i, chunk := range chunks {
+		pbChunks[i] = &pb.RetrievedChunk{
+			DocumentId:   chunk.DocumentID,
+			Kind:         chunk.Kind,
+			Subjects:     chunk.Subjects,
+			ChapterId:    chunk.ChapterID,
+			ChapterIndex: int32(chunk.ChapterIndex),
+			ChapterTitle: chunk.ChapterTitle,
+			Content:      chunk.Content,
+			Tokens:       int32(chunk.Tokens),
+			Score:        chunk.Score,
+		}
+	}
+	return pbChunks
+}
+
+// convertPlotThreadsToProto 转换情节线索
+func convertPlotThreadsToProto(threads []*models.PlotThread) []*pb.PlotThread {
+	pbThreads := make([]*pb.PlotThread, 0, len(threads))
+	for _, thread := range threads {
+		planted := make([]int32, len(thread.PlantedChapters))
+		for i, index := range thread.PlantedChapters {
+			planted[i] = int32(index)
+		}
+		pbThreads = append(pbThreads, &pb.PlotThread{
+			Id:              thread.ID,
+			Kind:            thread.Kind,
+			Title:           thread.Title,
+			Description:     thread.Description,
+			Source:          thread.Source,
+			PlantedChapters: planted,
+			PayoffChapter:   int32(thread.PayoffChapter),
+			ResolvedChapter: int32(thread.ResolvedChapter),
+			Resolution:      thread.Resolution,
+			Status:          thread.Status,
+			UpdatedAt:       timestamppb.New(thread.UpdatedAt),
+		})
+	}
+	return pbThreads
+}
+
+// convertPlotThreadIssuesToProto 转换情节线索问题
+func convertPlotThreadIssuesToProto(issues []*models.PlotThreadIssue) []*pb.PlotThreadIssue {
+	pbIssues := make([]*pb.PlotThreadIssue, 0, len(issues))
+	for _, issue := range issues {
+		pbIssues = append(pbIssues, &pb.PlotThreadIssue{
+			ThreadId:     issue.ThreadID,
+			Type:         issue.Type,
+			Title:        issue.Title,
+			ChapterIndex: int32(issue.ChapterIndex),
+			Description:  issue.Description,
+			Suggestion:   issue.Suggestion,
+		})
+	}
+	return pbIssues
+}
+
+// convertStoryStateToProto 转换故事状态，state 为 nil 时返回 nil
+func convertStoryStateToProto(state *models.StoryState) *pb.StoryState {
+	if state == nil {
+		return nil
+	}
+
+	pbState := &pb.StoryState{
+		ProjectId:    state.ProjectID,
+		ChapterId:    state.ChapterID,
+		ChapterIndex: int32(state.ChapterIndex),
+		Locations:    state.Locations,
+		Characters:   make([]*pb.CharacterState, len(state.Characters)),
+		Props:        make([]*pb.PropItem, len(state.Props)),
+		Timeline:     make([]*pb.TimelineEvent, len(state.Timeline)),
+	}
+	if !state.UpdatedAt.IsZero() {
+		pbState.UpdatedAt = timestamppb.New(state.UpdatedAt)
+	}
+	for i, character := range state.Characters {
+		pbState.Characters[i] = &pb.CharacterState{
+			Name:      character.Name,
+			Location:  character.Location,
+			Condition: character.Condition,
+			Status:    character.Status,
+		}
+	}
+	for i, prop := range state.Props {
+		pbState.Props[i] = &pb.PropItem{
+			Name:        prop.Name,
+			Description: prop.Description,
+			Location:    prop.Location,
+			Holder:      prop.Holder,
+		}
+	}
+	for i, event := range state.Timeline {
+		pbState.Timeline[i] = &pb.TimelineEvent{
+			Timestamp:    event.Timestamp,
+			Event:        event.Event,
+			Description:  event.Description,
+			ChapterIndex: int32(event.ChapterIndex),
+		}
+	}
+	return pbState
+}
+
+func convertTextSpanToProto(span *models.TextSpan) *pb.TextSpan {
+	if span == nil {
+		return nil
+	}
+	return &pb.TextSpan{
+		ChapterId: span.ChapterID,
+		Start:     int32(span.Start),
+		End:       int32(span.End),
+		Quote:     span.Quote,
+		Verified:  span.Verified,
+	}
+}
+
+func convertConsistencyIssueToProto(issue consistency.ConsistencyIssue) *pb.ConsistencyIssue {
+	return &pb.ConsistencyIssue{
+		Type:        issue.Type,
+		Severity:    issue.Severity,
+		Description: issue.Description,
+		Location:    issue.Location,
+		Suggestion:  issue.Suggestion,
+		Span:        convertTextSpanToProto(issue.Span),
+	}
+}
+
+func convertQualityIssueToProto(issue quality.Issue) *pb.QualityIssue {
+	return &pb.QualityIssue{
+		Type:        issue.Type,
+		Severity:    issue.Severity,
+		Description: issue.Description,
+		Position:    issue.Position,
+		Original:    issue.Original,
+		Corrected:   issue.Corrected,
+		Span:        convertTextSpanToProto(issue.Span),
+	}
+}
+
+func convertQualityResultToProto(result *quality.QualityCheckResponse) *pb.CheckQualityResponse {
+	pbResult := &pb.CheckQualityResponse{
+		OverallScore:    result.OverallScore,
+		Recommendations: result.Recommendations,
+	}
+
+	if result.PolishedChapter != nil {
+		pbResult.PolishedChapter = convertChapterToProto(result.PolishedChapter)
+	}
+
+	if result.ProofreadResult != nil {
+		pbResult.ProofreadResult = &pb.ProofreadResult{
+			CorrectedContent: result.ProofreadResult.CorrectedContent,
+			Suggestions:      result.ProofreadResult.Suggestions,
+		}
+
+		for _, issue := range result.ProofreadResult.Issues {
+			pbResult.ProofreadResult.Issues = append(pbResult.ProofreadResult.Issues, convertQualityIssueToProto(issue))
+		}
+	}
+
+	if result.CritiqueResult != nil {
+		pbResult.CritiqueResult = convertCritiqueResultToProto(result.CritiqueResult)
+	}
+
+	for _, issue := range result.ConsistencyIssues {
+		pbResult.ConsistencyIssues = append(pbResult.ConsistencyIssues, convertConsistencyIssueToProto(issue))
+	}
+
+	if result.Metrics != nil {
+		pbResult.Metrics = convertTextMetricsToProto(result.Metrics)
+	}
+
+	pbResult.Repetitions = convertRepetitionPairsToProto(result.Repetitions)
+
+	return pbResult
+}
+
+func convertRepetitionPairsToProto(pairs []*quality.RepetitionPair) []*pb.RepetitionPair {
+	if len(pairs) == 0 {
+		return nil
+	}
+	pbPairs := make([]*pb.RepetitionPair, len(pairs))
+	for i, pair := range pairs {
+		pbPairs[i] = &pb.RepetitionPair{
+			Source:     convertTextSpanToProto(pair.Source),
+			Target:     convertTextSpanToProto(pair.Target),
+			Similarity: pair.Similarity,
+		}
+	}
+	return pbPairs
+}
+
+func convertTextMetricsToProto(metrics *textmetrics.Metrics) *pb.TextMetrics {
+	pbMetrics := &pb.TextMetrics{
+		CharCount:              int32(metrics.CharCount),
+		SentenceCount:          int32(metrics.SentenceCount),
+		AvgSentenceLength:      metrics.AvgSentenceLength,
+		SentenceLengthStdDev:   metrics.SentenceLengthStdDev,
+		SentenceLengths:        make(map[string]int32, len(metrics.SentenceLengths)),
+		ParagraphCount:         int32(metrics.ParagraphCount),
+		AvgParagraphLength:     metrics.AvgParagraphLength,
+		MaxParagraphLength:     int32(metrics.MaxParagraphLength),
+		DialogueRatio:          metrics.DialogueRatio,
+		RepeatedNgrams:         convertPhraseCountsToProto(metrics.RepeatedNGrams),
+		OverusedPhrases:        convertPhraseCountsToProto(metrics.OverusedPhrases),
+		AdverbCount:            int32(metrics.AdverbCount),
+		AdverbDensity:          metrics.AdverbDensity,
+		PunctuationCount:       int32(metrics.PunctuationCount),
+		HalfWidthPunctuation:   int32(metrics.HalfWidthPunctuation),
+		PunctuationMisuseRatio: metrics.PunctuationMisuseRatio,
+		Score:                  metrics.Score,
+	}
+	for bucket, count := range metrics.SentenceLengths {
+		pbMetrics.SentenceLengths[bucket] = int32(count)
+	}
+	return pbMetrics
+}
+
+func convertPhraseCountsToProto(phrases []*textmetrics.PhraseCount) []*pb.PhraseCount {
+	pbPhrases := make([]*pb.PhraseCount, len(phrases))
+	for i, phrase := range phrases {
+		pbPhrases[i] = &pb.PhraseCount{Phrase: phrase.Phrase, Count: int32(phrase.Count)}
+	}
+	return pbPhrases
+}
+
+// convertQualityReportToProto 转换质量报告，并还原保存的完整检查结果
+func (s *NovelService) convertQualityReportToProto(ctx context.Context, report *models.QualityReport) *pb.QualityReport {
+	pbReport := &pb.QualityReport{
+		Id:               report.ID,
+		ProjectId:        report.ProjectID,
+		ChapterId:        report.ChapterID,
+		ChapterIndex:     int32(report.ChapterIndex),
+		ChapterVersion:   report.ChapterVersion,
+		Kind:             report.Kind,
+		CheckType:        report.CheckType,
+		Polished:         report.Polished,
+		OverallScore:     report.OverallScore,
+		IssueCount:       int32(report.IssueCount),
+		IssuesBySeverity: make(map[string]int32, len(report.IssuesBySeverity)),
+		CreatedAt:        timestamppb.New(report.CreatedAt),
+	}
+	for severity, count := range report.IssuesBySeverity {
+		pbReport.IssuesBySeverity[severity] = int32(count)
+	}
+	if report.Details == "" {
+		return pbReport
+	}
+
+	switch report.Kind {
+	case models.QualityReportQuality:
+		var result quality.QualityCheckResponse
+		if err := json.Unmarshal([]byte(report.Details), &result); err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to unmarshal quality report %s: %v", report.ID, err)
+			break
+		}
+		pbReport.QualityResult = convertQualityResultToProto(&result)
+	case models.QualityReportConsistency:
+		var issues []consistency.ConsistencyIssue
+		if err := json.Unmarshal([]byte(report.Details), &issues); err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to unmarshal consistency report %s: %v", report.ID, err)
+			break
+		}
+		for _, issue := range issues {
+			pbReport.ConsistencyIssues = append(pbReport.ConsistencyIssues, convertConsistencyIssueToProto(issue))
+		}
+	case models.QualityReportGate:
+		var attempt models.QualityGateAttempt
+		if err := json.Unmarshal([]byte(report.Details), &attempt); err != nil {
+			s.log.WithContext(ctx).Warnf("Failed to unmarshal quality gate report %s: %v", report.ID, err)
+			break
+		}
+		pbReport.GateAttempt = convertQualityGateAttemptToProto(&attempt)
+	}
+	return pbReport
+}
+
+func convertQualityGateAttemptToProto(attempt *models.QualityGateAttempt) *pb.QualityGateAttempt {
+	pbAttempt := &pb.QualityGateAttempt{
+		Attempt:   int32(attempt.Attempt),
+		Score:     attempt.Score,
+		Passed:    attempt.Passed,
+		Feedback:  attempt.Feedback,
+		Error:     attempt.Error,
+		CheckedAt: timestamppb.New(attempt.CheckedAt),
+	}
+	if attempt.Critique != nil {
+		pbAttempt.Critique = convertCritiqueResultToProto(attempt.Critique)
+	}
+	return pbAttempt
+}
+
+func convertQualityGateResultToProto(gate *models.QualityGateResult) *pb.QualityGateResult {
+	pbGate := &pb.QualityGateResult{
+		ChapterId:    gate.ChapterID,
+		ChapterIndex: int32(gate.ChapterIndex),
+		Threshold:    gate.Threshold,
+		Passed:       gate.Passed,
+		NeedsReview:  gate.NeedsReview,
+	}
+	for _, attempt := range gate.Attempts {
+		pbGate.Attempts = append(pbGate.Attempts, convertQualityGateAttemptToProto(attempt))
+	}
+	return pbGate
+}
+
+func convertCritiqueResultToProto(result *models.CritiqueResult) *pb.CritiqueResult {
+	pbResult := &pb.CritiqueResult{
+		LogicalIssues:   result.LogicalIssues,
+		CharacterIssues: result.CharacterIssues,
+		PacingIssues:    result.PacingIssues,
+		Improvements:    result.Improvements,
+		FixedExample:    result.FixedExample,
+		OverallScore:    int32(result.OverallScore),
+	}
+	for _, evidence := range result.Evidence {
+		pbResult.Evidence = append(pbResult.Evidence, &pb.CritiqueEvidence{
+			Category: evidence.Category,
+			Issue:    evidence.Issue,
+			Span:     convertTextSpanToProto(evidence.Span),
+		})
+	}
+	return pbResult
+}
+
+func convertSafetyReportToProto(report *models.SafetyReport) *pb.ReviewContentResponse {
+	resp := &pb.ReviewContentResponse{
+		Rating:      report.Rating,
+		BlockRating: report.BlockRating,
+		Blocked:     report.Blocked,
+		UsedLlm:     report.UsedLLM,
+		CheckedAt:   timestamppb.New(report.CheckedAt),
+	}
+	for _, review := range report.Reviews {
+		pbReview := &pb.SafetyReview{
+			ChapterId:    review.TargetID,
+			ChapterIndex: int32(review.ChapterIndex),
+			Rating:       review.Rating,
+			Blocked:      review.Blocked,
+		}
+		for _, label := range review.Labels {
+			pbReview.Labels = append(pbReview.Labels, &pb.SafetyLabel{
+				Category: label.Category,
+				Level:    label.Level,
+				HitCount: int32(label.HitCount),
+				Reason:   label.Reason,
+			})
+		}
+		for _, hit := range review.Hits {
+			pbReview.Hits = append(pbReview.Hits, &pb.SafetyHit{
+				Category: hit.Category,
+				Severity: hit.Severity,
+				Term:     hit.Term,
+				Quote:    hit.Quote,
+				Start:    int32(hit.Start),
+				End:      int32(hit.End),
+				Source:   hit.Source,
+			})
+		}
+		resp.Reviews = append(resp.Reviews, pbReview)
+	}
+	return resp
+}
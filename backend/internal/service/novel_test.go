@@ -2,16 +2,30 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"math"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	pb "backend/api/novel/v1"
+	"backend/internal/agent/chapter"
+	"backend/internal/agent/consistency"
+	"backend/internal/agent/outline"
+	"backend/internal/agent/quality"
+	"backend/internal/agent/safety"
+	"backend/internal/agent/storystate"
 	"backend/internal/biz"
+	"backend/internal/conf"
+	"backend/internal/data"
+	"backend/internal/pkg/auth"
+	"backend/internal/pkg/llm"
 	"backend/internal/pkg/models"
 	"backend/internal/service/mocks"
 
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -490,4 +504,1882 @@ func TestNovelService_CreateProject_EdgeCases(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+// bundleMocks 项目数据包用例依赖的仓库
+type bundleMocks struct {
+	repo    *mocks.MockNovelRepo
+	scripts *mocks.MockVideoScriptRepo
+	states  *mocks.MockStoryStateRepo
+	reports *mocks.MockQualityReportRepo
+	threads *mocks.MockPlotThreadRepo
+	trash   *mocks.MockTrashRepo
+}
+
+func newBundleMocks(ctrl *gomock.Controller) *bundleMocks {
+	return &bundleMocks{
+		repo:    mocks.NewMockNovelRepo(ctrl),
+		scripts: mocks.NewMockVideoScriptRepo(ctrl),
+		states:  mocks.NewMockStoryStateRepo(ctrl),
+		reports: mocks.NewMockQualityReportRepo(ctrl),
+		threads: mocks.NewMockPlotThreadRepo(ctrl),
+		trash:   mocks.NewMockTrashRepo(ctrl),
+	}
+}
+
+func (m *bundleMocks) usecase(access *biz.ProjectAuthorizer, logger log.Logger) *biz.ProjectBundleUsecase {
+	return biz.NewProjectBundleUsecase(m.repo, m.scripts, m.states, m.reports, m.threads, m.trash, access, logger)
+}
+
+func TestNovelService_ExportProjectBundle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newBundleMocks(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	service := &NovelService{bundleUc: m.usecase(nil, mockLogger)}
+
+	m.repo.EXPECT().GetProject(gomock.Any(), "proj-1").Return(&models.NovelProject{ID: "proj-1", Title: "项目"}, nil)
+	m.repo.EXPECT().ListChapters(gomock.Any(), "proj-1").Return([]*models.Chapter{{ID: "chap-1", ProjectID: "proj-1", Index: 1}}, nil)
+	m.scripts.EXPECT().ListVideoScripts(gomock.Any(), "proj-1", 1, gomock.Any()).Return([]*models.VideoScript{}, 0, nil)
+	m.states.EXPECT().ListStoryStates(gomock.Any(), "proj-1").Return([]*models.StoryState{{ProjectID: "proj-1", ChapterID: "chap-1", ChapterIndex: 1}}, nil)
+	m.reports.EXPECT().ListQualityReports(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, query *models.QualityReportQuery) ([]*models.QualityReport, int, error) {
+			assert.Equal(t, 0, query.PageSize)
+			return []*models.QualityReport{{ID: "qr-1", ProjectID: "proj-1", ChapterID: "chap-1"}}, 1, nil
+		})
+	m.threads.EXPECT().ListPlotThreads(gomock.Any(), "proj-1").Return([]*models.PlotThread{{ID: "pt-1", ProjectID: "proj-1"}}, nil)
+
+	resp, err := service.ExportProjectBundle(context.Background(), &pb.ExportProjectBundleRequest{ProjectId: "proj-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(models.ProjectBundleSchemaVersion), resp.SchemaVersion)
+
+	var bundle models.ProjectBundle
+	assert.NoError(t, json.Unmarshal(resp.Bundle, &bundle))
+	assert.Len(t, bundle.StoryStates, 1)
+	assert.Len(t, bundle.QualityReports, 1)
+	assert.Len(t, bundle.PlotThreads, 1)
+}
+
+func TestNovelService_ImportProjectBundle(t *testing.T) {
+	tests := []struct {
+		name        string
+		bundle      string
+		setupMock   func(m *bundleMocks)
+		expectError bool
+		errorMsg    string
+		check       func(t *testing.T, resp *pb.ImportProjectBundleResponse)
+	}{
+		{
+			name: "成功导入并重映射ID",
+			bundle: `{"format":"auto-novel.project-bundle","schema_version":1,
+				"project":{"id":"proj_old","title":"旧项目","world_view":{"project_id":"proj_old","title":"世界"}},
+				"chapters":[{"id":"chap_old","project_id":"proj_old","index":1,"title":"第一章","raw_content":"内容"}],
+				"video_scripts":[{"id":"script-old","project_id":"proj_old","chapter_id":"chap_old","title":"脚本"}]}`,
+			setupMock: func(m *bundleMocks) {
+				m.repo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, p *models.NovelProject) (*models.NovelProject, error) {
+						return p, nil
+					})
+				m.repo.EXPECT().SaveChapter(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, c *models.Chapter) (*models.Chapter, error) {
+						return c, nil
+					})
+				m.scripts.EXPECT().SaveVideoScript(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, s *models.VideoScript) (*models.VideoScript, error) {
+						return s, nil
+					})
+			},
+			check: func(t *testing.T, resp *pb.ImportProjectBundleResponse) {
+				assert.NotEqual(t, "proj_old", resp.Project.Id)
+				assert.Equal(t, resp.Project.Id, resp.IdMapping["proj_old"])
+				assert.NotEmpty(t, resp.IdMapping["chap_old"])
+				assert.NotEmpty(t, resp.IdMapping["script-old"])
+				assert.Equal(t, int32(1), resp.ChapterCount)
+				assert.Equal(t, int32(1), resp.VideoScriptCount)
+			},
+		},
+		{
+			name: "v2 数据包导入故事状态、质量报告与情节线索",
+			bundle: `{"format":"auto-novel.project-bundle","schema_version":2,
+				"project":{"id":"proj_old","title":"旧项目"},
+				"chapters":[{"id":"chap_old","project_id":"proj_old","index":1,"title":"第一章","raw_content":"内容"}],
+				"story_states":[{"project_id":"proj_old","chapter_id":"chap_old","chapter_index":1}],
+				"quality_reports":[{"id":"qr_old","project_id":"proj_old","chapter_id":"chap_old","kind":"quality"},
+					{"id":"qr_orphan","project_id":"proj_old","chapter_id":"chap_missing","kind":"quality"}],
+				"plot_threads":[{"id":"pt_1","project_id":"proj_old","title":"玉佩"}]}`,
+			setupMock: func(m *bundleMocks) {
+				var projectID, chapterID string
+				m.repo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, p *models.NovelProject) (*models.NovelProject, error) {
+						projectID = p.ID
+						return p, nil
+					})
+				m.repo.EXPECT().SaveChapter(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, c *models.Chapter) (*models.Chapter, error) {
+						chapterID = c.ID
+						return c, nil
+					})
+				m.states.EXPECT().SaveStoryState(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, state *models.StoryState) error {
+						assert.Equal(t, projectID, state.ProjectID)
+						assert.Equal(t, chapterID, state.ChapterID)
+						return nil
+					})
+				m.reports.EXPECT().SaveQualityReports(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, reports ...*models.QualityReport) error {
+						assert.NotEqual(t, "qr_old", reports[0].ID)
+						assert.Equal(t, chapterID, reports[0].ChapterID)
+						return nil
+					})
+				m.threads.EXPECT().SavePlotThreads(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id string, threads []*models.PlotThread) error {
+						assert.Equal(t, projectID, id)
+						assert.Equal(t, projectID, threads[0].ProjectID)
+						return nil
+					})
+			},
+			check: func(t *testing.T, resp *pb.ImportProjectBundleResponse) {
+				assert.Equal(t, int32(1), resp.ChapterCount)
+			},
+		},
+		{
+			name: "导入失败时彻底删除已写入的项目",
+			bundle: `{"format":"auto-novel.project-bundle","schema_version":2,
+				"project":{"id":"proj_old","title":"旧项目"},
+				"chapters":[{"id":"chap_old","project_id":"proj_old","index":1,"title":"第一章"}]}`,
+			setupMock: func(m *bundleMocks) {
+				m.repo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, p *models.NovelProject) (*models.NovelProject, error) {
+						return p, nil
+					})
+				m.repo.EXPECT().SaveChapter(gomock.Any(), gomock.Any()).Return(nil, errors.New("disk full"))
+				gomock.InOrder(
+					m.repo.EXPECT().DeleteProject(gomock.Any(), gomock.Any()).Return(nil),
+					m.trash.EXPECT().PurgeProject(gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+			expectError: true,
+			errorMsg:    "disk full",
+		},
+		{
+			name:        "不支持的版本",
+			bundle:      `{"format":"auto-novel.project-bundle","schema_version":99,"project":{"title":"项目"}}`,
+			setupMock:   func(m *bundleMocks) {},
+			expectError: true,
+			errorMsg:    "不支持的项目数据包版本",
+		},
+		{
+			name:        "无效JSON",
+			bundle:      `not json`,
+			setupMock:   func(m *bundleMocks) {},
+			expectError: true,
+			errorMsg:    "项目数据包格式无效",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newBundleMocks(ctrl)
+			mockLogger := log.NewStdLogger(os.Stdout)
+			service := &NovelService{bundleUc: m.usecase(nil, mockLogger)}
+
+			tt.setupMock(m)
+
+			resp, err := service.ImportProjectBundle(context.Background(), &pb.ImportProjectBundleRequest{Bundle: []byte(tt.bundle)})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.check != nil {
+				tt.check(t, resp)
+			}
+		})
+	}
+}
+
+func TestNovelService_ImportProjectBundle_IndexesProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newBundleMocks(ctrl)
+	mockStates := mocks.NewMockIndexStateRepo(ctrl)
+	mockIndexer := mocks.NewMockKnowledgeIndexer(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	indexUc := biz.NewIndexUsecase(m.repo, mockStates, mockIndexer, nil, mockLogger)
+	service := &NovelService{
+		bundleUc: biz.NewProjectBundleUsecaseWithIndex(m.repo, m.scripts, m.states, m.reports, m.threads, m.trash, nil, indexUc, mockLogger),
+	}
+
+	var created *models.NovelProject
+	var chapters []*models.Chapter
+	m.repo.EXPECT().CreateProject(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, p *models.NovelProject) (*models.NovelProject, error) {
+			created = p
+			return p, nil
+		})
+	m.repo.EXPECT().SaveChapter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, c *models.Chapter) (*models.Chapter, error) {
+			chapters = append(chapters, c)
+			return c, nil
+		})
+
+	resp, err := service.ImportProjectBundle(context.Background(), &pb.ImportProjectBundleRequest{Bundle: []byte(`{"format":"auto-novel.project-bundle","schema_version":1,
+		"project":{"id":"proj_old","title":"旧项目","world_view":{"project_id":"proj_old","title":"世界"}},
+		"chapters":[{"id":"chap_old","project_id":"proj_old","index":1,"title":"第一章","raw_content":"林远推开门。"}]}`)})
+	assert.NoError(t, err)
+
+	// 导入完成后为新项目排队同步索引，世界观与章节都被索引
+	m.repo.EXPECT().GetProject(gomock.Any(), resp.Project.Id).Return(created, nil)
+	m.repo.EXPECT().ListChapters(gomock.Any(), resp.Project.Id).Return(chapters, nil)
+	mockStates.EXPECT().ListIndexStates(gomock.Any(), resp.Project.Id).Return([]*models.IndexState{}, nil)
+	mockStates.EXPECT().SaveIndexState(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mockIndexer.EXPECT().IndexWorldView(gomock.Any(), gomock.Any()).Return(nil)
+	mockIndexer.EXPECT().IndexChapter(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	indexUc.Drain(context.Background())
+}
+
+func TestNovelService_UpdateProject_VersionConflict(t *testing.T) {
+	tests := []struct {
+		name            string
+		expectedVersion int64
+		setupMock       func(mockRepo *mocks.MockNovelRepo)
+		expectConflict  bool
+		expectVersion   int64
+	}{
+		{
+			name:            "版本一致时更新成功并返回新版本",
+			expectedVersion: 3,
+			setupMock: func(mockRepo *mocks.MockNovelRepo) {
+				mockRepo.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, p *models.NovelProject) (*models.NovelProject, error) {
+						assert.Equal(t, int64(3), p.Version)
+						updated := *p
+						updated.Version = p.Version + 1
+						return &updated, nil
+					})
+			},
+			expectVersion: 4,
+		},
+		{
+			name:            "版本不一致时返回冲突",
+			expectedVersion: 2,
+			setupMock: func(mockRepo *mocks.MockNovelRepo) {
+				mockRepo.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).
+					Return(nil, biz.NewVersionConflictError("project", "test-id", 3))
+			},
+			expectConflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockNovelRepo(ctrl)
+			mockExportService := mocks.NewMockExportService(ctrl)
+			mockVideoScriptService := mocks.NewMockVideoScriptService(ctrl)
+			mockLogger := log.NewStdLogger(os.Stdout)
+			uc := biz.NewNovelUsecase(mockRepo, mockExportService, mockVideoScriptService, mockLogger)
+			service := &NovelService{uc: uc, log: log.NewHelper(mockLogger)}
+
+			mockRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(&models.NovelProject{
+				ID:      "test-id",
+				Title:   "测试小说",
+				Version: 3,
+			}, nil)
+			mockRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return([]*models.Chapter{}, nil)
+			tt.setupMock(mockRepo)
+
+			resp, err := service.UpdateProject(context.Background(), &pb.UpdateProjectRequest{
+				ProjectId:       "test-id",
+				Title:           "新标题",
+				ExpectedVersion: tt.expectedVersion,
+			})
+
+			if tt.expectConflict {
+				assert.Error(t, err)
+				assert.True(t, biz.IsVersionConflict(err))
+				assert.Equal(t, "3", kerrors.FromError(err).Metadata["current_version"])
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectVersion, resp.Project.Version)
+			}
+		})
+	}
+}
+
+func TestNovelService_DeleteChapter(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     *pb.DeleteChapterRequest
+		setupMock   func(mockRepo *mocks.MockNovelRepo)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:    "成功删除章节",
+			request: &pb.DeleteChapterRequest{ProjectId: "proj-1", ChapterId: "chap-1"},
+			setupMock: func(mockRepo *mocks.MockNovelRepo) {
+				mockRepo.EXPECT().GetChapter(gomock.Any(), "chap-1").Return(&models.Chapter{ID: "chap-1", ProjectID: "proj-1"}, nil)
+				mockRepo.EXPECT().DeleteChapter(gomock.Any(), "chap-1").Return(nil)
+			},
+		},
+		{
+			name:    "章节不属于该项目",
+			request: &pb.DeleteChapterRequest{ProjectId: "proj-2", ChapterId: "chap-1"},
+			setupMock: func(mockRepo *mocks.MockNovelRepo) {
+				mockRepo.EXPECT().GetChapter(gomock.Any(), "chap-1").Return(&models.Chapter{ID: "chap-1", ProjectID: "proj-1"}, nil)
+			},
+			expectError: true,
+			errorMsg:    "does not belong to project",
+		},
+		{
+			name:        "章节ID为空",
+			request:     &pb.DeleteChapterRequest{ProjectId: "proj-1"},
+			setupMock:   func(mockRepo *mocks.MockNovelRepo) {},
+			expectError: true,
+			errorMsg:    "chapter_id is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockNovelRepo(ctrl)
+			mockExportService := mocks.NewMockExportService(ctrl)
+			mockVideoScriptService := mocks.NewMockVideoScriptService(ctrl)
+			mockLogger := log.NewStdLogger(os.Stdout)
+			uc := biz.NewNovelUsecase(mockRepo, mockExportService, mockVideoScriptService, mockLogger)
+			service := &NovelService{uc: uc}
+
+			tt.setupMock(mockRepo)
+
+			resp, err := service.DeleteChapter(context.Background(), tt.request)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, resp.Success)
+			}
+		})
+	}
+}
+
+func TestNovelService_GetProject_AccessControl(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(mockUserRepo *mocks.MockUserRepo)
+		expectErr error
+	}{
+		{
+			name:      "所有者可以访问",
+			userID:    "user-owner",
+			setupMock: func(mockUserRepo *mocks.MockUserRepo) {},
+		},
+		{
+			name:   "查看者可以访问",
+			userID: "user-viewer",
+			setupMock: func(mockUserRepo *mocks.MockUserRepo) {
+				mockUserRepo.EXPECT().GetProjectRole(gomock.Any(), "test-id", "user-viewer").Return(models.ProjectRoleViewer, nil)
+			},
+		},
+		{
+			name:   "非成员无权访问",
+			userID: "user-other",
+			setupMock: func(mockUserRepo *mocks.MockUserRepo) {
+				mockUserRepo.EXPECT().GetProjectRole(gomock.Any(), "test-id", "user-other").Return("", nil)
+			},
+			expectErr: biz.ErrProjectForbidden,
+		},
+		{
+			name:      "匿名调用需要登录",
+			setupMock: func(mockUserRepo *mocks.MockUserRepo) {},
+			expectErr: biz.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockNovelRepo(ctrl)
+			mockUserRepo := mocks.NewMockUserRepo(ctrl)
+			mockExportService := mocks.NewMockExportService(ctrl)
+			mockVideoScriptService := mocks.NewMockVideoScriptService(ctrl)
+			mockLogger := log.NewStdLogger(os.Stdout)
+			access := biz.NewProjectAuthorizer(mockRepo, mockUserRepo)
+			uc := biz.NewNovelUsecaseWithAccess(mockRepo, mockExportService, mockVideoScriptService, access, mockLogger)
+			service := &NovelService{uc: uc}
+
+			mockRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(&models.NovelProject{
+				ID:      "test-id",
+				Title:   "测试小说",
+				OwnerID: "user-owner",
+			}, nil)
+			if tt.expectErr == nil {
+				mockRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return([]*models.Chapter{}, nil)
+			}
+			tt.setupMock(mockUserRepo)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, tt.userID)
+			}
+			resp, err := service.GetProject(ctx, &pb.GetProjectRequest{ProjectId: "test-id"})
+
+			if tt.expectErr != nil {
+				assert.Error(t, err)
+				assert.True(t, kerrors.Is(err, tt.expectErr))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "user-owner", resp.Project.OwnerId)
+			}
+		})
+	}
+}
+
+// generateNovelStream 记录 GenerateNovel 发送的响应
+type generateNovelStream struct {
+	pb.NovelService_GenerateNovelServer
+	ctx  context.Context
+	sent []*pb.GenerateNovelResponse
+}
+
+func (s *generateNovelStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *generateNovelStream) Send(resp *pb.GenerateNovelResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func TestNovelService_GenerateNovel_RequiresEditor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNovelRepo(ctrl)
+	mockUserRepo := mocks.NewMockUserRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	access := biz.NewProjectAuthorizer(mockRepo, mockUserRepo)
+	service := &NovelService{
+		uc:  biz.NewNovelUsecaseWithAccess(mockRepo, nil, nil, access, mockLogger),
+		log: log.NewHelper(mockLogger),
+	}
+
+	mockRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(&models.NovelProject{ID: "test-id", OwnerID: "user-owner"}, nil)
+	mockUserRepo.EXPECT().GetProjectRole(gomock.Any(), "test-id", "user-viewer").Return(models.ProjectRoleViewer, nil)
+
+	// 查看者在生成开始前即被拒绝，不会调用生成流程
+	stream := &generateNovelStream{ctx: auth.NewContext(context.Background(), "user-viewer")}
+	err := service.GenerateNovel(&pb.GenerateNovelRequest{ProjectId: "test-id"}, stream)
+	assert.True(t, kerrors.Is(err, biz.ErrProjectForbidden))
+	assert.Empty(t, stream.sent)
+}
+
+func TestNovelUsecase_SaveGeneratedNovel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNovelRepo(ctrl)
+	uc := biz.NewNovelUsecase(mockRepo, nil, nil, log.NewStdLogger(os.Stdout))
+
+	baseline := biz.NewGenerationBaseline(&models.NovelProject{
+		ID:       "test-id",
+		Version:  2,
+		Chapters: []*models.Chapter{{ID: "chapter-1", Index: 1, Version: 4}},
+	})
+	generated := &models.NovelProject{
+		ID:        "test-id",
+		Title:     "测试小说",
+		WorldView: &models.WorldView{Setting: "群山环绕的宗门"},
+		Outline:   &models.Outline{Chapters: []*models.ChapterOutline{{Index: 1, Title: "下山"}}},
+	}
+	chapters := []*models.Chapter{
+		{Index: 1, Title: "下山", RawContent: "初稿一", PolishedContent: "润色稿一"},
+		{Index: 2, Title: "弃剑", RawContent: "初稿二"},
+	}
+
+	mockRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return([]*models.Chapter{
+		{ID: "chapter-1", ProjectID: "test-id", Index: 1, Version: 4},
+	}, nil)
+
+	// 项目与已存在的章节都按生成开始时的版本做条件更新，新章节直接保存，润色后的内容作为正文
+	mockRepo.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, project *models.NovelProject) (*models.NovelProject, error) {
+			assert.Equal(t, int64(2), project.Version)
+			assert.Equal(t, generated.Outline, project.Outline)
+			return project, nil
+		})
+	mockRepo.EXPECT().UpdateChapter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, chapter *models.Chapter) (*models.Chapter, error) {
+			assert.Equal(t, "chapter-1", chapter.ID)
+			assert.Equal(t, int64(4), chapter.Version)
+			assert.Equal(t, "润色稿一", chapter.RawContent)
+			return chapter, nil
+		})
+	mockRepo.EXPECT().SaveChapter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, chapter *models.Chapter) (*models.Chapter, error) {
+			assert.NotEmpty(t, chapter.ID)
+			assert.Equal(t, "test-id", chapter.ProjectID)
+			assert.Equal(t, "初稿二", chapter.RawContent)
+			return chapter, nil
+		})
+
+	project, saved, err := uc.SaveGeneratedNovel(context.Background(), baseline, generated, chapters)
+	assert.NoError(t, err)
+	assert.Equal(t, "测试小说", project.Title)
+	assert.Len(t, saved, 2)
+	assert.Equal(t, "chapter-1", saved[0].ID)
+}
+
+func TestNovelUsecase_SaveGeneratedNovel_VersionConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(t *testing.T, ctx context.Context, uc *biz.NovelUsecase, project *models.NovelProject)
+	}{
+		{
+			name: "生成期间修改了大纲",
+			edit: func(t *testing.T, ctx context.Context, uc *biz.NovelUsecase, project *models.NovelProject) {
+				edited := *project
+				edited.Outline = &models.Outline{Chapters: []*models.ChapterOutline{{Index: 1, Title: "编辑改过的大纲"}}}
+				_, err := uc.UpdateProject(ctx, &edited)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "生成期间修改了章节",
+			edit: func(t *testing.T, ctx context.Context, uc *biz.NovelUsecase, project *models.NovelProject) {
+				edited := *project.Chapters[0]
+				edited.RawContent = "编辑改过的正文"
+				_, err := uc.UpdateChapter(ctx, &edited)
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := log.NewStdLogger(os.Stdout)
+			d, cleanup, err := data.NewData(&conf.Data{Database: &conf.Data_Database{Source: filepath.Join(t.TempDir(), "novel.db")}}, log.DefaultLogger)
+			assert.NoError(t, err)
+			defer cleanup()
+			repo := data.NewNovelRepo(d, logger)
+			uc := biz.NewNovelUsecase(repo, nil, nil, logger)
+			ctx := context.Background()
+
+			_, err = repo.CreateProject(ctx, &models.NovelProject{
+				ID:      "test-id",
+				Title:   "测试小说",
+				Outline: &models.Outline{Chapters: []*models.ChapterOutline{{Index: 1, Title: "下山"}}},
+			})
+			assert.NoError(t, err)
+			_, err = repo.SaveChapter(ctx, &models.Chapter{ID: "chapter-1", ProjectID: "test-id", Index: 1, Title: "下山", RawContent: "原来的正文"})
+			assert.NoError(t, err)
+
+			// 生成开始时读取项目，生成期间他人修改了项目或章节
+			project, err := uc.GetProjectForEdit(ctx, "test-id")
+			assert.NoError(t, err)
+			baseline := biz.NewGenerationBaseline(project)
+			tt.edit(t, ctx, uc, project)
+			before, err := repo.GetProject(ctx, "test-id")
+			assert.NoError(t, err)
+
+			generated := *project
+			generated.Outline = &models.Outline{Chapters: []*models.ChapterOutline{{Index: 1, Title: "生成的大纲"}}}
+			_, _, err = uc.SaveGeneratedNovel(ctx, baseline, &generated, []*models.Chapter{
+				{Index: 1, Title: "下山", RawContent: "生成的正文"},
+			})
+			assert.True(t, biz.IsVersionConflict(err), "expected a version conflict, got %v", err)
+
+			// 他人的修改保持不变
+			after, err := repo.GetProject(ctx, "test-id")
+			assert.NoError(t, err)
+			assert.Equal(t, before.Version, after.Version)
+			assert.Equal(t, before.Outline, after.Outline)
+			chapter, err := repo.GetChapter(ctx, "chapter-1")
+			assert.NoError(t, err)
+			assert.NotEqual(t, "生成的正文", chapter.RawContent)
+		})
+	}
+}
+
+func TestNovelService_ProjectScopedOperations_AccessControl(t *testing.T) {
+	owned := &models.NovelProject{ID: "test-id", OwnerID: "user-owner"}
+
+	tests := []struct {
+		name      string
+		role      string
+		setupMock func(m *bundleMocks, search *mocks.MockSearchRepo)
+		call      func(ctx context.Context, service *NovelService) error
+	}{
+		{
+			name: "非成员不能导出数据包",
+			setupMock: func(m *bundleMocks, search *mocks.MockSearchRepo) {
+				m.repo.EXPECT().GetProject(gomock.Any(), "test-id").Return(owned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.ExportProjectBundle(ctx, &pb.ExportProjectBundleRequest{ProjectId: "test-id"})
+				return err
+			},
+		},
+		{
+			name: "非成员不能检索项目内容",
+			setupMock: func(m *bundleMocks, search *mocks.MockSearchRepo) {
+				m.repo.EXPECT().GetProject(gomock.Any(), "test-id").Return(owned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.SearchContent(ctx, &pb.SearchContentRequest{Query: "青云宗", ProjectId: "test-id"})
+				return err
+			},
+		},
+		{
+			name: "非成员不能恢复项目",
+			setupMock: func(m *bundleMocks, search *mocks.MockSearchRepo) {
+				m.trash.EXPECT().GetTrashItemProject(gomock.Any(), models.TrashItemProject, "test-id").Return(owned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.RestoreProject(ctx, &pb.RestoreProjectRequest{ProjectId: "test-id"})
+				return err
+			},
+		},
+		{
+			name: "查看者不能恢复章节",
+			role: models.ProjectRoleViewer,
+			setupMock: func(m *bundleMocks, search *mocks.MockSearchRepo) {
+				m.trash.EXPECT().GetTrashItemProject(gomock.Any(), models.TrashItemChapter, "chap-1").Return(owned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.RestoreChapter(ctx, &pb.RestoreChapterRequest{ChapterId: "chap-1"})
+				return err
+			},
+		},
+		{
+			name: "编辑者不能彻底删除项目",
+			role: models.ProjectRoleEditor,
+			setupMock: func(m *bundleMocks, search *mocks.MockSearchRepo) {
+				m.trash.EXPECT().GetTrashItemProject(gomock.Any(), models.TrashItemProject, "test-id").Return(owned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.PurgeProject(ctx, &pb.PurgeProjectRequest{ProjectId: "test-id"})
+				return err
+			},
+		},
+		{
+			name: "编辑者不能彻底删除章节",
+			role: models.ProjectRoleEditor,
+			setupMock: func(m *bundleMocks, search *mocks.MockSearchRepo) {
+				m.trash.EXPECT().GetTrashItemProject(gomock.Any(), models.TrashItemChapter, "chap-1").Return(owned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.PurgeChapter(ctx, &pb.PurgeChapterRequest{ChapterId: "chap-1"})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newBundleMocks(ctrl)
+			mockSearchRepo := mocks.NewMockSearchRepo(ctrl)
+			mockUserRepo := mocks.NewMockUserRepo(ctrl)
+			mockLogger := log.NewStdLogger(os.Stdout)
+			access := biz.NewProjectAuthorizer(m.repo, mockUserRepo)
+			service := &NovelService{
+				bundleUc: m.usecase(access, mockLogger),
+				searchUc: biz.NewSearchUsecase(mockSearchRepo, access, mockLogger),
+				trashUc:  biz.NewTrashUsecase(&conf.Data{}, m.trash, access, mockLogger),
+			}
+
+			// 权限不足时不会访问数据
+			tt.setupMock(m, mockSearchRepo)
+			mockUserRepo.EXPECT().GetProjectRole(gomock.Any(), "test-id", "user-other").Return(tt.role, nil)
+
+			err := tt.call(auth.NewContext(context.Background(), "user-other"), service)
+			assert.True(t, kerrors.Is(err, biz.ErrProjectForbidden), "unexpected error: %v", err)
+		})
+	}
+}
+
+func TestNovelService_SearchAndTrash_ScopedToCaller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNovelRepo := mocks.NewMockNovelRepo(ctrl)
+	mockSearchRepo := mocks.NewMockSearchRepo(ctrl)
+	mockTrashRepo := mocks.NewMockTrashRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	access := biz.NewProjectAuthorizer(mockNovelRepo, mocks.NewMockUserRepo(ctrl))
+	service := &NovelService{
+		searchUc: biz.NewSearchUsecase(mockSearchRepo, access, mockLogger),
+		trashUc:  biz.NewTrashUsecase(&conf.Data{}, mockTrashRepo, access, mockLogger),
+	}
+	ctx := auth.NewContext(context.Background(), "user-other")
+
+	// 未指定项目时只检索与列出调用者可见的项目
+	mockSearchRepo.EXPECT().SearchContent(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, query *models.SearchQuery) ([]*models.SearchHit, int, error) {
+			assert.Equal(t, &models.ProjectScope{UserID: "user-other"}, query.Scope)
+			return []*models.SearchHit{}, 0, nil
+		})
+	mockTrashRepo.EXPECT().ListTrash(gomock.Any(), "", &models.ProjectScope{UserID: "user-other"}, 1, 20).Return([]*models.TrashItem{}, 0, nil)
+
+	_, err := service.SearchContent(ctx, &pb.SearchContentRequest{Query: "青云宗"})
+	assert.NoError(t, err)
+	_, err = service.ListTrash(ctx, &pb.ListTrashRequest{})
+	assert.NoError(t, err)
+}
+
+func TestNovelService_AnonymousAndUnownedProjects(t *testing.T) {
+	unowned := &models.NovelProject{ID: "test-id", Title: "旧项目"}
+
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(m *bundleMocks)
+		call      func(ctx context.Context, service *NovelService) error
+		expectErr error
+	}{
+		{
+			name:      "匿名调用不能创建项目",
+			setupMock: func(m *bundleMocks) {},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.CreateProject(ctx, &pb.CreateProjectRequest{Title: "新项目"})
+				return err
+			},
+			expectErr: biz.ErrUnauthenticated,
+		},
+		{
+			name:      "匿名调用不能列出项目",
+			setupMock: func(m *bundleMocks) {},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.ListProjects(ctx, &pb.ListProjectsRequest{})
+				return err
+			},
+			expectErr: biz.ErrUnauthenticated,
+		},
+		{
+			name:      "匿名调用不能检索内容",
+			setupMock: func(m *bundleMocks) {},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.SearchContent(ctx, &pb.SearchContentRequest{Query: "青云宗"})
+				return err
+			},
+			expectErr: biz.ErrUnauthenticated,
+		},
+		{
+			name:      "匿名调用不能查看回收站",
+			setupMock: func(m *bundleMocks) {},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.ListTrash(ctx, &pb.ListTrashRequest{})
+				return err
+			},
+			expectErr: biz.ErrUnauthenticated,
+		},
+		{
+			name: "匿名调用不能访问未归属项目",
+			setupMock: func(m *bundleMocks) {
+				m.repo.EXPECT().GetProject(gomock.Any(), "test-id").Return(unowned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.GetProject(ctx, &pb.GetProjectRequest{ProjectId: "test-id"})
+				return err
+			},
+			expectErr: biz.ErrUnauthenticated,
+		},
+		{
+			name: "匿名调用不能彻底删除未归属项目",
+			setupMock: func(m *bundleMocks) {
+				m.trash.EXPECT().GetTrashItemProject(gomock.Any(), models.TrashItemProject, "test-id").Return(unowned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.PurgeProject(ctx, &pb.PurgeProjectRequest{ProjectId: "test-id"})
+				return err
+			},
+			expectErr: biz.ErrUnauthenticated,
+		},
+		{
+			name:   "登录用户可以查看未归属项目",
+			userID: "user-other",
+			setupMock: func(m *bundleMocks) {
+				m.repo.EXPECT().GetProject(gomock.Any(), "test-id").Return(unowned, nil)
+				m.repo.EXPECT().ListChapters(gomock.Any(), "test-id").Return([]*models.Chapter{}, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.GetProject(ctx, &pb.GetProjectRequest{ProjectId: "test-id"})
+				return err
+			},
+		},
+		{
+			name:   "登录用户不能删除未归属项目",
+			userID: "user-other",
+			setupMock: func(m *bundleMocks) {
+				m.repo.EXPECT().GetProject(gomock.Any(), "test-id").Return(unowned, nil).Times(2)
+				m.repo.EXPECT().ListChapters(gomock.Any(), "test-id").Return([]*models.Chapter{}, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.DeleteProject(ctx, &pb.DeleteProjectRequest{ProjectId: "test-id"})
+				return err
+			},
+			expectErr: biz.ErrProjectForbidden,
+		},
+		{
+			name:   "登录用户不能彻底删除未归属项目",
+			userID: "user-other",
+			setupMock: func(m *bundleMocks) {
+				m.trash.EXPECT().GetTrashItemProject(gomock.Any(), models.TrashItemProject, "test-id").Return(unowned, nil)
+			},
+			call: func(ctx context.Context, service *NovelService) error {
+				_, err := service.PurgeProject(ctx, &pb.PurgeProjectRequest{ProjectId: "test-id"})
+				return err
+			},
+			expectErr: biz.ErrProjectForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newBundleMocks(ctrl)
+			mockSearchRepo := mocks.NewMockSearchRepo(ctrl)
+			mockUserRepo := mocks.NewMockUserRepo(ctrl)
+			mockLogger := log.NewStdLogger(os.Stdout)
+			access := biz.NewProjectAuthorizer(m.repo, mockUserRepo)
+			service := &NovelService{
+				uc:       biz.NewNovelUsecaseWithAccess(m.repo, nil, nil, access, mockLogger),
+				searchUc: biz.NewSearchUsecase(mockSearchRepo, access, mockLogger),
+				trashUc:  biz.NewTrashUsecase(&conf.Data{}, m.trash, access, mockLogger),
+			}
+
+			// 匿名调用与越权操作不会写入数据，未归属项目不查询成员角色
+			tt.setupMock(m)
+
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = auth.NewContext(ctx, tt.userID)
+			}
+			err := tt.call(ctx, service)
+			if tt.expectErr != nil {
+				assert.True(t, kerrors.Is(err, tt.expectErr), "unexpected error: %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNovelService_ReindexProject(t *testing.T) {
+	tests := []struct {
+		name          string
+		chapterErr    error
+		expectFresh   bool
+		expectIndexed int32
+		expectFailed  int32
+	}{
+		{
+			name:          "全部条目索引成功后状态为最新",
+			expectFresh:   true,
+			expectIndexed: 3,
+		},
+		{
+			name:          "章节索引失败时记录失败原因",
+			chapterErr:    errors.New("embedding unavailable"),
+			expectIndexed: 2,
+			expectFailed:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockNovelRepo(ctrl)
+			mockStates := mocks.NewMockIndexStateRepo(ctrl)
+			mockIndexer := mocks.NewMockKnowledgeIndexer(ctrl)
+			mockLogger := log.NewStdLogger(os.Stdout)
+			indexUc := biz.NewIndexUsecase(mockRepo, mockStates, mockIndexer, nil, mockLogger)
+			service := &NovelService{indexUc: indexUc}
+
+			mockRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(&models.NovelProject{
+				ID:         "test-id",
+				WorldView:  &models.WorldView{ID: "wv-1", Title: "世界观"},
+				Characters: []*models.Character{{ID: "char-1", Name: "林远"}},
+			}, nil).AnyTimes()
+			mockRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return([]*models.Chapter{
+				{ID: "chapter-1", ProjectID: "test-id", Index: 1, Title: "第一章", RawContent: "林远推开门。"},
+			}, nil).AnyTimes()
+
+			var saved []*models.IndexState
+			mockStates.EXPECT().ListIndexStates(gomock.Any(), "test-id").DoAndReturn(
+				func(ctx context.Context, projectID string) ([]*models.IndexState, error) {
+					return saved, nil
+				}).AnyTimes()
+			mockStates.EXPECT().SaveIndexState(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, state *models.IndexState) error {
+					saved = append(saved, state)
+					return nil
+				}).Times(3)
+
+			mockIndexer.EXPECT().IndexWorldView(gomock.Any(), gomock.Any()).Return(nil)
+			mockIndexer.EXPECT().IndexCharacter(gomock.Any(), gomock.Any()).Return(nil)
+			mockIndexer.EXPECT().IndexChapter(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.chapterErr)
+
+			resp, err := service.ReindexProject(context.Background(), &pb.ReindexProjectRequest{ProjectId: "test-id"})
+			assert.NoError(t, err)
+			assert.False(t, resp.Status.Fresh)
+			assert.Equal(t, int32(3), resp.Status.Missing)
+			assert.Equal(t, int32(1), resp.Status.Pending)
+
+			indexUc.Drain(context.Background())
+
+			statusResp, err := service.GetIndexStatus(context.Background(), &pb.GetIndexStatusRequest{ProjectId: "test-id"})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectFresh, statusResp.Status.Fresh)
+			assert.Equal(t, int32(3), statusResp.Status.Total)
+			assert.Equal(t, tt.expectIndexed, statusResp.Status.Indexed)
+			assert.Equal(t, tt.expectFailed, statusResp.Status.Failed)
+			assert.Equal(t, int32(0), statusResp.Status.Pending)
+			assert.NotNil(t, statusResp.Status.LastIndexedAt)
+		})
+	}
+}
+
+func TestNovelService_SearchProjectKnowledge(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *pb.SearchProjectKnowledgeRequest
+		setupMock    func(mockRepo *mocks.MockKnowledgeRepo)
+		expectError  bool
+		expectTitles []string
+	}{
+		{
+			name: "合并多种内容类型并按相关度截取",
+			req:  &pb.SearchProjectKnowledgeRequest{ProjectId: "test-id", Question: "林晚什么时候第一次见到侦探？", TopK: 2},
+			setupMock: func(mockRepo *mocks.MockKnowledgeRepo) {
+				mockRepo.EXPECT().SearchKnowledge(gomock.Any(), "test-id", "林晚什么时候第一次见到侦探？", models.KnowledgeWorldView, 2).
+					Return([]*models.KnowledgePassage{{ContentType: models.KnowledgeWorldView, Title: "山海", Score: 0.2}}, nil)
+				mockRepo.EXPECT().SearchKnowledge(gomock.Any(), "test-id", gomock.Any(), models.KnowledgeCharacter, 2).
+					Return([]*models.KnowledgePassage{{ContentType: models.KnowledgeCharacter, Title: "沈舟", Score: 0.5}}, nil)
+				mockRepo.EXPECT().SearchKnowledge(gomock.Any(), "test-id", gomock.Any(), models.KnowledgeChapter, 2).
+					Return([]*models.KnowledgePassage{{ContentType: models.KnowledgeChapter, Title: "初见", ChapterIndex: 3, Score: 0.9}}, nil)
+				mockRepo.EXPECT().KnowledgeStats(gomock.Any(), "test-id").
+					Return(map[string]int{models.KnowledgeChapter: 12, models.KnowledgeCharacter: 2, models.KnowledgeWorldView: 1}, nil)
+			},
+			expectTitles: []string{"初见", "沈舟"},
+		},
+		{
+			name: "只检索指定的内容类型",
+			req: &pb.SearchProjectKnowledgeRequest{ProjectId: "test-id", Question: "侦探",
+				ContentTypes: []string{models.KnowledgeChapter}},
+			setupMock: func(mockRepo *mocks.MockKnowledgeRepo) {
+				mockRepo.EXPECT().SearchKnowledge(gomock.Any(), "test-id", "侦探", models.KnowledgeChapter, 10).
+					Return([]*models.KnowledgePassage{{ContentType: models.KnowledgeChapter, Title: "初见", Score: 0.9}}, nil)
+				mockRepo.EXPECT().KnowledgeStats(gomock.Any(), "test-id").Return(map[string]int{}, nil)
+			},
+			expectTitles: []string{"初见"},
+		},
+		{
+			name:        "问题为空",
+			req:         &pb.SearchProjectKnowledgeRequest{ProjectId: "test-id", Question: "  "},
+			setupMock:   func(mockRepo *mocks.MockKnowledgeRepo) {},
+			expectError: true,
+		},
+		{
+			name:        "不支持的内容类型",
+			req:         &pb.SearchProjectKnowledgeRequest{ProjectId: "test-id", Question: "侦探", ContentTypes: []string{"outline"}},
+			setupMock:   func(mockRepo *mocks.MockKnowledgeRepo) {},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockKnowledgeRepo(ctrl)
+			mockLogger := log.NewStdLogger(os.Stdout)
+			service := &NovelService{knowledgeUc: biz.NewKnowledgeUsecase(mockRepo, nil, mockLogger)}
+			tt.setupMock(mockRepo)
+
+			resp, err := service.SearchProjectKnowledge(context.Background(), tt.req)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			titles := make([]string, len(resp.Passages))
+			for i, passage := range resp.Passages {
+				titles[i] = passage.Title
+			}
+			assert.Equal(t, tt.expectTitles, titles)
+		})
+	}
+}
+
+// storyStateLLM 返回固定章节正文与状态提取结果的模型客户端
+type storyStateLLM struct {
+	prompts []string
+}
+
+func (l *storyStateLLM) GenerateText(ctx context.Context, prompt string, opts *llm.GenerateOptions) (string, error) {
+	l.prompts = append(l.prompts, prompt)
+	return "林晚在桥上与追兵交手，把青锋剑扔进了河里，独自向北逃去。", nil
+}
+
+func (l *storyStateLLM) GenerateJSON(ctx context.Context, prompt string, opts *llm.GenerateOptions) (map[string]interface{}, error) {
+	l.prompts = append(l.prompts, prompt)
+	return map[string]interface{}{
+		"characters": []interface{}{
+			map[string]interface{}{"name": "林晚", "location": "北岸树林", "status": "被追捕"},
+		},
+		"locations": []interface{}{"石桥", "北岸树林"},
+		"props": []interface{}{
+			map[string]interface{}{"name": "青锋剑", "location": "石桥下的河底", "holder": "无"},
+		},
+		"events": []interface{}{
+			map[string]interface{}{"timestamp": "第三日黄昏", "event": "林晚弃剑"},
+		},
+	}, nil
+}
+
+func (l *storyStateLLM) GenerateWithTemplate(ctx context.Context, template string, data map[string]interface{}, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+func TestNovelService_GenerateChapter_TracksStoryState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNovelRepo := mocks.NewMockNovelRepo(ctrl)
+	mockStateRepo := mocks.NewMockStoryStateRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	llmClient := &storyStateLLM{}
+	service := &NovelService{
+		uc:              biz.NewNovelUsecase(mockNovelRepo, nil, nil, mockLogger),
+		storyStateUc:    biz.NewStoryStateUsecase(mockStateRepo, nil, mockLogger),
+		chapterAgent:    chapter.NewChapterAgent(llmClient),
+		storyStateAgent: storystate.NewStoryStateAgent(llmClient),
+		log:             log.NewHelper(mockLogger),
+	}
+
+	previous := &models.StoryState{
+		ProjectID:    "test-id",
+		ChapterIndex: 2,
+		Characters:   []*models.CharacterState{{Name: "林晚", Location: "山门", Condition: "左臂受伤"}},
+		Props:        []*models.PropItem{{Name: "青锋剑", Description: "师父的遗物", Holder: "林晚"}},
+		Locations:    []string{"山门"},
+		Timeline:     []*models.TimelineEvent{{Timestamp: "第二日", Event: "下山", ChapterIndex: 2}},
+	}
+	mockStateRepo.EXPECT().GetStoryState(gomock.Any(), "test-id", 2).Return(previous, nil)
+	mockNovelRepo.EXPECT().SaveChapter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, chapter *models.Chapter) (*models.Chapter, error) {
+			chapter.ID = "chapter-3"
+			return chapter, nil
+		})
+
+	var saved *models.StoryState
+	mockStateRepo.EXPECT().SaveStoryState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, state *models.StoryState) error {
+			saved = state
+			return nil
+		})
+
+	_, err := service.GenerateChapter(context.Background(), &pb.GenerateChapterRequest{
+		ProjectId:      "test-id",
+		ChapterOutline: &pb.ChapterOutline{Index: 3, Title: "弃剑"},
+		Context:        &pb.GenerationContext{Characters: []*pb.Character{{Name: "林晚"}}},
+	})
+	assert.NoError(t, err)
+
+	// 上一章的状态写入了本章的提示词
+	assert.Contains(t, llmClient.prompts[0], "左臂受伤")
+	assert.Contains(t, llmClient.prompts[0], "青锋剑：师父的遗物（持有人：林晚）")
+
+	assert.NotNil(t, saved)
+	assert.Equal(t, 3, saved.ChapterIndex)
+	assert.Equal(t, "chapter-3", saved.ChapterID)
+	assert.Equal(t, []*models.CharacterState{{Name: "林晚", Location: "北岸树林", Condition: "左臂受伤", Status: "被追捕"}}, saved.Characters)
+	assert.Equal(t, []*models.PropItem{{Name: "青锋剑", Description: "师父的遗物", Location: "石桥下的河底"}}, saved.Props)
+	assert.Equal(t, []string{"山门", "石桥", "北岸树林"}, saved.Locations)
+	assert.Len(t, saved.Timeline, 2)
+	assert.Equal(t, 3, saved.Timeline[1].ChapterIndex)
+}
+
+func TestNovelService_GetStoryState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockStoryStateRepo(ctrl)
+	service := &NovelService{storyStateUc: biz.NewStoryStateUsecase(mockRepo, nil, log.NewStdLogger(os.Stdout))}
+
+	mockRepo.EXPECT().GetStoryState(gomock.Any(), "test-id", math.MaxInt32).Return(&models.StoryState{
+		ProjectID:    "test-id",
+		ChapterIndex: 3,
+		Props:        []*models.PropItem{{Name: "青锋剑", Location: "石桥下的河底"}},
+	}, nil)
+	mockRepo.EXPECT().GetStoryState(gomock.Any(), "test-id", 1).Return(nil, nil)
+
+	resp, err := service.GetStoryState(context.Background(), &pb.GetStoryStateRequest{ProjectId: "test-id"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.State.ChapterIndex)
+	assert.Equal(t, "石桥下的河底", resp.State.Props[0].Location)
+
+	resp, err = service.GetStoryState(context.Background(), &pb.GetStoryStateRequest{ProjectId: "test-id", ChapterIndex: 1})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.State)
+}
+
+func TestNovelService_CheckConsistency_Rules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNovelRepo := mocks.NewMockNovelRepo(ctrl)
+	mockStateRepo := mocks.NewMockStoryStateRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	llmClient := &storyStateLLM{}
+	service := &NovelService{
+		uc:               biz.NewNovelUsecase(mockNovelRepo, nil, nil, mockLogger),
+		storyStateUc:     biz.NewStoryStateUsecase(mockStateRepo, nil, mockLogger),
+		consistencyAgent: consistency.NewConsistencyAgent(llmClient),
+		log:              log.NewHelper(mockLogger),
+	}
+
+	chapters := []*models.Chapter{
+		{ID: "chapter-1", Index: 1, Title: "下山", RawContent: "林晚今年十七岁，第一次离开山门。\n“走吧。”沈舟说。\n玉佩摔在石阶上，碎成了几瓣。"},
+		{ID: "chapter-3", Index: 3, Title: "雨夜", RawContent: "三年后，林晚已经二十岁了。\n“你来了。”沈洲笑道。\n“站住！”赵七喊道。\n林晚握紧腰间的玉佩。"},
+	}
+	project := &models.NovelProject{
+		ID: "test-id",
+		Characters: []*models.Character{
+			{Name: "林晚", Age: 17},
+			{Name: "沈舟", Age: 20},
+		},
+		Outline: &models.Outline{Chapters: []*models.ChapterOutline{
+			{Index: 1, Title: "下山", ImportantItems: []string{"玉佩"}},
+			{Index: 2, Title: "断桥"},
+			{Index: 3, Title: "重逢"},
+		}},
+	}
+	mockNovelRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(project, nil)
+	mockNovelRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return(chapters, nil)
+	mockStateRepo.EXPECT().GetStoryState(gomock.Any(), "test-id", math.MaxInt32).Return(nil, nil)
+
+	resp, err := service.CheckConsistency(context.Background(), &pb.CheckConsistencyRequest{
+		ProjectId: "test-id",
+		CheckType: consistency.CheckTypeRules,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, llmClient.prompts, "rule checks should not call the model")
+
+	descriptions := make([]string, len(resp.Issues))
+	for i, issue := range resp.Issues {
+		descriptions[i] = issue.Description + " @ " + issue.Location
+	}
+	assert.Len(t, resp.Issues, 5, descriptions)
+	assert.Contains(t, descriptions, "人名「沈洲」与人物「沈舟」只差一个字，疑似错写（共出现1次） @ 第3章《雨夜》第2段（第21字）")
+	assert.Contains(t, descriptions, "人物「赵七」不在人物列表中（共出现1次） @ 第3章《雨夜》第3段（第32字）")
+	assert.Contains(t, descriptions, "道具「玉佩」已在第1章《下山》第3段（第28字）损毁，此处又被使用 @ 第3章《雨夜》第4段（第45字）")
+	assert.Contains(t, descriptions, "第3章标题《雨夜》与大纲《重逢》不一致 @ 第3章《雨夜》")
+	assert.Contains(t, descriptions, "大纲中的第2章《断桥》没有对应章节 @ 第2章")
+	assert.Less(t, resp.OverallScore, 1.0)
+
+	// 正文中的问题带有可直接高亮的位置，章节级的问题没有引文
+	spans := 0
+	for _, issue := range resp.Issues {
+		if issue.Span == nil {
+			continue
+		}
+		spans++
+		assert.True(t, issue.Span.Verified)
+		assert.Equal(t, "chapter-3", issue.Span.ChapterId)
+		content := []rune(chapters[1].RawContent)
+		assert.Equal(t, issue.Span.Quote, string(content[issue.Span.Start:issue.Span.End]))
+	}
+	assert.Equal(t, 3, spans)
+}
+
+func TestNovelService_CheckConsistency_Voice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNovelRepo := mocks.NewMockNovelRepo(ctrl)
+	mockStateRepo := mocks.NewMockStoryStateRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	llmClient := &storyStateLLM{}
+	service := &NovelService{
+		uc:               biz.NewNovelUsecase(mockNovelRepo, nil, nil, mockLogger),
+		storyStateUc:     biz.NewStoryStateUsecase(mockStateRepo, nil, mockLogger),
+		consistencyAgent: consistency.NewConsistencyAgent(llmClient),
+		log:              log.NewHelper(mockLogger),
+	}
+
+	// 已定稿章节建立林风的台词画像：短句、常以「吧」结尾
+	chapters := []*models.Chapter{
+		{ID: "chapter-1", Index: 1, Status: "completed", RawContent: "林风笑道：“走吧，别磨蹭了吧。”\n“好吧，听你的吧。”林风说。\n苏雨皱眉：“你又迟到了呢。”\n“快走吧，天要黑了吧。”林风催促。\n“行吧，走吧。”林风点头。\n林风摆手：“别担心，没事吧。”"},
+		{ID: "chapter-2", Index: 2, RawContent: "林风缓缓开口：“夫天地者万物之逆旅光阴者百代之过客而浮生若梦为欢几何古人秉烛夜游良有以也呢。”\n“走吧。”林风说。\n苏雨笑道：“好啊。”"},
+	}
+	project := &models.NovelProject{
+		ID:         "test-id",
+		Characters: []*models.Character{{Name: "林风", SpeechTone: "干脆利落"}, {Name: "苏雨"}},
+	}
+	mockNovelRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(project, nil)
+	mockNovelRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return(chapters, nil)
+	mockStateRepo.EXPECT().GetStoryState(gomock.Any(), "test-id", math.MaxInt32).Return(nil, nil)
+
+	resp, err := service.CheckConsistency(context.Background(), &pb.CheckConsistencyRequest{
+		ProjectId: "test-id",
+		CheckType: consistency.CheckTypeRules,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, llmClient.prompts)
+
+	// 只有偏离画像的长句被报告；符合画像的台词与台词不足的苏雨不报告
+	assert.Len(t, resp.Issues, 1)
+	issue := resp.Issues[0]
+	assert.Equal(t, "character", issue.Type)
+	assert.Equal(t, "medium", issue.Severity)
+	assert.Contains(t, issue.Description, "「林风」的台词与以往的说话方式不符")
+	assert.Contains(t, issue.Description, "以往台词从不以「呢」结尾")
+	assert.Equal(t, "第2章第1段（第9字）", issue.Location)
+	assert.Equal(t, "chapter-2", issue.Span.ChapterId)
+	assert.Equal(t, int32(8), issue.Span.Start)
+	assert.Equal(t, issue.Span.Quote, string([]rune(chapters[1].RawContent)[issue.Span.Start:issue.Span.End]))
+}
+
+func TestNovelService_ApplyCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNovelRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	service := &NovelService{uc: biz.NewNovelUsecase(mockRepo, nil, nil, mockLogger)}
+
+	// 存储只保留一份正文，读取时原文与润色内容相同
+	content := "沈洲推开门。雨还在下，沈洲没有回头。"
+	newChapter := func() *models.Chapter {
+		return &models.Chapter{ID: "chapter-1", ProjectID: "test-id", RawContent: content, PolishedContent: content, Version: 3}
+	}
+	var saved *models.Chapter
+	mockRepo.EXPECT().UpdateChapter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, chapter *models.Chapter) (*models.Chapter, error) {
+			saved = chapter
+			return chapter, nil
+		}).Times(2)
+
+	// 偏移与原文一致时直接替换
+	mockRepo.EXPECT().GetChapter(gomock.Any(), "chapter-1").Return(newChapter(), nil)
+	resp, err := service.ApplyCorrection(context.Background(), &pb.ApplyCorrectionRequest{
+		ProjectId: "test-id", ChapterId: "chapter-1", Start: 11, End: 13, Original: "沈洲", Replacement: "沈舟",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "沈洲推开门。雨还在下，沈舟没有回头。", saved.RawContent)
+	assert.Equal(t, saved.RawContent, saved.PolishedContent)
+	assert.Equal(t, int64(3), saved.Version)
+	assert.Equal(t, &pb.TextSpan{ChapterId: "chapter-1", Start: 11, End: 13, Quote: "沈舟", Verified: true}, resp.Span)
+
+	// 偏移已过时，按原文重新定位离原偏移最近的一处
+	mockRepo.EXPECT().GetChapter(gomock.Any(), "chapter-1").Return(newChapter(), nil)
+	resp, err = service.ApplyCorrection(context.Background(), &pb.ApplyCorrectionRequest{
+		ProjectId: "test-id", ChapterId: "chapter-1", Start: 1, End: 3, Original: "沈洲", Replacement: "沈舟",
+		ExpectedVersion: 2,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "沈舟推开门。雨还在下，沈洲没有回头。", saved.RawContent)
+	assert.Equal(t, int64(2), saved.Version)
+	assert.Equal(t, int32(0), resp.Span.Start)
+
+	// 原文已不存在
+	mockRepo.EXPECT().GetChapter(gomock.Any(), "chapter-1").Return(newChapter(), nil)
+	_, err = service.ApplyCorrection(context.Background(), &pb.ApplyCorrectionRequest{
+		ProjectId: "test-id", ChapterId: "chapter-1", Start: 0, End: 2, Original: "林晚", Replacement: "沈舟",
+	})
+	assert.ErrorIs(t, err, biz.ErrCorrectionSpanMismatch)
+}
+
+func TestNovelService_QualityReports(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 章节经由真实仓库的映射读取：存储只保留一份正文，读出的润色内容总是非空
+	mockLogger := log.NewStdLogger(os.Stdout)
+	d, cleanup, err := data.NewData(&conf.Data{Database: &conf.Data_Database{Source: filepath.Join(t.TempDir(), "novel.db")}}, log.DefaultLogger)
+	assert.NoError(t, err)
+	defer cleanup()
+	novelRepo := data.NewNovelRepo(d, mockLogger)
+	mockReportRepo := mocks.NewMockQualityReportRepo(ctrl)
+	service := &NovelService{
+		uc:               biz.NewNovelUsecase(novelRepo, nil, nil, mockLogger),
+		qualityReportUc:  biz.NewQualityReportUsecase(mockReportRepo, nil, mockLogger),
+		consistencyAgent: consistency.NewConsistencyAgent(&storyStateLLM{}),
+		log:              log.NewHelper(mockLogger),
+	}
+
+	ctx := context.Background()
+	_, err = novelRepo.CreateProject(ctx, &models.NovelProject{
+		ID:         "test-id",
+		Title:      "测试小说",
+		Characters: []*models.Character{{Name: "沈舟"}},
+	})
+	assert.NoError(t, err)
+	draft, err := novelRepo.SaveChapter(ctx, &models.Chapter{ID: "chapter-1", ProjectID: "test-id", Index: 1, Title: "下山", RawContent: "“走吧。”沈洲说。", Status: "draft"})
+	assert.NoError(t, err)
+	_, err = novelRepo.SaveChapter(ctx, &models.Chapter{ID: "chapter-2", ProjectID: "test-id", Index: 2, Title: "断桥", RawContent: "雨下了整整一夜。", Status: models.ChapterStatusPolished})
+	assert.NoError(t, err)
+	loaded, err := novelRepo.GetChapter(ctx, "chapter-1")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, loaded.PolishedContent)
+
+	// 一致性检查后按章节各保存一份报告
+	var saved []*models.QualityReport
+	mockReportRepo.EXPECT().SaveQualityReports(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, reports ...*models.QualityReport) error {
+			saved = reports
+			return nil
+		})
+	_, err = service.CheckConsistency(context.Background(), &pb.CheckConsistencyRequest{
+		ProjectId: "test-id",
+		CheckType: consistency.CheckTypeRules,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, saved, 2)
+	assert.Equal(t, "chapter-1", saved[0].ChapterID)
+	assert.Equal(t, models.QualityReportConsistency, saved[0].Kind)
+	assert.Equal(t, draft.Version, saved[0].ChapterVersion)
+	assert.False(t, saved[0].Polished, "chapters are polished only by status")
+	assert.Equal(t, 1, saved[0].IssueCount)
+	assert.Less(t, saved[0].OverallScore, 1.0)
+	assert.NotEmpty(t, saved[0].ID)
+	assert.Equal(t, "chapter-2", saved[1].ChapterID)
+	assert.True(t, saved[1].Polished)
+	assert.Equal(t, 0, saved[1].IssueCount)
+	assert.Equal(t, 1.0, saved[1].OverallScore)
+
+	// 历史记录还原保存时的问题与位置
+	mockReportRepo.EXPECT().ListQualityReports(gomock.Any(), &models.QualityReportQuery{
+		ProjectID: "test-id", ChapterID: "chapter-1", Page: 1, PageSize: 20,
+	}).Return(saved[:1], 1, nil)
+	list, err := service.ListQualityReports(context.Background(), &pb.ListQualityReportsRequest{
+		ProjectId: "test-id", ChapterId: "chapter-1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), list.Total)
+	assert.Len(t, list.Reports[0].ConsistencyIssues, 1)
+	assert.Equal(t, "沈洲", list.Reports[0].ConsistencyIssues[0].Span.Quote)
+	assert.Equal(t, saved[0].IssuesBySeverity[list.Reports[0].ConsistencyIssues[0].Severity], 1)
+
+	// 趋势按天平均，并对比同一章节润色前后最近一次的分数
+	day := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mockReportRepo.EXPECT().ListQualityReports(gomock.Any(), &models.QualityReportQuery{
+		ProjectID: "test-id", Kind: models.QualityReportQuality,
+	}).Return([]*models.QualityReport{
+		{ChapterID: "chapter-1", ChapterIndex: 1, Polished: true, OverallScore: 0.9, CreatedAt: day.AddDate(0, 0, 1)},
+		{ChapterID: "chapter-1", ChapterIndex: 1, OverallScore: 0.6, CreatedAt: day.Add(time.Hour)},
+		{ChapterID: "chapter-1", ChapterIndex: 1, OverallScore: 0.4, CreatedAt: day},
+		{ChapterID: "chapter-2", ChapterIndex: 2, OverallScore: 0.8, CreatedAt: day},
+	}, 4, nil)
+	trends, err := service.GetQualityTrends(context.Background(), &pb.GetQualityTrendsRequest{ProjectId: "test-id"})
+	assert.NoError(t, err)
+	assert.Equal(t, models.QualityReportQuality, trends.Kind)
+	assert.Len(t, trends.Points, 2)
+	assert.Equal(t, "2024-05-01", trends.Points[0].Date)
+	assert.InDelta(t, 0.6, trends.Points[0].AverageScore, 1e-9)
+	assert.Equal(t, int32(3), trends.Points[0].ReportCount)
+	assert.Len(t, trends.Chapters, 2)
+	assert.True(t, trends.Chapters[0].HasPolishCompare)
+	assert.Equal(t, 0.9, trends.Chapters[0].LatestScore)
+	assert.Equal(t, 0.6, trends.Chapters[0].BeforePolishScore)
+	assert.False(t, trends.Chapters[1].HasPolishCompare)
+	assert.Equal(t, int32(1), trends.PolishComparedCount)
+	assert.Equal(t, 0.6, trends.AverageBefore)
+	assert.Equal(t, 0.9, trends.AverageAfter)
+}
+
+func TestNovelService_ListQualityReports_QualityGate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReportRepo := mocks.NewMockQualityReportRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	service := &NovelService{
+		qualityReportUc: biz.NewQualityReportUsecase(mockReportRepo, nil, mockLogger),
+		log:             log.NewHelper(mockLogger),
+	}
+
+	mockReportRepo.EXPECT().ListQualityReports(gomock.Any(), gomock.Any()).Return([]*models.QualityReport{{
+		ID:           "qr-1",
+		ProjectID:    "test-id",
+		ChapterID:    "chapter-1",
+		Kind:         models.QualityReportGate,
+		CheckType:    "critique",
+		OverallScore: 0.5,
+		Details:      `{"attempt":2,"score":0.5,"critique":{"improvements":["加强冲突"],"overall_score":5},"feedback":"- 加强冲突"}`,
+	}}, 1, nil)
+
+	resp, err := service.ListQualityReports(context.Background(), &pb.ListQualityReportsRequest{
+		ProjectId: "test-id", ChapterId: "chapter-1", Kind: models.QualityReportGate,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Reports, 1)
+	attempt := resp.Reports[0].GateAttempt
+	assert.NotNil(t, attempt)
+	assert.Equal(t, int32(2), attempt.Attempt)
+	assert.False(t, attempt.Passed)
+	assert.Equal(t, "- 加强冲突", attempt.Feedback)
+	assert.Equal(t, []string{"加强冲突"}, attempt.Critique.Improvements)
+	assert.Nil(t, resp.Reports[0].QualityResult)
+}
+
+func TestNovelService_RecordQualityGates_LinksSavedChapters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReportRepo := mocks.NewMockQualityReportRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	service := &NovelService{
+		qualityReportUc: biz.NewQualityReportUsecase(mockReportRepo, nil, mockLogger),
+		log:             log.NewHelper(mockLogger),
+	}
+
+	var saved []*models.QualityReport
+	mockReportRepo.EXPECT().SaveQualityReports(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, reports ...*models.QualityReport) error {
+			saved = reports
+			return nil
+		})
+
+	// 门禁结果在章节保存前产生，没有章节ID
+	gates := []*models.QualityGateResult{
+		{ChapterIndex: 1, Attempts: []*models.QualityGateAttempt{{Attempt: 1, Score: 0.5}, {Attempt: 2, Score: 0.8, Passed: true}}},
+		{ChapterIndex: 2, Attempts: []*models.QualityGateAttempt{{Attempt: 1, Score: 0.9, Passed: true}}},
+	}
+	chapters := []*models.Chapter{
+		{ID: "chapter-1", Index: 1, Version: 1},
+		{ID: "chapter-2", Index: 2, Version: 3},
+	}
+	service.recordQualityGates(context.Background(), "test-id", chapters, gates)
+
+	assert.Len(t, saved, 3)
+	assert.Equal(t, "chapter-1", saved[0].ChapterID)
+	assert.Equal(t, "chapter-1", saved[1].ChapterID)
+	assert.Equal(t, "chapter-2", saved[2].ChapterID)
+	assert.Equal(t, int64(3), saved[2].ChapterVersion)
+	assert.Equal(t, models.QualityReportGate, saved[2].Kind)
+}
+
+func TestNovelService_CheckQuality_Metrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNovelRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	llmClient := &storyStateLLM{}
+	service := &NovelService{
+		uc:           biz.NewNovelUsecase(mockRepo, nil, nil, mockLogger),
+		qualityAgent: quality.NewQualityAgent(llmClient, nil, nil),
+		log:          log.NewHelper(mockLogger),
+	}
+
+	content := "林晚深吸一口气，缓缓推开门。\n“你来了。”沈舟微微一笑,缓缓站起身.\n他轻轻地放下茶杯，缓缓走到窗前，深吸一口气，又深吸一口气。"
+	mockRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(&models.NovelProject{ID: "test-id"}, nil)
+	mockRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return(nil, nil)
+	mockRepo.EXPECT().GetChapter(gomock.Any(), "chapter-1").Return(&models.Chapter{ID: "chapter-1", ProjectID: "test-id", RawContent: content}, nil)
+
+	resp, err := service.CheckQuality(context.Background(), &pb.CheckQualityRequest{
+		ProjectId:  "test-id",
+		ChapterId:  "chapter-1",
+		CheckType:  "metrics",
+		LlmOptions: &pb.LLMOptions{},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, llmClient.prompts, "metrics should not call the model")
+
+	metrics := resp.Metrics
+	assert.NotNil(t, metrics)
+	assert.Equal(t, int32(3), metrics.ParagraphCount)
+	assert.Equal(t, int32(4), metrics.SentenceCount)
+	assert.Equal(t, int32(1), metrics.SentenceLengths["1-10"])
+	assert.Equal(t, int32(2), metrics.HalfWidthPunctuation)
+	assert.InDelta(t, 4.0/float64(metrics.CharCount), metrics.DialogueRatio, 1e-9)
+	assert.Equal(t, []*pb.PhraseCount{{Phrase: "深吸一口气", Count: 3}}, metrics.RepeatedNgrams)
+	assert.Contains(t, metrics.OverusedPhrases, &pb.PhraseCount{Phrase: "缓缓", Count: 3})
+	assert.Equal(t, int32(4), metrics.AdverbCount)
+	assert.Less(t, metrics.Score, 1.0)
+	assert.Equal(t, metrics.Score, resp.OverallScore)
+	assert.Contains(t, resp.Recommendations, "有2处半角标点，中文正文应使用全角标点")
+}
+
+func TestNovelService_BatchCheckQuality_Repetition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNovelRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	llmClient := &storyStateLLM{}
+	service := &NovelService{
+		uc:           biz.NewNovelUsecase(mockRepo, nil, nil, mockLogger),
+		qualityAgent: quality.NewQualityAgent(llmClient, nil, nil),
+		log:          log.NewHelper(mockLogger),
+	}
+
+	opening := "天刚蒙蒙亮，山门外的石阶上还挂着露水，林晚背着行囊一步一步往下走，身后的钟声一下一下地追着她。"
+	chapters := []*models.Chapter{
+		{ID: "chapter-1", Index: 1, RawContent: opening + "\n“走吧。”沈舟说。"},
+		{ID: "chapter-2", Index: 2, RawContent: "断桥边的茶棚里坐满了赶路的客商，说书人正讲到三十年前那场大火，满堂喝彩。"},
+		{ID: "chapter-3", Index: 3, RawContent: "“你来了。”\n天刚蒙蒙亮，山门外的石阶上还挂着露水，林晚背着包袱一步一步往下走，身后的钟声一下一下地追着她。"},
+	}
+	mockRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(&models.NovelProject{ID: "test-id"}, nil)
+	mockRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return(chapters, nil)
+
+	resp, err := service.BatchCheckQuality(context.Background(), &pb.BatchCheckQualityRequest{
+		ProjectId:  "test-id",
+		CheckType:  quality.CheckTypeRepetition,
+		LlmOptions: &pb.LLMOptions{},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, llmClient.prompts, "repetition checks should not call the model")
+
+	assert.Len(t, resp.Repetitions, 1)
+	pair := resp.Repetitions[0]
+	assert.Equal(t, &pb.TextSpan{ChapterId: "chapter-1", Start: 0, End: int32(len([]rune(opening))), Quote: opening, Verified: true}, pair.Source)
+	assert.Equal(t, "chapter-3", pair.Target.ChapterId)
+	assert.Equal(t, int32(7), pair.Target.Start)
+	assert.Greater(t, pair.Similarity, 0.5)
+	assert.Less(t, pair.Similarity, 1.0)
+
+	assert.Len(t, resp.Results, 3)
+	assert.Len(t, resp.Results[0].Repetitions, 1)
+	assert.Equal(t, []string{"与第3章有1处相似段落，建议改写"}, resp.Results[0].Recommendations)
+	assert.Less(t, resp.Results[0].OverallScore, 0.5)
+	assert.Empty(t, resp.Results[1].Repetitions)
+	assert.Equal(t, 1.0, resp.Results[1].OverallScore)
+	assert.Equal(t, int32(1), resp.Summary.IssuesByType[quality.CheckTypeRepetition])
+}
+
+func TestNovelService_CheckPlotThreads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNovelRepo := mocks.NewMockNovelRepo(ctrl)
+	mockThreadRepo := mocks.NewMockPlotThreadRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	service := &NovelService{
+		uc:           biz.NewNovelUsecase(mockNovelRepo, nil, nil, mockLogger),
+		plotThreadUc: biz.NewPlotThreadUsecase(mockThreadRepo, nil, mockLogger),
+		log:          log.NewHelper(mockLogger),
+	}
+
+	project := &models.NovelProject{
+		ID: "test-id",
+		Outline: &models.Outline{Chapters: []*models.ChapterOutline{
+			{Index: 1, Title: "下山", ImportantItems: []string{"玉佩"}},
+			{Index: 2, Title: "断桥"},
+			{Index: 3, Title: "雨夜"},
+			{Index: 4, Title: "真相", TwistHint: "师父才是幕后黑手"},
+			{Index: 5, Title: "归来"},
+		}},
+	}
+	chapters := []*models.Chapter{{ID: "chapter-1", Index: 1}, {ID: "chapter-2", Index: 2}, {ID: "chapter-3", Index: 3}}
+	existing := []*models.PlotThread{
+		{ID: "clue:玉佩", Kind: models.PlotThreadClue, Title: "玉佩", Source: models.PlotThreadFromOutline, PlantedChapters: []int{1, 2}, Status: models.PlotThreadOpen},
+		{ID: "pt-1", Kind: models.PlotThreadPromise, Title: "三年之约", Source: models.PlotThreadFromChapter, PlantedChapters: []int{1}, ResolvedChapter: 3, Status: models.PlotThreadResolved},
+		{ID: "pt-2", Kind: models.PlotThreadTwist, Title: "身世", Source: models.PlotThreadFromChapter, ResolvedChapter: 2, Status: models.PlotThreadResolved},
+		{ID: "twist:2", Kind: models.PlotThreadTwist, Title: "断桥", Source: models.PlotThreadFromOutline, PayoffChapter: 2, Status: models.PlotThreadOpen},
+	}
+	var saved []*models.PlotThread
+	mockNovelRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(project, nil)
+	mockNovelRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return(chapters, nil)
+	mockThreadRepo.EXPECT().ListPlotThreads(gomock.Any(), "test-id").Return(existing, nil)
+	mockThreadRepo.EXPECT().SavePlotThreads(gomock.Any(), "test-id", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, projectID string, threads []*models.PlotThread) error {
+			saved = threads
+			return nil
+		})
+
+	resp, err := service.CheckPlotThreads(context.Background(), &pb.CheckPlotThreadsRequest{ProjectId: "test-id"})
+	assert.NoError(t, err)
+
+	// 已从大纲删除的转折随之删除，章节中的线索与大纲线索的埋设记录保留
+	ids := make([]string, len(saved))
+	for i, thread := range saved {
+		ids[i] = thread.ID
+	}
+	assert.ElementsMatch(t, []string{"clue:玉佩", "twist:4", "pt-1", "pt-2"}, ids)
+	assert.Equal(t, []int{1, 2}, saved[0].PlantedChapters)
+
+	// 第4章即进入收尾阶段：玉佩未回收，身世回收前没有铺垫，第4章的转折没有铺垫
+	assert.Equal(t, int32(4), resp.NextChapter)
+	assert.Equal(t, int32(5), resp.TotalChapters)
+	assert.Len(t, resp.OpenThreads, 2)
+	descriptions := make([]string, len(resp.Issues))
+	for i, issue := range resp.Issues {
+		descriptions[i] = issue.Type + " " + issue.Description
+	}
+	assert.Equal(t, []string{
+		"dangling 第1章埋下的「玉佩」在故事收尾前仍未回收",
+		"unprepared 第2章回收的「身世」此前没有铺垫",
+		"unprepared 第4章的转折「师父才是幕后黑手」此前没有铺垫",
+	}, descriptions)
+}
+
+func TestNovelService_RefineOutline_OpenThreads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNovelRepo := mocks.NewMockNovelRepo(ctrl)
+	mockThreadRepo := mocks.NewMockPlotThreadRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	llmClient := &storyStateLLM{}
+	service := &NovelService{
+		uc:           biz.NewNovelUsecase(mockNovelRepo, nil, nil, mockLogger),
+		plotThreadUc: biz.NewPlotThreadUsecase(mockThreadRepo, nil, mockLogger),
+		outlineAgent: outline.NewOutlineAgent(llmClient),
+		log:          log.NewHelper(mockLogger),
+	}
+
+	project := &models.NovelProject{
+		ID:      "test-id",
+		Outline: &models.Outline{Chapters: []*models.ChapterOutline{{Index: 1, Title: "下山"}, {Index: 2, Title: "断桥"}}},
+	}
+	threads := []*models.PlotThread{
+		{ID: "pt-1", Title: "三年之约", Description: "林晚答应三年后回来", Source: models.PlotThreadFromChapter, PlantedChapters: []int{1}, Status: models.PlotThreadOpen},
+		{ID: "pt-2", Title: "身世", Source: models.PlotThreadFromChapter, ResolvedChapter: 2, Status: models.PlotThreadResolved},
+	}
+	mockNovelRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(project, nil)
+	mockNovelRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return(nil, nil)
+	mockNovelRepo.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Return(project, nil)
+	// 优化前同步一次线索，保存大纲后再同步一次
+	mockThreadRepo.EXPECT().ListPlotThreads(gomock.Any(), "test-id").Return(threads, nil).Times(2)
+	mockThreadRepo.EXPECT().SavePlotThreads(gomock.Any(), "test-id", gomock.Any()).Return(nil).Times(2)
+
+	resp, err := service.RefineOutline(context.Background(), &pb.RefineOutlineRequest{
+		ProjectId:          "test-id",
+		Feedback:           "节奏太慢",
+		IncludeOpenThreads: true,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.OpenThreads, 1)
+	assert.Equal(t, "pt-1", resp.OpenThreads[0].Id)
+
+	assert.Len(t, llmClient.prompts, 1)
+	assert.Contains(t, llmClient.prompts[0], "尚未回收的情节线索：\n- [pt-1] 三年之约：林晚答应三年后回来（第1章埋下）")
+	assert.NotContains(t, llmClient.prompts[0], "身世")
+}
+
+// safetyLLM 返回固定内容安全分类结果的模型客户端
+type safetyLLM struct {
+	prompts []string
+}
+
+func (l *safetyLLM) GenerateText(ctx context.Context, prompt string, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+func (l *safetyLLM) GenerateJSON(ctx context.Context, prompt string, opts *llm.GenerateOptions) (map[string]interface{}, error) {
+	l.prompts = append(l.prompts, prompt)
+	return map[string]interface{}{
+		"labels": []interface{}{
+			map[string]interface{}{"category": "violence", "level": "medium", "reason": "打斗描写较为血腥", "quotes": []interface{}{"刀锋划过他的肩头"}},
+			map[string]interface{}{"category": "sexual", "level": "none", "reason": ""},
+		},
+	}, nil
+}
+
+func (l *safetyLLM) GenerateWithTemplate(ctx context.Context, template string, data map[string]interface{}, opts *llm.GenerateOptions) (string, error) {
+	return "", nil
+}
+
+func TestNovelService_ReviewContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNovelRepo := mocks.NewMockNovelRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	safetyConf := &conf.Data{Safety: &conf.Data_Safety{
+		BlockRating: models.ContentRatingRestricted,
+		Rules: []*conf.Data_Safety_Rule{
+			{Category: models.SafetyPolitics, Severity: models.SafetyLevelLow, Keywords: []string{"朝廷"}},
+			{Category: models.SafetyViolence, Patterns: []string{"(["}}, // 无效正则被忽略
+		},
+	}}
+	llmClient := &safetyLLM{}
+	service := &NovelService{
+		uc:          biz.NewNovelUsecase(mockNovelRepo, nil, nil, mockLogger),
+		safetyUc:    biz.NewSafetyUsecase(safetyConf, mockLogger),
+		safetyAgent: safety.NewSafetyAgent(llmClient),
+		log:         log.NewHelper(mockLogger),
+	}
+
+	project := &models.NovelProject{ID: "test-id"}
+	chapters := []*models.Chapter{
+		{ID: "chapter-1", Index: 1, RawContent: "林晚向朝廷递了折子。"},
+		{ID: "chapter-2", Index: 2, RawContent: "刀锋划过他的肩头，仇家被肢解后抛入河中。"},
+		{ID: "chapter-3", Index: 3, RawContent: "春风拂过山谷。"},
+	}
+	mockNovelRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(project, nil).Times(3)
+	mockNovelRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return(chapters, nil).Times(3)
+
+	// 只用词库：内置词条与自定义词条都生效，偏移按字符计
+	resp, err := service.ReviewContent(context.Background(), &pb.ReviewContentRequest{ProjectId: "test-id"})
+	assert.NoError(t, err)
+	assert.Empty(t, llmClient.prompts)
+	assert.Equal(t, models.ContentRatingRestricted, resp.Rating)
+	assert.Equal(t, models.ContentRatingRestricted, resp.BlockRating)
+	assert.True(t, resp.Blocked)
+	assert.False(t, resp.UsedLlm)
+	assert.Len(t, resp.Reviews, 3)
+
+	assert.Equal(t, models.ContentRatingTeen, resp.Reviews[0].Rating)
+	assert.False(t, resp.Reviews[0].Blocked)
+	assert.Len(t, resp.Reviews[0].Labels, 4)
+	assert.Equal(t, models.SafetyPolitics, resp.Reviews[0].Labels[2].Category)
+	assert.Equal(t, models.SafetyLevelLow, resp.Reviews[0].Labels[2].Level)
+	assert.Equal(t, int32(1), resp.Reviews[0].Labels[2].HitCount)
+	assert.Equal(t, int32(3), resp.Reviews[0].Hits[0].Start)
+	assert.Equal(t, int32(5), resp.Reviews[0].Hits[0].End)
+
+	assert.Equal(t, models.ContentRatingRestricted, resp.Reviews[1].Rating)
+	assert.True(t, resp.Reviews[1].Blocked)
+	assert.Equal(t, "肢解", resp.Reviews[1].Hits[0].Quote)
+	assert.Equal(t, "dictionary", resp.Reviews[1].Hits[0].Source)
+
+	assert.Equal(t, models.ContentRatingGeneral, resp.Reviews[2].Rating)
+	assert.Empty(t, resp.Reviews[2].Hits)
+
+	// 指定章节并调用模型分类：模型等级与词库等级取较高者，引文定位到原文
+	resp, err = service.ReviewContent(context.Background(), &pb.ReviewContentRequest{ProjectId: "test-id", ChapterIndexes: []int32{3}, UseLlm: true})
+	assert.NoError(t, err)
+	assert.Len(t, llmClient.prompts, 1)
+	assert.Contains(t, llmClient.prompts[0], "春风拂过山谷")
+	assert.True(t, resp.UsedLlm)
+	assert.Len(t, resp.Reviews, 1)
+	assert.Equal(t, int32(3), resp.Reviews[0].ChapterIndex)
+	assert.Equal(t, models.ContentRatingMature, resp.Reviews[0].Rating)
+	assert.False(t, resp.Blocked)
+	assert.Equal(t, models.SafetyLevelMedium, resp.Reviews[0].Labels[0].Level)
+	assert.Equal(t, "打斗描写较为血腥", resp.Reviews[0].Labels[0].Reason)
+	assert.Equal(t, models.SafetyLevelNone, resp.Reviews[0].Labels[1].Level)
+	assert.Empty(t, resp.Reviews[0].Hits) // 引文不在该章正文中
+
+	// 只审核部分章节时只标记各章节，不给出项目整体的分级与阻止状态
+	resp, err = service.ReviewContent(context.Background(), &pb.ReviewContentRequest{ProjectId: "test-id", ChapterIndexes: []int32{2}})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Reviews, 1)
+	assert.Equal(t, models.ContentRatingRestricted, resp.Reviews[0].Rating)
+	assert.True(t, resp.Reviews[0].Blocked)
+	assert.Empty(t, resp.Rating)
+	assert.False(t, resp.Blocked)
+	assert.Equal(t, models.ContentRatingRestricted, resp.BlockRating)
+}
+
+func TestNovelService_ExportNovel_ContentBlocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNovelRepo := mocks.NewMockNovelRepo(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	safetyConf := &conf.Data{Safety: &conf.Data_Safety{BlockRating: models.ContentRatingMature}}
+	service := &NovelService{
+		uc:          biz.NewNovelUsecase(mockNovelRepo, nil, nil, mockLogger),
+		safetyUc:    biz.NewSafetyUsecase(safetyConf, mockLogger),
+		safetyAgent: safety.NewSafetyAgent(&safetyLLM{}),
+		log:         log.NewHelper(mockLogger),
+	}
+
+	project := &models.NovelProject{ID: "test-id", Title: "测试小说"}
+	chapters := []*models.Chapter{
+		{ID: "chapter-1", Index: 1, RawContent: "两人相视一笑。"},
+		{ID: "chapter-2", Index: 2, RawContent: "尸体早已血肉模糊。"},
+	}
+	mockNovelRepo.EXPECT().GetProject(gomock.Any(), "test-id").Return(project, nil)
+	mockNovelRepo.EXPECT().ListChapters(gomock.Any(), "test-id").Return(chapters, nil)
+
+	_, err := service.ExportNovel(context.Background(), &pb.ExportNovelRequest{
+		ProjectId: "test-id",
+		Format:    "txt",
+		Options:   &pb.ExportOptions{},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, pb.ErrorReason_CONTENT_BLOCKED.String(), kerrors.Reason(err))
+	assert.Equal(t, 403, kerrors.Code(err))
+	assert.Equal(t, models.ContentRatingMature, kerrors.FromError(err).Metadata["rating"])
+}
+
+func TestNovelService_ExportProjectBundle_ContentBlocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newBundleMocks(ctrl)
+	mockLogger := log.NewStdLogger(os.Stdout)
+	safetyConf := &conf.Data{Safety: &conf.Data_Safety{BlockRating: models.ContentRatingMature}}
+	service := &NovelService{
+		bundleUc:    m.usecase(nil, mockLogger),
+		safetyUc:    biz.NewSafetyUsecase(safetyConf, mockLogger),
+		safetyAgent: safety.NewSafetyAgent(&safetyLLM{}),
+		log:         log.NewHelper(mockLogger),
+	}
+
+	m.repo.EXPECT().GetProject(gomock.Any(), "proj-1").Return(&models.NovelProject{ID: "proj-1", Title: "项目"}, nil)
+	m.repo.EXPECT().ListChapters(gomock.Any(), "proj-1").Return([]*models.Chapter{
+		{ID: "chap-1", ProjectID: "proj-1", Index: 1, RawContent: "两人相视一笑。"},
+		{ID: "chap-2", ProjectID: "proj-1", Index: 2, RawContent: "尸体早已血肉模糊。"},
+	}, nil)
+	m.scripts.EXPECT().ListVideoScripts(gomock.Any(), "proj-1", 1, gomock.Any()).Return([]*models.VideoScript{}, 0, nil)
+	m.states.EXPECT().ListStoryStates(gomock.Any(), "proj-1").Return(nil, nil)
+	m.reports.EXPECT().ListQualityReports(gomock.Any(), gomock.Any()).Return(nil, 0, nil)
+	m.threads.EXPECT().ListPlotThreads(gomock.Any(), "proj-1").Return(nil, nil)
+
+	// 数据包包含章节正文，分级达到阈值时与导出小说一样被阻止
+	resp, err := service.ExportProjectBundle(context.Background(), &pb.ExportProjectBundleRequest{ProjectId: "proj-1"})
+	assert.Nil(t, resp)
+	assert.Equal(t, pb.ErrorReason_CONTENT_BLOCKED.String(), kerrors.Reason(err))
+	assert.Equal(t, 403, kerrors.Code(err))
+	assert.Equal(t, models.ContentRatingMature, kerrors.FromError(err).Metadata["rating"])
+}
+
+func TestTrashUsecase_PurgeExpired_RetentionCutoff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrashRepo := mocks.NewMockTrashRepo(ctrl)
+	uc := biz.NewTrashUsecase(&conf.Data{Trash: &conf.Data_Trash{RetentionDays: 7}}, mockTrashRepo, nil, log.NewStdLogger(os.Stdout))
+
+	var cutoff time.Time
+	mockTrashRepo.EXPECT().PurgeDeletedBefore(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, before time.Time) ([]string, int, error) {
+			cutoff = before
+			return []string{"p1"}, 3, nil
+		})
+
+	start := time.Now()
+	count, err := uc.PurgeExpired(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, 7*24*time.Hour, uc.Retention())
+
+	// 截止时间为当前时间减去配置的保留天数
+	assert.False(t, cutoff.Before(start.Add(-uc.Retention())))
+	assert.False(t, cutoff.After(time.Now().Add(-uc.Retention())))
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+
+	pb "backend/api/user/v1"
+	"backend/internal/biz"
+	"backend/internal/pkg/models"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UserService 用户与项目协作服务
+type UserService struct {
+	pb.UnimplementedUserServiceServer
+
+	uc  *biz.UserUsecase
+	log *log.Helper
+}
+
+// NewUserService 创建用户服务
+func NewUserService(uc *biz.UserUsecase, logger log.Logger) *UserService {
+	return &UserService{
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// Authenticate 根据访问令牌解析用户ID，供认证中间件使用
+func (s *UserService) Authenticate(ctx context.Context, token string) (string, error) {
+	user, err := s.uc.Authenticate(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// CreateUser 注册用户
+func (s *UserService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	user, token, err := s.uc.CreateUser(ctx, req.Username, req.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateUserResponse{
+		User:  convertUserToProto(user),
+		Token: token,
+	}, nil
+}
+
+// GetCurrentUser 获取当前登录用户
+func (s *UserService) GetCurrentUser(ctx context.Context, req *pb.GetCurrentUserRequest) (*pb.GetCurrentUserResponse, error) {
+	user, err := s.uc.CurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetCurrentUserResponse{
+		User: convertUserToProto(user),
+	}, nil
+}
+
+// ListProjectMembers 列出项目成员
+func (s *UserService) ListProjectMembers(ctx context.Context, req *pb.ListProjectMembersRequest) (*pb.ListProjectMembersResponse, error) {
+	members, err := s.uc.ListProjectMembers(ctx, req.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	pbMembers := make([]*pb.ProjectMember, len(members))
+	for i, member := range members {
+		pbMembers[i] = convertProjectMemberToProto(member)
+	}
+
+	return &pb.ListProjectMembersResponse{
+		Members: pbMembers,
+	}, nil
+}
+
+// AddProjectMember 添加项目成员
+func (s *UserService) AddProjectMember(ctx context.Context, req *pb.AddProjectMemberRequest) (*pb.AddProjectMemberResponse, error) {
+	member, err := s.uc.AddProjectMember(ctx, req.ProjectId, req.UserId, req.Username, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddProjectMemberResponse{
+		Member: convertProjectMemberToProto(member),
+	}, nil
+}
+
+// RemoveProjectMember 移除项目成员
+func (s *UserService) RemoveProjectMember(ctx context.Context, req *pb.RemoveProjectMemberRequest) (*pb.RemoveProjectMemberResponse, error) {
+	if err := s.uc.RemoveProjectMember(ctx, req.ProjectId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveProjectMemberResponse{Success: true}, nil
+}
+
+// convertUserToProto 将用户转换为protobuf格式
+func convertUserToProto(user *models.User) *pb.User {
+	return &pb.User{
+		Id:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		CreatedAt:   timestamppb.New(user.CreatedAt),
+	}
+}
+
+// convertProjectMemberToProto 将项目成员转换为protobuf格式
+func convertProjectMemberToProto(member *models.ProjectMember) *pb.ProjectMember {
+	return &pb.ProjectMember{
+		ProjectId: member.ProjectID,
+		UserId:    member.UserID,
+		Username:  member.Username,
+		Role:      member.Role,
+		CreatedAt: timestamppb.New(member.CreatedAt),
+	}
+}
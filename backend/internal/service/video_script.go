@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	pb "backend/api/video_script/v1"
+	"backend/internal/agent/safety"
 	"backend/internal/agent/video_script"
 	"backend/internal/biz"
+	"backend/internal/pkg/llm"
 	"backend/internal/pkg/models"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -15,9 +18,11 @@ import (
 // VideoScriptService 短视频脚本服务
 type VideoScriptService struct {
 	pb.UnimplementedVideoScriptServiceServer
-	bizUC      *biz.VideoScriptUseCase
-	videoAgent video_script.VideoScriptAgent
-	logger     *log.Helper
+	bizUC       *biz.VideoScriptUseCase
+	videoAgent  video_script.VideoScriptAgent
+	safetyUc    *biz.SafetyUsecase
+	safetyAgent *safety.SafetyAgent
+	logger      *log.Helper
 }
 
 // NewVideoScriptService 创建短视频脚本服务
@@ -33,6 +38,20 @@ func NewVideoScriptService(
 	}
 }
 
+// NewVideoScriptServiceWithSafety 创建发布前进行内容安全审核的短视频脚本服务
+func NewVideoScriptServiceWithSafety(
+	bizUC *biz.VideoScriptUseCase,
+	videoAgent video_script.VideoScriptAgent,
+	safetyUc *biz.SafetyUsecase,
+	llmClient llm.LLMClient,
+	logger log.Logger,
+) *VideoScriptService {
+	service := NewVideoScriptService(bizUC, videoAgent, logger)
+	service.safetyUc = safetyUc
+	service.safetyAgent = safety.NewSafetyAgent(llmClient)
+	return service
+}
+
 // GenerateVideoScript 生成短视频脚本
 func (s *VideoScriptService) GenerateVideoScript(ctx context.Context, req *pb.GenerateVideoScriptRequest) (*pb.GenerateVideoScriptResponse, error) {
 	s.logger.WithContext(ctx).Infof("Generating video script for project: %s, chapter: %s", req.ProjectId, req.ChapterId)
@@ -211,6 +230,121 @@ func (s *VideoScriptService) DeleteVideoScript(ctx context.Context, req *pb.Dele
 	}, nil
 }
 
+// ReviewVideoScript 内容安全审核，按分镜标注风险并给出内容分级
+func (s *VideoScriptService) ReviewVideoScript(ctx context.Context, req *pb.ReviewVideoScriptRequest) (*pb.ReviewVideoScriptResponse, error) {
+	s.logger.WithContext(ctx).Infof("Reviewing video script: %s", req.ScriptId)
+
+	if s.safetyUc == nil {
+		return nil, fmt.Errorf("content safety service not available")
+	}
+	script, err := s.bizUC.GetVideoScript(ctx, req.ScriptId)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.safetyAgent.ReviewScript(ctx, &safety.ReviewScriptRequest{
+		Script:     script,
+		Dictionary: s.safetyUc.Dictionary(),
+		UseLLM:     req.UseLlm,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.safetyUc.Apply(report)
+
+	return convertSceneSafetyReportToProto(report), nil
+}
+
+// PublishVideoScript 发布视频脚本，分镜分级达到阈值时拒绝发布
+func (s *VideoScriptService) PublishVideoScript(ctx context.Context, req *pb.PublishVideoScriptRequest) (*pb.PublishVideoScriptResponse, error) {
+	s.logger.WithContext(ctx).Infof("Publishing video script: %s", req.ScriptId)
+
+	script, err := s.bizUC.GetVideoScript(ctx, req.ScriptId)
+	if err != nil {
+		return nil, err
+	}
+
+	var review *pb.ReviewVideoScriptResponse
+	if s.safetyUc != nil {
+		report, err := s.reviewBeforePublish(ctx, script)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.safetyUc.Enforce(report); err != nil {
+			s.logger.WithContext(ctx).Warnf("Blocked publishing video script %s: rating=%s", script.ID, report.Rating)
+			return nil, err
+		}
+		review = convertSceneSafetyReportToProto(report)
+	}
+
+	script.Status = "published"
+	script.UpdatedAt = time.Now()
+	updated, err := s.bizUC.UpdateVideoScript(ctx, script)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("Failed to publish video script: %v", err)
+		return nil, err
+	}
+
+	return &pb.PublishVideoScriptResponse{
+		VideoScript: convertVideoScriptToProto(updated),
+		Review:      review,
+	}, nil
+}
+
+// reviewBeforePublish 发布前审核，按配置调用模型分类，模型不可用时只使用词库
+func (s *VideoScriptService) reviewBeforePublish(ctx context.Context, script *models.VideoScript) (*models.SafetyReport, error) {
+	req := &safety.ReviewScriptRequest{
+		Script:     script,
+		Dictionary: s.safetyUc.Dictionary(),
+		UseLLM:     s.safetyUc.UseLLM(),
+	}
+	report, err := s.safetyAgent.ReviewScript(ctx, req)
+	if err != nil && req.UseLLM {
+		s.logger.WithContext(ctx).Warnf("Failed to classify video script %s, falling back to dictionary: %v", script.ID, err)
+		req.UseLLM = false
+		report, err = s.safetyAgent.ReviewScript(ctx, req)
+	}
+	return report, err
+}
+
+func convertSceneSafetyReportToProto(report *models.SafetyReport) *pb.ReviewVideoScriptResponse {
+	resp := &pb.ReviewVideoScriptResponse{
+		Rating:      report.Rating,
+		BlockRating: report.BlockRating,
+		Blocked:     report.Blocked,
+		UsedLlm:     report.UsedLLM,
+		CheckedAt:   report.CheckedAt.Unix(),
+	}
+	for _, review := range report.Reviews {
+		pbReview := &pb.SceneSafetyReview{
+			SceneIndex: int32(review.SceneIndex),
+			Rating:     review.Rating,
+			Blocked:    review.Blocked,
+		}
+		for _, label := range review.Labels {
+			pbReview.Labels = append(pbReview.Labels, &pb.SafetyLabel{
+				Category: label.Category,
+				Level:    label.Level,
+				HitCount: int32(label.HitCount),
+				Reason:   label.Reason,
+			})
+		}
+		for _, hit := range review.Hits {
+			pbReview.Hits = append(pbReview.Hits, &pb.SafetyHit{
+				Category: hit.Category,
+				Severity: hit.Severity,
+				Term:     hit.Term,
+				Quote:    hit.Quote,
+				Start:    int32(hit.Start),
+				End:      int32(hit.End),
+				Source:   hit.Source,
+			})
+		}
+		resp.Reviews = append(resp.Reviews, pbReview)
+	}
+	return resp
+}
+
 // convertVideoScriptToProto 将业务模型转换为 protobuf 模型
 func convertVideoScriptToProto(script *models.VideoScript) *pb.VideoScript {
 	if script == nil {
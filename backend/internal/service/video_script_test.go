@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	pb "backend/api/video_script/v1"
 	"backend/internal/agent/video_script"
 	"backend/internal/biz"
+	"backend/internal/conf"
 	"backend/internal/pkg/models"
 	"backend/internal/service/mocks"
 
@@ -535,4 +537,57 @@ func TestVideoScriptService_GenerateVideoScript_EdgeCases(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestVideoScriptService_PublishVideoScript(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockVideoScriptRepo(ctrl)
+	logger := log.With(log.NewStdLogger(os.Stdout))
+	uc := biz.NewVideoScriptUseCase(mockRepo, logger)
+	mockAgent := mocks.NewMockVideoScriptAgent(ctrl)
+	safetyUc := biz.NewSafetyUsecase(&conf.Data{Safety: &conf.Data_Safety{BlockRating: models.ContentRatingRestricted}}, logger)
+	service := NewVideoScriptServiceWithSafety(uc, mockAgent, safetyUc, nil, logger)
+
+	newScript := func(narration string) *models.VideoScript {
+		return &models.VideoScript{
+			ID:        "script-id",
+			ProjectID: "test-project-id",
+			Title:     "测试视频脚本",
+			Duration:  30,
+			Status:    "optimized",
+			Scenes: []*models.VideoScriptScene{
+				{Index: 1, Duration: 15, VisualDescription: "雨夜，长街", Narration: "他独自走进巷子"},
+				{Index: 2, Duration: 15, VisualDescription: "巷子深处", Narration: narration},
+			},
+		}
+	}
+
+	t.Run("分级达到阈值时拒绝发布", func(t *testing.T) {
+		mockRepo.EXPECT().GetVideoScript(gomock.Any(), "script-id").Return(newScript("凶手碎尸后逃走"), nil)
+
+		_, err := service.PublishVideoScript(context.Background(), &pb.PublishVideoScriptRequest{ScriptId: "script-id"})
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, biz.ErrContentBlocked))
+	})
+
+	t.Run("审核通过后发布", func(t *testing.T) {
+		mockRepo.EXPECT().GetVideoScript(gomock.Any(), "script-id").Return(newScript("他的衣衫鲜血淋漓"), nil)
+		mockRepo.EXPECT().UpdateVideoScript(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, script *models.VideoScript) (*models.VideoScript, error) {
+				return script, nil
+			})
+
+		resp, err := service.PublishVideoScript(context.Background(), &pb.PublishVideoScriptRequest{ScriptId: "script-id"})
+		assert.NoError(t, err)
+		assert.Equal(t, "published", resp.VideoScript.Status)
+		assert.Equal(t, models.ContentRatingTeen, resp.Review.Rating)
+		assert.False(t, resp.Review.Blocked)
+		assert.Len(t, resp.Review.Reviews, 2)
+		assert.Equal(t, int32(2), resp.Review.Reviews[1].SceneIndex)
+		// 偏移相对于按行拼接的画面描述与旁白
+		assert.Equal(t, "鲜血淋漓", resp.Review.Reviews[1].Hits[0].Quote)
+		assert.Equal(t, int32(9), resp.Review.Reviews[1].Hits[0].Start)
+	})
+}